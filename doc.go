@@ -67,6 +67,11 @@ Call the OpenSearch APIs by invoking the corresponding methods on the client:
 
 	log.Println(res)
 
+The client is safe for concurrent use by multiple goroutines, and is meant to be shared:
+the connection pool, the metrics and the cached cluster version are guarded by the transport.
+The headers set on a request, eg. with WithHTTPHeader, are copied to the HTTP request,
+so the same http.Header can be shared by concurrent requests, as long as it isn't modified.
+
 See the github.com/alphastrikelabs/opensearch-go/opensearchapi package for more information about using the API.
 
 See the github.com/alphastrikelabs/opensearch-go/opensearchtransport package for more information about configuring the transport.
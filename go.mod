@@ -7,5 +7,8 @@ require (
 	github.com/aws/aws-sdk-go-v2 v1.17.8
 	github.com/aws/aws-sdk-go-v2/config v1.18.21
 	github.com/stretchr/testify v1.8.2
+	golang.org/x/crypto v0.6.0
 	golang.org/x/net v0.7.0 // indirect
+	golang.org/x/time v0.3.0
+	gopkg.in/yaml.v3 v3.0.1
 )
@@ -372,6 +372,23 @@ func (f ` + g.Endpoint.MethodWithNamespace() + `) WithBody(v io.Reader) func(*`
 		r.Body = v
 	}
 }
+`)
+		}
+
+		// Generate WithJSONBody method, except for the newline-delimited bodies
+		if b.ContentType != "bulk" {
+			replaces := "the body set with WithBody"
+			if skipRequiredArgs["body"] {
+				replaces = "the body passed to the function"
+			}
+			g.w(`
+// WithJSONBody - the request body, encoded to JSON with the JSON codec of the client; it replaces ` + replaces + `.
+//
+func (f ` + g.Endpoint.MethodWithNamespace() + `) WithJSONBody(v interface{}) func(*` + g.Endpoint.MethodWithNamespace() + `Request) {
+	return func(r *` + g.Endpoint.MethodWithNamespace() + `Request) {
+		r.Body = JSONBody(v)
+	}
+}
 `)
 		}
 	}
@@ -432,6 +449,18 @@ func (f ` + g.Endpoint.MethodWithNamespace() + `) WithErrorTrace() func(*` + g.E
 		r.ErrorTrace = true
 	}
 }
+`)
+
+	g.w(`
+// WithDebug makes the response body pretty-printed and human-readable, and includes the stack trace for errors.
+//
+func (f ` + g.Endpoint.MethodWithNamespace() + `) WithDebug() func(*` + g.Endpoint.MethodWithNamespace() + `Request) {
+	return func(r *` + g.Endpoint.MethodWithNamespace() + `Request) {
+		r.Pretty = true
+		r.Human = true
+		r.ErrorTrace = true
+	}
+}
 `)
 
 	g.w(`
@@ -458,6 +487,36 @@ func (f ` + g.Endpoint.MethodWithNamespace() + `) WithHeader(h map[string]string
 		}
 	}
 }
+`)
+
+	g.w(`
+// WithHTTPHeader adds the headers to the HTTP request, keeping the multiple values of a header.
+//
+func (f ` + g.Endpoint.MethodWithNamespace() + `) WithHTTPHeader(h http.Header) func(*` + g.Endpoint.MethodWithNamespace() + `Request) {
+	return func(r *` + g.Endpoint.MethodWithNamespace() + `Request) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, vv := range h {
+			for _, v := range vv {
+				r.Header.Add(k, v)
+			}
+		}
+	}
+}
+`)
+
+	g.w(`
+// WithAccept sets the media type of the response, eg. "application/yaml"; the default is JSON.
+//
+func (f ` + g.Endpoint.MethodWithNamespace() + `) WithAccept(v string) func(*` + g.Endpoint.MethodWithNamespace() + `Request) {
+	return func(r *` + g.Endpoint.MethodWithNamespace() + `Request) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Accept", v)
+	}
+}
 `)
 
 	// Generate methods for the X-Opaque-ID header
@@ -472,6 +531,36 @@ func (f ` + g.Endpoint.MethodWithNamespace() + `) WithOpaqueID(s string) func(*`
 		r.Header.Set("X-Opaque-Id", s)
 	}
 }
+`)
+
+	// Generate methods for the Content-Type header
+	if g.Endpoint.Body != nil {
+		g.w(`
+// WithContentType sets the Content-Type header of the request, overriding the default of the request body.
+//
+func (f ` + g.Endpoint.MethodWithNamespace() + `) WithContentType(v string) func(*` + g.Endpoint.MethodWithNamespace() + `Request) {
+	return func(r *` + g.Endpoint.MethodWithNamespace() + `Request) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Content-Type", v)
+	}
+}
+`)
+	}
+
+	// Generate methods for the Authorization header
+	g.w(`
+// WithBasicAuth sets the credentials of the request, overriding the credentials of the client.
+//
+func (f ` + g.Endpoint.MethodWithNamespace() + `) WithBasicAuth(username, password string) func(*` + g.Endpoint.MethodWithNamespace() + `Request) {
+	return func(r *` + g.Endpoint.MethodWithNamespace() + `Request) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Authorization", basicAuth(username, password))
+	}
+}
 `)
 }
 
@@ -764,7 +853,7 @@ func (r ` + g.Endpoint.MethodWithNamespace() + `Request) Do(ctx context.Context,
 		httpBody = "nil"
 	}
 
-	g.w(`req, err := newRequest(method, path.String(), ` + httpBody + `)` + "\n")
+	g.w(`req, err := newRequest(transport, method, path.String(), ` + httpBody + `)` + "\n")
 	g.w(`if err != nil {
 		return nil, err
 	}` + "\n\n")
@@ -777,20 +866,20 @@ func (r ` + g.Endpoint.MethodWithNamespace() + `Request) Do(ctx context.Context,
 		req.URL.RawQuery = q.Encode()
 	}` + "\n\n")
 
-	if g.Endpoint.Body != nil {
-		g.w(`if r.Body != nil {
-		req.Header[headerContentType] = headerContentTypeJSON
+	if b := g.Endpoint.Body; b != nil {
+		contentType := "headerContentTypeJSON"
+		if b.ContentType == "bulk" {
+			contentType = "bodyContentType(r.Body)"
+		}
+		g.w(`if r.Body != nil && r.Header.Get(headerContentType) == "" {
+		req.Header[headerContentType] = ` + contentType + `
 	}` + "\n\n")
 	}
 
 	g.w(`if len(r.Header) > 0 {
-		if len(req.Header) == 0 {
-			req.Header = r.Header
-		} else {
-			for k, vv := range r.Header {
-				for _, v := range vv {
-					req.Header.Add(k, v)
-				}
+		for k, vv := range r.Header {
+			for _, v := range vv {
+				req.Header.Add(k, v)
 			}
 		}
 	}` + "\n\n")
@@ -811,6 +900,7 @@ func (r ` + g.Endpoint.MethodWithNamespace() + `Request) Do(ctx context.Context,
 		StatusCode:	res.StatusCode,
 		Body:				res.Body,
 		Header:			res.Header,
+		codec:			JSONCodecOf(transport),
 	}` + "\n")
 	g.w("\n\treturn &response, nil\n")
 
@@ -0,0 +1,62 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+// Package redact replaces the secrets of the JSON bodies written to the logs and the errors.
+package redact
+
+import (
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// defaultKeys are the keys of the JSON values redacted when no keys are passed.
+var defaultKeys = []string{"password", "current_password", "hash"}
+
+// redactedValue replaces the values of the redacted keys.
+const redactedValue = "***"
+
+var regexps sync.Map
+
+// DefaultKeys returns a copy of the keys redacted when no keys are passed.
+func DefaultKeys() []string {
+	return append([]string(nil), defaultKeys...)
+}
+
+// Body returns a copy of the JSON body where the string values of the keys
+// are replaced with "***", eg. {"password":"***"}. The formatting of the body is preserved,
+// and bodies which are not JSON, eg. NDJSON, are redacted as well.
+//
+// The default keys are used when keys is empty.
+func Body(body []byte, keys []string) []byte {
+	if len(keys) == 0 {
+		keys = defaultKeys
+	}
+	if len(body) == 0 {
+		return body
+	}
+	return regexpFor(keys).ReplaceAll(body, []byte(`"$1":"`+redactedValue+`"`))
+}
+
+// regexpFor returns the cached regular expression matching the key-value pairs of the keys.
+func regexpFor(keys []string) *regexp.Regexp {
+	id := strings.Join(keys, "\x00")
+	if re, ok := regexps.Load(id); ok {
+		return re.(*regexp.Regexp)
+	}
+
+	quoted := make([]string, len(keys))
+	for i, k := range keys {
+		quoted[i] = regexp.QuoteMeta(k)
+	}
+	re := regexp.MustCompile(`"(` + strings.Join(quoted, "|") + `)"\s*:\s*"(?:[^"\\]|\\.)*"`)
+
+	regexps.Store(id, re)
+	return re
+}
@@ -0,0 +1,53 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+//go:build !integration
+// +build !integration
+
+package redact
+
+import (
+	"testing"
+)
+
+func TestBody(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		keys []string
+		want string
+	}{
+		{"Default keys", `{"password":"foo","hash":"$2y$12$abc","current_password":"bar","backend_roles":["admin"]}`, nil,
+			`{"password":"***","hash":"***","current_password":"***","backend_roles":["admin"]}`},
+		{"Formatting", "{\n  \"password\" : \"foo\",\n  \"user\": \"bar\"\n}", nil,
+			"{\n  \"password\":\"***\",\n  \"user\": \"bar\"\n}"},
+		{"Escaped quotes", `{"password":"f\"o\\o","user":"bar"}`, nil, `{"password":"***","user":"bar"}`},
+		{"NDJSON", "{\"index\":{}}\n{\"password\":\"foo\"}\n", nil, "{\"index\":{}}\n{\"password\":\"***\"}\n"},
+		{"Custom keys", `{"password":"foo","token":"bar"}`, []string{"token"}, `{"password":"foo","token":"***"}`},
+		{"Not JSON", `password=foo`, nil, `password=foo`},
+		{"Empty", ``, nil, ``},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := string(Body([]byte(tt.body), tt.keys)); got != tt.want {
+				t.Errorf("Unexpected body: want=%q, got=%q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestDefaultKeys(t *testing.T) {
+	keys := DefaultKeys()
+	keys[0] = "user"
+
+	if got := string(Body([]byte(`{"password":"foo","user":"bar"}`), nil)); got != `{"password":"***","user":"bar"}` {
+		t.Errorf("Expected the default keys to be unchanged, got: %s", got)
+	}
+}
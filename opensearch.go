@@ -27,8 +27,13 @@
 package opensearch
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
+	"io/ioutil"
 	"net/http"
 	"net/url"
 	"os"
@@ -70,7 +75,24 @@ type Config struct {
 	Username  string   // Username for HTTP Basic Authentication.
 	Password  string   // Password for HTTP Basic Authentication.
 
-	Header http.Header // Global HTTP request header.
+	Header http.Header // Global HTTP request header; the headers set on a request or its context take precedence.
+
+	// Methods, eg. PUT or DELETE, of the requests to send as POST with the X-HTTP-Method-Override header,
+	// for the proxies and gateways which block them. Default: none.
+	// See the WithMethodOverride option of the APIs to enable it per request.
+	MethodOverride []string
+
+	// Headers to copy from the request context to every request, eg. opensearchtransport.TraceHeaders.
+	// See opensearchtransport.ContextWithPropagatedHeader.
+	PropagateHeaders []string
+
+	// Function setting headers from the request context, eg. the trace headers of its span.
+	// See opensearchtransport.TraceHeaders.
+	InjectHeaders func(ctx context.Context, header http.Header)
+
+	EnableCorrelationID bool          // Set a generated ID in the correlation header of every request which has none. Default: false.
+	CorrelationIDHeader string        // Name of the correlation header. Default: "X-Opaque-Id".
+	CorrelationIDFunc   func() string // Generator of the correlation IDs. Default: opensearchtransport.NewUUID.
 
 	Signer signer.Signer
 
@@ -79,33 +101,86 @@ type Config struct {
 	// The option is only valid when the transport is not specified, or when it's http.Transport.
 	CACert []byte
 
-	RetryOnStatus        []int // List of status codes for retry. Default: 502, 503, 504.
+	// PEM-encoded certificate and key of the client, for mutual TLS authentication.
+	// Both must be set; an error is returned by NewClient when they can't be loaded.
+	// The options are only valid when the transport is not specified, or when it's http.Transport.
+	ClientCert []byte
+	ClientKey  []byte
+
+	// Disable the verification of the server certificate; use only for testing.
+	// The option is only valid when the transport is not specified, or when it's http.Transport.
+	InsecureSkipVerify bool
+
+	// Connection settings of the HTTP transport.
+	// The options are only valid when the transport is not specified, or when it's http.Transport.
+	MaxIdleConnsPerHost int           // Maximum idle connections to keep per host. Default: http.DefaultMaxIdleConnsPerHost.
+	IdleConnTimeout     time.Duration // Close the connections idle for longer than the duration. Default: 90s.
+	ForceHTTP2          bool          // Attempt HTTP/2 even with a custom TLS or dial configuration. Default: false.
+	DialTimeout         time.Duration // Timeout of the connection to a node, independent of the request context. Default: 30s.
+	TLSHandshakeTimeout time.Duration // Timeout of the TLS handshake, independent of the request context. Default: 10s.
+
+	RetryOnStatus        []int // List of status codes for retry. Default: 502, 503, 504, 429.
 	DisableRetry         bool  // Default: false.
 	EnableRetryOnTimeout bool  // Default: false.
+	RetryOnlyUnprocessed bool  // Retry non-idempotent requests only when not processed by the server. Default: false.
 	MaxRetries           int   // Default: 3.
 
+	RequestTimeout time.Duration // Timeout for requests which context has no deadline. Default: disabled.
+
+	ResponseCacheTTL   time.Duration // Cache the successful GET responses of the security API for the duration. Default: disabled.
+	ResponseCachePaths []string      // Path prefixes of the cached GET requests. Default: "/_plugins/_security/api/".
+
 	CompressRequestBody bool // Default: false.
 
+	MaxResponseBodySize int64 // Fail reading response bodies larger than the size, in bytes. Default: unlimited.
+
+	// Detect the version of the cluster to send the cluster manager timeouts in the parameter it accepts.
+	EnableVersionDetection bool
+
 	DiscoverNodesOnStart  bool          // Discover nodes when initializing the client. Default: false.
 	DiscoverNodesInterval time.Duration // Discover nodes periodically. Default: disabled.
 
 	EnableMetrics     bool // Enable the metrics collection.
 	EnableDebugLogger bool // Enable the debug logging.
 
+	SlowRequestThreshold time.Duration                       // Log requests slower than the threshold. Default: disabled.
+	SlowRequestLogger    opensearchtransport.DebuggingLogger // The slow request logger. Default: os.Stderr.
+
 	RetryBackoff func(attempt int) time.Duration // Optional backoff duration. Default: nil.
 
+	// Limit the requests of the client to the number per second, with bursts of up to RateLimitBurst requests.
+	// The requests wait for the limiter, or until their context is done. Default: disabled, burst of 1.
+	RateLimit      float64
+	RateLimitBurst int
+
+	// Open the circuit breaker after the number of consecutive failed requests, ie. with a network error
+	// or a 5xx response, within the window, if set; the requests then fail with opensearchtransport.ErrCircuitOpen
+	// until the cooldown has elapsed, default 30s. Default: disabled.
+	CircuitBreakerThreshold int
+	CircuitBreakerWindow    time.Duration
+	CircuitBreakerCooldown  time.Duration
+
+	// Optional function deciding whether to retry a 429 response, and the backoff duration.
+	// Default: opensearchtransport.DefaultRetryOnRejection, which doesn't retry circuit breaker trips.
+	RetryOnRejection func(rejection opensearchtransport.Rejection, attempt int) (time.Duration, bool)
+
 	Transport http.RoundTripper            // The HTTP transport object.
 	Logger    opensearchtransport.Logger   // The logger object.
 	Selector  opensearchtransport.Selector // The selector object.
 
 	// Optional constructor function for a custom ConnectionPool. Default: nil.
 	ConnectionPoolFunc func([]*opensearchtransport.Connection, opensearchtransport.Selector) opensearchtransport.ConnectionPool
+
+	// Optional JSON codec of the typed request bodies and the opensearchutil helpers. Default: encoding/json.
+	JSONCodec opensearchapi.JSONCodec
 }
 
-// Client represents the OpenSearch client.
+// Client represents the OpenSearch client. It's safe for concurrent use by multiple goroutines.
 type Client struct {
 	*opensearchapi.API // Embeds the API methods
 	Transport          opensearchtransport.Interface
+
+	jsonCodec opensearchapi.JSONCodec
 }
 
 type esVersion struct {
@@ -131,6 +206,21 @@ func NewDefaultClient() (*Client, error) {
 	return NewClient(Config{})
 }
 
+// NewAdminClient creates a new client authenticated with the admin certificate
+// of the security plugin, the PEM-encoded cert and key, instead of the credentials in cfg.
+//
+// The admin certificate is required by the APIs which change the security configuration
+// directly, eg. Security.Migrate; they are rejected for the users authenticated with
+// basic auth, even with the all_access role.
+func NewAdminClient(cfg Config, cert, key []byte) (*Client, error) {
+	cfg.Username = ""
+	cfg.Password = ""
+	cfg.ClientCert = cert
+	cfg.ClientKey = key
+
+	return NewClient(cfg)
+}
+
 // NewClient creates a new client with configuration from cfg.
 //
 // It will use http://localhost:9200 as the default address.
@@ -177,19 +267,56 @@ func NewClient(cfg Config) (*Client, error) {
 		Header: cfg.Header,
 		CACert: cfg.CACert,
 
+		ClientCert:         cfg.ClientCert,
+		ClientKey:          cfg.ClientKey,
+		InsecureSkipVerify: cfg.InsecureSkipVerify,
+
+		MaxIdleConnsPerHost: cfg.MaxIdleConnsPerHost,
+		IdleConnTimeout:     cfg.IdleConnTimeout,
+		ForceHTTP2:          cfg.ForceHTTP2,
+		DialTimeout:         cfg.DialTimeout,
+		TLSHandshakeTimeout: cfg.TLSHandshakeTimeout,
+
+		PropagateHeaders: cfg.PropagateHeaders,
+		InjectHeaders:    cfg.InjectHeaders,
+		MethodOverride:   cfg.MethodOverride,
+
+		EnableCorrelationID: cfg.EnableCorrelationID,
+		CorrelationIDHeader: cfg.CorrelationIDHeader,
+		CorrelationIDFunc:   cfg.CorrelationIDFunc,
+
 		Signer: cfg.Signer,
 
 		RetryOnStatus:        cfg.RetryOnStatus,
 		DisableRetry:         cfg.DisableRetry,
 		EnableRetryOnTimeout: cfg.EnableRetryOnTimeout,
+		RetryOnlyUnprocessed: cfg.RetryOnlyUnprocessed,
+		RequestTimeout:       cfg.RequestTimeout,
+		ResponseCacheTTL:     cfg.ResponseCacheTTL,
+		ResponseCachePaths:   cfg.ResponseCachePaths,
 		MaxRetries:           cfg.MaxRetries,
 		RetryBackoff:         cfg.RetryBackoff,
+		RetryOnRejection:     cfg.RetryOnRejection,
+
+		RateLimit:      cfg.RateLimit,
+		RateLimitBurst: cfg.RateLimitBurst,
+
+		CircuitBreakerThreshold: cfg.CircuitBreakerThreshold,
+		CircuitBreakerWindow:    cfg.CircuitBreakerWindow,
+		CircuitBreakerCooldown:  cfg.CircuitBreakerCooldown,
 
 		CompressRequestBody: cfg.CompressRequestBody,
 
+		MaxResponseBodySize: cfg.MaxResponseBodySize,
+
+		EnableVersionDetection: cfg.EnableVersionDetection,
+
 		EnableMetrics:     cfg.EnableMetrics,
 		EnableDebugLogger: cfg.EnableDebugLogger,
 
+		SlowRequestThreshold: cfg.SlowRequestThreshold,
+		SlowRequestLogger:    cfg.SlowRequestLogger,
+
 		DiscoverNodesInterval: cfg.DiscoverNodesInterval,
 
 		Transport:          cfg.Transport,
@@ -201,7 +328,7 @@ func NewClient(cfg Config) (*Client, error) {
 		return nil, fmt.Errorf("error creating transport: %s", err)
 	}
 
-	client := &Client{Transport: tp}
+	client := &Client{Transport: tp, jsonCodec: cfg.JSONCodec}
 	client.API = opensearchapi.New(client)
 
 	if cfg.DiscoverNodesOnStart {
@@ -259,6 +386,51 @@ func (c *Client) Perform(req *http.Request) (*http.Response, error) {
 	return c.Transport.Perform(req)
 }
 
+// Do performs a request to an endpoint without a typed API, eg. of a plugin, with the same authentication,
+// headers, signing and retries as the typed APIs, see opensearchapi.GenericRequest.
+//
+// When out is not nil, the body of a successful response is decoded into out with the JSON codec
+// of the client, and closed. Otherwise, the caller must close the body of the response.
+func (c *Client) Do(ctx context.Context, method, path string, body io.Reader, out interface{}) (*opensearchapi.Response, error) {
+	res, err := opensearchapi.GenericRequest{Method: method, Path: path, Body: body}.Do(ctx, c)
+	if err != nil || out == nil {
+		return res, err
+	}
+	defer res.Close()
+
+	b, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return res, fmt.Errorf("cannot read response body: %s", err)
+	}
+	if err := c.JSONCodec().Unmarshal(b, out); err != nil {
+		return res, fmt.Errorf("cannot decode response body: %s", err)
+	}
+	return res, nil
+}
+
+// JSONCodec returns the JSON codec of the client, see Config.JSONCodec.
+func (c *Client) JSONCodec() opensearchapi.JSONCodec {
+	if c.jsonCodec == nil {
+		return opensearchapi.DefaultJSONCodec
+	}
+	return c.jsonCodec
+}
+
+// MarshalIndented encodes v to JSON with the JSON codec of the client, and indents it with indent, eg. "  ".
+// The encoded JSON is indented after the encoding, so it works with any codec.
+func (c *Client) MarshalIndented(v interface{}, indent string) ([]byte, error) {
+	b, err := c.JSONCodec().Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	if err := json.Indent(&buf, b, "", indent); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
 // Metrics returns the client metrics.
 func (c *Client) Metrics() (opensearchtransport.Metrics, error) {
 	if mt, ok := c.Transport.(opensearchtransport.Measurable); ok {
@@ -30,6 +30,7 @@
 package opensearch
 
 import (
+	"context"
 	"errors"
 	"io/ioutil"
 	"net/http"
@@ -41,6 +42,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/alphastrikelabs/opensearch-go/v2/opensearchapi"
 	"github.com/alphastrikelabs/opensearch-go/v2/opensearchtransport"
 )
 
@@ -206,6 +208,31 @@ func TestClientConfiguration(t *testing.T) {
 	})
 }
 
+func TestNewAdminClient(t *testing.T) {
+	cert, _ := ioutil.ReadFile("opensearchtransport/testdata/cert.pem")
+	key, _ := ioutil.ReadFile("opensearchtransport/testdata/key.pem")
+
+	t.Run("Valid certificate", func(t *testing.T) {
+		c, err := NewAdminClient(Config{Username: "foo", Password: "bar"}, cert, key)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if c.Transport == nil {
+			t.Errorf("Expected the transport to be set")
+		}
+	})
+
+	t.Run("Invalid certificate", func(t *testing.T) {
+		_, err := NewAdminClient(Config{}, cert, cert)
+		if err == nil {
+			t.Fatalf("Expected error")
+		}
+		if !strings.Contains(err.Error(), "unable to load client certificate") {
+			t.Errorf("Unexpected error: %s", err)
+		}
+	})
+}
+
 func TestClientInterface(t *testing.T) {
 	t.Run("Transport", func(t *testing.T) {
 		c, err := NewClient(Config{Transport: &mockTransp{}})
@@ -226,6 +253,68 @@ func TestClientInterface(t *testing.T) {
 	})
 }
 
+func TestClientDo(t *testing.T) {
+	c, _ := NewClient(Config{
+		Header:       http.Header{"X-Team": []string{"search"}},
+		DisableRetry: true,
+		Transport: &mockTransp{
+			RoundTripFunc: func(req *http.Request) (*http.Response, error) {
+				if req.URL.Path == "/_plugins/_ml/models/missing" {
+					return &http.Response{StatusCode: http.StatusNotFound, Body: ioutil.NopCloser(strings.NewReader(`{}`))}, nil
+				}
+
+				b, _ := ioutil.ReadAll(req.Body)
+				if req.Method != "POST" || req.URL.String() != "http://localhost:9200/_plugins/_ml/models/_search?size=1" {
+					t.Errorf("Unexpected request: %s %s", req.Method, req.URL)
+				}
+				if string(b) != `{"query":{"match_all":{}}}` {
+					t.Errorf("Unexpected body: %s", b)
+				}
+				if req.Header.Get("Content-Type") != "application/json" || req.Header.Get("X-Team") != "search" {
+					t.Errorf("Unexpected headers: %v", req.Header)
+				}
+				return &http.Response{StatusCode: http.StatusOK, Body: ioutil.NopCloser(strings.NewReader(`{"hits":{"total":{"value":3}}}`))}, nil
+			},
+		},
+	})
+
+	var out struct {
+		Hits struct {
+			Total struct {
+				Value int `json:"value"`
+			} `json:"total"`
+		} `json:"hits"`
+	}
+	res, err := c.Do(context.Background(), "POST", "/_plugins/_ml/models/_search?size=1", strings.NewReader(`{"query":{"match_all":{}}}`), &out)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if res.StatusCode != http.StatusOK || out.Hits.Total.Value != 3 {
+		t.Errorf("Unexpected response: %d, %+v", res.StatusCode, out)
+	}
+
+	_, err = c.Do(context.Background(), "GET", "/_plugins/_ml/models/missing", nil, &out)
+	if !errors.Is(err, opensearchapi.ErrNotFound) {
+		t.Errorf("Expected ErrNotFound, got: %v", err)
+	}
+}
+
+func TestClientMarshalIndented(t *testing.T) {
+	c, _ := NewClient(Config{})
+
+	b, err := c.MarshalIndented(map[string]interface{}{"acknowledged": true, "shards": []int{1}}, "\t")
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if want := "{\n\t\"acknowledged\": true,\n\t\"shards\": [\n\t\t1\n\t]\n}"; string(b) != want {
+		t.Errorf("Unexpected output:\n%s", b)
+	}
+
+	if _, err := c.MarshalIndented(make(chan int), "  "); err == nil {
+		t.Errorf("Expected error")
+	}
+}
+
 func TestAddrsToURLs(t *testing.T) {
 	tt := []struct {
 		name  string
@@ -0,0 +1,115 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+//go:build !integration
+// +build !integration
+
+package opensearch
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/alphastrikelabs/opensearch-go/v2/opensearchapi"
+)
+
+// TestClientConcurrency shares a client, and the headers of the requests, between goroutines;
+// run it with -race to detect the data races.
+func TestClientConcurrency(t *testing.T) {
+	c, err := NewClient(Config{
+		Addresses:              []string{"http://node-1:9200", "http://node-2:9200"},
+		Header:                 http.Header{"X-Team": []string{"search"}},
+		EnableMetrics:          true,
+		EnableCorrelationID:    true,
+		EnableVersionDetection: true,
+		PropagateHeaders:       []string{"Traceparent"},
+		Transport: &mockTransp{
+			RoundTripFunc: func(req *http.Request) (*http.Response, error) {
+				body := `{"status":"OK"}`
+				switch req.URL.Path {
+				case "/":
+					body = `{"version":{"distribution":"opensearch","number":"2.5.0"}}`
+				case "/_nodes/http":
+					body = `{"nodes":{"1":{"roles":["data"],"http":{"publish_address":"node-1:9200"}},` +
+						`"2":{"roles":["data"],"http":{"publish_address":"node-2:9200"}}}}`
+				}
+				if req.Method == http.MethodGet && strings.HasPrefix(req.URL.Path, "/_plugins/_security/api/roles/") {
+					body = `{"readers":{"cluster_permissions":["cluster_monitor"]}}`
+				}
+				return &http.Response{StatusCode: http.StatusOK, Header: http.Header{}, Body: ioutil.NopCloser(strings.NewReader(body))}, nil
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	var (
+		wg     sync.WaitGroup
+		header = http.Header{"X-Request-Source": []string{"race-test"}}
+		role   = opensearchapi.RoleBody{ClusterPermissions: []string{"cluster_monitor"}}
+	)
+	for i := 0; i < 200; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			name := fmt.Sprintf("role-%d", i%10)
+
+			res, err := opensearchapi.RoleCreateRequest{
+				Role:                  name,
+				RoleBody:              &role,
+				ClusterManagerTimeout: time.Second,
+				Header:                header,
+			}.Do(context.Background(), c)
+			if err != nil {
+				t.Errorf("Unexpected error: %s", err)
+				return
+			}
+			res.Close()
+
+			res, err = c.Role.GetRole(c.Role.GetRole.WithRole(name), c.Role.GetRole.WithHTTPHeader(header))
+			if err != nil {
+				t.Errorf("Unexpected error: %s", err)
+				return
+			}
+			res.Close()
+
+			res, err = opensearchapi.RoleGetRequest{Role: name, Header: header}.Do(context.Background(), c)
+			if err != nil {
+				t.Errorf("Unexpected error: %s", err)
+				return
+			}
+			res.Close()
+
+			if i%20 == 0 {
+				if err := c.DiscoverNodes(); err != nil {
+					t.Errorf("Unexpected error: %s", err)
+				}
+				m, _ := c.Metrics()
+				for status := range m.Responses {
+					m.Responses[status]++
+				}
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	if v := header.Get("User-Agent"); v != "" {
+		t.Errorf("Expected the shared header not to be modified, got User-Agent: %q", v)
+	}
+	if m, _ := c.Metrics(); m.Requests < 600 || m.Responses[http.StatusOK] != m.Requests {
+		t.Errorf("Unexpected metrics: %d requests, responses: %v", m.Requests, m.Responses)
+	}
+}
@@ -32,6 +32,8 @@ type API struct {
 	Cluster     *Cluster
 	Indices     *Indices
 	Role        *Role
+	Security    *Security
+	ISM         *ISM
 	Ingest      *Ingest
 	Nodes       *Nodes
 	Remote      *Remote
@@ -185,12 +187,41 @@ type Indices struct {
 
 // Role contains the Role APIs
 type Role struct {
+	GetRole           RoleGet
 	CreateRole        RoleCreate
 	DeleteRole        RoleDelete
+	GetRoleMapping    RoleMappingGet
 	CreateRoleMapping RoleMappingCreate
 	DeleteRoleMapping RoleMappingDelete
 }
 
+// Security contains the Security plugin APIs
+type Security struct {
+	GetNodesDN    NodesDNGet
+	UpdateNodesDN NodesDNUpdate
+	DeleteNodesDN NodesDNDelete
+	Migrate       SecurityMigrate
+	Validate      SecurityValidate
+
+	GetTenancyConfig    TenancyConfigGet
+	UpdateTenancyConfig TenancyConfigUpdate
+
+	GetInternalUser    InternalUserGet
+	CreateInternalUser InternalUserCreate
+	DeleteInternalUser InternalUserDelete
+
+	GetAuditConfig    AuditConfigGet
+	UpdateAuditConfig AuditConfigUpdate
+}
+
+// ISM contains the Index State Management plugin APIs
+type ISM struct {
+	CreatePolicy ISMPolicyCreate
+	GetPolicy    ISMPolicyGet
+	DeletePolicy ISMPolicyDelete
+	Explain      ISMExplain
+}
+
 // Ingest contains the Ingest APIs
 type Ingest struct {
 	DeletePipeline IngestDeletePipeline
@@ -380,8 +411,35 @@ func New(t Transport) *API {
 			ValidateQuery:         newIndicesValidateQueryFunc(t),
 		},
 		Role: &Role{
+			GetRole:           newRoleGetFunc(t),
 			CreateRole:        newRoleCreateFunc(t),
+			DeleteRole:        newRoleDeleteFunc(t),
+			GetRoleMapping:    newRoleMappingGetFunc(t),
 			CreateRoleMapping: newRoleMappingCreateFunc(t),
+			DeleteRoleMapping: newRoleMappingDeleteFunc(t),
+		},
+		Security: &Security{
+			GetNodesDN:    newNodesDNGetFunc(t),
+			UpdateNodesDN: newNodesDNUpdateFunc(t),
+			DeleteNodesDN: newNodesDNDeleteFunc(t),
+			Migrate:       newSecurityMigrateFunc(t),
+			Validate:      newSecurityValidateFunc(t),
+
+			GetTenancyConfig:    newTenancyConfigGetFunc(t),
+			UpdateTenancyConfig: newTenancyConfigUpdateFunc(t),
+
+			GetInternalUser:    newInternalUserGetFunc(t),
+			CreateInternalUser: newInternalUserCreateFunc(t),
+			DeleteInternalUser: newInternalUserDeleteFunc(t),
+
+			GetAuditConfig:    newAuditConfigGetFunc(t),
+			UpdateAuditConfig: newAuditConfigUpdateFunc(t),
+		},
+		ISM: &ISM{
+			CreatePolicy: newISMPolicyCreateFunc(t),
+			GetPolicy:    newISMPolicyGetFunc(t),
+			DeletePolicy: newISMPolicyDeleteFunc(t),
+			Explain:      newISMExplainFunc(t),
 		},
 		Ingest: &Ingest{
 			DeletePipeline: newIngestDeletePipelineFunc(t),
@@ -0,0 +1,259 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchapi
+
+import (
+	"context"
+	"net/http"
+	"strings"
+)
+
+func newAuditConfigGetFunc(t Transport) AuditConfigGet {
+	return func(o ...func(*AuditConfigGetRequest)) (*Response, error) {
+		var r = AuditConfigGetRequest{}
+		for _, f := range o {
+			f(&r)
+		}
+		return r.Do(r.ctx, t)
+	}
+}
+
+// ----- API Definition -------------------------------------------------------
+
+// AuditConfigGet returns the audit logging configuration of the cluster.
+type AuditConfigGet func(o ...func(*AuditConfigGetRequest)) (*Response, error)
+
+// AuditConfigGetRequest configures the Audit Config Get API request.
+type AuditConfigGetRequest struct {
+	QueryParams map[string]string
+
+	Pretty     bool
+	Human      bool
+	ErrorTrace bool
+	FilterPath []string
+
+	Header http.Header
+
+	ctx context.Context
+}
+
+// AuditConfigGetResponse represents the Audit Config Get API response.
+type AuditConfigGetResponse struct {
+	ReadOnly []string    `json:"_readonly,omitempty"`
+	Config   AuditConfig `json:"config"`
+}
+
+// ParseAuditConfigGetResponse decodes the body of an Audit Config Get API response and closes it.
+func ParseAuditConfigGetResponse(res *Response) (*AuditConfigGetResponse, error) {
+	var ar AuditConfigGetResponse
+	if err := parseSecurityResponse(res, "audit config get", &ar); err != nil {
+		return nil, err
+	}
+	return &ar, nil
+}
+
+// Do executes the request and returns response or error.
+func (r AuditConfigGetRequest) Do(ctx context.Context, transport Transport) (*Response, error) {
+	var (
+		method string
+		path   strings.Builder
+		params map[string]string
+	)
+
+	method = "GET"
+
+	path.Grow(len("/_plugins/_security/api/audit"))
+	path.WriteString("/_plugins/_security/api/audit")
+
+	params = make(map[string]string)
+
+	if r.Pretty {
+		params["pretty"] = "true"
+	}
+
+	if r.Human {
+		params["human"] = "true"
+	}
+
+	if r.ErrorTrace {
+		params["error_trace"] = "true"
+	}
+
+	if len(r.FilterPath) > 0 {
+		params["filter_path"] = strings.Join(r.FilterPath, ",")
+	}
+
+	for k, v := range r.QueryParams {
+		if _, ok := params[k]; !ok {
+			params[k] = v
+		}
+	}
+
+	req, err := newRequest(transport, method, path.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(params) > 0 {
+		q := req.URL.Query()
+		for k, v := range params {
+			q.Set(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	if len(r.Header) > 0 {
+		for k, vv := range r.Header {
+			for _, v := range vv {
+				req.Header.Add(k, v)
+			}
+		}
+	}
+
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	res, err := transport.Perform(req)
+	if err != nil {
+		return nil, err
+	}
+
+	response := Response{
+		StatusCode: res.StatusCode,
+		Body:       res.Body,
+		Header:     res.Header,
+		codec:      JSONCodecOf(transport),
+	}
+
+	return &response, nil
+}
+
+// WithContext sets the request context.
+func (f AuditConfigGet) WithContext(v context.Context) func(*AuditConfigGetRequest) {
+	return func(r *AuditConfigGetRequest) {
+		r.ctx = v
+	}
+}
+
+// WithQueryParam adds a query parameter which has no dedicated option.
+//
+// Parameters set by the other options take precedence.
+func (f AuditConfigGet) WithQueryParam(key, value string) func(*AuditConfigGetRequest) {
+	return func(r *AuditConfigGetRequest) {
+		if r.QueryParams == nil {
+			r.QueryParams = make(map[string]string)
+		}
+		r.QueryParams[key] = value
+	}
+}
+
+// WithPretty makes the response body pretty-printed.
+func (f AuditConfigGet) WithPretty() func(*AuditConfigGetRequest) {
+	return func(r *AuditConfigGetRequest) {
+		r.Pretty = true
+	}
+}
+
+// WithHuman makes statistical values human-readable.
+func (f AuditConfigGet) WithHuman() func(*AuditConfigGetRequest) {
+	return func(r *AuditConfigGetRequest) {
+		r.Human = true
+	}
+}
+
+// WithErrorTrace includes the stack trace for errors in the response body.
+func (f AuditConfigGet) WithErrorTrace() func(*AuditConfigGetRequest) {
+	return func(r *AuditConfigGetRequest) {
+		r.ErrorTrace = true
+	}
+}
+
+// WithDebug makes the response body pretty-printed and human-readable, and includes the stack trace for errors.
+func (f AuditConfigGet) WithDebug() func(*AuditConfigGetRequest) {
+	return func(r *AuditConfigGetRequest) {
+		r.Pretty = true
+		r.Human = true
+		r.ErrorTrace = true
+	}
+}
+
+// WithFilterPath filters the properties of the response body.
+func (f AuditConfigGet) WithFilterPath(v ...string) func(*AuditConfigGetRequest) {
+	return func(r *AuditConfigGetRequest) {
+		r.FilterPath = v
+	}
+}
+
+// WithHeader adds the headers to the HTTP request.
+func (f AuditConfigGet) WithHeader(h map[string]string) func(*AuditConfigGetRequest) {
+	return func(r *AuditConfigGetRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, v := range h {
+			r.Header.Add(k, v)
+		}
+	}
+}
+
+// WithHTTPHeader adds the headers to the HTTP request, keeping the multiple values of a header.
+func (f AuditConfigGet) WithHTTPHeader(h http.Header) func(*AuditConfigGetRequest) {
+	return func(r *AuditConfigGetRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, vv := range h {
+			for _, v := range vv {
+				r.Header.Add(k, v)
+			}
+		}
+	}
+}
+
+// WithAccept sets the media type of the response, eg. "application/yaml"; the default is JSON.
+func (f AuditConfigGet) WithAccept(v string) func(*AuditConfigGetRequest) {
+	return func(r *AuditConfigGetRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Accept", v)
+	}
+}
+
+// WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
+func (f AuditConfigGet) WithOpaqueID(s string) func(*AuditConfigGetRequest) {
+	return func(r *AuditConfigGetRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("X-Opaque-Id", s)
+	}
+}
+
+// WithBasicAuth sets the credentials of the request, overriding the credentials of the client.
+func (f AuditConfigGet) WithBasicAuth(username, password string) func(*AuditConfigGetRequest) {
+	return func(r *AuditConfigGetRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Authorization", basicAuth(username, password))
+	}
+}
+
+// WithNoCache bypasses the response cache of the client, when enabled.
+func (f AuditConfigGet) WithNoCache() func(*AuditConfigGetRequest) {
+	return func(r *AuditConfigGetRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Cache-Control", "no-cache")
+	}
+}
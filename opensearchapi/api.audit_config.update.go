@@ -0,0 +1,345 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchapi
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+func newAuditConfigUpdateFunc(t Transport) AuditConfigUpdate {
+	return func(config AuditConfig, o ...func(*AuditConfigUpdateRequest)) (*Response, error) {
+		var r = AuditConfigUpdateRequest{Config: config}
+		for _, f := range o {
+			f(&r)
+		}
+		return r.Do(r.ctx, t)
+	}
+}
+
+// ----- API Definition -------------------------------------------------------
+
+// AuditConfigUpdate replaces the audit logging configuration of the cluster.
+//
+// The configuration is replaced as a whole; the current configuration is returned by AuditConfigGet.
+type AuditConfigUpdate func(config AuditConfig, o ...func(*AuditConfigUpdateRequest)) (*Response, error)
+
+// AuditConfigUpdateRequest configures the Audit Config Update API request.
+type AuditConfigUpdateRequest struct {
+	Config AuditConfig
+
+	Body io.Reader
+
+	QueryParams map[string]string
+
+	Pretty     bool
+	Human      bool
+	ErrorTrace bool
+	FilterPath []string
+
+	Header http.Header
+
+	ctx context.Context
+}
+
+// AuditConfig represents the audit logging configuration.
+type AuditConfig struct {
+	Enabled    bool            `json:"enabled"`
+	Audit      AuditSettings   `json:"audit"`
+	Compliance AuditCompliance `json:"compliance"`
+}
+
+// AuditSettings represents the settings of the audit logging of the REST and transport layers.
+type AuditSettings struct {
+	EnableREST                  bool     `json:"enable_rest"`
+	DisabledRESTCategories      []string `json:"disabled_rest_categories,omitempty"`
+	EnableTransport             bool     `json:"enable_transport"`
+	DisabledTransportCategories []string `json:"disabled_transport_categories,omitempty"`
+	IgnoreUsers                 []string `json:"ignore_users,omitempty"`
+	IgnoreRequests              []string `json:"ignore_requests,omitempty"`
+	LogRequestBody              bool     `json:"log_request_body"`
+	ResolveIndices              bool     `json:"resolve_indices"`
+	ResolveBulkRequests         bool     `json:"resolve_bulk_requests"`
+	ExcludeSensitiveHeaders     bool     `json:"exclude_sensitive_headers"`
+}
+
+// AuditCompliance represents the settings of the compliance logging.
+type AuditCompliance struct {
+	Enabled             bool                `json:"enabled"`
+	InternalConfig      bool                `json:"internal_config"`
+	ExternalConfig      bool                `json:"external_config"`
+	ReadMetadataOnly    bool                `json:"read_metadata_only"`
+	ReadWatchedFields   map[string][]string `json:"read_watched_fields,omitempty"`
+	ReadIgnoreUsers     []string            `json:"read_ignore_users,omitempty"`
+	WriteMetadataOnly   bool                `json:"write_metadata_only"`
+	WriteLogDiffs       bool                `json:"write_log_diffs"`
+	WriteWatchedIndices []string            `json:"write_watched_indices,omitempty"`
+	WriteIgnoreUsers    []string            `json:"write_ignore_users,omitempty"`
+}
+
+// IsCategoryEnabled returns true when the events of the category, eg. "FAILED_LOGIN",
+// are logged for the REST or the transport layer.
+func (c AuditConfig) IsCategoryEnabled(category string) bool {
+	if !c.Enabled {
+		return false
+	}
+
+	enabled := func(disabled []string) bool {
+		for _, d := range disabled {
+			if strings.EqualFold(d, category) {
+				return false
+			}
+		}
+		return true
+	}
+	return (c.Audit.EnableREST && enabled(c.Audit.DisabledRESTCategories)) ||
+		(c.Audit.EnableTransport && enabled(c.Audit.DisabledTransportCategories))
+}
+
+// Do executes the request and returns response or error.
+func (r AuditConfigUpdateRequest) Do(ctx context.Context, transport Transport) (*Response, error) {
+	var (
+		method string
+		path   strings.Builder
+		params map[string]string
+	)
+
+	method = "PUT"
+
+	path.Grow(len("/_plugins/_security/api/audit/config"))
+	path.WriteString("/_plugins/_security/api/audit/config")
+
+	params = make(map[string]string)
+
+	if r.Pretty {
+		params["pretty"] = "true"
+	}
+
+	if r.Human {
+		params["human"] = "true"
+	}
+
+	if r.ErrorTrace {
+		params["error_trace"] = "true"
+	}
+
+	if len(r.FilterPath) > 0 {
+		params["filter_path"] = strings.Join(r.FilterPath, ",")
+	}
+
+	for k, v := range r.QueryParams {
+		if _, ok := params[k]; !ok {
+			params[k] = v
+		}
+	}
+
+	var body io.Reader = r.Body
+	if body == nil {
+		b, err := JSONCodecOf(transport).Marshal(r.Config)
+		if err != nil {
+			return nil, fmt.Errorf("cannot encode audit config: %s", err)
+		}
+		body = bytes.NewReader(b)
+	}
+
+	req, err := newRequest(transport, method, path.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(params) > 0 {
+		q := req.URL.Query()
+		for k, v := range params {
+			q.Set(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	if r.Header.Get(headerContentType) == "" {
+		req.Header[headerContentType] = headerContentTypeJSON
+	}
+
+	if len(r.Header) > 0 {
+		for k, vv := range r.Header {
+			for _, v := range vv {
+				req.Header.Add(k, v)
+			}
+		}
+	}
+
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	res, err := transport.Perform(req)
+	if err != nil {
+		return nil, err
+	}
+
+	response := Response{
+		StatusCode: res.StatusCode,
+		Body:       res.Body,
+		Header:     res.Header,
+		codec:      JSONCodecOf(transport),
+	}
+
+	return &response, nil
+}
+
+// WithContext sets the request context.
+func (f AuditConfigUpdate) WithContext(v context.Context) func(*AuditConfigUpdateRequest) {
+	return func(r *AuditConfigUpdateRequest) {
+		r.ctx = v
+	}
+}
+
+// WithBody - The request body, it replaces the configuration passed to the function.
+func (f AuditConfigUpdate) WithBody(v io.Reader) func(*AuditConfigUpdateRequest) {
+	return func(r *AuditConfigUpdateRequest) {
+		r.Body = v
+	}
+}
+
+// WithJSONBody - the request body, encoded to JSON with the JSON codec of the client; it replaces the body set with WithBody.
+func (f AuditConfigUpdate) WithJSONBody(v interface{}) func(*AuditConfigUpdateRequest) {
+	return func(r *AuditConfigUpdateRequest) {
+		r.Body = JSONBody(v)
+	}
+}
+
+// WithQueryParam adds a query parameter which has no dedicated option.
+//
+// Parameters set by the other options take precedence.
+func (f AuditConfigUpdate) WithQueryParam(key, value string) func(*AuditConfigUpdateRequest) {
+	return func(r *AuditConfigUpdateRequest) {
+		if r.QueryParams == nil {
+			r.QueryParams = make(map[string]string)
+		}
+		r.QueryParams[key] = value
+	}
+}
+
+// WithPretty makes the response body pretty-printed.
+func (f AuditConfigUpdate) WithPretty() func(*AuditConfigUpdateRequest) {
+	return func(r *AuditConfigUpdateRequest) {
+		r.Pretty = true
+	}
+}
+
+// WithHuman makes statistical values human-readable.
+func (f AuditConfigUpdate) WithHuman() func(*AuditConfigUpdateRequest) {
+	return func(r *AuditConfigUpdateRequest) {
+		r.Human = true
+	}
+}
+
+// WithErrorTrace includes the stack trace for errors in the response body.
+func (f AuditConfigUpdate) WithErrorTrace() func(*AuditConfigUpdateRequest) {
+	return func(r *AuditConfigUpdateRequest) {
+		r.ErrorTrace = true
+	}
+}
+
+// WithDebug makes the response body pretty-printed and human-readable, and includes the stack trace for errors.
+func (f AuditConfigUpdate) WithDebug() func(*AuditConfigUpdateRequest) {
+	return func(r *AuditConfigUpdateRequest) {
+		r.Pretty = true
+		r.Human = true
+		r.ErrorTrace = true
+	}
+}
+
+// WithFilterPath filters the properties of the response body.
+func (f AuditConfigUpdate) WithFilterPath(v ...string) func(*AuditConfigUpdateRequest) {
+	return func(r *AuditConfigUpdateRequest) {
+		r.FilterPath = v
+	}
+}
+
+// WithHeader adds the headers to the HTTP request.
+func (f AuditConfigUpdate) WithHeader(h map[string]string) func(*AuditConfigUpdateRequest) {
+	return func(r *AuditConfigUpdateRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, v := range h {
+			r.Header.Add(k, v)
+		}
+	}
+}
+
+// WithHTTPHeader adds the headers to the HTTP request, keeping the multiple values of a header.
+func (f AuditConfigUpdate) WithHTTPHeader(h http.Header) func(*AuditConfigUpdateRequest) {
+	return func(r *AuditConfigUpdateRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, vv := range h {
+			for _, v := range vv {
+				r.Header.Add(k, v)
+			}
+		}
+	}
+}
+
+// WithAccept sets the media type of the response, eg. "application/yaml"; the default is JSON.
+func (f AuditConfigUpdate) WithAccept(v string) func(*AuditConfigUpdateRequest) {
+	return func(r *AuditConfigUpdateRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Accept", v)
+	}
+}
+
+// WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
+func (f AuditConfigUpdate) WithOpaqueID(s string) func(*AuditConfigUpdateRequest) {
+	return func(r *AuditConfigUpdateRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("X-Opaque-Id", s)
+	}
+}
+
+// WithContentType sets the Content-Type header of the request, overriding the default of the request body.
+func (f AuditConfigUpdate) WithContentType(v string) func(*AuditConfigUpdateRequest) {
+	return func(r *AuditConfigUpdateRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Content-Type", v)
+	}
+}
+
+// WithBasicAuth sets the credentials of the request, overriding the credentials of the client.
+func (f AuditConfigUpdate) WithBasicAuth(username, password string) func(*AuditConfigUpdateRequest) {
+	return func(r *AuditConfigUpdateRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Authorization", basicAuth(username, password))
+	}
+}
+
+// WithMethodOverride sends the request as POST, with the X-HTTP-Method-Override header set to PUT,
+// for the proxies which block PUT requests.
+func (f AuditConfigUpdate) WithMethodOverride() func(*AuditConfigUpdateRequest) {
+	return func(r *AuditConfigUpdateRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("X-HTTP-Method-Override", "PUT")
+	}
+}
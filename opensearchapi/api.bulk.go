@@ -27,7 +27,10 @@
 package opensearchapi
 
 import (
+	"bytes"
 	"context"
+	"errors"
+	"fmt"
 	"io"
 	"net/http"
 	"strconv"
@@ -69,6 +72,9 @@ type BulkRequest struct {
 	Timeout             time.Duration
 	WaitForActiveShards string
 
+	ValidateBody bool
+	Chunked      bool
+
 	Pretty     bool
 	Human      bool
 	ErrorTrace bool
@@ -79,6 +85,103 @@ type BulkRequest struct {
 	ctx context.Context
 }
 
+// BulkResponse represents the Bulk API response.
+//
+type BulkResponse struct {
+	Took   int                `json:"took"`
+	Errors bool               `json:"errors"`
+	Items  []BulkResponseItem `json:"items"`
+}
+
+// BulkResponseItem represents the result of a single bulk action;
+// only the field matching the action is set.
+//
+type BulkResponseItem struct {
+	Index  *BulkResponseItemResult `json:"index,omitempty"`
+	Create *BulkResponseItemResult `json:"create,omitempty"`
+	Update *BulkResponseItemResult `json:"update,omitempty"`
+	Delete *BulkResponseItemResult `json:"delete,omitempty"`
+}
+
+// BulkResponseItemResult represents the result of a bulk action.
+//
+type BulkResponseItemResult struct {
+	Index       string `json:"_index"`
+	ID          string `json:"_id"`
+	Version     int64  `json:"_version"`
+	Result      string `json:"result"`
+	Status      int    `json:"status"`
+	SeqNo       int64  `json:"_seq_no"`
+	PrimaryTerm int64  `json:"_primary_term"`
+	Shards      struct {
+		Total      int `json:"total"`
+		Successful int `json:"successful"`
+		Failed     int `json:"failed"`
+	} `json:"_shards"`
+	Error *BulkResponseItemError `json:"error,omitempty"`
+}
+
+// BulkResponseItemError represents the error of a failed bulk action.
+//
+type BulkResponseItemError struct {
+	Type     string                 `json:"type"`
+	Reason   string                 `json:"reason"`
+	Index    string                 `json:"index,omitempty"`
+	Shard    string                 `json:"shard,omitempty"`
+	CausedBy *BulkResponseItemError `json:"caused_by,omitempty"`
+}
+
+// Action returns the name and the result of the bulk action.
+//
+func (i BulkResponseItem) Action() (string, *BulkResponseItemResult) {
+	switch {
+	case i.Index != nil:
+		return "index", i.Index
+	case i.Create != nil:
+		return "create", i.Create
+	case i.Update != nil:
+		return "update", i.Update
+	case i.Delete != nil:
+		return "delete", i.Delete
+	}
+	return "", nil
+}
+
+// Failed returns the items with an error, or with a status other than 2xx.
+//
+func (r *BulkResponse) Failed() []BulkResponseItem {
+	var failed []BulkResponseItem
+	for _, item := range r.Items {
+		if _, result := item.Action(); result != nil && (result.Error != nil || result.Status > 299) {
+			failed = append(failed, item)
+		}
+	}
+	return failed
+}
+
+// ParseBulkResponse decodes the body of a Bulk API response and closes it.
+//
+// It returns an error when the request itself failed; failures of single actions
+// are reported in the items, see BulkResponse.Failed.
+//
+func ParseBulkResponse(res *Response) (*BulkResponse, error) {
+	if res == nil || res.Body == nil {
+		return nil, errors.New("cannot parse bulk response: empty response")
+	}
+	defer res.Close()
+
+	if err := res.Err(); err != nil {
+		return nil, err
+	}
+
+	var br BulkResponse
+	if err := decodeBody(res, &br); err != nil {
+		return nil, fmt.Errorf("cannot parse bulk response: %s", err)
+	}
+
+	return &br, nil
+}
+
 // Do executes the request and returns response or error.
 //
 func (r BulkRequest) Do(ctx context.Context, transport Transport) (*Response, error) {
@@ -105,6 +208,9 @@ func (r BulkRequest) Do(ctx context.Context, transport Transport) (*Response, er
 	}
 
 	if r.Refresh != "" {
+		if err := ValidateRefresh(r.Refresh); err != nil {
+			return nil, err
+		}
 		params["refresh"] = r.Refresh
 	}
 
@@ -137,6 +243,9 @@ func (r BulkRequest) Do(ctx context.Context, transport Transport) (*Response, er
 	}
 
 	if r.WaitForActiveShards != "" {
+		if err := ValidateWaitForActiveShards(r.WaitForActiveShards); err != nil {
+			return nil, err
+		}
 		params["wait_for_active_shards"] = r.WaitForActiveShards
 	}
 
@@ -156,11 +265,32 @@ func (r BulkRequest) Do(ctx context.Context, transport Transport) (*Response, er
 		params["filter_path"] = strings.Join(r.FilterPath, ",")
 	}
 
-	req, err := newRequest(method, path.String(), r.Body)
+	if r.ValidateBody && r.Chunked {
+		return nil, errors.New("cannot validate a chunked bulk body: the validation buffers the whole body")
+	}
+
+	if r.ValidateBody && r.Body != nil {
+		var buf bytes.Buffer
+		if _, err := buf.ReadFrom(r.Body); err != nil {
+			return nil, err
+		}
+		if err := ValidateBulkBody(bytes.NewReader(buf.Bytes())); err != nil {
+			return nil, err
+		}
+		r.Body = &buf
+	}
+
+	req, err := newRequest(transport, method, path.String(), r.Body)
 	if err != nil {
 		return nil, err
 	}
 
+	// Same as opensearchtransport.SetChunked, without depending on the transport package
+	if r.Chunked {
+		req.TransferEncoding = []string{"chunked"}
+		req.ContentLength = -1
+	}
+
 	if len(params) > 0 {
 		q := req.URL.Query()
 		for k, v := range params {
@@ -169,18 +299,14 @@ func (r BulkRequest) Do(ctx context.Context, transport Transport) (*Response, er
 		req.URL.RawQuery = q.Encode()
 	}
 
-	if r.Body != nil {
-		req.Header[headerContentType] = headerContentTypeJSON
+	if r.Body != nil && r.Header.Get(headerContentType) == "" {
+		req.Header[headerContentType] = bodyContentType(r.Body)
 	}
 
 	if len(r.Header) > 0 {
-		if len(req.Header) == 0 {
-			req.Header = r.Header
-		} else {
-			for k, vv := range r.Header {
-				for _, v := range vv {
-					req.Header.Add(k, v)
-				}
+		for k, vv := range r.Header {
+			for _, v := range vv {
+				req.Header.Add(k, v)
 			}
 		}
 	}
@@ -198,6 +324,7 @@ func (r BulkRequest) Do(ctx context.Context, transport Transport) (*Response, er
 		StatusCode: res.StatusCode,
 		Body:       res.Body,
 		Header:     res.Header,
+		codec:      JSONCodecOf(transport),
 	}
 
 	return &response, response.Err()
@@ -229,6 +356,8 @@ func (f Bulk) WithPipeline(v string) func(*BulkRequest) {
 
 // WithRefresh - if `true` then refresh the affected shards to make this operation visible to search, if `wait_for` then wait for a refresh to make this operation visible to search, if `false` (the default) then do nothing with refreshes..
 //
+// See ValidateRefresh for the valid values.
+//
 func (f Bulk) WithRefresh(v string) func(*BulkRequest) {
 	return func(r *BulkRequest) {
 		r.Refresh = v
@@ -245,6 +374,9 @@ func (f Bulk) WithRequireAlias(v bool) func(*BulkRequest) {
 
 // WithRouting - specific routing value.
 //
+// It's the default routing of the actions; the actions are routed individually
+// with the routing of their metadata line, eg. opensearchutil.BulkActionMeta.
+//
 func (f Bulk) WithRouting(v string) func(*BulkRequest) {
 	return func(r *BulkRequest) {
 		r.Routing = v
@@ -291,6 +423,28 @@ func (f Bulk) WithWaitForActiveShards(v string) func(*BulkRequest) {
 	}
 }
 
+// WithValidateBody validates the newline-delimited JSON body before sending the request, see ValidateBulkBody.
+//
+// The body is buffered in memory for the validation, so it can't be combined with WithChunked.
+//
+func (f Bulk) WithValidateBody() func(*BulkRequest) {
+	return func(r *BulkRequest) {
+		r.ValidateBody = true
+	}
+}
+
+// WithChunked sends the body with the chunked transfer encoding, streaming the bodies of unknown length,
+// eg. an opensearchutil.NDJSONStream, without buffering them; see opensearchtransport.SetChunked.
+//
+// The streamed bodies are not retried, as they can't be sent again; use ReplayableBody for retries.
+// The request fails when combined with WithValidateBody, which would buffer the whole body.
+//
+func (f Bulk) WithChunked() func(*BulkRequest) {
+	return func(r *BulkRequest) {
+		r.Chunked = true
+	}
+}
+
 // WithPretty makes the response body pretty-printed.
 //
 func (f Bulk) WithPretty() func(*BulkRequest) {
@@ -315,6 +469,16 @@ func (f Bulk) WithErrorTrace() func(*BulkRequest) {
 	}
 }
 
+// WithDebug makes the response body pretty-printed and human-readable, and includes the stack trace for errors.
+//
+func (f Bulk) WithDebug() func(*BulkRequest) {
+	return func(r *BulkRequest) {
+		r.Pretty = true
+		r.Human = true
+		r.ErrorTrace = true
+	}
+}
+
 // WithFilterPath filters the properties of the response body.
 //
 func (f Bulk) WithFilterPath(v ...string) func(*BulkRequest) {
@@ -336,6 +500,32 @@ func (f Bulk) WithHeader(h map[string]string) func(*BulkRequest) {
 	}
 }
 
+// WithHTTPHeader adds the headers to the HTTP request, keeping the multiple values of a header.
+//
+func (f Bulk) WithHTTPHeader(h http.Header) func(*BulkRequest) {
+	return func(r *BulkRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, vv := range h {
+			for _, v := range vv {
+				r.Header.Add(k, v)
+			}
+		}
+	}
+}
+
+// WithAccept sets the media type of the response, eg. "application/yaml"; the default is JSON.
+//
+func (f Bulk) WithAccept(v string) func(*BulkRequest) {
+	return func(r *BulkRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Accept", v)
+	}
+}
+
 // WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
 //
 func (f Bulk) WithOpaqueID(s string) func(*BulkRequest) {
@@ -346,3 +536,25 @@ func (f Bulk) WithOpaqueID(s string) func(*BulkRequest) {
 		r.Header.Set("X-Opaque-Id", s)
 	}
 }
+
+// WithContentType sets the Content-Type header of the request, overriding the default of the request body.
+//
+func (f Bulk) WithContentType(v string) func(*BulkRequest) {
+	return func(r *BulkRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Content-Type", v)
+	}
+}
+
+// WithBasicAuth sets the credentials of the request, overriding the credentials of the client.
+//
+func (f Bulk) WithBasicAuth(username, password string) func(*BulkRequest) {
+	return func(r *BulkRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Authorization", basicAuth(username, password))
+	}
+}
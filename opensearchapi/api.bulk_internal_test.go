@@ -0,0 +1,77 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+//go:build !integration
+// +build !integration
+
+package opensearchapi
+
+import (
+	"io/ioutil"
+	"strings"
+	"testing"
+)
+
+func TestParseBulkResponse(t *testing.T) {
+	t.Run("Items", func(t *testing.T) {
+		body := `{"took":30,"errors":true,"items":[
+			{"index":{"_index":"test","_id":"1","_version":1,"result":"created","status":201,"_seq_no":0,"_primary_term":1}},
+			{"create":{"_index":"test","_id":"2","status":409,"error":{"type":"version_conflict_engine_exception","reason":"document already exists"}}},
+			{"update":{"_index":"test","_id":"3","status":429,"error":{"type":"rejected_execution_exception","reason":"rejected"}}},
+			{"delete":{"_index":"test","_id":"4","_version":2,"result":"deleted","status":200,"_seq_no":5}}
+		]}`
+		res := &Response{StatusCode: 200, Body: ioutil.NopCloser(strings.NewReader(body))}
+
+		br, err := ParseBulkResponse(res)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+
+		if br.Took != 30 || !br.Errors || len(br.Items) != 4 {
+			t.Fatalf("Unexpected response: %+v", br)
+		}
+
+		for i, want := range []string{"index", "create", "update", "delete"} {
+			if action, result := br.Items[i].Action(); action != want || result == nil {
+				t.Errorf("Unexpected action for item %d: %s", i, action)
+			}
+		}
+
+		if br.Items[3].Delete.SeqNo != 5 || br.Items[3].Delete.Result != "deleted" {
+			t.Errorf("Unexpected delete result: %+v", br.Items[3].Delete)
+		}
+
+		failed := br.Failed()
+		if len(failed) != 2 {
+			t.Fatalf("Unexpected number of failed items: %d", len(failed))
+		}
+		if failed[0].Create.Error.Type != "version_conflict_engine_exception" {
+			t.Errorf("Unexpected error: %+v", failed[0].Create.Error)
+		}
+		if failed[1].Update.Status != 429 {
+			t.Errorf("Unexpected status: %d", failed[1].Update.Status)
+		}
+	})
+
+	t.Run("Error response", func(t *testing.T) {
+		res := &Response{
+			StatusCode: 400,
+			Body:       ioutil.NopCloser(strings.NewReader(`{"error":{"type":"illegal_argument_exception","reason":"bad"},"status":400}`)),
+		}
+		if _, err := ParseBulkResponse(res); err == nil {
+			t.Errorf("Expected error")
+		}
+	})
+
+	t.Run("Empty response", func(t *testing.T) {
+		if _, err := ParseBulkResponse(&Response{StatusCode: 200}); err == nil {
+			t.Errorf("Expected error")
+		}
+	})
+}
@@ -140,7 +140,7 @@ func (r CatAliasesRequest) Do(ctx context.Context, transport Transport) (*Respon
 		params["filter_path"] = strings.Join(r.FilterPath, ",")
 	}
 
-	req, err := newRequest(method, path.String(), nil)
+	req, err := newRequest(transport, method, path.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -154,13 +154,9 @@ func (r CatAliasesRequest) Do(ctx context.Context, transport Transport) (*Respon
 	}
 
 	if len(r.Header) > 0 {
-		if len(req.Header) == 0 {
-			req.Header = r.Header
-		} else {
-			for k, vv := range r.Header {
-				for _, v := range vv {
-					req.Header.Add(k, v)
-				}
+		for k, vv := range r.Header {
+			for _, v := range vv {
+				req.Header.Add(k, v)
 			}
 		}
 	}
@@ -178,6 +174,7 @@ func (r CatAliasesRequest) Do(ctx context.Context, transport Transport) (*Respon
 		StatusCode: res.StatusCode,
 		Body:       res.Body,
 		Header:     res.Header,
+		codec:      JSONCodecOf(transport),
 	}
 
 	return &response, response.Err()
@@ -279,6 +276,16 @@ func (f CatAliases) WithErrorTrace() func(*CatAliasesRequest) {
 	}
 }
 
+// WithDebug makes the response body pretty-printed and human-readable, and includes the stack trace for errors.
+//
+func (f CatAliases) WithDebug() func(*CatAliasesRequest) {
+	return func(r *CatAliasesRequest) {
+		r.Pretty = true
+		r.Human = true
+		r.ErrorTrace = true
+	}
+}
+
 // WithFilterPath filters the properties of the response body.
 //
 func (f CatAliases) WithFilterPath(v ...string) func(*CatAliasesRequest) {
@@ -300,6 +307,32 @@ func (f CatAliases) WithHeader(h map[string]string) func(*CatAliasesRequest) {
 	}
 }
 
+// WithHTTPHeader adds the headers to the HTTP request, keeping the multiple values of a header.
+//
+func (f CatAliases) WithHTTPHeader(h http.Header) func(*CatAliasesRequest) {
+	return func(r *CatAliasesRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, vv := range h {
+			for _, v := range vv {
+				r.Header.Add(k, v)
+			}
+		}
+	}
+}
+
+// WithAccept sets the media type of the response, eg. "application/yaml"; the default is JSON.
+//
+func (f CatAliases) WithAccept(v string) func(*CatAliasesRequest) {
+	return func(r *CatAliasesRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Accept", v)
+	}
+}
+
 // WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
 //
 func (f CatAliases) WithOpaqueID(s string) func(*CatAliasesRequest) {
@@ -310,3 +343,14 @@ func (f CatAliases) WithOpaqueID(s string) func(*CatAliasesRequest) {
 		r.Header.Set("X-Opaque-Id", s)
 	}
 }
+
+// WithBasicAuth sets the credentials of the request, overriding the credentials of the client.
+//
+func (f CatAliases) WithBasicAuth(username, password string) func(*CatAliasesRequest) {
+	return func(r *CatAliasesRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Authorization", basicAuth(username, password))
+	}
+}
@@ -152,7 +152,7 @@ func (r CatAllocationRequest) Do(ctx context.Context, transport Transport) (*Res
 		params["filter_path"] = strings.Join(r.FilterPath, ",")
 	}
 
-	req, err := newRequest(method, path.String(), nil)
+	req, err := newRequest(transport, method, path.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -166,13 +166,9 @@ func (r CatAllocationRequest) Do(ctx context.Context, transport Transport) (*Res
 	}
 
 	if len(r.Header) > 0 {
-		if len(req.Header) == 0 {
-			req.Header = r.Header
-		} else {
-			for k, vv := range r.Header {
-				for _, v := range vv {
-					req.Header.Add(k, v)
-				}
+		for k, vv := range r.Header {
+			for _, v := range vv {
+				req.Header.Add(k, v)
 			}
 		}
 	}
@@ -190,6 +186,7 @@ func (r CatAllocationRequest) Do(ctx context.Context, transport Transport) (*Res
 		StatusCode: res.StatusCode,
 		Body:       res.Body,
 		Header:     res.Header,
+		codec:      JSONCodecOf(transport),
 	}
 
 	return &response, response.Err()
@@ -309,6 +306,16 @@ func (f CatAllocation) WithErrorTrace() func(*CatAllocationRequest) {
 	}
 }
 
+// WithDebug makes the response body pretty-printed and human-readable, and includes the stack trace for errors.
+//
+func (f CatAllocation) WithDebug() func(*CatAllocationRequest) {
+	return func(r *CatAllocationRequest) {
+		r.Pretty = true
+		r.Human = true
+		r.ErrorTrace = true
+	}
+}
+
 // WithFilterPath filters the properties of the response body.
 //
 func (f CatAllocation) WithFilterPath(v ...string) func(*CatAllocationRequest) {
@@ -330,6 +337,32 @@ func (f CatAllocation) WithHeader(h map[string]string) func(*CatAllocationReques
 	}
 }
 
+// WithHTTPHeader adds the headers to the HTTP request, keeping the multiple values of a header.
+//
+func (f CatAllocation) WithHTTPHeader(h http.Header) func(*CatAllocationRequest) {
+	return func(r *CatAllocationRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, vv := range h {
+			for _, v := range vv {
+				r.Header.Add(k, v)
+			}
+		}
+	}
+}
+
+// WithAccept sets the media type of the response, eg. "application/yaml"; the default is JSON.
+//
+func (f CatAllocation) WithAccept(v string) func(*CatAllocationRequest) {
+	return func(r *CatAllocationRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Accept", v)
+	}
+}
+
 // WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
 //
 func (f CatAllocation) WithOpaqueID(s string) func(*CatAllocationRequest) {
@@ -340,3 +373,14 @@ func (f CatAllocation) WithOpaqueID(s string) func(*CatAllocationRequest) {
 		r.Header.Set("X-Opaque-Id", s)
 	}
 }
+
+// WithBasicAuth sets the credentials of the request, overriding the credentials of the client.
+//
+func (f CatAllocation) WithBasicAuth(username, password string) func(*CatAllocationRequest) {
+	return func(r *CatAllocationRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Authorization", basicAuth(username, password))
+	}
+}
@@ -116,7 +116,7 @@ func (r CatClusterManagerRequest) Do(ctx context.Context, transport Transport) (
 		params["filter_path"] = strings.Join(r.FilterPath, ",")
 	}
 
-	req, err := newRequest(method, path.String(), nil)
+	req, err := newRequest(transport, method, path.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -130,13 +130,9 @@ func (r CatClusterManagerRequest) Do(ctx context.Context, transport Transport) (
 	}
 
 	if len(r.Header) > 0 {
-		if len(req.Header) == 0 {
-			req.Header = r.Header
-		} else {
-			for k, vv := range r.Header {
-				for _, v := range vv {
-					req.Header.Add(k, v)
-				}
+		for k, vv := range r.Header {
+			for _, v := range vv {
+				req.Header.Add(k, v)
 			}
 		}
 	}
@@ -154,6 +150,7 @@ func (r CatClusterManagerRequest) Do(ctx context.Context, transport Transport) (
 		StatusCode: res.StatusCode,
 		Body:       res.Body,
 		Header:     res.Header,
+		codec:      JSONCodecOf(transport),
 	}
 
 	return &response, response.Err()
@@ -245,6 +242,15 @@ func (f CatClusterManager) WithErrorTrace() func(*CatClusterManagerRequest) {
 	}
 }
 
+// WithDebug makes the response body pretty-printed and human-readable, and includes the stack trace for errors.
+func (f CatClusterManager) WithDebug() func(*CatClusterManagerRequest) {
+	return func(r *CatClusterManagerRequest) {
+		r.Pretty = true
+		r.Human = true
+		r.ErrorTrace = true
+	}
+}
+
 // WithFilterPath filters the properties of the response body.
 func (f CatClusterManager) WithFilterPath(v ...string) func(*CatClusterManagerRequest) {
 	return func(r *CatClusterManagerRequest) {
@@ -264,6 +270,30 @@ func (f CatClusterManager) WithHeader(h map[string]string) func(*CatClusterManag
 	}
 }
 
+// WithHTTPHeader adds the headers to the HTTP request, keeping the multiple values of a header.
+func (f CatClusterManager) WithHTTPHeader(h http.Header) func(*CatClusterManagerRequest) {
+	return func(r *CatClusterManagerRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, vv := range h {
+			for _, v := range vv {
+				r.Header.Add(k, v)
+			}
+		}
+	}
+}
+
+// WithAccept sets the media type of the response, eg. "application/yaml"; the default is JSON.
+func (f CatClusterManager) WithAccept(v string) func(*CatClusterManagerRequest) {
+	return func(r *CatClusterManagerRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Accept", v)
+	}
+}
+
 // WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
 func (f CatClusterManager) WithOpaqueID(s string) func(*CatClusterManagerRequest) {
 	return func(r *CatClusterManagerRequest) {
@@ -273,3 +303,13 @@ func (f CatClusterManager) WithOpaqueID(s string) func(*CatClusterManagerRequest
 		r.Header.Set("X-Opaque-Id", s)
 	}
 }
+
+// WithBasicAuth sets the credentials of the request, overriding the credentials of the client.
+func (f CatClusterManager) WithBasicAuth(username, password string) func(*CatClusterManagerRequest) {
+	return func(r *CatClusterManagerRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Authorization", basicAuth(username, password))
+	}
+}
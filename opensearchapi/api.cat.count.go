@@ -131,7 +131,7 @@ func (r CatCountRequest) Do(ctx context.Context, transport Transport) (*Response
 		params["filter_path"] = strings.Join(r.FilterPath, ",")
 	}
 
-	req, err := newRequest(method, path.String(), nil)
+	req, err := newRequest(transport, method, path.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -145,13 +145,9 @@ func (r CatCountRequest) Do(ctx context.Context, transport Transport) (*Response
 	}
 
 	if len(r.Header) > 0 {
-		if len(req.Header) == 0 {
-			req.Header = r.Header
-		} else {
-			for k, vv := range r.Header {
-				for _, v := range vv {
-					req.Header.Add(k, v)
-				}
+		for k, vv := range r.Header {
+			for _, v := range vv {
+				req.Header.Add(k, v)
 			}
 		}
 	}
@@ -169,6 +165,7 @@ func (r CatCountRequest) Do(ctx context.Context, transport Transport) (*Response
 		StatusCode: res.StatusCode,
 		Body:       res.Body,
 		Header:     res.Header,
+		codec:      JSONCodecOf(transport),
 	}
 
 	return &response, response.Err()
@@ -254,6 +251,16 @@ func (f CatCount) WithErrorTrace() func(*CatCountRequest) {
 	}
 }
 
+// WithDebug makes the response body pretty-printed and human-readable, and includes the stack trace for errors.
+//
+func (f CatCount) WithDebug() func(*CatCountRequest) {
+	return func(r *CatCountRequest) {
+		r.Pretty = true
+		r.Human = true
+		r.ErrorTrace = true
+	}
+}
+
 // WithFilterPath filters the properties of the response body.
 //
 func (f CatCount) WithFilterPath(v ...string) func(*CatCountRequest) {
@@ -275,6 +282,32 @@ func (f CatCount) WithHeader(h map[string]string) func(*CatCountRequest) {
 	}
 }
 
+// WithHTTPHeader adds the headers to the HTTP request, keeping the multiple values of a header.
+//
+func (f CatCount) WithHTTPHeader(h http.Header) func(*CatCountRequest) {
+	return func(r *CatCountRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, vv := range h {
+			for _, v := range vv {
+				r.Header.Add(k, v)
+			}
+		}
+	}
+}
+
+// WithAccept sets the media type of the response, eg. "application/yaml"; the default is JSON.
+//
+func (f CatCount) WithAccept(v string) func(*CatCountRequest) {
+	return func(r *CatCountRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Accept", v)
+	}
+}
+
 // WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
 //
 func (f CatCount) WithOpaqueID(s string) func(*CatCountRequest) {
@@ -285,3 +318,14 @@ func (f CatCount) WithOpaqueID(s string) func(*CatCountRequest) {
 		r.Header.Set("X-Opaque-Id", s)
 	}
 }
+
+// WithBasicAuth sets the credentials of the request, overriding the credentials of the client.
+//
+func (f CatCount) WithBasicAuth(username, password string) func(*CatCountRequest) {
+	return func(r *CatCountRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Authorization", basicAuth(username, password))
+	}
+}
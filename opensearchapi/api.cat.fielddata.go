@@ -140,7 +140,7 @@ func (r CatFielddataRequest) Do(ctx context.Context, transport Transport) (*Resp
 		params["filter_path"] = strings.Join(r.FilterPath, ",")
 	}
 
-	req, err := newRequest(method, path.String(), nil)
+	req, err := newRequest(transport, method, path.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -154,13 +154,9 @@ func (r CatFielddataRequest) Do(ctx context.Context, transport Transport) (*Resp
 	}
 
 	if len(r.Header) > 0 {
-		if len(req.Header) == 0 {
-			req.Header = r.Header
-		} else {
-			for k, vv := range r.Header {
-				for _, v := range vv {
-					req.Header.Add(k, v)
-				}
+		for k, vv := range r.Header {
+			for _, v := range vv {
+				req.Header.Add(k, v)
 			}
 		}
 	}
@@ -178,6 +174,7 @@ func (r CatFielddataRequest) Do(ctx context.Context, transport Transport) (*Resp
 		StatusCode: res.StatusCode,
 		Body:       res.Body,
 		Header:     res.Header,
+		codec:      JSONCodecOf(transport),
 	}
 
 	return &response, response.Err()
@@ -271,6 +268,16 @@ func (f CatFielddata) WithErrorTrace() func(*CatFielddataRequest) {
 	}
 }
 
+// WithDebug makes the response body pretty-printed and human-readable, and includes the stack trace for errors.
+//
+func (f CatFielddata) WithDebug() func(*CatFielddataRequest) {
+	return func(r *CatFielddataRequest) {
+		r.Pretty = true
+		r.Human = true
+		r.ErrorTrace = true
+	}
+}
+
 // WithFilterPath filters the properties of the response body.
 //
 func (f CatFielddata) WithFilterPath(v ...string) func(*CatFielddataRequest) {
@@ -292,6 +299,32 @@ func (f CatFielddata) WithHeader(h map[string]string) func(*CatFielddataRequest)
 	}
 }
 
+// WithHTTPHeader adds the headers to the HTTP request, keeping the multiple values of a header.
+//
+func (f CatFielddata) WithHTTPHeader(h http.Header) func(*CatFielddataRequest) {
+	return func(r *CatFielddataRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, vv := range h {
+			for _, v := range vv {
+				r.Header.Add(k, v)
+			}
+		}
+	}
+}
+
+// WithAccept sets the media type of the response, eg. "application/yaml"; the default is JSON.
+//
+func (f CatFielddata) WithAccept(v string) func(*CatFielddataRequest) {
+	return func(r *CatFielddataRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Accept", v)
+	}
+}
+
 // WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
 //
 func (f CatFielddata) WithOpaqueID(s string) func(*CatFielddataRequest) {
@@ -302,3 +335,14 @@ func (f CatFielddata) WithOpaqueID(s string) func(*CatFielddataRequest) {
 		r.Header.Set("X-Opaque-Id", s)
 	}
 }
+
+// WithBasicAuth sets the credentials of the request, overriding the credentials of the client.
+//
+func (f CatFielddata) WithBasicAuth(username, password string) func(*CatFielddataRequest) {
+	return func(r *CatFielddataRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Authorization", basicAuth(username, password))
+	}
+}
@@ -106,7 +106,7 @@ func (r CatHelpRequest) Do(ctx context.Context, transport Transport) (*Response,
 		params["filter_path"] = strings.Join(r.FilterPath, ",")
 	}
 
-	req, err := newRequest(method, path.String(), nil)
+	req, err := newRequest(transport, method, path.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -120,13 +120,9 @@ func (r CatHelpRequest) Do(ctx context.Context, transport Transport) (*Response,
 	}
 
 	if len(r.Header) > 0 {
-		if len(req.Header) == 0 {
-			req.Header = r.Header
-		} else {
-			for k, vv := range r.Header {
-				for _, v := range vv {
-					req.Header.Add(k, v)
-				}
+		for k, vv := range r.Header {
+			for _, v := range vv {
+				req.Header.Add(k, v)
 			}
 		}
 	}
@@ -144,6 +140,7 @@ func (r CatHelpRequest) Do(ctx context.Context, transport Transport) (*Response,
 		StatusCode: res.StatusCode,
 		Body:       res.Body,
 		Header:     res.Header,
+		codec:      JSONCodecOf(transport),
 	}
 
 	return &response, response.Err()
@@ -197,6 +194,16 @@ func (f CatHelp) WithErrorTrace() func(*CatHelpRequest) {
 	}
 }
 
+// WithDebug makes the response body pretty-printed and human-readable, and includes the stack trace for errors.
+//
+func (f CatHelp) WithDebug() func(*CatHelpRequest) {
+	return func(r *CatHelpRequest) {
+		r.Pretty = true
+		r.Human = true
+		r.ErrorTrace = true
+	}
+}
+
 // WithFilterPath filters the properties of the response body.
 //
 func (f CatHelp) WithFilterPath(v ...string) func(*CatHelpRequest) {
@@ -218,6 +225,32 @@ func (f CatHelp) WithHeader(h map[string]string) func(*CatHelpRequest) {
 	}
 }
 
+// WithHTTPHeader adds the headers to the HTTP request, keeping the multiple values of a header.
+//
+func (f CatHelp) WithHTTPHeader(h http.Header) func(*CatHelpRequest) {
+	return func(r *CatHelpRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, vv := range h {
+			for _, v := range vv {
+				r.Header.Add(k, v)
+			}
+		}
+	}
+}
+
+// WithAccept sets the media type of the response, eg. "application/yaml"; the default is JSON.
+//
+func (f CatHelp) WithAccept(v string) func(*CatHelpRequest) {
+	return func(r *CatHelpRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Accept", v)
+	}
+}
+
 // WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
 //
 func (f CatHelp) WithOpaqueID(s string) func(*CatHelpRequest) {
@@ -228,3 +261,14 @@ func (f CatHelp) WithOpaqueID(s string) func(*CatHelpRequest) {
 		r.Header.Set("X-Opaque-Id", s)
 	}
 }
+
+// WithBasicAuth sets the credentials of the request, overriding the credentials of the client.
+//
+func (f CatHelp) WithBasicAuth(username, password string) func(*CatHelpRequest) {
+	return func(r *CatHelpRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Authorization", basicAuth(username, password))
+	}
+}
@@ -81,6 +81,33 @@ type CatIndicesRequest struct {
 	ctx context.Context
 }
 
+// CatIndicesItem represents a row of the Cat Indices API response in the JSON format.
+//
+type CatIndicesItem struct {
+	Health       string `json:"health"`
+	Status       string `json:"status"`
+	Index        string `json:"index"`
+	UUID         string `json:"uuid"`
+	Pri          string `json:"pri"`
+	Rep          string `json:"rep"`
+	DocsCount    string `json:"docs.count"`
+	DocsDeleted  string `json:"docs.deleted"`
+	StoreSize    string `json:"store.size"`
+	PriStoreSize string `json:"pri.store.size"`
+}
+
+// ParseCatIndices decodes the body of a Cat Indices API response requested with WithFormat("json"), and closes it.
+//
+// opensearchutil.CatIndices performs the request in the JSON format and decodes the response.
+//
+func ParseCatIndices(res *Response) ([]CatIndicesItem, error) {
+	var items []CatIndicesItem
+	if err := parseCatResponse(res, &items); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 // Do executes the request and returns response or error.
 //
 func (r CatIndicesRequest) Do(ctx context.Context, transport Transport) (*Response, error) {
@@ -176,7 +203,7 @@ func (r CatIndicesRequest) Do(ctx context.Context, transport Transport) (*Respon
 		params["filter_path"] = strings.Join(r.FilterPath, ",")
 	}
 
-	req, err := newRequest(method, path.String(), nil)
+	req, err := newRequest(transport, method, path.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -190,13 +217,9 @@ func (r CatIndicesRequest) Do(ctx context.Context, transport Transport) (*Respon
 	}
 
 	if len(r.Header) > 0 {
-		if len(req.Header) == 0 {
-			req.Header = r.Header
-		} else {
-			for k, vv := range r.Header {
-				for _, v := range vv {
-					req.Header.Add(k, v)
-				}
+		for k, vv := range r.Header {
+			for _, v := range vv {
+				req.Header.Add(k, v)
 			}
 		}
 	}
@@ -214,6 +237,7 @@ func (r CatIndicesRequest) Do(ctx context.Context, transport Transport) (*Respon
 		StatusCode: res.StatusCode,
 		Body:       res.Body,
 		Header:     res.Header,
+		codec:      JSONCodecOf(transport),
 	}
 
 	return &response, response.Err()
@@ -373,6 +397,16 @@ func (f CatIndices) WithErrorTrace() func(*CatIndicesRequest) {
 	}
 }
 
+// WithDebug makes the response body pretty-printed and human-readable, and includes the stack trace for errors.
+//
+func (f CatIndices) WithDebug() func(*CatIndicesRequest) {
+	return func(r *CatIndicesRequest) {
+		r.Pretty = true
+		r.Human = true
+		r.ErrorTrace = true
+	}
+}
+
 // WithFilterPath filters the properties of the response body.
 //
 func (f CatIndices) WithFilterPath(v ...string) func(*CatIndicesRequest) {
@@ -394,6 +428,32 @@ func (f CatIndices) WithHeader(h map[string]string) func(*CatIndicesRequest) {
 	}
 }
 
+// WithHTTPHeader adds the headers to the HTTP request, keeping the multiple values of a header.
+//
+func (f CatIndices) WithHTTPHeader(h http.Header) func(*CatIndicesRequest) {
+	return func(r *CatIndicesRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, vv := range h {
+			for _, v := range vv {
+				r.Header.Add(k, v)
+			}
+		}
+	}
+}
+
+// WithAccept sets the media type of the response, eg. "application/yaml"; the default is JSON.
+//
+func (f CatIndices) WithAccept(v string) func(*CatIndicesRequest) {
+	return func(r *CatIndicesRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Accept", v)
+	}
+}
+
 // WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
 //
 func (f CatIndices) WithOpaqueID(s string) func(*CatIndicesRequest) {
@@ -404,3 +464,14 @@ func (f CatIndices) WithOpaqueID(s string) func(*CatIndicesRequest) {
 		r.Header.Set("X-Opaque-Id", s)
 	}
 }
+
+// WithBasicAuth sets the credentials of the request, overriding the credentials of the client.
+//
+func (f CatIndices) WithBasicAuth(username, password string) func(*CatIndicesRequest) {
+	return func(r *CatIndicesRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Authorization", basicAuth(username, password))
+	}
+}
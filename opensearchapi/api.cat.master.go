@@ -135,7 +135,7 @@ func (r CatMasterRequest) Do(ctx context.Context, transport Transport) (*Respons
 		params["filter_path"] = strings.Join(r.FilterPath, ",")
 	}
 
-	req, err := newRequest(method, path.String(), nil)
+	req, err := newRequest(transport, method, path.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -149,13 +149,9 @@ func (r CatMasterRequest) Do(ctx context.Context, transport Transport) (*Respons
 	}
 
 	if len(r.Header) > 0 {
-		if len(req.Header) == 0 {
-			req.Header = r.Header
-		} else {
-			for k, vv := range r.Header {
-				for _, v := range vv {
-					req.Header.Add(k, v)
-				}
+		for k, vv := range r.Header {
+			for _, v := range vv {
+				req.Header.Add(k, v)
 			}
 		}
 	}
@@ -173,6 +169,7 @@ func (r CatMasterRequest) Do(ctx context.Context, transport Transport) (*Respons
 		StatusCode: res.StatusCode,
 		Body:       res.Body,
 		Header:     res.Header,
+		codec:      JSONCodecOf(transport),
 	}
 
 	return &response, response.Err()
@@ -264,6 +261,15 @@ func (f CatMaster) WithErrorTrace() func(*CatMasterRequest) {
 	}
 }
 
+// WithDebug makes the response body pretty-printed and human-readable, and includes the stack trace for errors.
+func (f CatMaster) WithDebug() func(*CatMasterRequest) {
+	return func(r *CatMasterRequest) {
+		r.Pretty = true
+		r.Human = true
+		r.ErrorTrace = true
+	}
+}
+
 // WithFilterPath filters the properties of the response body.
 func (f CatMaster) WithFilterPath(v ...string) func(*CatMasterRequest) {
 	return func(r *CatMasterRequest) {
@@ -283,6 +289,30 @@ func (f CatMaster) WithHeader(h map[string]string) func(*CatMasterRequest) {
 	}
 }
 
+// WithHTTPHeader adds the headers to the HTTP request, keeping the multiple values of a header.
+func (f CatMaster) WithHTTPHeader(h http.Header) func(*CatMasterRequest) {
+	return func(r *CatMasterRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, vv := range h {
+			for _, v := range vv {
+				r.Header.Add(k, v)
+			}
+		}
+	}
+}
+
+// WithAccept sets the media type of the response, eg. "application/yaml"; the default is JSON.
+func (f CatMaster) WithAccept(v string) func(*CatMasterRequest) {
+	return func(r *CatMasterRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Accept", v)
+	}
+}
+
 // WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
 func (f CatMaster) WithOpaqueID(s string) func(*CatMasterRequest) {
 	return func(r *CatMasterRequest) {
@@ -292,3 +322,13 @@ func (f CatMaster) WithOpaqueID(s string) func(*CatMasterRequest) {
 		r.Header.Set("X-Opaque-Id", s)
 	}
 }
+
+// WithBasicAuth sets the credentials of the request, overriding the credentials of the client.
+func (f CatMaster) WithBasicAuth(username, password string) func(*CatMasterRequest) {
+	return func(r *CatMasterRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Authorization", basicAuth(username, password))
+	}
+}
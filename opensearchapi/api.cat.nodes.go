@@ -77,6 +77,36 @@ type CatNodesRequest struct {
 	ctx context.Context
 }
 
+// CatNodesItem represents a row of the Cat Nodes API response in the JSON format.
+//
+type CatNodesItem struct {
+	IP             string `json:"ip"`
+	HeapPercent    string `json:"heap.percent"`
+	RAMPercent     string `json:"ram.percent"`
+	CPU            string `json:"cpu"`
+	Load1m         string `json:"load_1m"`
+	Load5m         string `json:"load_5m"`
+	Load15m        string `json:"load_15m"`
+	NodeRole       string `json:"node.role"`
+	ClusterManager string `json:"cluster_manager"`
+	Name           string `json:"name"`
+
+	// Deprecated: To promote inclusive language, use ClusterManager instead.
+	Master string `json:"master"`
+}
+
+// ParseCatNodes decodes the body of a Cat Nodes API response requested with WithFormat("json"), and closes it.
+//
+// opensearchutil.CatNodes performs the request in the JSON format and decodes the response.
+//
+func ParseCatNodes(res *Response) ([]CatNodesItem, error) {
+	var items []CatNodesItem
+	if err := parseCatResponse(res, &items); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 // Do executes the request and returns response or error.
 //
 func (r CatNodesRequest) Do(ctx context.Context, transport Transport) (*Response, error) {
@@ -157,7 +187,7 @@ func (r CatNodesRequest) Do(ctx context.Context, transport Transport) (*Response
 		params["filter_path"] = strings.Join(r.FilterPath, ",")
 	}
 
-	req, err := newRequest(method, path.String(), nil)
+	req, err := newRequest(transport, method, path.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -171,13 +201,9 @@ func (r CatNodesRequest) Do(ctx context.Context, transport Transport) (*Response
 	}
 
 	if len(r.Header) > 0 {
-		if len(req.Header) == 0 {
-			req.Header = r.Header
-		} else {
-			for k, vv := range r.Header {
-				for _, v := range vv {
-					req.Header.Add(k, v)
-				}
+		for k, vv := range r.Header {
+			for _, v := range vv {
+				req.Header.Add(k, v)
 			}
 		}
 	}
@@ -195,6 +221,7 @@ func (r CatNodesRequest) Do(ctx context.Context, transport Transport) (*Response
 		StatusCode: res.StatusCode,
 		Body:       res.Body,
 		Header:     res.Header,
+		codec:      JSONCodecOf(transport),
 	}
 
 	return &response, response.Err()
@@ -330,6 +357,16 @@ func (f CatNodes) WithErrorTrace() func(*CatNodesRequest) {
 	}
 }
 
+// WithDebug makes the response body pretty-printed and human-readable, and includes the stack trace for errors.
+//
+func (f CatNodes) WithDebug() func(*CatNodesRequest) {
+	return func(r *CatNodesRequest) {
+		r.Pretty = true
+		r.Human = true
+		r.ErrorTrace = true
+	}
+}
+
 // WithFilterPath filters the properties of the response body.
 //
 func (f CatNodes) WithFilterPath(v ...string) func(*CatNodesRequest) {
@@ -351,6 +388,32 @@ func (f CatNodes) WithHeader(h map[string]string) func(*CatNodesRequest) {
 	}
 }
 
+// WithHTTPHeader adds the headers to the HTTP request, keeping the multiple values of a header.
+//
+func (f CatNodes) WithHTTPHeader(h http.Header) func(*CatNodesRequest) {
+	return func(r *CatNodesRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, vv := range h {
+			for _, v := range vv {
+				r.Header.Add(k, v)
+			}
+		}
+	}
+}
+
+// WithAccept sets the media type of the response, eg. "application/yaml"; the default is JSON.
+//
+func (f CatNodes) WithAccept(v string) func(*CatNodesRequest) {
+	return func(r *CatNodesRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Accept", v)
+	}
+}
+
 // WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
 //
 func (f CatNodes) WithOpaqueID(s string) func(*CatNodesRequest) {
@@ -361,3 +424,14 @@ func (f CatNodes) WithOpaqueID(s string) func(*CatNodesRequest) {
 		r.Header.Set("X-Opaque-Id", s)
 	}
 }
+
+// WithBasicAuth sets the credentials of the request, overriding the credentials of the client.
+//
+func (f CatNodes) WithBasicAuth(username, password string) func(*CatNodesRequest) {
+	return func(r *CatNodesRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Authorization", basicAuth(username, password))
+	}
+}
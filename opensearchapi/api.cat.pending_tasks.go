@@ -142,7 +142,7 @@ func (r CatPendingTasksRequest) Do(ctx context.Context, transport Transport) (*R
 		params["filter_path"] = strings.Join(r.FilterPath, ",")
 	}
 
-	req, err := newRequest(method, path.String(), nil)
+	req, err := newRequest(transport, method, path.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -156,13 +156,9 @@ func (r CatPendingTasksRequest) Do(ctx context.Context, transport Transport) (*R
 	}
 
 	if len(r.Header) > 0 {
-		if len(req.Header) == 0 {
-			req.Header = r.Header
-		} else {
-			for k, vv := range r.Header {
-				for _, v := range vv {
-					req.Header.Add(k, v)
-				}
+		for k, vv := range r.Header {
+			for _, v := range vv {
+				req.Header.Add(k, v)
 			}
 		}
 	}
@@ -180,6 +176,7 @@ func (r CatPendingTasksRequest) Do(ctx context.Context, transport Transport) (*R
 		StatusCode: res.StatusCode,
 		Body:       res.Body,
 		Header:     res.Header,
+		codec:      JSONCodecOf(transport),
 	}
 
 	return &response, response.Err()
@@ -291,6 +288,16 @@ func (f CatPendingTasks) WithErrorTrace() func(*CatPendingTasksRequest) {
 	}
 }
 
+// WithDebug makes the response body pretty-printed and human-readable, and includes the stack trace for errors.
+//
+func (f CatPendingTasks) WithDebug() func(*CatPendingTasksRequest) {
+	return func(r *CatPendingTasksRequest) {
+		r.Pretty = true
+		r.Human = true
+		r.ErrorTrace = true
+	}
+}
+
 // WithFilterPath filters the properties of the response body.
 //
 func (f CatPendingTasks) WithFilterPath(v ...string) func(*CatPendingTasksRequest) {
@@ -312,6 +319,32 @@ func (f CatPendingTasks) WithHeader(h map[string]string) func(*CatPendingTasksRe
 	}
 }
 
+// WithHTTPHeader adds the headers to the HTTP request, keeping the multiple values of a header.
+//
+func (f CatPendingTasks) WithHTTPHeader(h http.Header) func(*CatPendingTasksRequest) {
+	return func(r *CatPendingTasksRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, vv := range h {
+			for _, v := range vv {
+				r.Header.Add(k, v)
+			}
+		}
+	}
+}
+
+// WithAccept sets the media type of the response, eg. "application/yaml"; the default is JSON.
+//
+func (f CatPendingTasks) WithAccept(v string) func(*CatPendingTasksRequest) {
+	return func(r *CatPendingTasksRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Accept", v)
+	}
+}
+
 // WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
 //
 func (f CatPendingTasks) WithOpaqueID(s string) func(*CatPendingTasksRequest) {
@@ -322,3 +355,14 @@ func (f CatPendingTasks) WithOpaqueID(s string) func(*CatPendingTasksRequest) {
 		r.Header.Set("X-Opaque-Id", s)
 	}
 }
+
+// WithBasicAuth sets the credentials of the request, overriding the credentials of the client.
+//
+func (f CatPendingTasks) WithBasicAuth(username, password string) func(*CatPendingTasksRequest) {
+	return func(r *CatPendingTasksRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Authorization", basicAuth(username, password))
+	}
+}
@@ -142,7 +142,7 @@ func (r CatPluginsRequest) Do(ctx context.Context, transport Transport) (*Respon
 		params["filter_path"] = strings.Join(r.FilterPath, ",")
 	}
 
-	req, err := newRequest(method, path.String(), nil)
+	req, err := newRequest(transport, method, path.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -156,13 +156,9 @@ func (r CatPluginsRequest) Do(ctx context.Context, transport Transport) (*Respon
 	}
 
 	if len(r.Header) > 0 {
-		if len(req.Header) == 0 {
-			req.Header = r.Header
-		} else {
-			for k, vv := range r.Header {
-				for _, v := range vv {
-					req.Header.Add(k, v)
-				}
+		for k, vv := range r.Header {
+			for _, v := range vv {
+				req.Header.Add(k, v)
 			}
 		}
 	}
@@ -180,6 +176,7 @@ func (r CatPluginsRequest) Do(ctx context.Context, transport Transport) (*Respon
 		StatusCode: res.StatusCode,
 		Body:       res.Body,
 		Header:     res.Header,
+		codec:      JSONCodecOf(transport),
 	}
 
 	return &response, response.Err()
@@ -291,6 +288,16 @@ func (f CatPlugins) WithErrorTrace() func(*CatPluginsRequest) {
 	}
 }
 
+// WithDebug makes the response body pretty-printed and human-readable, and includes the stack trace for errors.
+//
+func (f CatPlugins) WithDebug() func(*CatPluginsRequest) {
+	return func(r *CatPluginsRequest) {
+		r.Pretty = true
+		r.Human = true
+		r.ErrorTrace = true
+	}
+}
+
 // WithFilterPath filters the properties of the response body.
 //
 func (f CatPlugins) WithFilterPath(v ...string) func(*CatPluginsRequest) {
@@ -312,6 +319,32 @@ func (f CatPlugins) WithHeader(h map[string]string) func(*CatPluginsRequest) {
 	}
 }
 
+// WithHTTPHeader adds the headers to the HTTP request, keeping the multiple values of a header.
+//
+func (f CatPlugins) WithHTTPHeader(h http.Header) func(*CatPluginsRequest) {
+	return func(r *CatPluginsRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, vv := range h {
+			for _, v := range vv {
+				r.Header.Add(k, v)
+			}
+		}
+	}
+}
+
+// WithAccept sets the media type of the response, eg. "application/yaml"; the default is JSON.
+//
+func (f CatPlugins) WithAccept(v string) func(*CatPluginsRequest) {
+	return func(r *CatPluginsRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Accept", v)
+	}
+}
+
 // WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
 //
 func (f CatPlugins) WithOpaqueID(s string) func(*CatPluginsRequest) {
@@ -322,3 +355,14 @@ func (f CatPlugins) WithOpaqueID(s string) func(*CatPluginsRequest) {
 		r.Header.Set("X-Opaque-Id", s)
 	}
 }
+
+// WithBasicAuth sets the credentials of the request, overriding the credentials of the client.
+//
+func (f CatPlugins) WithBasicAuth(username, password string) func(*CatPluginsRequest) {
+	return func(r *CatPluginsRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Authorization", basicAuth(username, password))
+	}
+}
@@ -137,7 +137,7 @@ func (r CatRepositoriesRequest) Do(ctx context.Context, transport Transport) (*R
 		params["filter_path"] = strings.Join(r.FilterPath, ",")
 	}
 
-	req, err := newRequest(method, path.String(), nil)
+	req, err := newRequest(transport, method, path.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -151,13 +151,9 @@ func (r CatRepositoriesRequest) Do(ctx context.Context, transport Transport) (*R
 	}
 
 	if len(r.Header) > 0 {
-		if len(req.Header) == 0 {
-			req.Header = r.Header
-		} else {
-			for k, vv := range r.Header {
-				for _, v := range vv {
-					req.Header.Add(k, v)
-				}
+		for k, vv := range r.Header {
+			for _, v := range vv {
+				req.Header.Add(k, v)
 			}
 		}
 	}
@@ -175,6 +171,7 @@ func (r CatRepositoriesRequest) Do(ctx context.Context, transport Transport) (*R
 		StatusCode: res.StatusCode,
 		Body:       res.Body,
 		Header:     res.Header,
+		codec:      JSONCodecOf(transport),
 	}
 
 	return &response, response.Err()
@@ -278,6 +275,16 @@ func (f CatRepositories) WithErrorTrace() func(*CatRepositoriesRequest) {
 	}
 }
 
+// WithDebug makes the response body pretty-printed and human-readable, and includes the stack trace for errors.
+//
+func (f CatRepositories) WithDebug() func(*CatRepositoriesRequest) {
+	return func(r *CatRepositoriesRequest) {
+		r.Pretty = true
+		r.Human = true
+		r.ErrorTrace = true
+	}
+}
+
 // WithFilterPath filters the properties of the response body.
 //
 func (f CatRepositories) WithFilterPath(v ...string) func(*CatRepositoriesRequest) {
@@ -299,6 +306,32 @@ func (f CatRepositories) WithHeader(h map[string]string) func(*CatRepositoriesRe
 	}
 }
 
+// WithHTTPHeader adds the headers to the HTTP request, keeping the multiple values of a header.
+//
+func (f CatRepositories) WithHTTPHeader(h http.Header) func(*CatRepositoriesRequest) {
+	return func(r *CatRepositoriesRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, vv := range h {
+			for _, v := range vv {
+				r.Header.Add(k, v)
+			}
+		}
+	}
+}
+
+// WithAccept sets the media type of the response, eg. "application/yaml"; the default is JSON.
+//
+func (f CatRepositories) WithAccept(v string) func(*CatRepositoriesRequest) {
+	return func(r *CatRepositoriesRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Accept", v)
+	}
+}
+
 // WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
 //
 func (f CatRepositories) WithOpaqueID(s string) func(*CatRepositoriesRequest) {
@@ -309,3 +342,14 @@ func (f CatRepositories) WithOpaqueID(s string) func(*CatRepositoriesRequest) {
 		r.Header.Set("X-Opaque-Id", s)
 	}
 }
+
+// WithBasicAuth sets the credentials of the request, overriding the credentials of the client.
+//
+func (f CatRepositories) WithBasicAuth(username, password string) func(*CatRepositoriesRequest) {
+	return func(r *CatRepositoriesRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Authorization", basicAuth(username, password))
+	}
+}
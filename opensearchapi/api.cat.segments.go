@@ -135,7 +135,7 @@ func (r CatSegmentsRequest) Do(ctx context.Context, transport Transport) (*Respo
 		params["filter_path"] = strings.Join(r.FilterPath, ",")
 	}
 
-	req, err := newRequest(method, path.String(), nil)
+	req, err := newRequest(transport, method, path.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -149,13 +149,9 @@ func (r CatSegmentsRequest) Do(ctx context.Context, transport Transport) (*Respo
 	}
 
 	if len(r.Header) > 0 {
-		if len(req.Header) == 0 {
-			req.Header = r.Header
-		} else {
-			for k, vv := range r.Header {
-				for _, v := range vv {
-					req.Header.Add(k, v)
-				}
+		for k, vv := range r.Header {
+			for _, v := range vv {
+				req.Header.Add(k, v)
 			}
 		}
 	}
@@ -173,6 +169,7 @@ func (r CatSegmentsRequest) Do(ctx context.Context, transport Transport) (*Respo
 		StatusCode: res.StatusCode,
 		Body:       res.Body,
 		Header:     res.Header,
+		codec:      JSONCodecOf(transport),
 	}
 
 	return &response, response.Err()
@@ -266,6 +263,16 @@ func (f CatSegments) WithErrorTrace() func(*CatSegmentsRequest) {
 	}
 }
 
+// WithDebug makes the response body pretty-printed and human-readable, and includes the stack trace for errors.
+//
+func (f CatSegments) WithDebug() func(*CatSegmentsRequest) {
+	return func(r *CatSegmentsRequest) {
+		r.Pretty = true
+		r.Human = true
+		r.ErrorTrace = true
+	}
+}
+
 // WithFilterPath filters the properties of the response body.
 //
 func (f CatSegments) WithFilterPath(v ...string) func(*CatSegmentsRequest) {
@@ -287,6 +294,32 @@ func (f CatSegments) WithHeader(h map[string]string) func(*CatSegmentsRequest) {
 	}
 }
 
+// WithHTTPHeader adds the headers to the HTTP request, keeping the multiple values of a header.
+//
+func (f CatSegments) WithHTTPHeader(h http.Header) func(*CatSegmentsRequest) {
+	return func(r *CatSegmentsRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, vv := range h {
+			for _, v := range vv {
+				r.Header.Add(k, v)
+			}
+		}
+	}
+}
+
+// WithAccept sets the media type of the response, eg. "application/yaml"; the default is JSON.
+//
+func (f CatSegments) WithAccept(v string) func(*CatSegmentsRequest) {
+	return func(r *CatSegmentsRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Accept", v)
+	}
+}
+
 // WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
 //
 func (f CatSegments) WithOpaqueID(s string) func(*CatSegmentsRequest) {
@@ -297,3 +330,14 @@ func (f CatSegments) WithOpaqueID(s string) func(*CatSegmentsRequest) {
 		r.Header.Set("X-Opaque-Id", s)
 	}
 }
+
+// WithBasicAuth sets the credentials of the request, overriding the credentials of the client.
+//
+func (f CatSegments) WithBasicAuth(username, password string) func(*CatSegmentsRequest) {
+	return func(r *CatSegmentsRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Authorization", basicAuth(username, password))
+	}
+}
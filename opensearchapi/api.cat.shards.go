@@ -77,6 +77,32 @@ type CatShardsRequest struct {
 	ctx context.Context
 }
 
+// CatShardsItem represents a row of the Cat Shards API response in the JSON format.
+//
+type CatShardsItem struct {
+	Index            string `json:"index"`
+	Shard            string `json:"shard"`
+	PriRep           string `json:"prirep"`
+	State            string `json:"state"`
+	Docs             string `json:"docs"`
+	Store            string `json:"store"`
+	IP               string `json:"ip"`
+	Node             string `json:"node"`
+	UnassignedReason string `json:"unassigned.reason"`
+}
+
+// ParseCatShards decodes the body of a Cat Shards API response requested with WithFormat("json"), and closes it.
+//
+// opensearchutil.CatShards performs the request in the JSON format and decodes the response.
+//
+func ParseCatShards(res *Response) ([]CatShardsItem, error) {
+	var items []CatShardsItem
+	if err := parseCatResponse(res, &items); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 // Do executes the request and returns response or error.
 //
 func (r CatShardsRequest) Do(ctx context.Context, transport Transport) (*Response, error) {
@@ -156,7 +182,7 @@ func (r CatShardsRequest) Do(ctx context.Context, transport Transport) (*Respons
 		params["filter_path"] = strings.Join(r.FilterPath, ",")
 	}
 
-	req, err := newRequest(method, path.String(), nil)
+	req, err := newRequest(transport, method, path.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -170,13 +196,9 @@ func (r CatShardsRequest) Do(ctx context.Context, transport Transport) (*Respons
 	}
 
 	if len(r.Header) > 0 {
-		if len(req.Header) == 0 {
-			req.Header = r.Header
-		} else {
-			for k, vv := range r.Header {
-				for _, v := range vv {
-					req.Header.Add(k, v)
-				}
+		for k, vv := range r.Header {
+			for _, v := range vv {
+				req.Header.Add(k, v)
 			}
 		}
 	}
@@ -194,6 +216,7 @@ func (r CatShardsRequest) Do(ctx context.Context, transport Transport) (*Respons
 		StatusCode: res.StatusCode,
 		Body:       res.Body,
 		Header:     res.Header,
+		codec:      JSONCodecOf(transport),
 	}
 
 	return &response, response.Err()
@@ -321,6 +344,16 @@ func (f CatShards) WithErrorTrace() func(*CatShardsRequest) {
 	}
 }
 
+// WithDebug makes the response body pretty-printed and human-readable, and includes the stack trace for errors.
+//
+func (f CatShards) WithDebug() func(*CatShardsRequest) {
+	return func(r *CatShardsRequest) {
+		r.Pretty = true
+		r.Human = true
+		r.ErrorTrace = true
+	}
+}
+
 // WithFilterPath filters the properties of the response body.
 //
 func (f CatShards) WithFilterPath(v ...string) func(*CatShardsRequest) {
@@ -342,6 +375,32 @@ func (f CatShards) WithHeader(h map[string]string) func(*CatShardsRequest) {
 	}
 }
 
+// WithHTTPHeader adds the headers to the HTTP request, keeping the multiple values of a header.
+//
+func (f CatShards) WithHTTPHeader(h http.Header) func(*CatShardsRequest) {
+	return func(r *CatShardsRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, vv := range h {
+			for _, v := range vv {
+				r.Header.Add(k, v)
+			}
+		}
+	}
+}
+
+// WithAccept sets the media type of the response, eg. "application/yaml"; the default is JSON.
+//
+func (f CatShards) WithAccept(v string) func(*CatShardsRequest) {
+	return func(r *CatShardsRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Accept", v)
+	}
+}
+
 // WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
 //
 func (f CatShards) WithOpaqueID(s string) func(*CatShardsRequest) {
@@ -352,3 +411,14 @@ func (f CatShards) WithOpaqueID(s string) func(*CatShardsRequest) {
 		r.Header.Set("X-Opaque-Id", s)
 	}
 }
+
+// WithBasicAuth sets the credentials of the request, overriding the credentials of the client.
+//
+func (f CatShards) WithBasicAuth(username, password string) func(*CatShardsRequest) {
+	return func(r *CatShardsRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Authorization", basicAuth(username, password))
+	}
+}
@@ -146,7 +146,7 @@ func (r CatTasksRequest) Do(ctx context.Context, transport Transport) (*Response
 		params["filter_path"] = strings.Join(r.FilterPath, ",")
 	}
 
-	req, err := newRequest(method, path.String(), nil)
+	req, err := newRequest(transport, method, path.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -160,13 +160,9 @@ func (r CatTasksRequest) Do(ctx context.Context, transport Transport) (*Response
 	}
 
 	if len(r.Header) > 0 {
-		if len(req.Header) == 0 {
-			req.Header = r.Header
-		} else {
-			for k, vv := range r.Header {
-				for _, v := range vv {
-					req.Header.Add(k, v)
-				}
+		for k, vv := range r.Header {
+			for _, v := range vv {
+				req.Header.Add(k, v)
 			}
 		}
 	}
@@ -184,6 +180,7 @@ func (r CatTasksRequest) Do(ctx context.Context, transport Transport) (*Response
 		StatusCode: res.StatusCode,
 		Body:       res.Body,
 		Header:     res.Header,
+		codec:      JSONCodecOf(transport),
 	}
 
 	return &response, response.Err()
@@ -301,6 +298,16 @@ func (f CatTasks) WithErrorTrace() func(*CatTasksRequest) {
 	}
 }
 
+// WithDebug makes the response body pretty-printed and human-readable, and includes the stack trace for errors.
+//
+func (f CatTasks) WithDebug() func(*CatTasksRequest) {
+	return func(r *CatTasksRequest) {
+		r.Pretty = true
+		r.Human = true
+		r.ErrorTrace = true
+	}
+}
+
 // WithFilterPath filters the properties of the response body.
 //
 func (f CatTasks) WithFilterPath(v ...string) func(*CatTasksRequest) {
@@ -322,6 +329,32 @@ func (f CatTasks) WithHeader(h map[string]string) func(*CatTasksRequest) {
 	}
 }
 
+// WithHTTPHeader adds the headers to the HTTP request, keeping the multiple values of a header.
+//
+func (f CatTasks) WithHTTPHeader(h http.Header) func(*CatTasksRequest) {
+	return func(r *CatTasksRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, vv := range h {
+			for _, v := range vv {
+				r.Header.Add(k, v)
+			}
+		}
+	}
+}
+
+// WithAccept sets the media type of the response, eg. "application/yaml"; the default is JSON.
+//
+func (f CatTasks) WithAccept(v string) func(*CatTasksRequest) {
+	return func(r *CatTasksRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Accept", v)
+	}
+}
+
 // WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
 //
 func (f CatTasks) WithOpaqueID(s string) func(*CatTasksRequest) {
@@ -332,3 +365,14 @@ func (f CatTasks) WithOpaqueID(s string) func(*CatTasksRequest) {
 		r.Header.Set("X-Opaque-Id", s)
 	}
 }
+
+// WithBasicAuth sets the credentials of the request, overriding the credentials of the client.
+//
+func (f CatTasks) WithBasicAuth(username, password string) func(*CatTasksRequest) {
+	return func(r *CatTasksRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Authorization", basicAuth(username, password))
+	}
+}
@@ -146,7 +146,7 @@ func (r CatTemplatesRequest) Do(ctx context.Context, transport Transport) (*Resp
 		params["filter_path"] = strings.Join(r.FilterPath, ",")
 	}
 
-	req, err := newRequest(method, path.String(), nil)
+	req, err := newRequest(transport, method, path.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -160,13 +160,9 @@ func (r CatTemplatesRequest) Do(ctx context.Context, transport Transport) (*Resp
 	}
 
 	if len(r.Header) > 0 {
-		if len(req.Header) == 0 {
-			req.Header = r.Header
-		} else {
-			for k, vv := range r.Header {
-				for _, v := range vv {
-					req.Header.Add(k, v)
-				}
+		for k, vv := range r.Header {
+			for _, v := range vv {
+				req.Header.Add(k, v)
 			}
 		}
 	}
@@ -184,6 +180,7 @@ func (r CatTemplatesRequest) Do(ctx context.Context, transport Transport) (*Resp
 		StatusCode: res.StatusCode,
 		Body:       res.Body,
 		Header:     res.Header,
+		codec:      JSONCodecOf(transport),
 	}
 
 	return &response, response.Err()
@@ -295,6 +292,16 @@ func (f CatTemplates) WithErrorTrace() func(*CatTemplatesRequest) {
 	}
 }
 
+// WithDebug makes the response body pretty-printed and human-readable, and includes the stack trace for errors.
+//
+func (f CatTemplates) WithDebug() func(*CatTemplatesRequest) {
+	return func(r *CatTemplatesRequest) {
+		r.Pretty = true
+		r.Human = true
+		r.ErrorTrace = true
+	}
+}
+
 // WithFilterPath filters the properties of the response body.
 //
 func (f CatTemplates) WithFilterPath(v ...string) func(*CatTemplatesRequest) {
@@ -316,6 +323,32 @@ func (f CatTemplates) WithHeader(h map[string]string) func(*CatTemplatesRequest)
 	}
 }
 
+// WithHTTPHeader adds the headers to the HTTP request, keeping the multiple values of a header.
+//
+func (f CatTemplates) WithHTTPHeader(h http.Header) func(*CatTemplatesRequest) {
+	return func(r *CatTemplatesRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, vv := range h {
+			for _, v := range vv {
+				r.Header.Add(k, v)
+			}
+		}
+	}
+}
+
+// WithAccept sets the media type of the response, eg. "application/yaml"; the default is JSON.
+//
+func (f CatTemplates) WithAccept(v string) func(*CatTemplatesRequest) {
+	return func(r *CatTemplatesRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Accept", v)
+	}
+}
+
 // WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
 //
 func (f CatTemplates) WithOpaqueID(s string) func(*CatTemplatesRequest) {
@@ -326,3 +359,14 @@ func (f CatTemplates) WithOpaqueID(s string) func(*CatTemplatesRequest) {
 		r.Header.Set("X-Opaque-Id", s)
 	}
 }
+
+// WithBasicAuth sets the credentials of the request, overriding the credentials of the client.
+//
+func (f CatTemplates) WithBasicAuth(username, password string) func(*CatTemplatesRequest) {
+	return func(r *CatTemplatesRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Authorization", basicAuth(username, password))
+	}
+}
@@ -152,7 +152,7 @@ func (r CatThreadPoolRequest) Do(ctx context.Context, transport Transport) (*Res
 		params["filter_path"] = strings.Join(r.FilterPath, ",")
 	}
 
-	req, err := newRequest(method, path.String(), nil)
+	req, err := newRequest(transport, method, path.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -166,13 +166,9 @@ func (r CatThreadPoolRequest) Do(ctx context.Context, transport Transport) (*Res
 	}
 
 	if len(r.Header) > 0 {
-		if len(req.Header) == 0 {
-			req.Header = r.Header
-		} else {
-			for k, vv := range r.Header {
-				for _, v := range vv {
-					req.Header.Add(k, v)
-				}
+		for k, vv := range r.Header {
+			for _, v := range vv {
+				req.Header.Add(k, v)
 			}
 		}
 	}
@@ -190,6 +186,7 @@ func (r CatThreadPoolRequest) Do(ctx context.Context, transport Transport) (*Res
 		StatusCode: res.StatusCode,
 		Body:       res.Body,
 		Header:     res.Header,
+		codec:      JSONCodecOf(transport),
 	}
 
 	return &response, response.Err()
@@ -309,6 +306,16 @@ func (f CatThreadPool) WithErrorTrace() func(*CatThreadPoolRequest) {
 	}
 }
 
+// WithDebug makes the response body pretty-printed and human-readable, and includes the stack trace for errors.
+//
+func (f CatThreadPool) WithDebug() func(*CatThreadPoolRequest) {
+	return func(r *CatThreadPoolRequest) {
+		r.Pretty = true
+		r.Human = true
+		r.ErrorTrace = true
+	}
+}
+
 // WithFilterPath filters the properties of the response body.
 //
 func (f CatThreadPool) WithFilterPath(v ...string) func(*CatThreadPoolRequest) {
@@ -330,6 +337,32 @@ func (f CatThreadPool) WithHeader(h map[string]string) func(*CatThreadPoolReques
 	}
 }
 
+// WithHTTPHeader adds the headers to the HTTP request, keeping the multiple values of a header.
+//
+func (f CatThreadPool) WithHTTPHeader(h http.Header) func(*CatThreadPoolRequest) {
+	return func(r *CatThreadPoolRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, vv := range h {
+			for _, v := range vv {
+				r.Header.Add(k, v)
+			}
+		}
+	}
+}
+
+// WithAccept sets the media type of the response, eg. "application/yaml"; the default is JSON.
+//
+func (f CatThreadPool) WithAccept(v string) func(*CatThreadPoolRequest) {
+	return func(r *CatThreadPoolRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Accept", v)
+	}
+}
+
 // WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
 //
 func (f CatThreadPool) WithOpaqueID(s string) func(*CatThreadPoolRequest) {
@@ -340,3 +373,14 @@ func (f CatThreadPool) WithOpaqueID(s string) func(*CatThreadPoolRequest) {
 		r.Header.Set("X-Opaque-Id", s)
 	}
 }
+
+// WithBasicAuth sets the credentials of the request, overriding the credentials of the client.
+//
+func (f CatThreadPool) WithBasicAuth(username, password string) func(*CatThreadPoolRequest) {
+	return func(r *CatThreadPoolRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Authorization", basicAuth(username, password))
+	}
+}
@@ -106,7 +106,7 @@ func (r ClearScrollRequest) Do(ctx context.Context, transport Transport) (*Respo
 		params["filter_path"] = strings.Join(r.FilterPath, ",")
 	}
 
-	req, err := newRequest(method, path.String(), r.Body)
+	req, err := newRequest(transport, method, path.String(), r.Body)
 	if err != nil {
 		return nil, err
 	}
@@ -119,18 +119,14 @@ func (r ClearScrollRequest) Do(ctx context.Context, transport Transport) (*Respo
 		req.URL.RawQuery = q.Encode()
 	}
 
-	if r.Body != nil {
+	if r.Body != nil && r.Header.Get(headerContentType) == "" {
 		req.Header[headerContentType] = headerContentTypeJSON
 	}
 
 	if len(r.Header) > 0 {
-		if len(req.Header) == 0 {
-			req.Header = r.Header
-		} else {
-			for k, vv := range r.Header {
-				for _, v := range vv {
-					req.Header.Add(k, v)
-				}
+		for k, vv := range r.Header {
+			for _, v := range vv {
+				req.Header.Add(k, v)
 			}
 		}
 	}
@@ -148,6 +144,7 @@ func (r ClearScrollRequest) Do(ctx context.Context, transport Transport) (*Respo
 		StatusCode: res.StatusCode,
 		Body:       res.Body,
 		Header:     res.Header,
+		codec:      JSONCodecOf(transport),
 	}
 
 	return &response, response.Err()
@@ -169,6 +166,14 @@ func (f ClearScroll) WithBody(v io.Reader) func(*ClearScrollRequest) {
 	}
 }
 
+// WithJSONBody - the request body, encoded to JSON with the JSON codec of the client; it replaces the body set with WithBody.
+//
+func (f ClearScroll) WithJSONBody(v interface{}) func(*ClearScrollRequest) {
+	return func(r *ClearScrollRequest) {
+		r.Body = JSONBody(v)
+	}
+}
+
 // WithScrollID - a list of scroll ids to clear.
 //
 func (f ClearScroll) WithScrollID(v ...string) func(*ClearScrollRequest) {
@@ -201,6 +206,16 @@ func (f ClearScroll) WithErrorTrace() func(*ClearScrollRequest) {
 	}
 }
 
+// WithDebug makes the response body pretty-printed and human-readable, and includes the stack trace for errors.
+//
+func (f ClearScroll) WithDebug() func(*ClearScrollRequest) {
+	return func(r *ClearScrollRequest) {
+		r.Pretty = true
+		r.Human = true
+		r.ErrorTrace = true
+	}
+}
+
 // WithFilterPath filters the properties of the response body.
 //
 func (f ClearScroll) WithFilterPath(v ...string) func(*ClearScrollRequest) {
@@ -222,6 +237,32 @@ func (f ClearScroll) WithHeader(h map[string]string) func(*ClearScrollRequest) {
 	}
 }
 
+// WithHTTPHeader adds the headers to the HTTP request, keeping the multiple values of a header.
+//
+func (f ClearScroll) WithHTTPHeader(h http.Header) func(*ClearScrollRequest) {
+	return func(r *ClearScrollRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, vv := range h {
+			for _, v := range vv {
+				r.Header.Add(k, v)
+			}
+		}
+	}
+}
+
+// WithAccept sets the media type of the response, eg. "application/yaml"; the default is JSON.
+//
+func (f ClearScroll) WithAccept(v string) func(*ClearScrollRequest) {
+	return func(r *ClearScrollRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Accept", v)
+	}
+}
+
 // WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
 //
 func (f ClearScroll) WithOpaqueID(s string) func(*ClearScrollRequest) {
@@ -232,3 +273,25 @@ func (f ClearScroll) WithOpaqueID(s string) func(*ClearScrollRequest) {
 		r.Header.Set("X-Opaque-Id", s)
 	}
 }
+
+// WithContentType sets the Content-Type header of the request, overriding the default of the request body.
+//
+func (f ClearScroll) WithContentType(v string) func(*ClearScrollRequest) {
+	return func(r *ClearScrollRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Content-Type", v)
+	}
+}
+
+// WithBasicAuth sets the credentials of the request, overriding the credentials of the client.
+//
+func (f ClearScroll) WithBasicAuth(username, password string) func(*ClearScrollRequest) {
+	return func(r *ClearScrollRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Authorization", basicAuth(username, password))
+	}
+}
@@ -109,7 +109,7 @@ func (r ClusterAllocationExplainRequest) Do(ctx context.Context, transport Trans
 		params["filter_path"] = strings.Join(r.FilterPath, ",")
 	}
 
-	req, err := newRequest(method, path.String(), r.Body)
+	req, err := newRequest(transport, method, path.String(), r.Body)
 	if err != nil {
 		return nil, err
 	}
@@ -122,18 +122,14 @@ func (r ClusterAllocationExplainRequest) Do(ctx context.Context, transport Trans
 		req.URL.RawQuery = q.Encode()
 	}
 
-	if r.Body != nil {
+	if r.Body != nil && r.Header.Get(headerContentType) == "" {
 		req.Header[headerContentType] = headerContentTypeJSON
 	}
 
 	if len(r.Header) > 0 {
-		if len(req.Header) == 0 {
-			req.Header = r.Header
-		} else {
-			for k, vv := range r.Header {
-				for _, v := range vv {
-					req.Header.Add(k, v)
-				}
+		for k, vv := range r.Header {
+			for _, v := range vv {
+				req.Header.Add(k, v)
 			}
 		}
 	}
@@ -151,6 +147,7 @@ func (r ClusterAllocationExplainRequest) Do(ctx context.Context, transport Trans
 		StatusCode: res.StatusCode,
 		Body:       res.Body,
 		Header:     res.Header,
+		codec:      JSONCodecOf(transport),
 	}
 
 	return &response, response.Err()
@@ -172,6 +169,14 @@ func (f ClusterAllocationExplain) WithBody(v io.Reader) func(*ClusterAllocationE
 	}
 }
 
+// WithJSONBody - the request body, encoded to JSON with the JSON codec of the client; it replaces the body set with WithBody.
+//
+func (f ClusterAllocationExplain) WithJSONBody(v interface{}) func(*ClusterAllocationExplainRequest) {
+	return func(r *ClusterAllocationExplainRequest) {
+		r.Body = JSONBody(v)
+	}
+}
+
 // WithIncludeDiskInfo - return information about disk usage and shard sizes (default: false).
 //
 func (f ClusterAllocationExplain) WithIncludeDiskInfo(v bool) func(*ClusterAllocationExplainRequest) {
@@ -212,6 +217,16 @@ func (f ClusterAllocationExplain) WithErrorTrace() func(*ClusterAllocationExplai
 	}
 }
 
+// WithDebug makes the response body pretty-printed and human-readable, and includes the stack trace for errors.
+//
+func (f ClusterAllocationExplain) WithDebug() func(*ClusterAllocationExplainRequest) {
+	return func(r *ClusterAllocationExplainRequest) {
+		r.Pretty = true
+		r.Human = true
+		r.ErrorTrace = true
+	}
+}
+
 // WithFilterPath filters the properties of the response body.
 //
 func (f ClusterAllocationExplain) WithFilterPath(v ...string) func(*ClusterAllocationExplainRequest) {
@@ -233,6 +248,32 @@ func (f ClusterAllocationExplain) WithHeader(h map[string]string) func(*ClusterA
 	}
 }
 
+// WithHTTPHeader adds the headers to the HTTP request, keeping the multiple values of a header.
+//
+func (f ClusterAllocationExplain) WithHTTPHeader(h http.Header) func(*ClusterAllocationExplainRequest) {
+	return func(r *ClusterAllocationExplainRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, vv := range h {
+			for _, v := range vv {
+				r.Header.Add(k, v)
+			}
+		}
+	}
+}
+
+// WithAccept sets the media type of the response, eg. "application/yaml"; the default is JSON.
+//
+func (f ClusterAllocationExplain) WithAccept(v string) func(*ClusterAllocationExplainRequest) {
+	return func(r *ClusterAllocationExplainRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Accept", v)
+	}
+}
+
 // WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
 //
 func (f ClusterAllocationExplain) WithOpaqueID(s string) func(*ClusterAllocationExplainRequest) {
@@ -243,3 +284,25 @@ func (f ClusterAllocationExplain) WithOpaqueID(s string) func(*ClusterAllocation
 		r.Header.Set("X-Opaque-Id", s)
 	}
 }
+
+// WithContentType sets the Content-Type header of the request, overriding the default of the request body.
+//
+func (f ClusterAllocationExplain) WithContentType(v string) func(*ClusterAllocationExplainRequest) {
+	return func(r *ClusterAllocationExplainRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Content-Type", v)
+	}
+}
+
+// WithBasicAuth sets the credentials of the request, overriding the credentials of the client.
+//
+func (f ClusterAllocationExplain) WithBasicAuth(username, password string) func(*ClusterAllocationExplainRequest) {
+	return func(r *ClusterAllocationExplainRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Authorization", basicAuth(username, password))
+	}
+}
@@ -116,7 +116,7 @@ func (r ClusterDeleteComponentTemplateRequest) Do(ctx context.Context, transport
 		params["filter_path"] = strings.Join(r.FilterPath, ",")
 	}
 
-	req, err := newRequest(method, path.String(), nil)
+	req, err := newRequest(transport, method, path.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -130,13 +130,9 @@ func (r ClusterDeleteComponentTemplateRequest) Do(ctx context.Context, transport
 	}
 
 	if len(r.Header) > 0 {
-		if len(req.Header) == 0 {
-			req.Header = r.Header
-		} else {
-			for k, vv := range r.Header {
-				for _, v := range vv {
-					req.Header.Add(k, v)
-				}
+		for k, vv := range r.Header {
+			for _, v := range vv {
+				req.Header.Add(k, v)
 			}
 		}
 	}
@@ -154,6 +150,7 @@ func (r ClusterDeleteComponentTemplateRequest) Do(ctx context.Context, transport
 		StatusCode: res.StatusCode,
 		Body:       res.Body,
 		Header:     res.Header,
+		codec:      JSONCodecOf(transport),
 	}
 
 	return &response, response.Err()
@@ -217,6 +214,16 @@ func (f ClusterDeleteComponentTemplate) WithErrorTrace() func(*ClusterDeleteComp
 	}
 }
 
+// WithDebug makes the response body pretty-printed and human-readable, and includes the stack trace for errors.
+//
+func (f ClusterDeleteComponentTemplate) WithDebug() func(*ClusterDeleteComponentTemplateRequest) {
+	return func(r *ClusterDeleteComponentTemplateRequest) {
+		r.Pretty = true
+		r.Human = true
+		r.ErrorTrace = true
+	}
+}
+
 // WithFilterPath filters the properties of the response body.
 //
 func (f ClusterDeleteComponentTemplate) WithFilterPath(v ...string) func(*ClusterDeleteComponentTemplateRequest) {
@@ -238,6 +245,32 @@ func (f ClusterDeleteComponentTemplate) WithHeader(h map[string]string) func(*Cl
 	}
 }
 
+// WithHTTPHeader adds the headers to the HTTP request, keeping the multiple values of a header.
+//
+func (f ClusterDeleteComponentTemplate) WithHTTPHeader(h http.Header) func(*ClusterDeleteComponentTemplateRequest) {
+	return func(r *ClusterDeleteComponentTemplateRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, vv := range h {
+			for _, v := range vv {
+				r.Header.Add(k, v)
+			}
+		}
+	}
+}
+
+// WithAccept sets the media type of the response, eg. "application/yaml"; the default is JSON.
+//
+func (f ClusterDeleteComponentTemplate) WithAccept(v string) func(*ClusterDeleteComponentTemplateRequest) {
+	return func(r *ClusterDeleteComponentTemplateRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Accept", v)
+	}
+}
+
 // WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
 //
 func (f ClusterDeleteComponentTemplate) WithOpaqueID(s string) func(*ClusterDeleteComponentTemplateRequest) {
@@ -248,3 +281,14 @@ func (f ClusterDeleteComponentTemplate) WithOpaqueID(s string) func(*ClusterDele
 		r.Header.Set("X-Opaque-Id", s)
 	}
 }
+
+// WithBasicAuth sets the credentials of the request, overriding the credentials of the client.
+//
+func (f ClusterDeleteComponentTemplate) WithBasicAuth(username, password string) func(*ClusterDeleteComponentTemplateRequest) {
+	return func(r *ClusterDeleteComponentTemplateRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Authorization", basicAuth(username, password))
+	}
+}
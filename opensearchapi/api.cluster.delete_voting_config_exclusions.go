@@ -101,7 +101,7 @@ func (r ClusterDeleteVotingConfigExclusionsRequest) Do(ctx context.Context, tran
 		params["filter_path"] = strings.Join(r.FilterPath, ",")
 	}
 
-	req, err := newRequest(method, path.String(), nil)
+	req, err := newRequest(transport, method, path.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -115,13 +115,9 @@ func (r ClusterDeleteVotingConfigExclusionsRequest) Do(ctx context.Context, tran
 	}
 
 	if len(r.Header) > 0 {
-		if len(req.Header) == 0 {
-			req.Header = r.Header
-		} else {
-			for k, vv := range r.Header {
-				for _, v := range vv {
-					req.Header.Add(k, v)
-				}
+		for k, vv := range r.Header {
+			for _, v := range vv {
+				req.Header.Add(k, v)
 			}
 		}
 	}
@@ -139,6 +135,7 @@ func (r ClusterDeleteVotingConfigExclusionsRequest) Do(ctx context.Context, tran
 		StatusCode: res.StatusCode,
 		Body:       res.Body,
 		Header:     res.Header,
+		codec:      JSONCodecOf(transport),
 	}
 
 	return &response, response.Err()
@@ -184,6 +181,16 @@ func (f ClusterDeleteVotingConfigExclusions) WithErrorTrace() func(*ClusterDelet
 	}
 }
 
+// WithDebug makes the response body pretty-printed and human-readable, and includes the stack trace for errors.
+//
+func (f ClusterDeleteVotingConfigExclusions) WithDebug() func(*ClusterDeleteVotingConfigExclusionsRequest) {
+	return func(r *ClusterDeleteVotingConfigExclusionsRequest) {
+		r.Pretty = true
+		r.Human = true
+		r.ErrorTrace = true
+	}
+}
+
 // WithFilterPath filters the properties of the response body.
 //
 func (f ClusterDeleteVotingConfigExclusions) WithFilterPath(v ...string) func(*ClusterDeleteVotingConfigExclusionsRequest) {
@@ -205,6 +212,32 @@ func (f ClusterDeleteVotingConfigExclusions) WithHeader(h map[string]string) fun
 	}
 }
 
+// WithHTTPHeader adds the headers to the HTTP request, keeping the multiple values of a header.
+//
+func (f ClusterDeleteVotingConfigExclusions) WithHTTPHeader(h http.Header) func(*ClusterDeleteVotingConfigExclusionsRequest) {
+	return func(r *ClusterDeleteVotingConfigExclusionsRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, vv := range h {
+			for _, v := range vv {
+				r.Header.Add(k, v)
+			}
+		}
+	}
+}
+
+// WithAccept sets the media type of the response, eg. "application/yaml"; the default is JSON.
+//
+func (f ClusterDeleteVotingConfigExclusions) WithAccept(v string) func(*ClusterDeleteVotingConfigExclusionsRequest) {
+	return func(r *ClusterDeleteVotingConfigExclusionsRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Accept", v)
+	}
+}
+
 // WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
 //
 func (f ClusterDeleteVotingConfigExclusions) WithOpaqueID(s string) func(*ClusterDeleteVotingConfigExclusionsRequest) {
@@ -215,3 +248,14 @@ func (f ClusterDeleteVotingConfigExclusions) WithOpaqueID(s string) func(*Cluste
 		r.Header.Set("X-Opaque-Id", s)
 	}
 }
+
+// WithBasicAuth sets the credentials of the request, overriding the credentials of the client.
+//
+func (f ClusterDeleteVotingConfigExclusions) WithBasicAuth(username, password string) func(*ClusterDeleteVotingConfigExclusionsRequest) {
+	return func(r *ClusterDeleteVotingConfigExclusionsRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Authorization", basicAuth(username, password))
+	}
+}
@@ -117,7 +117,7 @@ func (r ClusterExistsComponentTemplateRequest) Do(ctx context.Context, transport
 		params["filter_path"] = strings.Join(r.FilterPath, ",")
 	}
 
-	req, err := newRequest(method, path.String(), nil)
+	req, err := newRequest(transport, method, path.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -131,13 +131,9 @@ func (r ClusterExistsComponentTemplateRequest) Do(ctx context.Context, transport
 	}
 
 	if len(r.Header) > 0 {
-		if len(req.Header) == 0 {
-			req.Header = r.Header
-		} else {
-			for k, vv := range r.Header {
-				for _, v := range vv {
-					req.Header.Add(k, v)
-				}
+		for k, vv := range r.Header {
+			for _, v := range vv {
+				req.Header.Add(k, v)
 			}
 		}
 	}
@@ -155,6 +151,7 @@ func (r ClusterExistsComponentTemplateRequest) Do(ctx context.Context, transport
 		StatusCode: res.StatusCode,
 		Body:       res.Body,
 		Header:     res.Header,
+		codec:      JSONCodecOf(transport),
 	}
 
 	return &response, response.Err()
@@ -218,6 +215,16 @@ func (f ClusterExistsComponentTemplate) WithErrorTrace() func(*ClusterExistsComp
 	}
 }
 
+// WithDebug makes the response body pretty-printed and human-readable, and includes the stack trace for errors.
+//
+func (f ClusterExistsComponentTemplate) WithDebug() func(*ClusterExistsComponentTemplateRequest) {
+	return func(r *ClusterExistsComponentTemplateRequest) {
+		r.Pretty = true
+		r.Human = true
+		r.ErrorTrace = true
+	}
+}
+
 // WithFilterPath filters the properties of the response body.
 //
 func (f ClusterExistsComponentTemplate) WithFilterPath(v ...string) func(*ClusterExistsComponentTemplateRequest) {
@@ -239,6 +246,32 @@ func (f ClusterExistsComponentTemplate) WithHeader(h map[string]string) func(*Cl
 	}
 }
 
+// WithHTTPHeader adds the headers to the HTTP request, keeping the multiple values of a header.
+//
+func (f ClusterExistsComponentTemplate) WithHTTPHeader(h http.Header) func(*ClusterExistsComponentTemplateRequest) {
+	return func(r *ClusterExistsComponentTemplateRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, vv := range h {
+			for _, v := range vv {
+				r.Header.Add(k, v)
+			}
+		}
+	}
+}
+
+// WithAccept sets the media type of the response, eg. "application/yaml"; the default is JSON.
+//
+func (f ClusterExistsComponentTemplate) WithAccept(v string) func(*ClusterExistsComponentTemplateRequest) {
+	return func(r *ClusterExistsComponentTemplateRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Accept", v)
+	}
+}
+
 // WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
 //
 func (f ClusterExistsComponentTemplate) WithOpaqueID(s string) func(*ClusterExistsComponentTemplateRequest) {
@@ -249,3 +282,14 @@ func (f ClusterExistsComponentTemplate) WithOpaqueID(s string) func(*ClusterExis
 		r.Header.Set("X-Opaque-Id", s)
 	}
 }
+
+// WithBasicAuth sets the credentials of the request, overriding the credentials of the client.
+//
+func (f ClusterExistsComponentTemplate) WithBasicAuth(username, password string) func(*ClusterExistsComponentTemplateRequest) {
+	return func(r *ClusterExistsComponentTemplateRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Authorization", basicAuth(username, password))
+	}
+}
@@ -28,6 +28,8 @@ package opensearchapi
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"net/http"
 	"strconv"
 	"strings"
@@ -70,6 +72,77 @@ type ClusterGetSettingsRequest struct {
 	ctx context.Context
 }
 
+// ClusterSettingsResp represents the settings of the Cluster Get Settings and Cluster Put Settings API responses.
+//
+// The settings are keyed by their name in the flat form, eg. "cluster.routing.allocation.enable",
+// whether the response was requested with flat_settings or not. The values are strings, or lists
+// of strings, as returned by the cluster.
+//
+type ClusterSettingsResp struct {
+	Acknowledged bool                   `json:"acknowledged"` // Only set in the Cluster Put Settings API responses
+	Persistent   map[string]interface{} `json:"persistent"`
+	Transient    map[string]interface{} `json:"transient"`
+	Defaults     map[string]interface{} `json:"defaults"` // Only set when the defaults are requested
+}
+
+// Get returns the value of the setting in effect: the transient value, the persistent value,
+// or the default value, in that order.
+//
+func (s *ClusterSettingsResp) Get(name string) (interface{}, bool) {
+	for _, m := range []map[string]interface{}{s.Transient, s.Persistent, s.Defaults} {
+		if v, ok := m[name]; ok {
+			return v, true
+		}
+	}
+	return nil, false
+}
+
+// ParseClusterSettingsResponse decodes the body of a Cluster Get Settings or Cluster Put Settings API response
+// and closes it. The nested settings are flattened, see ClusterSettingsResp.
+//
+func ParseClusterSettingsResponse(res *Response) (*ClusterSettingsResp, error) {
+	if res == nil || res.Body == nil {
+		return nil, errors.New("cannot parse cluster settings response: empty response")
+	}
+	defer res.Close()
+
+	if err := res.Err(); err != nil {
+		return nil, err
+	}
+
+	var sr ClusterSettingsResp
+	if err := decodeBody(res, &sr); err != nil {
+		return nil, fmt.Errorf("cannot parse cluster settings response: %s", err)
+	}
+	sr.Persistent = flattenSettings(sr.Persistent)
+	sr.Transient = flattenSettings(sr.Transient)
+	sr.Defaults = flattenSettings(sr.Defaults)
+
+	return &sr, nil
+}
+
+// flattenSettings returns the settings with the nested objects replaced by their settings,
+// with the names joined with dots, eg. {"cluster":{"name":"logs"}} becomes {"cluster.name":"logs"}.
+//
+func flattenSettings(m map[string]interface{}) map[string]interface{} {
+	if m == nil {
+		return nil
+	}
+	out := make(map[string]interface{}, len(m))
+	var flatten func(prefix string, m map[string]interface{})
+	flatten = func(prefix string, m map[string]interface{}) {
+		for k, v := range m {
+			if nested, ok := v.(map[string]interface{}); ok {
+				flatten(prefix+k+".", nested)
+				continue
+			}
+			out[prefix+k] = v
+		}
+	}
+	flatten("", m)
+	return out
+}
+
 // Do executes the request and returns response or error.
 //
 func (r ClusterGetSettingsRequest) Do(ctx context.Context, transport Transport) (*Response, error) {
@@ -122,7 +195,7 @@ func (r ClusterGetSettingsRequest) Do(ctx context.Context, transport Transport)
 		params["filter_path"] = strings.Join(r.FilterPath, ",")
 	}
 
-	req, err := newRequest(method, path.String(), nil)
+	req, err := newRequest(transport, method, path.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -136,13 +209,9 @@ func (r ClusterGetSettingsRequest) Do(ctx context.Context, transport Transport)
 	}
 
 	if len(r.Header) > 0 {
-		if len(req.Header) == 0 {
-			req.Header = r.Header
-		} else {
-			for k, vv := range r.Header {
-				for _, v := range vv {
-					req.Header.Add(k, v)
-				}
+		for k, vv := range r.Header {
+			for _, v := range vv {
+				req.Header.Add(k, v)
 			}
 		}
 	}
@@ -160,6 +229,7 @@ func (r ClusterGetSettingsRequest) Do(ctx context.Context, transport Transport)
 		StatusCode: res.StatusCode,
 		Body:       res.Body,
 		Header:     res.Header,
+		codec:      JSONCodecOf(transport),
 	}
 
 	return &response, response.Err()
@@ -239,6 +309,16 @@ func (f ClusterGetSettings) WithErrorTrace() func(*ClusterGetSettingsRequest) {
 	}
 }
 
+// WithDebug makes the response body pretty-printed and human-readable, and includes the stack trace for errors.
+//
+func (f ClusterGetSettings) WithDebug() func(*ClusterGetSettingsRequest) {
+	return func(r *ClusterGetSettingsRequest) {
+		r.Pretty = true
+		r.Human = true
+		r.ErrorTrace = true
+	}
+}
+
 // WithFilterPath filters the properties of the response body.
 //
 func (f ClusterGetSettings) WithFilterPath(v ...string) func(*ClusterGetSettingsRequest) {
@@ -260,6 +340,32 @@ func (f ClusterGetSettings) WithHeader(h map[string]string) func(*ClusterGetSett
 	}
 }
 
+// WithHTTPHeader adds the headers to the HTTP request, keeping the multiple values of a header.
+//
+func (f ClusterGetSettings) WithHTTPHeader(h http.Header) func(*ClusterGetSettingsRequest) {
+	return func(r *ClusterGetSettingsRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, vv := range h {
+			for _, v := range vv {
+				r.Header.Add(k, v)
+			}
+		}
+	}
+}
+
+// WithAccept sets the media type of the response, eg. "application/yaml"; the default is JSON.
+//
+func (f ClusterGetSettings) WithAccept(v string) func(*ClusterGetSettingsRequest) {
+	return func(r *ClusterGetSettingsRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Accept", v)
+	}
+}
+
 // WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
 //
 func (f ClusterGetSettings) WithOpaqueID(s string) func(*ClusterGetSettingsRequest) {
@@ -270,3 +376,14 @@ func (f ClusterGetSettings) WithOpaqueID(s string) func(*ClusterGetSettingsReque
 		r.Header.Set("X-Opaque-Id", s)
 	}
 }
+
+// WithBasicAuth sets the credentials of the request, overriding the credentials of the client.
+//
+func (f ClusterGetSettings) WithBasicAuth(username, password string) func(*ClusterGetSettingsRequest) {
+	return func(r *ClusterGetSettingsRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Authorization", basicAuth(username, password))
+	}
+}
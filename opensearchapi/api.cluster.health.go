@@ -56,7 +56,9 @@ type ClusterHealth func(o ...func(*ClusterHealthRequest)) (*Response, error)
 type ClusterHealthRequest struct {
 	Index []string
 
+	AllowNoIndices              *bool
 	ExpandWildcards             string
+	IgnoreUnavailable           *bool
 	Level                       string
 	Local                       *bool
 	MasterTimeout               time.Duration
@@ -102,10 +104,18 @@ func (r ClusterHealthRequest) Do(ctx context.Context, transport Transport) (*Res
 
 	params = make(map[string]string)
 
+	if r.AllowNoIndices != nil {
+		params["allow_no_indices"] = strconv.FormatBool(*r.AllowNoIndices)
+	}
+
 	if r.ExpandWildcards != "" {
 		params["expand_wildcards"] = r.ExpandWildcards
 	}
 
+	if r.IgnoreUnavailable != nil {
+		params["ignore_unavailable"] = strconv.FormatBool(*r.IgnoreUnavailable)
+	}
+
 	if r.Level != "" {
 		params["level"] = r.Level
 	}
@@ -127,6 +137,9 @@ func (r ClusterHealthRequest) Do(ctx context.Context, transport Transport) (*Res
 	}
 
 	if r.WaitForActiveShards != "" {
+		if err := ValidateWaitForActiveShards(r.WaitForActiveShards); err != nil {
+			return nil, err
+		}
 		params["wait_for_active_shards"] = r.WaitForActiveShards
 	}
 
@@ -166,7 +179,7 @@ func (r ClusterHealthRequest) Do(ctx context.Context, transport Transport) (*Res
 		params["filter_path"] = strings.Join(r.FilterPath, ",")
 	}
 
-	req, err := newRequest(method, path.String(), nil)
+	req, err := newRequest(transport, method, path.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -180,13 +193,9 @@ func (r ClusterHealthRequest) Do(ctx context.Context, transport Transport) (*Res
 	}
 
 	if len(r.Header) > 0 {
-		if len(req.Header) == 0 {
-			req.Header = r.Header
-		} else {
-			for k, vv := range r.Header {
-				for _, v := range vv {
-					req.Header.Add(k, v)
-				}
+		for k, vv := range r.Header {
+			for _, v := range vv {
+				req.Header.Add(k, v)
 			}
 		}
 	}
@@ -204,6 +213,7 @@ func (r ClusterHealthRequest) Do(ctx context.Context, transport Transport) (*Res
 		StatusCode: res.StatusCode,
 		Body:       res.Body,
 		Header:     res.Header,
+		codec:      JSONCodecOf(transport),
 	}
 
 	return &response, response.Err()
@@ -225,6 +235,14 @@ func (f ClusterHealth) WithIndex(v ...string) func(*ClusterHealthRequest) {
 	}
 }
 
+// WithAllowNoIndices - whether to ignore if a wildcard indices expression resolves into no concrete indices. (this includes `_all` string or when no indices have been specified).
+//
+func (f ClusterHealth) WithAllowNoIndices(v bool) func(*ClusterHealthRequest) {
+	return func(r *ClusterHealthRequest) {
+		r.AllowNoIndices = &v
+	}
+}
+
 // WithExpandWildcards - whether to expand wildcard expression to concrete indices that are open, closed or both..
 //
 func (f ClusterHealth) WithExpandWildcards(v string) func(*ClusterHealthRequest) {
@@ -233,6 +251,14 @@ func (f ClusterHealth) WithExpandWildcards(v string) func(*ClusterHealthRequest)
 	}
 }
 
+// WithIgnoreUnavailable - whether specified concrete indices should be ignored when unavailable (missing or closed).
+//
+func (f ClusterHealth) WithIgnoreUnavailable(v bool) func(*ClusterHealthRequest) {
+	return func(r *ClusterHealthRequest) {
+		r.IgnoreUnavailable = &v
+	}
+}
+
 // WithLevel - specify the level of detail for returned information.
 //
 func (f ClusterHealth) WithLevel(v string) func(*ClusterHealthRequest) {
@@ -347,6 +373,16 @@ func (f ClusterHealth) WithErrorTrace() func(*ClusterHealthRequest) {
 	}
 }
 
+// WithDebug makes the response body pretty-printed and human-readable, and includes the stack trace for errors.
+//
+func (f ClusterHealth) WithDebug() func(*ClusterHealthRequest) {
+	return func(r *ClusterHealthRequest) {
+		r.Pretty = true
+		r.Human = true
+		r.ErrorTrace = true
+	}
+}
+
 // WithFilterPath filters the properties of the response body.
 //
 func (f ClusterHealth) WithFilterPath(v ...string) func(*ClusterHealthRequest) {
@@ -368,6 +404,32 @@ func (f ClusterHealth) WithHeader(h map[string]string) func(*ClusterHealthReques
 	}
 }
 
+// WithHTTPHeader adds the headers to the HTTP request, keeping the multiple values of a header.
+//
+func (f ClusterHealth) WithHTTPHeader(h http.Header) func(*ClusterHealthRequest) {
+	return func(r *ClusterHealthRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, vv := range h {
+			for _, v := range vv {
+				r.Header.Add(k, v)
+			}
+		}
+	}
+}
+
+// WithAccept sets the media type of the response, eg. "application/yaml"; the default is JSON.
+//
+func (f ClusterHealth) WithAccept(v string) func(*ClusterHealthRequest) {
+	return func(r *ClusterHealthRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Accept", v)
+	}
+}
+
 // WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
 //
 func (f ClusterHealth) WithOpaqueID(s string) func(*ClusterHealthRequest) {
@@ -378,3 +440,14 @@ func (f ClusterHealth) WithOpaqueID(s string) func(*ClusterHealthRequest) {
 		r.Header.Set("X-Opaque-Id", s)
 	}
 }
+
+// WithBasicAuth sets the credentials of the request, overriding the credentials of the client.
+//
+func (f ClusterHealth) WithBasicAuth(username, password string) func(*ClusterHealthRequest) {
+	return func(r *ClusterHealthRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Authorization", basicAuth(username, password))
+	}
+}
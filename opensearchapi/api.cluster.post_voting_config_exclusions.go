@@ -111,7 +111,7 @@ func (r ClusterPostVotingConfigExclusionsRequest) Do(ctx context.Context, transp
 		params["filter_path"] = strings.Join(r.FilterPath, ",")
 	}
 
-	req, err := newRequest(method, path.String(), nil)
+	req, err := newRequest(transport, method, path.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -125,13 +125,9 @@ func (r ClusterPostVotingConfigExclusionsRequest) Do(ctx context.Context, transp
 	}
 
 	if len(r.Header) > 0 {
-		if len(req.Header) == 0 {
-			req.Header = r.Header
-		} else {
-			for k, vv := range r.Header {
-				for _, v := range vv {
-					req.Header.Add(k, v)
-				}
+		for k, vv := range r.Header {
+			for _, v := range vv {
+				req.Header.Add(k, v)
 			}
 		}
 	}
@@ -149,6 +145,7 @@ func (r ClusterPostVotingConfigExclusionsRequest) Do(ctx context.Context, transp
 		StatusCode: res.StatusCode,
 		Body:       res.Body,
 		Header:     res.Header,
+		codec:      JSONCodecOf(transport),
 	}
 
 	return &response, response.Err()
@@ -210,6 +207,16 @@ func (f ClusterPostVotingConfigExclusions) WithErrorTrace() func(*ClusterPostVot
 	}
 }
 
+// WithDebug makes the response body pretty-printed and human-readable, and includes the stack trace for errors.
+//
+func (f ClusterPostVotingConfigExclusions) WithDebug() func(*ClusterPostVotingConfigExclusionsRequest) {
+	return func(r *ClusterPostVotingConfigExclusionsRequest) {
+		r.Pretty = true
+		r.Human = true
+		r.ErrorTrace = true
+	}
+}
+
 // WithFilterPath filters the properties of the response body.
 //
 func (f ClusterPostVotingConfigExclusions) WithFilterPath(v ...string) func(*ClusterPostVotingConfigExclusionsRequest) {
@@ -231,6 +238,32 @@ func (f ClusterPostVotingConfigExclusions) WithHeader(h map[string]string) func(
 	}
 }
 
+// WithHTTPHeader adds the headers to the HTTP request, keeping the multiple values of a header.
+//
+func (f ClusterPostVotingConfigExclusions) WithHTTPHeader(h http.Header) func(*ClusterPostVotingConfigExclusionsRequest) {
+	return func(r *ClusterPostVotingConfigExclusionsRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, vv := range h {
+			for _, v := range vv {
+				r.Header.Add(k, v)
+			}
+		}
+	}
+}
+
+// WithAccept sets the media type of the response, eg. "application/yaml"; the default is JSON.
+//
+func (f ClusterPostVotingConfigExclusions) WithAccept(v string) func(*ClusterPostVotingConfigExclusionsRequest) {
+	return func(r *ClusterPostVotingConfigExclusionsRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Accept", v)
+	}
+}
+
 // WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
 //
 func (f ClusterPostVotingConfigExclusions) WithOpaqueID(s string) func(*ClusterPostVotingConfigExclusionsRequest) {
@@ -241,3 +274,14 @@ func (f ClusterPostVotingConfigExclusions) WithOpaqueID(s string) func(*ClusterP
 		r.Header.Set("X-Opaque-Id", s)
 	}
 }
+
+// WithBasicAuth sets the credentials of the request, overriding the credentials of the client.
+//
+func (f ClusterPostVotingConfigExclusions) WithBasicAuth(username, password string) func(*ClusterPostVotingConfigExclusionsRequest) {
+	return func(r *ClusterPostVotingConfigExclusionsRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Authorization", basicAuth(username, password))
+	}
+}
@@ -125,7 +125,7 @@ func (r ClusterPutComponentTemplateRequest) Do(ctx context.Context, transport Tr
 		params["filter_path"] = strings.Join(r.FilterPath, ",")
 	}
 
-	req, err := newRequest(method, path.String(), r.Body)
+	req, err := newRequest(transport, method, path.String(), r.Body)
 	if err != nil {
 		return nil, err
 	}
@@ -138,18 +138,14 @@ func (r ClusterPutComponentTemplateRequest) Do(ctx context.Context, transport Tr
 		req.URL.RawQuery = q.Encode()
 	}
 
-	if r.Body != nil {
+	if r.Body != nil && r.Header.Get(headerContentType) == "" {
 		req.Header[headerContentType] = headerContentTypeJSON
 	}
 
 	if len(r.Header) > 0 {
-		if len(req.Header) == 0 {
-			req.Header = r.Header
-		} else {
-			for k, vv := range r.Header {
-				for _, v := range vv {
-					req.Header.Add(k, v)
-				}
+		for k, vv := range r.Header {
+			for _, v := range vv {
+				req.Header.Add(k, v)
 			}
 		}
 	}
@@ -167,6 +163,7 @@ func (r ClusterPutComponentTemplateRequest) Do(ctx context.Context, transport Tr
 		StatusCode: res.StatusCode,
 		Body:       res.Body,
 		Header:     res.Header,
+		codec:      JSONCodecOf(transport),
 	}
 
 	return &response, response.Err()
@@ -180,6 +177,14 @@ func (f ClusterPutComponentTemplate) WithContext(v context.Context) func(*Cluste
 	}
 }
 
+// WithJSONBody - the request body, encoded to JSON with the JSON codec of the client; it replaces the body passed to the function.
+//
+func (f ClusterPutComponentTemplate) WithJSONBody(v interface{}) func(*ClusterPutComponentTemplateRequest) {
+	return func(r *ClusterPutComponentTemplateRequest) {
+		r.Body = JSONBody(v)
+	}
+}
+
 // WithCreate - whether the index template should only be added if new or can also replace an existing one.
 //
 func (f ClusterPutComponentTemplate) WithCreate(v bool) func(*ClusterPutComponentTemplateRequest) {
@@ -238,6 +243,16 @@ func (f ClusterPutComponentTemplate) WithErrorTrace() func(*ClusterPutComponentT
 	}
 }
 
+// WithDebug makes the response body pretty-printed and human-readable, and includes the stack trace for errors.
+//
+func (f ClusterPutComponentTemplate) WithDebug() func(*ClusterPutComponentTemplateRequest) {
+	return func(r *ClusterPutComponentTemplateRequest) {
+		r.Pretty = true
+		r.Human = true
+		r.ErrorTrace = true
+	}
+}
+
 // WithFilterPath filters the properties of the response body.
 //
 func (f ClusterPutComponentTemplate) WithFilterPath(v ...string) func(*ClusterPutComponentTemplateRequest) {
@@ -259,6 +274,32 @@ func (f ClusterPutComponentTemplate) WithHeader(h map[string]string) func(*Clust
 	}
 }
 
+// WithHTTPHeader adds the headers to the HTTP request, keeping the multiple values of a header.
+//
+func (f ClusterPutComponentTemplate) WithHTTPHeader(h http.Header) func(*ClusterPutComponentTemplateRequest) {
+	return func(r *ClusterPutComponentTemplateRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, vv := range h {
+			for _, v := range vv {
+				r.Header.Add(k, v)
+			}
+		}
+	}
+}
+
+// WithAccept sets the media type of the response, eg. "application/yaml"; the default is JSON.
+//
+func (f ClusterPutComponentTemplate) WithAccept(v string) func(*ClusterPutComponentTemplateRequest) {
+	return func(r *ClusterPutComponentTemplateRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Accept", v)
+	}
+}
+
 // WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
 //
 func (f ClusterPutComponentTemplate) WithOpaqueID(s string) func(*ClusterPutComponentTemplateRequest) {
@@ -269,3 +310,25 @@ func (f ClusterPutComponentTemplate) WithOpaqueID(s string) func(*ClusterPutComp
 		r.Header.Set("X-Opaque-Id", s)
 	}
 }
+
+// WithContentType sets the Content-Type header of the request, overriding the default of the request body.
+//
+func (f ClusterPutComponentTemplate) WithContentType(v string) func(*ClusterPutComponentTemplateRequest) {
+	return func(r *ClusterPutComponentTemplateRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Content-Type", v)
+	}
+}
+
+// WithBasicAuth sets the credentials of the request, overriding the credentials of the client.
+//
+func (f ClusterPutComponentTemplate) WithBasicAuth(username, password string) func(*ClusterPutComponentTemplateRequest) {
+	return func(r *ClusterPutComponentTemplateRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Authorization", basicAuth(username, password))
+	}
+}
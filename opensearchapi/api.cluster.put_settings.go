@@ -27,7 +27,10 @@
 package opensearchapi
 
 import (
+	"bytes"
 	"context"
+	"errors"
+	"fmt"
 	"io"
 	"net/http"
 	"strconv"
@@ -55,7 +58,8 @@ type ClusterPutSettings func(body io.Reader, o ...func(*ClusterPutSettingsReques
 // ClusterPutSettingsRequest configures the Cluster Put Settings API request.
 //
 type ClusterPutSettingsRequest struct {
-	Body io.Reader
+	Body         io.Reader
+	SettingsBody *ClusterSettingsBody
 
 	FlatSettings          *bool
 	MasterTimeout         time.Duration
@@ -72,6 +76,16 @@ type ClusterPutSettingsRequest struct {
 	ctx context.Context
 }
 
+// ClusterSettingsBody represents the body of the Cluster Put Settings API request.
+//
+// The settings are keyed by their name, in the flat form, eg. "cluster.routing.allocation.enable",
+// or as nested objects. A nil value resets the setting to its default.
+//
+type ClusterSettingsBody struct {
+	Persistent map[string]interface{} `json:"persistent,omitempty"`
+	Transient  map[string]interface{} `json:"transient,omitempty"`
+}
+
 // Do executes the request and returns response or error.
 //
 func (r ClusterPutSettingsRequest) Do(ctx context.Context, transport Transport) (*Response, error) {
@@ -120,7 +134,19 @@ func (r ClusterPutSettingsRequest) Do(ctx context.Context, transport Transport)
 		params["filter_path"] = strings.Join(r.FilterPath, ",")
 	}
 
-	req, err := newRequest(method, path.String(), r.Body)
+	var body io.Reader = r.Body
+	if r.SettingsBody != nil {
+		if len(r.SettingsBody.Persistent) == 0 && len(r.SettingsBody.Transient) == 0 {
+			return nil, errors.New("invalid cluster settings: persistent or transient settings are required")
+		}
+		b, err := JSONCodecOf(transport).Marshal(r.SettingsBody)
+		if err != nil {
+			return nil, fmt.Errorf("cannot encode cluster settings: %s", err)
+		}
+		body = bytes.NewReader(b)
+	}
+
+	req, err := newRequest(transport, method, path.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -133,18 +159,14 @@ func (r ClusterPutSettingsRequest) Do(ctx context.Context, transport Transport)
 		req.URL.RawQuery = q.Encode()
 	}
 
-	if r.Body != nil {
+	if body != nil && r.Header.Get(headerContentType) == "" {
 		req.Header[headerContentType] = headerContentTypeJSON
 	}
 
 	if len(r.Header) > 0 {
-		if len(req.Header) == 0 {
-			req.Header = r.Header
-		} else {
-			for k, vv := range r.Header {
-				for _, v := range vv {
-					req.Header.Add(k, v)
-				}
+		for k, vv := range r.Header {
+			for _, v := range vv {
+				req.Header.Add(k, v)
 			}
 		}
 	}
@@ -162,6 +184,7 @@ func (r ClusterPutSettingsRequest) Do(ctx context.Context, transport Transport)
 		StatusCode: res.StatusCode,
 		Body:       res.Body,
 		Header:     res.Header,
+		codec:      JSONCodecOf(transport),
 	}
 
 	return &response, response.Err()
@@ -175,6 +198,22 @@ func (f ClusterPutSettings) WithContext(v context.Context) func(*ClusterPutSetti
 	}
 }
 
+// WithJSONBody - the request body, encoded to JSON with the JSON codec of the client; it replaces the body passed to the function.
+//
+func (f ClusterPutSettings) WithJSONBody(v interface{}) func(*ClusterPutSettingsRequest) {
+	return func(r *ClusterPutSettingsRequest) {
+		r.Body = JSONBody(v)
+	}
+}
+
+// WithSettingsBody - the persistent and transient settings, encoded as the request body; it replaces the body passed to the function.
+//
+func (f ClusterPutSettings) WithSettingsBody(v ClusterSettingsBody) func(*ClusterPutSettingsRequest) {
+	return func(r *ClusterPutSettingsRequest) {
+		r.SettingsBody = &v
+	}
+}
+
 // WithFlatSettings - return settings in flat format (default: false).
 //
 func (f ClusterPutSettings) WithFlatSettings(v bool) func(*ClusterPutSettingsRequest) {
@@ -233,6 +272,16 @@ func (f ClusterPutSettings) WithErrorTrace() func(*ClusterPutSettingsRequest) {
 	}
 }
 
+// WithDebug makes the response body pretty-printed and human-readable, and includes the stack trace for errors.
+//
+func (f ClusterPutSettings) WithDebug() func(*ClusterPutSettingsRequest) {
+	return func(r *ClusterPutSettingsRequest) {
+		r.Pretty = true
+		r.Human = true
+		r.ErrorTrace = true
+	}
+}
+
 // WithFilterPath filters the properties of the response body.
 //
 func (f ClusterPutSettings) WithFilterPath(v ...string) func(*ClusterPutSettingsRequest) {
@@ -254,6 +303,32 @@ func (f ClusterPutSettings) WithHeader(h map[string]string) func(*ClusterPutSett
 	}
 }
 
+// WithHTTPHeader adds the headers to the HTTP request, keeping the multiple values of a header.
+//
+func (f ClusterPutSettings) WithHTTPHeader(h http.Header) func(*ClusterPutSettingsRequest) {
+	return func(r *ClusterPutSettingsRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, vv := range h {
+			for _, v := range vv {
+				r.Header.Add(k, v)
+			}
+		}
+	}
+}
+
+// WithAccept sets the media type of the response, eg. "application/yaml"; the default is JSON.
+//
+func (f ClusterPutSettings) WithAccept(v string) func(*ClusterPutSettingsRequest) {
+	return func(r *ClusterPutSettingsRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Accept", v)
+	}
+}
+
 // WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
 //
 func (f ClusterPutSettings) WithOpaqueID(s string) func(*ClusterPutSettingsRequest) {
@@ -264,3 +339,25 @@ func (f ClusterPutSettings) WithOpaqueID(s string) func(*ClusterPutSettingsReque
 		r.Header.Set("X-Opaque-Id", s)
 	}
 }
+
+// WithContentType sets the Content-Type header of the request, overriding the default of the request body.
+//
+func (f ClusterPutSettings) WithContentType(v string) func(*ClusterPutSettingsRequest) {
+	return func(r *ClusterPutSettingsRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Content-Type", v)
+	}
+}
+
+// WithBasicAuth sets the credentials of the request, overriding the credentials of the client.
+//
+func (f ClusterPutSettings) WithBasicAuth(username, password string) func(*ClusterPutSettingsRequest) {
+	return func(r *ClusterPutSettingsRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Authorization", basicAuth(username, password))
+	}
+}
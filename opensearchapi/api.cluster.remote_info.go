@@ -28,6 +28,8 @@ package opensearchapi
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"net/http"
 	"strings"
 )
@@ -62,6 +64,40 @@ type ClusterRemoteInfoRequest struct {
 	ctx context.Context
 }
 
+// RemoteClusterInfo represents a remote cluster in the Cluster Remote Info API response.
+//
+// The seeds are only reported for the remote clusters in the "sniff" mode; the clusters in
+// the "proxy" mode report their proxy address instead.
+type RemoteClusterInfo struct {
+	Connected                bool     `json:"connected"`
+	Mode                     string   `json:"mode"`
+	Seeds                    []string `json:"seeds"`
+	NumNodesConnected        int      `json:"num_nodes_connected"`
+	MaxConnectionsPerCluster int      `json:"max_connections_per_cluster"`
+	ProxyAddress             string   `json:"proxy_address"`
+	InitialConnectTimeout    string   `json:"initial_connect_timeout"`
+	SkipUnavailable          bool     `json:"skip_unavailable"`
+}
+
+// ParseClusterRemoteInfoResponse decodes the body of a Cluster Remote Info API response,
+// keyed by the alias of the remote clusters, and closes it.
+func ParseClusterRemoteInfoResponse(res *Response) (map[string]RemoteClusterInfo, error) {
+	if res == nil || res.Body == nil {
+		return nil, errors.New("cannot parse remote info response: empty response")
+	}
+	defer res.Close()
+
+	if err := res.Err(); err != nil {
+		return nil, err
+	}
+
+	var info map[string]RemoteClusterInfo
+	if err := decodeBody(res, &info); err != nil {
+		return nil, fmt.Errorf("cannot parse remote info response: %s", err)
+	}
+	return info, nil
+}
+
 // Do executes the request and returns response or error.
 //
 func (r ClusterRemoteInfoRequest) Do(ctx context.Context, transport Transport) (*Response, error) {
@@ -94,7 +130,7 @@ func (r ClusterRemoteInfoRequest) Do(ctx context.Context, transport Transport) (
 		params["filter_path"] = strings.Join(r.FilterPath, ",")
 	}
 
-	req, err := newRequest(method, path.String(), nil)
+	req, err := newRequest(transport, method, path.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -108,13 +144,9 @@ func (r ClusterRemoteInfoRequest) Do(ctx context.Context, transport Transport) (
 	}
 
 	if len(r.Header) > 0 {
-		if len(req.Header) == 0 {
-			req.Header = r.Header
-		} else {
-			for k, vv := range r.Header {
-				for _, v := range vv {
-					req.Header.Add(k, v)
-				}
+		for k, vv := range r.Header {
+			for _, v := range vv {
+				req.Header.Add(k, v)
 			}
 		}
 	}
@@ -132,6 +164,7 @@ func (r ClusterRemoteInfoRequest) Do(ctx context.Context, transport Transport) (
 		StatusCode: res.StatusCode,
 		Body:       res.Body,
 		Header:     res.Header,
+		codec:      JSONCodecOf(transport),
 	}
 
 	return &response, response.Err()
@@ -169,6 +202,16 @@ func (f ClusterRemoteInfo) WithErrorTrace() func(*ClusterRemoteInfoRequest) {
 	}
 }
 
+// WithDebug makes the response body pretty-printed and human-readable, and includes the stack trace for errors.
+//
+func (f ClusterRemoteInfo) WithDebug() func(*ClusterRemoteInfoRequest) {
+	return func(r *ClusterRemoteInfoRequest) {
+		r.Pretty = true
+		r.Human = true
+		r.ErrorTrace = true
+	}
+}
+
 // WithFilterPath filters the properties of the response body.
 //
 func (f ClusterRemoteInfo) WithFilterPath(v ...string) func(*ClusterRemoteInfoRequest) {
@@ -190,6 +233,32 @@ func (f ClusterRemoteInfo) WithHeader(h map[string]string) func(*ClusterRemoteIn
 	}
 }
 
+// WithHTTPHeader adds the headers to the HTTP request, keeping the multiple values of a header.
+//
+func (f ClusterRemoteInfo) WithHTTPHeader(h http.Header) func(*ClusterRemoteInfoRequest) {
+	return func(r *ClusterRemoteInfoRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, vv := range h {
+			for _, v := range vv {
+				r.Header.Add(k, v)
+			}
+		}
+	}
+}
+
+// WithAccept sets the media type of the response, eg. "application/yaml"; the default is JSON.
+//
+func (f ClusterRemoteInfo) WithAccept(v string) func(*ClusterRemoteInfoRequest) {
+	return func(r *ClusterRemoteInfoRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Accept", v)
+	}
+}
+
 // WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
 //
 func (f ClusterRemoteInfo) WithOpaqueID(s string) func(*ClusterRemoteInfoRequest) {
@@ -200,3 +269,14 @@ func (f ClusterRemoteInfo) WithOpaqueID(s string) func(*ClusterRemoteInfoRequest
 		r.Header.Set("X-Opaque-Id", s)
 	}
 }
+
+// WithBasicAuth sets the credentials of the request, overriding the credentials of the client.
+//
+func (f ClusterRemoteInfo) WithBasicAuth(username, password string) func(*ClusterRemoteInfoRequest) {
+	return func(r *ClusterRemoteInfoRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Authorization", basicAuth(username, password))
+	}
+}
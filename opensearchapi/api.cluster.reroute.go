@@ -135,7 +135,7 @@ func (r ClusterRerouteRequest) Do(ctx context.Context, transport Transport) (*Re
 		params["filter_path"] = strings.Join(r.FilterPath, ",")
 	}
 
-	req, err := newRequest(method, path.String(), r.Body)
+	req, err := newRequest(transport, method, path.String(), r.Body)
 	if err != nil {
 		return nil, err
 	}
@@ -148,18 +148,14 @@ func (r ClusterRerouteRequest) Do(ctx context.Context, transport Transport) (*Re
 		req.URL.RawQuery = q.Encode()
 	}
 
-	if r.Body != nil {
+	if r.Body != nil && r.Header.Get(headerContentType) == "" {
 		req.Header[headerContentType] = headerContentTypeJSON
 	}
 
 	if len(r.Header) > 0 {
-		if len(req.Header) == 0 {
-			req.Header = r.Header
-		} else {
-			for k, vv := range r.Header {
-				for _, v := range vv {
-					req.Header.Add(k, v)
-				}
+		for k, vv := range r.Header {
+			for _, v := range vv {
+				req.Header.Add(k, v)
 			}
 		}
 	}
@@ -177,6 +173,7 @@ func (r ClusterRerouteRequest) Do(ctx context.Context, transport Transport) (*Re
 		StatusCode: res.StatusCode,
 		Body:       res.Body,
 		Header:     res.Header,
+		codec:      JSONCodecOf(transport),
 	}
 
 	return &response, response.Err()
@@ -198,6 +195,14 @@ func (f ClusterReroute) WithBody(v io.Reader) func(*ClusterRerouteRequest) {
 	}
 }
 
+// WithJSONBody - the request body, encoded to JSON with the JSON codec of the client; it replaces the body set with WithBody.
+//
+func (f ClusterReroute) WithJSONBody(v interface{}) func(*ClusterRerouteRequest) {
+	return func(r *ClusterRerouteRequest) {
+		r.Body = JSONBody(v)
+	}
+}
+
 // WithDryRun - simulate the operation only and return the resulting state.
 //
 func (f ClusterReroute) WithDryRun(v bool) func(*ClusterRerouteRequest) {
@@ -280,6 +285,16 @@ func (f ClusterReroute) WithErrorTrace() func(*ClusterRerouteRequest) {
 	}
 }
 
+// WithDebug makes the response body pretty-printed and human-readable, and includes the stack trace for errors.
+//
+func (f ClusterReroute) WithDebug() func(*ClusterRerouteRequest) {
+	return func(r *ClusterRerouteRequest) {
+		r.Pretty = true
+		r.Human = true
+		r.ErrorTrace = true
+	}
+}
+
 // WithFilterPath filters the properties of the response body.
 //
 func (f ClusterReroute) WithFilterPath(v ...string) func(*ClusterRerouteRequest) {
@@ -301,6 +316,32 @@ func (f ClusterReroute) WithHeader(h map[string]string) func(*ClusterRerouteRequ
 	}
 }
 
+// WithHTTPHeader adds the headers to the HTTP request, keeping the multiple values of a header.
+//
+func (f ClusterReroute) WithHTTPHeader(h http.Header) func(*ClusterRerouteRequest) {
+	return func(r *ClusterRerouteRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, vv := range h {
+			for _, v := range vv {
+				r.Header.Add(k, v)
+			}
+		}
+	}
+}
+
+// WithAccept sets the media type of the response, eg. "application/yaml"; the default is JSON.
+//
+func (f ClusterReroute) WithAccept(v string) func(*ClusterRerouteRequest) {
+	return func(r *ClusterRerouteRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Accept", v)
+	}
+}
+
 // WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
 //
 func (f ClusterReroute) WithOpaqueID(s string) func(*ClusterRerouteRequest) {
@@ -311,3 +352,25 @@ func (f ClusterReroute) WithOpaqueID(s string) func(*ClusterRerouteRequest) {
 		r.Header.Set("X-Opaque-Id", s)
 	}
 }
+
+// WithContentType sets the Content-Type header of the request, overriding the default of the request body.
+//
+func (f ClusterReroute) WithContentType(v string) func(*ClusterRerouteRequest) {
+	return func(r *ClusterRerouteRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Content-Type", v)
+	}
+}
+
+// WithBasicAuth sets the credentials of the request, overriding the credentials of the client.
+//
+func (f ClusterReroute) WithBasicAuth(username, password string) func(*ClusterRerouteRequest) {
+	return func(r *ClusterRerouteRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Authorization", basicAuth(username, password))
+	}
+}
@@ -0,0 +1,99 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+//go:build !integration
+// +build !integration
+
+package opensearchapi
+
+import (
+	"context"
+	"io/ioutil"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestClusterPutSettingsBody(t *testing.T) {
+	put := ClusterPutSettings(nil)
+
+	r := ClusterPutSettingsRequest{}
+	for _, o := range []func(*ClusterPutSettingsRequest){
+		put.WithSettingsBody(ClusterSettingsBody{
+			Persistent: map[string]interface{}{"cluster.routing.allocation.enable": "primaries"},
+			Transient:  map[string]interface{}{"indices.recovery.max_bytes_per_sec": nil},
+		}),
+		put.WithClusterManagerTimeout(time.Minute),
+	} {
+		o(&r)
+	}
+
+	req, err := BuildRequest(context.Background(), r)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	if req.URL.String() != "/_cluster/settings?cluster_manager_timeout=60000ms" {
+		t.Errorf("Unexpected URL: %s", req.URL)
+	}
+	if req.Header.Get("Content-Type") != "application/json" {
+		t.Errorf("Unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+	}
+	body, _ := ioutil.ReadAll(req.Body)
+	want := `{"persistent":{"cluster.routing.allocation.enable":"primaries"},"transient":{"indices.recovery.max_bytes_per_sec":null}}`
+	if string(body) != want {
+		t.Errorf("Unexpected body:\nwant: %s\ngot:  %s", want, body)
+	}
+
+	if _, err := BuildRequest(context.Background(), ClusterPutSettingsRequest{SettingsBody: &ClusterSettingsBody{}}); err == nil {
+		t.Errorf("Expected error for empty settings")
+	}
+}
+
+func TestParseClusterSettingsResponse(t *testing.T) {
+	want := &ClusterSettingsResp{
+		Persistent: map[string]interface{}{
+			"cluster.routing.allocation.enable": "primaries",
+			"cluster.remote.logs.seeds":         []interface{}{"10.0.0.1:9300"},
+		},
+		Transient: map[string]interface{}{"cluster.routing.allocation.enable": "none"},
+		Defaults:  map[string]interface{}{"cluster.name": "logs"},
+	}
+
+	for name, body := range map[string]string{
+		"Nested": `{"persistent":{"cluster":{"routing":{"allocation":{"enable":"primaries"}},"remote":{"logs":{"seeds":["10.0.0.1:9300"]}}}},
+			"transient":{"cluster":{"routing":{"allocation":{"enable":"none"}}}},"defaults":{"cluster":{"name":"logs"}}}`,
+		"Flat": `{"persistent":{"cluster.routing.allocation.enable":"primaries","cluster.remote.logs.seeds":["10.0.0.1:9300"]},
+			"transient":{"cluster.routing.allocation.enable":"none"},"defaults":{"cluster.name":"logs"}}`,
+	} {
+		t.Run(name, func(t *testing.T) {
+			res := &Response{StatusCode: 200, Body: ioutil.NopCloser(strings.NewReader(body))}
+			sr, err := ParseClusterSettingsResponse(res)
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if !reflect.DeepEqual(sr, want) {
+				t.Errorf("Unexpected settings: %+v", sr)
+			}
+
+			for name, value := range map[string]interface{}{
+				"cluster.routing.allocation.enable": "none",
+				"cluster.name":                      "logs",
+			} {
+				if v, ok := sr.Get(name); !ok || v != value {
+					t.Errorf("Unexpected value of %s: %v", name, v)
+				}
+			}
+			if _, ok := sr.Get("cluster.blocks.read_only"); ok {
+				t.Errorf("Expected missing setting")
+			}
+		})
+	}
+}
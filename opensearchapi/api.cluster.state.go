@@ -157,7 +157,7 @@ func (r ClusterStateRequest) Do(ctx context.Context, transport Transport) (*Resp
 		params["filter_path"] = strings.Join(r.FilterPath, ",")
 	}
 
-	req, err := newRequest(method, path.String(), nil)
+	req, err := newRequest(transport, method, path.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -171,13 +171,9 @@ func (r ClusterStateRequest) Do(ctx context.Context, transport Transport) (*Resp
 	}
 
 	if len(r.Header) > 0 {
-		if len(req.Header) == 0 {
-			req.Header = r.Header
-		} else {
-			for k, vv := range r.Header {
-				for _, v := range vv {
-					req.Header.Add(k, v)
-				}
+		for k, vv := range r.Header {
+			for _, v := range vv {
+				req.Header.Add(k, v)
 			}
 		}
 	}
@@ -195,6 +191,7 @@ func (r ClusterStateRequest) Do(ctx context.Context, transport Transport) (*Resp
 		StatusCode: res.StatusCode,
 		Body:       res.Body,
 		Header:     res.Header,
+		codec:      JSONCodecOf(transport),
 	}
 
 	return &response, response.Err()
@@ -322,6 +319,16 @@ func (f ClusterState) WithErrorTrace() func(*ClusterStateRequest) {
 	}
 }
 
+// WithDebug makes the response body pretty-printed and human-readable, and includes the stack trace for errors.
+//
+func (f ClusterState) WithDebug() func(*ClusterStateRequest) {
+	return func(r *ClusterStateRequest) {
+		r.Pretty = true
+		r.Human = true
+		r.ErrorTrace = true
+	}
+}
+
 // WithFilterPath filters the properties of the response body.
 //
 func (f ClusterState) WithFilterPath(v ...string) func(*ClusterStateRequest) {
@@ -343,6 +350,32 @@ func (f ClusterState) WithHeader(h map[string]string) func(*ClusterStateRequest)
 	}
 }
 
+// WithHTTPHeader adds the headers to the HTTP request, keeping the multiple values of a header.
+//
+func (f ClusterState) WithHTTPHeader(h http.Header) func(*ClusterStateRequest) {
+	return func(r *ClusterStateRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, vv := range h {
+			for _, v := range vv {
+				r.Header.Add(k, v)
+			}
+		}
+	}
+}
+
+// WithAccept sets the media type of the response, eg. "application/yaml"; the default is JSON.
+//
+func (f ClusterState) WithAccept(v string) func(*ClusterStateRequest) {
+	return func(r *ClusterStateRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Accept", v)
+	}
+}
+
 // WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
 //
 func (f ClusterState) WithOpaqueID(s string) func(*ClusterStateRequest) {
@@ -353,3 +386,14 @@ func (f ClusterState) WithOpaqueID(s string) func(*ClusterStateRequest) {
 		r.Header.Set("X-Opaque-Id", s)
 	}
 }
+
+// WithBasicAuth sets the credentials of the request, overriding the credentials of the client.
+//
+func (f ClusterState) WithBasicAuth(username, password string) func(*ClusterStateRequest) {
+	return func(r *ClusterStateRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Authorization", basicAuth(username, password))
+	}
+}
@@ -145,6 +145,9 @@ func (r CountRequest) Do(ctx context.Context, transport Transport) (*Response, e
 	}
 
 	if r.Preference != "" {
+		if err := ValidatePreference(r.Preference); err != nil {
+			return nil, err
+		}
 		params["preference"] = r.Preference
 	}
 
@@ -176,7 +179,7 @@ func (r CountRequest) Do(ctx context.Context, transport Transport) (*Response, e
 		params["filter_path"] = strings.Join(r.FilterPath, ",")
 	}
 
-	req, err := newRequest(method, path.String(), r.Body)
+	req, err := newRequest(transport, method, path.String(), r.Body)
 	if err != nil {
 		return nil, err
 	}
@@ -189,18 +192,14 @@ func (r CountRequest) Do(ctx context.Context, transport Transport) (*Response, e
 		req.URL.RawQuery = q.Encode()
 	}
 
-	if r.Body != nil {
+	if r.Body != nil && r.Header.Get(headerContentType) == "" {
 		req.Header[headerContentType] = headerContentTypeJSON
 	}
 
 	if len(r.Header) > 0 {
-		if len(req.Header) == 0 {
-			req.Header = r.Header
-		} else {
-			for k, vv := range r.Header {
-				for _, v := range vv {
-					req.Header.Add(k, v)
-				}
+		for k, vv := range r.Header {
+			for _, v := range vv {
+				req.Header.Add(k, v)
 			}
 		}
 	}
@@ -218,6 +217,7 @@ func (r CountRequest) Do(ctx context.Context, transport Transport) (*Response, e
 		StatusCode: res.StatusCode,
 		Body:       res.Body,
 		Header:     res.Header,
+		codec:      JSONCodecOf(transport),
 	}
 
 	return &response, response.Err()
@@ -239,6 +239,14 @@ func (f Count) WithBody(v io.Reader) func(*CountRequest) {
 	}
 }
 
+// WithJSONBody - the request body, encoded to JSON with the JSON codec of the client; it replaces the body set with WithBody.
+//
+func (f Count) WithJSONBody(v interface{}) func(*CountRequest) {
+	return func(r *CountRequest) {
+		r.Body = JSONBody(v)
+	}
+}
+
 // WithIndex - a list of indices to restrict the results.
 //
 func (f Count) WithIndex(v ...string) func(*CountRequest) {
@@ -329,6 +337,8 @@ func (f Count) WithMinScore(v int) func(*CountRequest) {
 
 // WithPreference - specify the node or shard the operation should be performed on (default: random).
 //
+// See ValidatePreference for the valid values.
+//
 func (f Count) WithPreference(v string) func(*CountRequest) {
 	return func(r *CountRequest) {
 		r.Preference = v
@@ -383,6 +393,16 @@ func (f Count) WithErrorTrace() func(*CountRequest) {
 	}
 }
 
+// WithDebug makes the response body pretty-printed and human-readable, and includes the stack trace for errors.
+//
+func (f Count) WithDebug() func(*CountRequest) {
+	return func(r *CountRequest) {
+		r.Pretty = true
+		r.Human = true
+		r.ErrorTrace = true
+	}
+}
+
 // WithFilterPath filters the properties of the response body.
 //
 func (f Count) WithFilterPath(v ...string) func(*CountRequest) {
@@ -404,6 +424,32 @@ func (f Count) WithHeader(h map[string]string) func(*CountRequest) {
 	}
 }
 
+// WithHTTPHeader adds the headers to the HTTP request, keeping the multiple values of a header.
+//
+func (f Count) WithHTTPHeader(h http.Header) func(*CountRequest) {
+	return func(r *CountRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, vv := range h {
+			for _, v := range vv {
+				r.Header.Add(k, v)
+			}
+		}
+	}
+}
+
+// WithAccept sets the media type of the response, eg. "application/yaml"; the default is JSON.
+//
+func (f Count) WithAccept(v string) func(*CountRequest) {
+	return func(r *CountRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Accept", v)
+	}
+}
+
 // WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
 //
 func (f Count) WithOpaqueID(s string) func(*CountRequest) {
@@ -414,3 +460,25 @@ func (f Count) WithOpaqueID(s string) func(*CountRequest) {
 		r.Header.Set("X-Opaque-Id", s)
 	}
 }
+
+// WithContentType sets the Content-Type header of the request, overriding the default of the request body.
+//
+func (f Count) WithContentType(v string) func(*CountRequest) {
+	return func(r *CountRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Content-Type", v)
+	}
+}
+
+// WithBasicAuth sets the credentials of the request, overriding the credentials of the client.
+//
+func (f Count) WithBasicAuth(username, password string) func(*CountRequest) {
+	return func(r *CountRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Authorization", basicAuth(username, password))
+	}
+}
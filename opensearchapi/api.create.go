@@ -106,6 +106,9 @@ func (r CreateRequest) Do(ctx context.Context, transport Transport) (*Response,
 	}
 
 	if r.Refresh != "" {
+		if err := ValidateRefresh(r.Refresh); err != nil {
+			return nil, err
+		}
 		params["refresh"] = r.Refresh
 	}
 
@@ -126,6 +129,9 @@ func (r CreateRequest) Do(ctx context.Context, transport Transport) (*Response,
 	}
 
 	if r.WaitForActiveShards != "" {
+		if err := ValidateWaitForActiveShards(r.WaitForActiveShards); err != nil {
+			return nil, err
+		}
 		params["wait_for_active_shards"] = r.WaitForActiveShards
 	}
 
@@ -145,7 +151,7 @@ func (r CreateRequest) Do(ctx context.Context, transport Transport) (*Response,
 		params["filter_path"] = strings.Join(r.FilterPath, ",")
 	}
 
-	req, err := newRequest(method, path.String(), r.Body)
+	req, err := newRequest(transport, method, path.String(), r.Body)
 	if err != nil {
 		return nil, err
 	}
@@ -158,18 +164,14 @@ func (r CreateRequest) Do(ctx context.Context, transport Transport) (*Response,
 		req.URL.RawQuery = q.Encode()
 	}
 
-	if r.Body != nil {
+	if r.Body != nil && r.Header.Get(headerContentType) == "" {
 		req.Header[headerContentType] = headerContentTypeJSON
 	}
 
 	if len(r.Header) > 0 {
-		if len(req.Header) == 0 {
-			req.Header = r.Header
-		} else {
-			for k, vv := range r.Header {
-				for _, v := range vv {
-					req.Header.Add(k, v)
-				}
+		for k, vv := range r.Header {
+			for _, v := range vv {
+				req.Header.Add(k, v)
 			}
 		}
 	}
@@ -187,6 +189,7 @@ func (r CreateRequest) Do(ctx context.Context, transport Transport) (*Response,
 		StatusCode: res.StatusCode,
 		Body:       res.Body,
 		Header:     res.Header,
+		codec:      JSONCodecOf(transport),
 	}
 
 	return &response, response.Err()
@@ -200,6 +203,14 @@ func (f Create) WithContext(v context.Context) func(*CreateRequest) {
 	}
 }
 
+// WithJSONBody - the request body, encoded to JSON with the JSON codec of the client; it replaces the body passed to the function.
+//
+func (f Create) WithJSONBody(v interface{}) func(*CreateRequest) {
+	return func(r *CreateRequest) {
+		r.Body = JSONBody(v)
+	}
+}
+
 // WithPipeline - the pipeline ID to preprocess incoming documents with.
 //
 func (f Create) WithPipeline(v string) func(*CreateRequest) {
@@ -210,6 +221,8 @@ func (f Create) WithPipeline(v string) func(*CreateRequest) {
 
 // WithRefresh - if `true` then refresh the affected shards to make this operation visible to search, if `wait_for` then wait for a refresh to make this operation visible to search, if `false` (the default) then do nothing with refreshes..
 //
+// See ValidateRefresh for the valid values.
+//
 func (f Create) WithRefresh(v string) func(*CreateRequest) {
 	return func(r *CreateRequest) {
 		r.Refresh = v
@@ -280,6 +293,16 @@ func (f Create) WithErrorTrace() func(*CreateRequest) {
 	}
 }
 
+// WithDebug makes the response body pretty-printed and human-readable, and includes the stack trace for errors.
+//
+func (f Create) WithDebug() func(*CreateRequest) {
+	return func(r *CreateRequest) {
+		r.Pretty = true
+		r.Human = true
+		r.ErrorTrace = true
+	}
+}
+
 // WithFilterPath filters the properties of the response body.
 //
 func (f Create) WithFilterPath(v ...string) func(*CreateRequest) {
@@ -301,6 +324,32 @@ func (f Create) WithHeader(h map[string]string) func(*CreateRequest) {
 	}
 }
 
+// WithHTTPHeader adds the headers to the HTTP request, keeping the multiple values of a header.
+//
+func (f Create) WithHTTPHeader(h http.Header) func(*CreateRequest) {
+	return func(r *CreateRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, vv := range h {
+			for _, v := range vv {
+				r.Header.Add(k, v)
+			}
+		}
+	}
+}
+
+// WithAccept sets the media type of the response, eg. "application/yaml"; the default is JSON.
+//
+func (f Create) WithAccept(v string) func(*CreateRequest) {
+	return func(r *CreateRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Accept", v)
+	}
+}
+
 // WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
 //
 func (f Create) WithOpaqueID(s string) func(*CreateRequest) {
@@ -311,3 +360,25 @@ func (f Create) WithOpaqueID(s string) func(*CreateRequest) {
 		r.Header.Set("X-Opaque-Id", s)
 	}
 }
+
+// WithContentType sets the Content-Type header of the request, overriding the default of the request body.
+//
+func (f Create) WithContentType(v string) func(*CreateRequest) {
+	return func(r *CreateRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Content-Type", v)
+	}
+}
+
+// WithBasicAuth sets the credentials of the request, overriding the credentials of the client.
+//
+func (f Create) WithBasicAuth(username, password string) func(*CreateRequest) {
+	return func(r *CreateRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Authorization", basicAuth(username, password))
+	}
+}
@@ -122,7 +122,7 @@ func (r DanglingIndicesDeleteDanglingIndexRequest) Do(ctx context.Context, trans
 		params["filter_path"] = strings.Join(r.FilterPath, ",")
 	}
 
-	req, err := newRequest(method, path.String(), nil)
+	req, err := newRequest(transport, method, path.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -136,13 +136,9 @@ func (r DanglingIndicesDeleteDanglingIndexRequest) Do(ctx context.Context, trans
 	}
 
 	if len(r.Header) > 0 {
-		if len(req.Header) == 0 {
-			req.Header = r.Header
-		} else {
-			for k, vv := range r.Header {
-				for _, v := range vv {
-					req.Header.Add(k, v)
-				}
+		for k, vv := range r.Header {
+			for _, v := range vv {
+				req.Header.Add(k, v)
 			}
 		}
 	}
@@ -160,6 +156,7 @@ func (r DanglingIndicesDeleteDanglingIndexRequest) Do(ctx context.Context, trans
 		StatusCode: res.StatusCode,
 		Body:       res.Body,
 		Header:     res.Header,
+		codec:      JSONCodecOf(transport),
 	}
 
 	return &response, response.Err()
@@ -231,6 +228,16 @@ func (f DanglingIndicesDeleteDanglingIndex) WithErrorTrace() func(*DanglingIndic
 	}
 }
 
+// WithDebug makes the response body pretty-printed and human-readable, and includes the stack trace for errors.
+//
+func (f DanglingIndicesDeleteDanglingIndex) WithDebug() func(*DanglingIndicesDeleteDanglingIndexRequest) {
+	return func(r *DanglingIndicesDeleteDanglingIndexRequest) {
+		r.Pretty = true
+		r.Human = true
+		r.ErrorTrace = true
+	}
+}
+
 // WithFilterPath filters the properties of the response body.
 //
 func (f DanglingIndicesDeleteDanglingIndex) WithFilterPath(v ...string) func(*DanglingIndicesDeleteDanglingIndexRequest) {
@@ -252,6 +259,32 @@ func (f DanglingIndicesDeleteDanglingIndex) WithHeader(h map[string]string) func
 	}
 }
 
+// WithHTTPHeader adds the headers to the HTTP request, keeping the multiple values of a header.
+//
+func (f DanglingIndicesDeleteDanglingIndex) WithHTTPHeader(h http.Header) func(*DanglingIndicesDeleteDanglingIndexRequest) {
+	return func(r *DanglingIndicesDeleteDanglingIndexRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, vv := range h {
+			for _, v := range vv {
+				r.Header.Add(k, v)
+			}
+		}
+	}
+}
+
+// WithAccept sets the media type of the response, eg. "application/yaml"; the default is JSON.
+//
+func (f DanglingIndicesDeleteDanglingIndex) WithAccept(v string) func(*DanglingIndicesDeleteDanglingIndexRequest) {
+	return func(r *DanglingIndicesDeleteDanglingIndexRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Accept", v)
+	}
+}
+
 // WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
 //
 func (f DanglingIndicesDeleteDanglingIndex) WithOpaqueID(s string) func(*DanglingIndicesDeleteDanglingIndexRequest) {
@@ -262,3 +295,14 @@ func (f DanglingIndicesDeleteDanglingIndex) WithOpaqueID(s string) func(*Danglin
 		r.Header.Set("X-Opaque-Id", s)
 	}
 }
+
+// WithBasicAuth sets the credentials of the request, overriding the credentials of the client.
+//
+func (f DanglingIndicesDeleteDanglingIndex) WithBasicAuth(username, password string) func(*DanglingIndicesDeleteDanglingIndexRequest) {
+	return func(r *DanglingIndicesDeleteDanglingIndexRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Authorization", basicAuth(username, password))
+	}
+}
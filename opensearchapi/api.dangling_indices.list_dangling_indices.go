@@ -94,7 +94,7 @@ func (r DanglingIndicesListDanglingIndicesRequest) Do(ctx context.Context, trans
 		params["filter_path"] = strings.Join(r.FilterPath, ",")
 	}
 
-	req, err := newRequest(method, path.String(), nil)
+	req, err := newRequest(transport, method, path.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -108,13 +108,9 @@ func (r DanglingIndicesListDanglingIndicesRequest) Do(ctx context.Context, trans
 	}
 
 	if len(r.Header) > 0 {
-		if len(req.Header) == 0 {
-			req.Header = r.Header
-		} else {
-			for k, vv := range r.Header {
-				for _, v := range vv {
-					req.Header.Add(k, v)
-				}
+		for k, vv := range r.Header {
+			for _, v := range vv {
+				req.Header.Add(k, v)
 			}
 		}
 	}
@@ -132,6 +128,7 @@ func (r DanglingIndicesListDanglingIndicesRequest) Do(ctx context.Context, trans
 		StatusCode: res.StatusCode,
 		Body:       res.Body,
 		Header:     res.Header,
+		codec:      JSONCodecOf(transport),
 	}
 
 	return &response, response.Err()
@@ -169,6 +166,16 @@ func (f DanglingIndicesListDanglingIndices) WithErrorTrace() func(*DanglingIndic
 	}
 }
 
+// WithDebug makes the response body pretty-printed and human-readable, and includes the stack trace for errors.
+//
+func (f DanglingIndicesListDanglingIndices) WithDebug() func(*DanglingIndicesListDanglingIndicesRequest) {
+	return func(r *DanglingIndicesListDanglingIndicesRequest) {
+		r.Pretty = true
+		r.Human = true
+		r.ErrorTrace = true
+	}
+}
+
 // WithFilterPath filters the properties of the response body.
 //
 func (f DanglingIndicesListDanglingIndices) WithFilterPath(v ...string) func(*DanglingIndicesListDanglingIndicesRequest) {
@@ -190,6 +197,32 @@ func (f DanglingIndicesListDanglingIndices) WithHeader(h map[string]string) func
 	}
 }
 
+// WithHTTPHeader adds the headers to the HTTP request, keeping the multiple values of a header.
+//
+func (f DanglingIndicesListDanglingIndices) WithHTTPHeader(h http.Header) func(*DanglingIndicesListDanglingIndicesRequest) {
+	return func(r *DanglingIndicesListDanglingIndicesRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, vv := range h {
+			for _, v := range vv {
+				r.Header.Add(k, v)
+			}
+		}
+	}
+}
+
+// WithAccept sets the media type of the response, eg. "application/yaml"; the default is JSON.
+//
+func (f DanglingIndicesListDanglingIndices) WithAccept(v string) func(*DanglingIndicesListDanglingIndicesRequest) {
+	return func(r *DanglingIndicesListDanglingIndicesRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Accept", v)
+	}
+}
+
 // WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
 //
 func (f DanglingIndicesListDanglingIndices) WithOpaqueID(s string) func(*DanglingIndicesListDanglingIndicesRequest) {
@@ -200,3 +233,14 @@ func (f DanglingIndicesListDanglingIndices) WithOpaqueID(s string) func(*Danglin
 		r.Header.Set("X-Opaque-Id", s)
 	}
 }
+
+// WithBasicAuth sets the credentials of the request, overriding the credentials of the client.
+//
+func (f DanglingIndicesListDanglingIndices) WithBasicAuth(username, password string) func(*DanglingIndicesListDanglingIndicesRequest) {
+	return func(r *DanglingIndicesListDanglingIndicesRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Authorization", basicAuth(username, password))
+	}
+}
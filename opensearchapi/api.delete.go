@@ -105,6 +105,9 @@ func (r DeleteRequest) Do(ctx context.Context, transport Transport) (*Response,
 	}
 
 	if r.Refresh != "" {
+		if err := ValidateRefresh(r.Refresh); err != nil {
+			return nil, err
+		}
 		params["refresh"] = r.Refresh
 	}
 
@@ -125,6 +128,9 @@ func (r DeleteRequest) Do(ctx context.Context, transport Transport) (*Response,
 	}
 
 	if r.WaitForActiveShards != "" {
+		if err := ValidateWaitForActiveShards(r.WaitForActiveShards); err != nil {
+			return nil, err
+		}
 		params["wait_for_active_shards"] = r.WaitForActiveShards
 	}
 
@@ -144,7 +150,7 @@ func (r DeleteRequest) Do(ctx context.Context, transport Transport) (*Response,
 		params["filter_path"] = strings.Join(r.FilterPath, ",")
 	}
 
-	req, err := newRequest(method, path.String(), nil)
+	req, err := newRequest(transport, method, path.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -158,13 +164,9 @@ func (r DeleteRequest) Do(ctx context.Context, transport Transport) (*Response,
 	}
 
 	if len(r.Header) > 0 {
-		if len(req.Header) == 0 {
-			req.Header = r.Header
-		} else {
-			for k, vv := range r.Header {
-				for _, v := range vv {
-					req.Header.Add(k, v)
-				}
+		for k, vv := range r.Header {
+			for _, v := range vv {
+				req.Header.Add(k, v)
 			}
 		}
 	}
@@ -182,6 +184,7 @@ func (r DeleteRequest) Do(ctx context.Context, transport Transport) (*Response,
 		StatusCode: res.StatusCode,
 		Body:       res.Body,
 		Header:     res.Header,
+		codec:      JSONCodecOf(transport),
 	}
 
 	return &response, response.Err()
@@ -213,6 +216,8 @@ func (f Delete) WithIfSeqNo(v int) func(*DeleteRequest) {
 
 // WithRefresh - if `true` then refresh the affected shards to make this operation visible to search, if `wait_for` then wait for a refresh to make this operation visible to search, if `false` (the default) then do nothing with refreshes..
 //
+// See ValidateRefresh for the valid values.
+//
 func (f Delete) WithRefresh(v string) func(*DeleteRequest) {
 	return func(r *DeleteRequest) {
 		r.Refresh = v
@@ -283,6 +288,16 @@ func (f Delete) WithErrorTrace() func(*DeleteRequest) {
 	}
 }
 
+// WithDebug makes the response body pretty-printed and human-readable, and includes the stack trace for errors.
+//
+func (f Delete) WithDebug() func(*DeleteRequest) {
+	return func(r *DeleteRequest) {
+		r.Pretty = true
+		r.Human = true
+		r.ErrorTrace = true
+	}
+}
+
 // WithFilterPath filters the properties of the response body.
 //
 func (f Delete) WithFilterPath(v ...string) func(*DeleteRequest) {
@@ -304,6 +319,32 @@ func (f Delete) WithHeader(h map[string]string) func(*DeleteRequest) {
 	}
 }
 
+// WithHTTPHeader adds the headers to the HTTP request, keeping the multiple values of a header.
+//
+func (f Delete) WithHTTPHeader(h http.Header) func(*DeleteRequest) {
+	return func(r *DeleteRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, vv := range h {
+			for _, v := range vv {
+				r.Header.Add(k, v)
+			}
+		}
+	}
+}
+
+// WithAccept sets the media type of the response, eg. "application/yaml"; the default is JSON.
+//
+func (f Delete) WithAccept(v string) func(*DeleteRequest) {
+	return func(r *DeleteRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Accept", v)
+	}
+}
+
 // WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
 //
 func (f Delete) WithOpaqueID(s string) func(*DeleteRequest) {
@@ -314,3 +355,14 @@ func (f Delete) WithOpaqueID(s string) func(*DeleteRequest) {
 		r.Header.Set("X-Opaque-Id", s)
 	}
 }
+
+// WithBasicAuth sets the credentials of the request, overriding the credentials of the client.
+//
+func (f Delete) WithBasicAuth(username, password string) func(*DeleteRequest) {
+	return func(r *DeleteRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Authorization", basicAuth(username, password))
+	}
+}
@@ -94,6 +94,8 @@ type DeleteByQueryRequest struct {
 	WaitForActiveShards string
 	WaitForCompletion   *bool
 
+	RequestsPerSecondFloat *float64
+
 	Pretty     bool
 	Human      bool
 	ErrorTrace bool
@@ -183,7 +185,9 @@ func (r DeleteByQueryRequest) Do(ctx context.Context, transport Transport) (*Res
 		params["request_cache"] = strconv.FormatBool(*r.RequestCache)
 	}
 
-	if r.RequestsPerSecond != nil {
+	if r.RequestsPerSecondFloat != nil {
+		params["requests_per_second"] = strconv.FormatFloat(*r.RequestsPerSecondFloat, 'f', -1, 64)
+	} else if r.RequestsPerSecond != nil {
 		params["requests_per_second"] = strconv.FormatInt(int64(*r.RequestsPerSecond), 10)
 	}
 
@@ -252,6 +256,9 @@ func (r DeleteByQueryRequest) Do(ctx context.Context, transport Transport) (*Res
 	}
 
 	if r.WaitForActiveShards != "" {
+		if err := ValidateWaitForActiveShards(r.WaitForActiveShards); err != nil {
+			return nil, err
+		}
 		params["wait_for_active_shards"] = r.WaitForActiveShards
 	}
 
@@ -275,7 +282,7 @@ func (r DeleteByQueryRequest) Do(ctx context.Context, transport Transport) (*Res
 		params["filter_path"] = strings.Join(r.FilterPath, ",")
 	}
 
-	req, err := newRequest(method, path.String(), r.Body)
+	req, err := newRequest(transport, method, path.String(), r.Body)
 	if err != nil {
 		return nil, err
 	}
@@ -288,18 +295,14 @@ func (r DeleteByQueryRequest) Do(ctx context.Context, transport Transport) (*Res
 		req.URL.RawQuery = q.Encode()
 	}
 
-	if r.Body != nil {
+	if r.Body != nil && r.Header.Get(headerContentType) == "" {
 		req.Header[headerContentType] = headerContentTypeJSON
 	}
 
 	if len(r.Header) > 0 {
-		if len(req.Header) == 0 {
-			req.Header = r.Header
-		} else {
-			for k, vv := range r.Header {
-				for _, v := range vv {
-					req.Header.Add(k, v)
-				}
+		for k, vv := range r.Header {
+			for _, v := range vv {
+				req.Header.Add(k, v)
 			}
 		}
 	}
@@ -317,6 +320,7 @@ func (r DeleteByQueryRequest) Do(ctx context.Context, transport Transport) (*Res
 		StatusCode: res.StatusCode,
 		Body:       res.Body,
 		Header:     res.Header,
+		codec:      JSONCodecOf(transport),
 	}
 
 	return &response, response.Err()
@@ -330,6 +334,14 @@ func (f DeleteByQuery) WithContext(v context.Context) func(*DeleteByQueryRequest
 	}
 }
 
+// WithJSONBody - the request body, encoded to JSON with the JSON codec of the client; it replaces the body passed to the function.
+//
+func (f DeleteByQuery) WithJSONBody(v interface{}) func(*DeleteByQueryRequest) {
+	return func(r *DeleteByQueryRequest) {
+		r.Body = JSONBody(v)
+	}
+}
+
 // WithAllowNoIndices - whether to ignore if a wildcard indices expression resolves into no concrete indices. (this includes `_all` string or when no indices have been specified).
 //
 func (f DeleteByQuery) WithAllowNoIndices(v bool) func(*DeleteByQueryRequest) {
@@ -458,6 +470,14 @@ func (f DeleteByQuery) WithRequestsPerSecond(v int) func(*DeleteByQueryRequest)
 	}
 }
 
+// WithRequestsPerSecondFloat - the throttle for this request in sub-requests per second, as a float, eg. 0.5; it takes precedence over WithRequestsPerSecond.
+//
+func (f DeleteByQuery) WithRequestsPerSecondFloat(v float64) func(*DeleteByQueryRequest) {
+	return func(r *DeleteByQueryRequest) {
+		r.RequestsPerSecondFloat = &v
+	}
+}
+
 // WithRouting - a list of specific routing values.
 //
 func (f DeleteByQuery) WithRouting(v ...string) func(*DeleteByQueryRequest) {
@@ -618,6 +638,16 @@ func (f DeleteByQuery) WithErrorTrace() func(*DeleteByQueryRequest) {
 	}
 }
 
+// WithDebug makes the response body pretty-printed and human-readable, and includes the stack trace for errors.
+//
+func (f DeleteByQuery) WithDebug() func(*DeleteByQueryRequest) {
+	return func(r *DeleteByQueryRequest) {
+		r.Pretty = true
+		r.Human = true
+		r.ErrorTrace = true
+	}
+}
+
 // WithFilterPath filters the properties of the response body.
 //
 func (f DeleteByQuery) WithFilterPath(v ...string) func(*DeleteByQueryRequest) {
@@ -639,6 +669,32 @@ func (f DeleteByQuery) WithHeader(h map[string]string) func(*DeleteByQueryReques
 	}
 }
 
+// WithHTTPHeader adds the headers to the HTTP request, keeping the multiple values of a header.
+//
+func (f DeleteByQuery) WithHTTPHeader(h http.Header) func(*DeleteByQueryRequest) {
+	return func(r *DeleteByQueryRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, vv := range h {
+			for _, v := range vv {
+				r.Header.Add(k, v)
+			}
+		}
+	}
+}
+
+// WithAccept sets the media type of the response, eg. "application/yaml"; the default is JSON.
+//
+func (f DeleteByQuery) WithAccept(v string) func(*DeleteByQueryRequest) {
+	return func(r *DeleteByQueryRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Accept", v)
+	}
+}
+
 // WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
 //
 func (f DeleteByQuery) WithOpaqueID(s string) func(*DeleteByQueryRequest) {
@@ -649,3 +705,25 @@ func (f DeleteByQuery) WithOpaqueID(s string) func(*DeleteByQueryRequest) {
 		r.Header.Set("X-Opaque-Id", s)
 	}
 }
+
+// WithContentType sets the Content-Type header of the request, overriding the default of the request body.
+//
+func (f DeleteByQuery) WithContentType(v string) func(*DeleteByQueryRequest) {
+	return func(r *DeleteByQueryRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Content-Type", v)
+	}
+}
+
+// WithBasicAuth sets the credentials of the request, overriding the credentials of the client.
+//
+func (f DeleteByQuery) WithBasicAuth(username, password string) func(*DeleteByQueryRequest) {
+	return func(r *DeleteByQueryRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Authorization", basicAuth(username, password))
+	}
+}
@@ -96,7 +96,7 @@ func (r GetScriptLanguagesRequest) Do(ctx context.Context, transport Transport)
 		params["filter_path"] = strings.Join(r.FilterPath, ",")
 	}
 
-	req, err := newRequest(method, path.String(), nil)
+	req, err := newRequest(transport, method, path.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -110,13 +110,9 @@ func (r GetScriptLanguagesRequest) Do(ctx context.Context, transport Transport)
 	}
 
 	if len(r.Header) > 0 {
-		if len(req.Header) == 0 {
-			req.Header = r.Header
-		} else {
-			for k, vv := range r.Header {
-				for _, v := range vv {
-					req.Header.Add(k, v)
-				}
+		for k, vv := range r.Header {
+			for _, v := range vv {
+				req.Header.Add(k, v)
 			}
 		}
 	}
@@ -134,6 +130,7 @@ func (r GetScriptLanguagesRequest) Do(ctx context.Context, transport Transport)
 		StatusCode: res.StatusCode,
 		Body:       res.Body,
 		Header:     res.Header,
+		codec:      JSONCodecOf(transport),
 	}
 
 	return &response, response.Err()
@@ -171,6 +168,16 @@ func (f GetScriptLanguages) WithErrorTrace() func(*GetScriptLanguagesRequest) {
 	}
 }
 
+// WithDebug makes the response body pretty-printed and human-readable, and includes the stack trace for errors.
+//
+func (f GetScriptLanguages) WithDebug() func(*GetScriptLanguagesRequest) {
+	return func(r *GetScriptLanguagesRequest) {
+		r.Pretty = true
+		r.Human = true
+		r.ErrorTrace = true
+	}
+}
+
 // WithFilterPath filters the properties of the response body.
 //
 func (f GetScriptLanguages) WithFilterPath(v ...string) func(*GetScriptLanguagesRequest) {
@@ -192,6 +199,32 @@ func (f GetScriptLanguages) WithHeader(h map[string]string) func(*GetScriptLangu
 	}
 }
 
+// WithHTTPHeader adds the headers to the HTTP request, keeping the multiple values of a header.
+//
+func (f GetScriptLanguages) WithHTTPHeader(h http.Header) func(*GetScriptLanguagesRequest) {
+	return func(r *GetScriptLanguagesRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, vv := range h {
+			for _, v := range vv {
+				r.Header.Add(k, v)
+			}
+		}
+	}
+}
+
+// WithAccept sets the media type of the response, eg. "application/yaml"; the default is JSON.
+//
+func (f GetScriptLanguages) WithAccept(v string) func(*GetScriptLanguagesRequest) {
+	return func(r *GetScriptLanguagesRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Accept", v)
+	}
+}
+
 // WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
 //
 func (f GetScriptLanguages) WithOpaqueID(s string) func(*GetScriptLanguagesRequest) {
@@ -202,3 +235,14 @@ func (f GetScriptLanguages) WithOpaqueID(s string) func(*GetScriptLanguagesReque
 		r.Header.Set("X-Opaque-Id", s)
 	}
 }
+
+// WithBasicAuth sets the credentials of the request, overriding the credentials of the client.
+//
+func (f GetScriptLanguages) WithBasicAuth(username, password string) func(*GetScriptLanguagesRequest) {
+	return func(r *GetScriptLanguagesRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Authorization", basicAuth(username, password))
+	}
+}
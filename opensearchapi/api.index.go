@@ -28,6 +28,8 @@ package opensearchapi
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"io"
 	"net/http"
 	"strconv"
@@ -82,6 +84,62 @@ type IndexRequest struct {
 	ctx context.Context
 }
 
+// Version types for the Index API and the Bulk API.
+//
+const (
+	VersionTypeInternal    = "internal"
+	VersionTypeExternal    = "external"
+	VersionTypeExternalGTE = "external_gte"
+)
+
+// ValidateVersionType returns an error when the version type is unknown,
+// or when it's an invalid combination with the version and the document ID.
+//
+// External version types require a version, and a version requires an external
+// version type and a document ID; use if_seq_no and if_primary_term for
+// optimistic concurrency control with internal versioning.
+//
+func ValidateVersionType(versionType string, hasVersion bool, hasDocumentID bool) error {
+	switch versionType {
+	case "", VersionTypeInternal:
+		if hasVersion {
+			return errors.New("invalid version: internal versioning can not be used with a version, use an external version type or if_seq_no and if_primary_term")
+		}
+	case VersionTypeExternal, VersionTypeExternalGTE:
+		if !hasVersion {
+			return fmt.Errorf("invalid version type %q: a version is required", versionType)
+		}
+		if !hasDocumentID {
+			return fmt.Errorf("invalid version type %q: a document ID is required", versionType)
+		}
+	default:
+		return fmt.Errorf("invalid version type %q", versionType)
+	}
+	return nil
+}
+
+// Refresh values for the Index, Create, Update, Delete and Bulk APIs.
+//
+const (
+	RefreshTrue    = "true"
+	RefreshFalse   = "false"
+	RefreshWaitFor = "wait_for"
+)
+
+// ValidateRefresh returns an error when the refresh value is unknown.
+//
+// RefreshWaitFor waits for the next refresh to make the changes visible to search,
+// without forcing a refresh as RefreshTrue does.
+//
+func ValidateRefresh(refresh string) error {
+	switch refresh {
+	case RefreshTrue, RefreshFalse, RefreshWaitFor:
+		return nil
+	default:
+		return fmt.Errorf("invalid refresh %q: must be %q, %q or %q", refresh, RefreshTrue, RefreshFalse, RefreshWaitFor)
+	}
+}
+
 // Do executes the request and returns response or error.
 //
 func (r IndexRequest) Do(ctx context.Context, transport Transport) (*Response, error) {
@@ -106,6 +164,12 @@ func (r IndexRequest) Do(ctx context.Context, transport Transport) (*Response, e
 		path.WriteString(r.DocumentID)
 	}
 
+	if r.Version != nil || r.VersionType != "" {
+		if err := ValidateVersionType(r.VersionType, r.Version != nil, r.DocumentID != ""); err != nil {
+			return nil, err
+		}
+	}
+
 	params = make(map[string]string)
 
 	if r.IfPrimaryTerm != nil {
@@ -125,6 +189,9 @@ func (r IndexRequest) Do(ctx context.Context, transport Transport) (*Response, e
 	}
 
 	if r.Refresh != "" {
+		if err := ValidateRefresh(r.Refresh); err != nil {
+			return nil, err
+		}
 		params["refresh"] = r.Refresh
 	}
 
@@ -149,6 +216,9 @@ func (r IndexRequest) Do(ctx context.Context, transport Transport) (*Response, e
 	}
 
 	if r.WaitForActiveShards != "" {
+		if err := ValidateWaitForActiveShards(r.WaitForActiveShards); err != nil {
+			return nil, err
+		}
 		params["wait_for_active_shards"] = r.WaitForActiveShards
 	}
 
@@ -168,7 +238,7 @@ func (r IndexRequest) Do(ctx context.Context, transport Transport) (*Response, e
 		params["filter_path"] = strings.Join(r.FilterPath, ",")
 	}
 
-	req, err := newRequest(method, path.String(), r.Body)
+	req, err := newRequest(transport, method, path.String(), r.Body)
 	if err != nil {
 		return nil, err
 	}
@@ -181,18 +251,14 @@ func (r IndexRequest) Do(ctx context.Context, transport Transport) (*Response, e
 		req.URL.RawQuery = q.Encode()
 	}
 
-	if r.Body != nil {
+	if r.Body != nil && r.Header.Get(headerContentType) == "" {
 		req.Header[headerContentType] = headerContentTypeJSON
 	}
 
 	if len(r.Header) > 0 {
-		if len(req.Header) == 0 {
-			req.Header = r.Header
-		} else {
-			for k, vv := range r.Header {
-				for _, v := range vv {
-					req.Header.Add(k, v)
-				}
+		for k, vv := range r.Header {
+			for _, v := range vv {
+				req.Header.Add(k, v)
 			}
 		}
 	}
@@ -210,6 +276,7 @@ func (r IndexRequest) Do(ctx context.Context, transport Transport) (*Response, e
 		StatusCode: res.StatusCode,
 		Body:       res.Body,
 		Header:     res.Header,
+		codec:      JSONCodecOf(transport),
 	}
 
 	return &response, response.Err()
@@ -223,6 +290,14 @@ func (f Index) WithContext(v context.Context) func(*IndexRequest) {
 	}
 }
 
+// WithJSONBody - the request body, encoded to JSON with the JSON codec of the client; it replaces the body passed to the function.
+//
+func (f Index) WithJSONBody(v interface{}) func(*IndexRequest) {
+	return func(r *IndexRequest) {
+		r.Body = JSONBody(v)
+	}
+}
+
 // WithDocumentID - document ID.
 //
 func (f Index) WithDocumentID(v string) func(*IndexRequest) {
@@ -265,6 +340,8 @@ func (f Index) WithPipeline(v string) func(*IndexRequest) {
 
 // WithRefresh - if `true` then refresh the affected shards to make this operation visible to search, if `wait_for` then wait for a refresh to make this operation visible to search, if `false` (the default) then do nothing with refreshes..
 //
+// See ValidateRefresh for the valid values.
+//
 func (f Index) WithRefresh(v string) func(*IndexRequest) {
 	return func(r *IndexRequest) {
 		r.Refresh = v
@@ -305,6 +382,8 @@ func (f Index) WithVersion(v int) func(*IndexRequest) {
 
 // WithVersionType - specific version type.
 //
+// See ValidateVersionType for the valid combinations with WithVersion.
+//
 func (f Index) WithVersionType(v string) func(*IndexRequest) {
 	return func(r *IndexRequest) {
 		r.VersionType = v
@@ -343,6 +422,16 @@ func (f Index) WithErrorTrace() func(*IndexRequest) {
 	}
 }
 
+// WithDebug makes the response body pretty-printed and human-readable, and includes the stack trace for errors.
+//
+func (f Index) WithDebug() func(*IndexRequest) {
+	return func(r *IndexRequest) {
+		r.Pretty = true
+		r.Human = true
+		r.ErrorTrace = true
+	}
+}
+
 // WithFilterPath filters the properties of the response body.
 //
 func (f Index) WithFilterPath(v ...string) func(*IndexRequest) {
@@ -364,6 +453,32 @@ func (f Index) WithHeader(h map[string]string) func(*IndexRequest) {
 	}
 }
 
+// WithHTTPHeader adds the headers to the HTTP request, keeping the multiple values of a header.
+//
+func (f Index) WithHTTPHeader(h http.Header) func(*IndexRequest) {
+	return func(r *IndexRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, vv := range h {
+			for _, v := range vv {
+				r.Header.Add(k, v)
+			}
+		}
+	}
+}
+
+// WithAccept sets the media type of the response, eg. "application/yaml"; the default is JSON.
+//
+func (f Index) WithAccept(v string) func(*IndexRequest) {
+	return func(r *IndexRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Accept", v)
+	}
+}
+
 // WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
 //
 func (f Index) WithOpaqueID(s string) func(*IndexRequest) {
@@ -374,3 +489,25 @@ func (f Index) WithOpaqueID(s string) func(*IndexRequest) {
 		r.Header.Set("X-Opaque-Id", s)
 	}
 }
+
+// WithContentType sets the Content-Type header of the request, overriding the default of the request body.
+//
+func (f Index) WithContentType(v string) func(*IndexRequest) {
+	return func(r *IndexRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Content-Type", v)
+	}
+}
+
+// WithBasicAuth sets the credentials of the request, overriding the credentials of the client.
+//
+func (f Index) WithBasicAuth(username, password string) func(*IndexRequest) {
+	return func(r *IndexRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Authorization", basicAuth(username, password))
+	}
+}
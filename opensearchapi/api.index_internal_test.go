@@ -0,0 +1,213 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+//go:build !integration
+// +build !integration
+
+package opensearchapi
+
+import (
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestIndexVersionType(t *testing.T) {
+	t.Run("Invalid combinations", func(t *testing.T) {
+		tests := []struct {
+			name        string
+			versionType string
+			version     bool
+			documentID  bool
+		}{
+			{"External without version", VersionTypeExternal, false, true},
+			{"External without document ID", VersionTypeExternalGTE, true, false},
+			{"Internal with version", VersionTypeInternal, true, true},
+			{"Default with version", "", true, true},
+			{"Unknown", "force", true, true},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				if err := ValidateVersionType(tt.versionType, tt.version, tt.documentID); err == nil {
+					t.Errorf("Expected error")
+				}
+			})
+		}
+
+		if err := ValidateVersionType(VersionTypeExternalGTE, true, true); err != nil {
+			t.Errorf("Unexpected error: %s", err)
+		}
+	})
+
+	t.Run("Request", func(t *testing.T) {
+		var numReqs int
+		tp := &mockTransport{RoundTripFunc: func(req *http.Request) (*http.Response, error) {
+			numReqs++
+			if q := req.URL.RawQuery; q != "version=3&version_type=external" {
+				t.Errorf("Unexpected query: %s", q)
+			}
+			return &http.Response{
+				StatusCode: http.StatusConflict,
+				Body: ioutil.NopCloser(strings.NewReader(
+					`{"error":{"type":"version_conflict_engine_exception","reason":"[1]: version conflict, current version [5] is higher or equal to the one provided [3]"},"status":409}`)),
+			}, nil
+		}}
+		index := newIndexFunc(tp)
+
+		if _, err := index("test", strings.NewReader(`{}`), index.WithVersionType(VersionTypeExternal)); err == nil {
+			t.Errorf("Expected error for missing version")
+		}
+		if numReqs != 0 {
+			t.Errorf("Expected no request to be performed")
+		}
+
+		_, err := index("test", strings.NewReader(`{}`),
+			index.WithDocumentID("1"),
+			index.WithVersion(3),
+			index.WithVersionType(VersionTypeExternal),
+		)
+		if !IsVersionConflict(err) {
+			t.Errorf("Expected version conflict, got: %v", err)
+		}
+	})
+}
+
+func TestIfSeqNoConflict(t *testing.T) {
+	tp := &mockTransport{RoundTripFunc: func(req *http.Request) (*http.Response, error) {
+		if q := req.URL.RawQuery; q != "if_primary_term=1&if_seq_no=7" {
+			t.Errorf("Unexpected query: %s", q)
+		}
+		return &http.Response{
+			StatusCode: http.StatusConflict,
+			Body: ioutil.NopCloser(strings.NewReader(
+				`{"error":{"type":"version_conflict_engine_exception","reason":"[1]: version conflict, required seqNo [7], primary term [1]. current document has seqNo [8] and primary term [1]"},"status":409}`)),
+		}, nil
+	}}
+
+	index := newIndexFunc(tp)
+	update := newUpdateFunc(tp)
+	del := newDeleteFunc(tp)
+
+	for name, do := range map[string]func() (*Response, error){
+		"Index": func() (*Response, error) {
+			return index("test", strings.NewReader(`{}`), index.WithDocumentID("1"), index.WithIfSeqNo(7), index.WithIfPrimaryTerm(1))
+		},
+		"Update": func() (*Response, error) {
+			return update("test", "1", strings.NewReader(`{"doc":{}}`), update.WithIfSeqNo(7), update.WithIfPrimaryTerm(1))
+		},
+		"Delete": func() (*Response, error) {
+			return del("test", "1", del.WithIfSeqNo(7), del.WithIfPrimaryTerm(1))
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			res, err := do()
+			if !IsVersionConflict(err) {
+				t.Errorf("Expected version conflict, got: %v", err)
+			}
+			if res == nil || res.StatusCode != http.StatusConflict {
+				t.Errorf("Unexpected response: %v", res)
+			}
+		})
+	}
+}
+
+func TestRefresh(t *testing.T) {
+	var numReqs int
+	tp := &mockTransport{RoundTripFunc: func(req *http.Request) (*http.Response, error) {
+		numReqs++
+		if q := req.URL.RawQuery; q != "refresh=wait_for" {
+			t.Errorf("Unexpected query: %s", q)
+		}
+		return &http.Response{StatusCode: http.StatusOK, Body: ioutil.NopCloser(strings.NewReader(`{}`))}, nil
+	}}
+
+	index := newIndexFunc(tp)
+	update := newUpdateFunc(tp)
+	del := newDeleteFunc(tp)
+	bulk := newBulkFunc(tp)
+
+	for _, refresh := range []string{RefreshTrue, RefreshFalse, RefreshWaitFor} {
+		if err := ValidateRefresh(refresh); err != nil {
+			t.Errorf("Unexpected error for %q: %s", refresh, err)
+		}
+	}
+
+	for name, do := range map[string]func(refresh string) (*Response, error){
+		"Index": func(refresh string) (*Response, error) {
+			return index("test", strings.NewReader(`{}`), index.WithRefresh(refresh))
+		},
+		"Update": func(refresh string) (*Response, error) {
+			return update("test", "1", strings.NewReader(`{"doc":{}}`), update.WithRefresh(refresh))
+		},
+		"Delete": func(refresh string) (*Response, error) {
+			return del("test", "1", del.WithRefresh(refresh))
+		},
+		"Bulk": func(refresh string) (*Response, error) {
+			return bulk(strings.NewReader("{\"delete\":{\"_index\":\"test\",\"_id\":\"1\"}}\n"), bulk.WithRefresh(refresh))
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			numReqs = 0
+			if _, err := do("wait"); err == nil {
+				t.Errorf("Expected error for invalid refresh")
+			}
+			if numReqs != 0 {
+				t.Errorf("Expected no request to be performed")
+			}
+
+			if _, err := do(RefreshWaitFor); err != nil {
+				t.Errorf("Unexpected error: %s", err)
+			}
+			if numReqs != 1 {
+				t.Errorf("Expected the request to be performed")
+			}
+		})
+	}
+}
+
+func TestWaitForActiveShards(t *testing.T) {
+	var numReqs int
+	tp := &mockTransport{RoundTripFunc: func(req *http.Request) (*http.Response, error) {
+		numReqs++
+		return &http.Response{StatusCode: http.StatusOK, Body: ioutil.NopCloser(strings.NewReader(`{}`))}, nil
+	}}
+
+	for _, v := range []string{WaitForActiveShardsAll, WaitForActiveShardsN(0), WaitForActiveShardsN(2)} {
+		if err := ValidateWaitForActiveShards(v); err != nil {
+			t.Errorf("Unexpected error for %q: %s", v, err)
+		}
+	}
+	for _, v := range []string{"most", "-1", "1.5", "ALL"} {
+		if err := ValidateWaitForActiveShards(v); err == nil {
+			t.Errorf("Expected error for %q", v)
+		}
+	}
+
+	index := newIndexFunc(tp)
+	create := newIndicesCreateFunc(tp)
+
+	if _, err := index("test", strings.NewReader(`{}`), index.WithWaitForActiveShards("most")); err == nil {
+		t.Errorf("Expected error for invalid wait for active shards")
+	}
+	if _, err := create("test", create.WithWaitForActiveShards("most")); err == nil {
+		t.Errorf("Expected error for invalid wait for active shards")
+	}
+	if numReqs != 0 {
+		t.Errorf("Expected no request to be performed")
+	}
+
+	if _, err := index("test", strings.NewReader(`{}`), index.WithWaitForActiveShards(WaitForActiveShardsAll)); err != nil {
+		t.Errorf("Unexpected error: %s", err)
+	}
+	if numReqs != 1 {
+		t.Errorf("Expected the request to be performed")
+	}
+}
@@ -136,7 +136,7 @@ func (r IndicesAddBlockRequest) Do(ctx context.Context, transport Transport) (*R
 		params["filter_path"] = strings.Join(r.FilterPath, ",")
 	}
 
-	req, err := newRequest(method, path.String(), nil)
+	req, err := newRequest(transport, method, path.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -150,13 +150,9 @@ func (r IndicesAddBlockRequest) Do(ctx context.Context, transport Transport) (*R
 	}
 
 	if len(r.Header) > 0 {
-		if len(req.Header) == 0 {
-			req.Header = r.Header
-		} else {
-			for k, vv := range r.Header {
-				for _, v := range vv {
-					req.Header.Add(k, v)
-				}
+		for k, vv := range r.Header {
+			for _, v := range vv {
+				req.Header.Add(k, v)
 			}
 		}
 	}
@@ -174,6 +170,7 @@ func (r IndicesAddBlockRequest) Do(ctx context.Context, transport Transport) (*R
 		StatusCode: res.StatusCode,
 		Body:       res.Body,
 		Header:     res.Header,
+		codec:      JSONCodecOf(transport),
 	}
 
 	return &response, response.Err()
@@ -261,6 +258,16 @@ func (f IndicesAddBlock) WithErrorTrace() func(*IndicesAddBlockRequest) {
 	}
 }
 
+// WithDebug makes the response body pretty-printed and human-readable, and includes the stack trace for errors.
+//
+func (f IndicesAddBlock) WithDebug() func(*IndicesAddBlockRequest) {
+	return func(r *IndicesAddBlockRequest) {
+		r.Pretty = true
+		r.Human = true
+		r.ErrorTrace = true
+	}
+}
+
 // WithFilterPath filters the properties of the response body.
 //
 func (f IndicesAddBlock) WithFilterPath(v ...string) func(*IndicesAddBlockRequest) {
@@ -282,6 +289,32 @@ func (f IndicesAddBlock) WithHeader(h map[string]string) func(*IndicesAddBlockRe
 	}
 }
 
+// WithHTTPHeader adds the headers to the HTTP request, keeping the multiple values of a header.
+//
+func (f IndicesAddBlock) WithHTTPHeader(h http.Header) func(*IndicesAddBlockRequest) {
+	return func(r *IndicesAddBlockRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, vv := range h {
+			for _, v := range vv {
+				r.Header.Add(k, v)
+			}
+		}
+	}
+}
+
+// WithAccept sets the media type of the response, eg. "application/yaml"; the default is JSON.
+//
+func (f IndicesAddBlock) WithAccept(v string) func(*IndicesAddBlockRequest) {
+	return func(r *IndicesAddBlockRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Accept", v)
+	}
+}
+
 // WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
 //
 func (f IndicesAddBlock) WithOpaqueID(s string) func(*IndicesAddBlockRequest) {
@@ -292,3 +325,14 @@ func (f IndicesAddBlock) WithOpaqueID(s string) func(*IndicesAddBlockRequest) {
 		r.Header.Set("X-Opaque-Id", s)
 	}
 }
+
+// WithBasicAuth sets the credentials of the request, overriding the credentials of the client.
+//
+func (f IndicesAddBlock) WithBasicAuth(username, password string) func(*IndicesAddBlockRequest) {
+	return func(r *IndicesAddBlockRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Authorization", basicAuth(username, password))
+	}
+}
@@ -144,7 +144,7 @@ func (r IndicesClearCacheRequest) Do(ctx context.Context, transport Transport) (
 		params["filter_path"] = strings.Join(r.FilterPath, ",")
 	}
 
-	req, err := newRequest(method, path.String(), nil)
+	req, err := newRequest(transport, method, path.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -158,13 +158,9 @@ func (r IndicesClearCacheRequest) Do(ctx context.Context, transport Transport) (
 	}
 
 	if len(r.Header) > 0 {
-		if len(req.Header) == 0 {
-			req.Header = r.Header
-		} else {
-			for k, vv := range r.Header {
-				for _, v := range vv {
-					req.Header.Add(k, v)
-				}
+		for k, vv := range r.Header {
+			for _, v := range vv {
+				req.Header.Add(k, v)
 			}
 		}
 	}
@@ -182,6 +178,7 @@ func (r IndicesClearCacheRequest) Do(ctx context.Context, transport Transport) (
 		StatusCode: res.StatusCode,
 		Body:       res.Body,
 		Header:     res.Header,
+		codec:      JSONCodecOf(transport),
 	}
 
 	return &response, response.Err()
@@ -283,6 +280,16 @@ func (f IndicesClearCache) WithErrorTrace() func(*IndicesClearCacheRequest) {
 	}
 }
 
+// WithDebug makes the response body pretty-printed and human-readable, and includes the stack trace for errors.
+//
+func (f IndicesClearCache) WithDebug() func(*IndicesClearCacheRequest) {
+	return func(r *IndicesClearCacheRequest) {
+		r.Pretty = true
+		r.Human = true
+		r.ErrorTrace = true
+	}
+}
+
 // WithFilterPath filters the properties of the response body.
 //
 func (f IndicesClearCache) WithFilterPath(v ...string) func(*IndicesClearCacheRequest) {
@@ -304,6 +311,32 @@ func (f IndicesClearCache) WithHeader(h map[string]string) func(*IndicesClearCac
 	}
 }
 
+// WithHTTPHeader adds the headers to the HTTP request, keeping the multiple values of a header.
+//
+func (f IndicesClearCache) WithHTTPHeader(h http.Header) func(*IndicesClearCacheRequest) {
+	return func(r *IndicesClearCacheRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, vv := range h {
+			for _, v := range vv {
+				r.Header.Add(k, v)
+			}
+		}
+	}
+}
+
+// WithAccept sets the media type of the response, eg. "application/yaml"; the default is JSON.
+//
+func (f IndicesClearCache) WithAccept(v string) func(*IndicesClearCacheRequest) {
+	return func(r *IndicesClearCacheRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Accept", v)
+	}
+}
+
 // WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
 //
 func (f IndicesClearCache) WithOpaqueID(s string) func(*IndicesClearCacheRequest) {
@@ -314,3 +347,14 @@ func (f IndicesClearCache) WithOpaqueID(s string) func(*IndicesClearCacheRequest
 		r.Header.Set("X-Opaque-Id", s)
 	}
 }
+
+// WithBasicAuth sets the credentials of the request, overriding the credentials of the client.
+//
+func (f IndicesClearCache) WithBasicAuth(username, password string) func(*IndicesClearCacheRequest) {
+	return func(r *IndicesClearCacheRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Authorization", basicAuth(username, password))
+	}
+}
@@ -109,6 +109,9 @@ func (r IndicesCloneRequest) Do(ctx context.Context, transport Transport) (*Resp
 	}
 
 	if r.WaitForActiveShards != "" {
+		if err := ValidateWaitForActiveShards(r.WaitForActiveShards); err != nil {
+			return nil, err
+		}
 		params["wait_for_active_shards"] = r.WaitForActiveShards
 	}
 
@@ -128,7 +131,7 @@ func (r IndicesCloneRequest) Do(ctx context.Context, transport Transport) (*Resp
 		params["filter_path"] = strings.Join(r.FilterPath, ",")
 	}
 
-	req, err := newRequest(method, path.String(), r.Body)
+	req, err := newRequest(transport, method, path.String(), r.Body)
 	if err != nil {
 		return nil, err
 	}
@@ -141,18 +144,14 @@ func (r IndicesCloneRequest) Do(ctx context.Context, transport Transport) (*Resp
 		req.URL.RawQuery = q.Encode()
 	}
 
-	if r.Body != nil {
+	if r.Body != nil && r.Header.Get(headerContentType) == "" {
 		req.Header[headerContentType] = headerContentTypeJSON
 	}
 
 	if len(r.Header) > 0 {
-		if len(req.Header) == 0 {
-			req.Header = r.Header
-		} else {
-			for k, vv := range r.Header {
-				for _, v := range vv {
-					req.Header.Add(k, v)
-				}
+		for k, vv := range r.Header {
+			for _, v := range vv {
+				req.Header.Add(k, v)
 			}
 		}
 	}
@@ -170,6 +169,7 @@ func (r IndicesCloneRequest) Do(ctx context.Context, transport Transport) (*Resp
 		StatusCode: res.StatusCode,
 		Body:       res.Body,
 		Header:     res.Header,
+		codec:      JSONCodecOf(transport),
 	}
 
 	return &response, response.Err()
@@ -191,6 +191,14 @@ func (f IndicesClone) WithBody(v io.Reader) func(*IndicesCloneRequest) {
 	}
 }
 
+// WithJSONBody - the request body, encoded to JSON with the JSON codec of the client; it replaces the body set with WithBody.
+//
+func (f IndicesClone) WithJSONBody(v interface{}) func(*IndicesCloneRequest) {
+	return func(r *IndicesCloneRequest) {
+		r.Body = JSONBody(v)
+	}
+}
+
 // WithMasterTimeout - explicit operation timeout for connection to cluster-manager node.
 //
 // Deprecated: To promote inclusive language, use WithClusterManagerTimeout instead.
@@ -249,6 +257,16 @@ func (f IndicesClone) WithErrorTrace() func(*IndicesCloneRequest) {
 	}
 }
 
+// WithDebug makes the response body pretty-printed and human-readable, and includes the stack trace for errors.
+//
+func (f IndicesClone) WithDebug() func(*IndicesCloneRequest) {
+	return func(r *IndicesCloneRequest) {
+		r.Pretty = true
+		r.Human = true
+		r.ErrorTrace = true
+	}
+}
+
 // WithFilterPath filters the properties of the response body.
 //
 func (f IndicesClone) WithFilterPath(v ...string) func(*IndicesCloneRequest) {
@@ -270,6 +288,32 @@ func (f IndicesClone) WithHeader(h map[string]string) func(*IndicesCloneRequest)
 	}
 }
 
+// WithHTTPHeader adds the headers to the HTTP request, keeping the multiple values of a header.
+//
+func (f IndicesClone) WithHTTPHeader(h http.Header) func(*IndicesCloneRequest) {
+	return func(r *IndicesCloneRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, vv := range h {
+			for _, v := range vv {
+				r.Header.Add(k, v)
+			}
+		}
+	}
+}
+
+// WithAccept sets the media type of the response, eg. "application/yaml"; the default is JSON.
+//
+func (f IndicesClone) WithAccept(v string) func(*IndicesCloneRequest) {
+	return func(r *IndicesCloneRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Accept", v)
+	}
+}
+
 // WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
 //
 func (f IndicesClone) WithOpaqueID(s string) func(*IndicesCloneRequest) {
@@ -280,3 +324,25 @@ func (f IndicesClone) WithOpaqueID(s string) func(*IndicesCloneRequest) {
 		r.Header.Set("X-Opaque-Id", s)
 	}
 }
+
+// WithContentType sets the Content-Type header of the request, overriding the default of the request body.
+//
+func (f IndicesClone) WithContentType(v string) func(*IndicesCloneRequest) {
+	return func(r *IndicesCloneRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Content-Type", v)
+	}
+}
+
+// WithBasicAuth sets the credentials of the request, overriding the credentials of the client.
+//
+func (f IndicesClone) WithBasicAuth(username, password string) func(*IndicesCloneRequest) {
+	return func(r *IndicesCloneRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Authorization", basicAuth(username, password))
+	}
+}
@@ -130,7 +130,7 @@ func (r IndicesDeleteRequest) Do(ctx context.Context, transport Transport) (*Res
 		params["filter_path"] = strings.Join(r.FilterPath, ",")
 	}
 
-	req, err := newRequest(method, path.String(), nil)
+	req, err := newRequest(transport, method, path.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -144,13 +144,9 @@ func (r IndicesDeleteRequest) Do(ctx context.Context, transport Transport) (*Res
 	}
 
 	if len(r.Header) > 0 {
-		if len(req.Header) == 0 {
-			req.Header = r.Header
-		} else {
-			for k, vv := range r.Header {
-				for _, v := range vv {
-					req.Header.Add(k, v)
-				}
+		for k, vv := range r.Header {
+			for _, v := range vv {
+				req.Header.Add(k, v)
 			}
 		}
 	}
@@ -168,6 +164,7 @@ func (r IndicesDeleteRequest) Do(ctx context.Context, transport Transport) (*Res
 		StatusCode: res.StatusCode,
 		Body:       res.Body,
 		Header:     res.Header,
+		codec:      JSONCodecOf(transport),
 	}
 
 	return &response, response.Err()
@@ -255,6 +252,16 @@ func (f IndicesDelete) WithErrorTrace() func(*IndicesDeleteRequest) {
 	}
 }
 
+// WithDebug makes the response body pretty-printed and human-readable, and includes the stack trace for errors.
+//
+func (f IndicesDelete) WithDebug() func(*IndicesDeleteRequest) {
+	return func(r *IndicesDeleteRequest) {
+		r.Pretty = true
+		r.Human = true
+		r.ErrorTrace = true
+	}
+}
+
 // WithFilterPath filters the properties of the response body.
 //
 func (f IndicesDelete) WithFilterPath(v ...string) func(*IndicesDeleteRequest) {
@@ -276,6 +283,32 @@ func (f IndicesDelete) WithHeader(h map[string]string) func(*IndicesDeleteReques
 	}
 }
 
+// WithHTTPHeader adds the headers to the HTTP request, keeping the multiple values of a header.
+//
+func (f IndicesDelete) WithHTTPHeader(h http.Header) func(*IndicesDeleteRequest) {
+	return func(r *IndicesDeleteRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, vv := range h {
+			for _, v := range vv {
+				r.Header.Add(k, v)
+			}
+		}
+	}
+}
+
+// WithAccept sets the media type of the response, eg. "application/yaml"; the default is JSON.
+//
+func (f IndicesDelete) WithAccept(v string) func(*IndicesDeleteRequest) {
+	return func(r *IndicesDeleteRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Accept", v)
+	}
+}
+
 // WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
 //
 func (f IndicesDelete) WithOpaqueID(s string) func(*IndicesDeleteRequest) {
@@ -286,3 +319,14 @@ func (f IndicesDelete) WithOpaqueID(s string) func(*IndicesDeleteRequest) {
 		r.Header.Set("X-Opaque-Id", s)
 	}
 }
+
+// WithBasicAuth sets the credentials of the request, overriding the credentials of the client.
+//
+func (f IndicesDelete) WithBasicAuth(username, password string) func(*IndicesDeleteRequest) {
+	return func(r *IndicesDeleteRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Authorization", basicAuth(username, password))
+	}
+}
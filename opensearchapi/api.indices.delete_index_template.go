@@ -116,7 +116,7 @@ func (r IndicesDeleteIndexTemplateRequest) Do(ctx context.Context, transport Tra
 		params["filter_path"] = strings.Join(r.FilterPath, ",")
 	}
 
-	req, err := newRequest(method, path.String(), nil)
+	req, err := newRequest(transport, method, path.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -130,13 +130,9 @@ func (r IndicesDeleteIndexTemplateRequest) Do(ctx context.Context, transport Tra
 	}
 
 	if len(r.Header) > 0 {
-		if len(req.Header) == 0 {
-			req.Header = r.Header
-		} else {
-			for k, vv := range r.Header {
-				for _, v := range vv {
-					req.Header.Add(k, v)
-				}
+		for k, vv := range r.Header {
+			for _, v := range vv {
+				req.Header.Add(k, v)
 			}
 		}
 	}
@@ -154,6 +150,7 @@ func (r IndicesDeleteIndexTemplateRequest) Do(ctx context.Context, transport Tra
 		StatusCode: res.StatusCode,
 		Body:       res.Body,
 		Header:     res.Header,
+		codec:      JSONCodecOf(transport),
 	}
 
 	return &response, response.Err()
@@ -217,6 +214,16 @@ func (f IndicesDeleteIndexTemplate) WithErrorTrace() func(*IndicesDeleteIndexTem
 	}
 }
 
+// WithDebug makes the response body pretty-printed and human-readable, and includes the stack trace for errors.
+//
+func (f IndicesDeleteIndexTemplate) WithDebug() func(*IndicesDeleteIndexTemplateRequest) {
+	return func(r *IndicesDeleteIndexTemplateRequest) {
+		r.Pretty = true
+		r.Human = true
+		r.ErrorTrace = true
+	}
+}
+
 // WithFilterPath filters the properties of the response body.
 //
 func (f IndicesDeleteIndexTemplate) WithFilterPath(v ...string) func(*IndicesDeleteIndexTemplateRequest) {
@@ -238,6 +245,32 @@ func (f IndicesDeleteIndexTemplate) WithHeader(h map[string]string) func(*Indice
 	}
 }
 
+// WithHTTPHeader adds the headers to the HTTP request, keeping the multiple values of a header.
+//
+func (f IndicesDeleteIndexTemplate) WithHTTPHeader(h http.Header) func(*IndicesDeleteIndexTemplateRequest) {
+	return func(r *IndicesDeleteIndexTemplateRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, vv := range h {
+			for _, v := range vv {
+				r.Header.Add(k, v)
+			}
+		}
+	}
+}
+
+// WithAccept sets the media type of the response, eg. "application/yaml"; the default is JSON.
+//
+func (f IndicesDeleteIndexTemplate) WithAccept(v string) func(*IndicesDeleteIndexTemplateRequest) {
+	return func(r *IndicesDeleteIndexTemplateRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Accept", v)
+	}
+}
+
 // WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
 //
 func (f IndicesDeleteIndexTemplate) WithOpaqueID(s string) func(*IndicesDeleteIndexTemplateRequest) {
@@ -248,3 +281,14 @@ func (f IndicesDeleteIndexTemplate) WithOpaqueID(s string) func(*IndicesDeleteIn
 		r.Header.Set("X-Opaque-Id", s)
 	}
 }
+
+// WithBasicAuth sets the credentials of the request, overriding the credentials of the client.
+//
+func (f IndicesDeleteIndexTemplate) WithBasicAuth(username, password string) func(*IndicesDeleteIndexTemplateRequest) {
+	return func(r *IndicesDeleteIndexTemplateRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Authorization", basicAuth(username, password))
+	}
+}
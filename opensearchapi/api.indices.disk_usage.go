@@ -128,7 +128,7 @@ func (r IndicesDiskUsageRequest) Do(ctx context.Context, transport Transport) (*
 		params["filter_path"] = strings.Join(r.FilterPath, ",")
 	}
 
-	req, err := newRequest(method, path.String(), nil)
+	req, err := newRequest(transport, method, path.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -142,13 +142,9 @@ func (r IndicesDiskUsageRequest) Do(ctx context.Context, transport Transport) (*
 	}
 
 	if len(r.Header) > 0 {
-		if len(req.Header) == 0 {
-			req.Header = r.Header
-		} else {
-			for k, vv := range r.Header {
-				for _, v := range vv {
-					req.Header.Add(k, v)
-				}
+		for k, vv := range r.Header {
+			for _, v := range vv {
+				req.Header.Add(k, v)
 			}
 		}
 	}
@@ -166,6 +162,7 @@ func (r IndicesDiskUsageRequest) Do(ctx context.Context, transport Transport) (*
 		StatusCode: res.StatusCode,
 		Body:       res.Body,
 		Header:     res.Header,
+		codec:      JSONCodecOf(transport),
 	}
 
 	return &response, response.Err()
@@ -243,6 +240,16 @@ func (f IndicesDiskUsage) WithErrorTrace() func(*IndicesDiskUsageRequest) {
 	}
 }
 
+// WithDebug makes the response body pretty-printed and human-readable, and includes the stack trace for errors.
+//
+func (f IndicesDiskUsage) WithDebug() func(*IndicesDiskUsageRequest) {
+	return func(r *IndicesDiskUsageRequest) {
+		r.Pretty = true
+		r.Human = true
+		r.ErrorTrace = true
+	}
+}
+
 // WithFilterPath filters the properties of the response body.
 //
 func (f IndicesDiskUsage) WithFilterPath(v ...string) func(*IndicesDiskUsageRequest) {
@@ -264,6 +271,32 @@ func (f IndicesDiskUsage) WithHeader(h map[string]string) func(*IndicesDiskUsage
 	}
 }
 
+// WithHTTPHeader adds the headers to the HTTP request, keeping the multiple values of a header.
+//
+func (f IndicesDiskUsage) WithHTTPHeader(h http.Header) func(*IndicesDiskUsageRequest) {
+	return func(r *IndicesDiskUsageRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, vv := range h {
+			for _, v := range vv {
+				r.Header.Add(k, v)
+			}
+		}
+	}
+}
+
+// WithAccept sets the media type of the response, eg. "application/yaml"; the default is JSON.
+//
+func (f IndicesDiskUsage) WithAccept(v string) func(*IndicesDiskUsageRequest) {
+	return func(r *IndicesDiskUsageRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Accept", v)
+	}
+}
+
 // WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
 //
 func (f IndicesDiskUsage) WithOpaqueID(s string) func(*IndicesDiskUsageRequest) {
@@ -274,3 +307,14 @@ func (f IndicesDiskUsage) WithOpaqueID(s string) func(*IndicesDiskUsageRequest)
 		r.Header.Set("X-Opaque-Id", s)
 	}
 }
+
+// WithBasicAuth sets the credentials of the request, overriding the credentials of the client.
+//
+func (f IndicesDiskUsage) WithBasicAuth(username, password string) func(*IndicesDiskUsageRequest) {
+	return func(r *IndicesDiskUsageRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Authorization", basicAuth(username, password))
+	}
+}
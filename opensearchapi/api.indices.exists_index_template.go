@@ -122,7 +122,7 @@ func (r IndicesExistsIndexTemplateRequest) Do(ctx context.Context, transport Tra
 		params["filter_path"] = strings.Join(r.FilterPath, ",")
 	}
 
-	req, err := newRequest(method, path.String(), nil)
+	req, err := newRequest(transport, method, path.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -136,13 +136,9 @@ func (r IndicesExistsIndexTemplateRequest) Do(ctx context.Context, transport Tra
 	}
 
 	if len(r.Header) > 0 {
-		if len(req.Header) == 0 {
-			req.Header = r.Header
-		} else {
-			for k, vv := range r.Header {
-				for _, v := range vv {
-					req.Header.Add(k, v)
-				}
+		for k, vv := range r.Header {
+			for _, v := range vv {
+				req.Header.Add(k, v)
 			}
 		}
 	}
@@ -160,6 +156,7 @@ func (r IndicesExistsIndexTemplateRequest) Do(ctx context.Context, transport Tra
 		StatusCode: res.StatusCode,
 		Body:       res.Body,
 		Header:     res.Header,
+		codec:      JSONCodecOf(transport),
 	}
 
 	return &response, response.Err()
@@ -231,6 +228,16 @@ func (f IndicesExistsIndexTemplate) WithErrorTrace() func(*IndicesExistsIndexTem
 	}
 }
 
+// WithDebug makes the response body pretty-printed and human-readable, and includes the stack trace for errors.
+//
+func (f IndicesExistsIndexTemplate) WithDebug() func(*IndicesExistsIndexTemplateRequest) {
+	return func(r *IndicesExistsIndexTemplateRequest) {
+		r.Pretty = true
+		r.Human = true
+		r.ErrorTrace = true
+	}
+}
+
 // WithFilterPath filters the properties of the response body.
 //
 func (f IndicesExistsIndexTemplate) WithFilterPath(v ...string) func(*IndicesExistsIndexTemplateRequest) {
@@ -252,6 +259,32 @@ func (f IndicesExistsIndexTemplate) WithHeader(h map[string]string) func(*Indice
 	}
 }
 
+// WithHTTPHeader adds the headers to the HTTP request, keeping the multiple values of a header.
+//
+func (f IndicesExistsIndexTemplate) WithHTTPHeader(h http.Header) func(*IndicesExistsIndexTemplateRequest) {
+	return func(r *IndicesExistsIndexTemplateRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, vv := range h {
+			for _, v := range vv {
+				r.Header.Add(k, v)
+			}
+		}
+	}
+}
+
+// WithAccept sets the media type of the response, eg. "application/yaml"; the default is JSON.
+//
+func (f IndicesExistsIndexTemplate) WithAccept(v string) func(*IndicesExistsIndexTemplateRequest) {
+	return func(r *IndicesExistsIndexTemplateRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Accept", v)
+	}
+}
+
 // WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
 //
 func (f IndicesExistsIndexTemplate) WithOpaqueID(s string) func(*IndicesExistsIndexTemplateRequest) {
@@ -262,3 +295,14 @@ func (f IndicesExistsIndexTemplate) WithOpaqueID(s string) func(*IndicesExistsIn
 		r.Header.Set("X-Opaque-Id", s)
 	}
 }
+
+// WithBasicAuth sets the credentials of the request, overriding the credentials of the client.
+//
+func (f IndicesExistsIndexTemplate) WithBasicAuth(username, password string) func(*IndicesExistsIndexTemplateRequest) {
+	return func(r *IndicesExistsIndexTemplateRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Authorization", basicAuth(username, password))
+	}
+}
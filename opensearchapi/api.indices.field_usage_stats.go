@@ -123,7 +123,7 @@ func (r IndicesFieldUsageStatsRequest) Do(ctx context.Context, transport Transpo
 		params["filter_path"] = strings.Join(r.FilterPath, ",")
 	}
 
-	req, err := newRequest(method, path.String(), nil)
+	req, err := newRequest(transport, method, path.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -137,13 +137,9 @@ func (r IndicesFieldUsageStatsRequest) Do(ctx context.Context, transport Transpo
 	}
 
 	if len(r.Header) > 0 {
-		if len(req.Header) == 0 {
-			req.Header = r.Header
-		} else {
-			for k, vv := range r.Header {
-				for _, v := range vv {
-					req.Header.Add(k, v)
-				}
+		for k, vv := range r.Header {
+			for _, v := range vv {
+				req.Header.Add(k, v)
 			}
 		}
 	}
@@ -161,6 +157,7 @@ func (r IndicesFieldUsageStatsRequest) Do(ctx context.Context, transport Transpo
 		StatusCode: res.StatusCode,
 		Body:       res.Body,
 		Header:     res.Header,
+		codec:      JSONCodecOf(transport),
 	}
 
 	return &response, response.Err()
@@ -230,6 +227,16 @@ func (f IndicesFieldUsageStats) WithErrorTrace() func(*IndicesFieldUsageStatsReq
 	}
 }
 
+// WithDebug makes the response body pretty-printed and human-readable, and includes the stack trace for errors.
+//
+func (f IndicesFieldUsageStats) WithDebug() func(*IndicesFieldUsageStatsRequest) {
+	return func(r *IndicesFieldUsageStatsRequest) {
+		r.Pretty = true
+		r.Human = true
+		r.ErrorTrace = true
+	}
+}
+
 // WithFilterPath filters the properties of the response body.
 //
 func (f IndicesFieldUsageStats) WithFilterPath(v ...string) func(*IndicesFieldUsageStatsRequest) {
@@ -251,6 +258,32 @@ func (f IndicesFieldUsageStats) WithHeader(h map[string]string) func(*IndicesFie
 	}
 }
 
+// WithHTTPHeader adds the headers to the HTTP request, keeping the multiple values of a header.
+//
+func (f IndicesFieldUsageStats) WithHTTPHeader(h http.Header) func(*IndicesFieldUsageStatsRequest) {
+	return func(r *IndicesFieldUsageStatsRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, vv := range h {
+			for _, v := range vv {
+				r.Header.Add(k, v)
+			}
+		}
+	}
+}
+
+// WithAccept sets the media type of the response, eg. "application/yaml"; the default is JSON.
+//
+func (f IndicesFieldUsageStats) WithAccept(v string) func(*IndicesFieldUsageStatsRequest) {
+	return func(r *IndicesFieldUsageStatsRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Accept", v)
+	}
+}
+
 // WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
 //
 func (f IndicesFieldUsageStats) WithOpaqueID(s string) func(*IndicesFieldUsageStatsRequest) {
@@ -261,3 +294,14 @@ func (f IndicesFieldUsageStats) WithOpaqueID(s string) func(*IndicesFieldUsageSt
 		r.Header.Set("X-Opaque-Id", s)
 	}
 }
+
+// WithBasicAuth sets the credentials of the request, overriding the credentials of the client.
+//
+func (f IndicesFieldUsageStats) WithBasicAuth(username, password string) func(*IndicesFieldUsageStatsRequest) {
+	return func(r *IndicesFieldUsageStatsRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Authorization", basicAuth(username, password))
+	}
+}
@@ -128,7 +128,7 @@ func (r IndicesFlushRequest) Do(ctx context.Context, transport Transport) (*Resp
 		params["filter_path"] = strings.Join(r.FilterPath, ",")
 	}
 
-	req, err := newRequest(method, path.String(), nil)
+	req, err := newRequest(transport, method, path.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -142,13 +142,9 @@ func (r IndicesFlushRequest) Do(ctx context.Context, transport Transport) (*Resp
 	}
 
 	if len(r.Header) > 0 {
-		if len(req.Header) == 0 {
-			req.Header = r.Header
-		} else {
-			for k, vv := range r.Header {
-				for _, v := range vv {
-					req.Header.Add(k, v)
-				}
+		for k, vv := range r.Header {
+			for _, v := range vv {
+				req.Header.Add(k, v)
 			}
 		}
 	}
@@ -166,6 +162,7 @@ func (r IndicesFlushRequest) Do(ctx context.Context, transport Transport) (*Resp
 		StatusCode: res.StatusCode,
 		Body:       res.Body,
 		Header:     res.Header,
+		codec:      JSONCodecOf(transport),
 	}
 
 	return &response, response.Err()
@@ -251,6 +248,16 @@ func (f IndicesFlush) WithErrorTrace() func(*IndicesFlushRequest) {
 	}
 }
 
+// WithDebug makes the response body pretty-printed and human-readable, and includes the stack trace for errors.
+//
+func (f IndicesFlush) WithDebug() func(*IndicesFlushRequest) {
+	return func(r *IndicesFlushRequest) {
+		r.Pretty = true
+		r.Human = true
+		r.ErrorTrace = true
+	}
+}
+
 // WithFilterPath filters the properties of the response body.
 //
 func (f IndicesFlush) WithFilterPath(v ...string) func(*IndicesFlushRequest) {
@@ -272,6 +279,32 @@ func (f IndicesFlush) WithHeader(h map[string]string) func(*IndicesFlushRequest)
 	}
 }
 
+// WithHTTPHeader adds the headers to the HTTP request, keeping the multiple values of a header.
+//
+func (f IndicesFlush) WithHTTPHeader(h http.Header) func(*IndicesFlushRequest) {
+	return func(r *IndicesFlushRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, vv := range h {
+			for _, v := range vv {
+				r.Header.Add(k, v)
+			}
+		}
+	}
+}
+
+// WithAccept sets the media type of the response, eg. "application/yaml"; the default is JSON.
+//
+func (f IndicesFlush) WithAccept(v string) func(*IndicesFlushRequest) {
+	return func(r *IndicesFlushRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Accept", v)
+	}
+}
+
 // WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
 //
 func (f IndicesFlush) WithOpaqueID(s string) func(*IndicesFlushRequest) {
@@ -282,3 +315,14 @@ func (f IndicesFlush) WithOpaqueID(s string) func(*IndicesFlushRequest) {
 		r.Header.Set("X-Opaque-Id", s)
 	}
 }
+
+// WithBasicAuth sets the credentials of the request, overriding the credentials of the client.
+//
+func (f IndicesFlush) WithBasicAuth(username, password string) func(*IndicesFlushRequest) {
+	return func(r *IndicesFlushRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Authorization", basicAuth(username, password))
+	}
+}
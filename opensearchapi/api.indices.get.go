@@ -140,7 +140,7 @@ func (r IndicesGetRequest) Do(ctx context.Context, transport Transport) (*Respon
 		params["filter_path"] = strings.Join(r.FilterPath, ",")
 	}
 
-	req, err := newRequest(method, path.String(), nil)
+	req, err := newRequest(transport, method, path.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -154,13 +154,9 @@ func (r IndicesGetRequest) Do(ctx context.Context, transport Transport) (*Respon
 	}
 
 	if len(r.Header) > 0 {
-		if len(req.Header) == 0 {
-			req.Header = r.Header
-		} else {
-			for k, vv := range r.Header {
-				for _, v := range vv {
-					req.Header.Add(k, v)
-				}
+		for k, vv := range r.Header {
+			for _, v := range vv {
+				req.Header.Add(k, v)
 			}
 		}
 	}
@@ -178,6 +174,7 @@ func (r IndicesGetRequest) Do(ctx context.Context, transport Transport) (*Respon
 		StatusCode: res.StatusCode,
 		Body:       res.Body,
 		Header:     res.Header,
+		codec:      JSONCodecOf(transport),
 	}
 
 	return &response, response.Err()
@@ -281,6 +278,16 @@ func (f IndicesGet) WithErrorTrace() func(*IndicesGetRequest) {
 	}
 }
 
+// WithDebug makes the response body pretty-printed and human-readable, and includes the stack trace for errors.
+//
+func (f IndicesGet) WithDebug() func(*IndicesGetRequest) {
+	return func(r *IndicesGetRequest) {
+		r.Pretty = true
+		r.Human = true
+		r.ErrorTrace = true
+	}
+}
+
 // WithFilterPath filters the properties of the response body.
 //
 func (f IndicesGet) WithFilterPath(v ...string) func(*IndicesGetRequest) {
@@ -302,6 +309,32 @@ func (f IndicesGet) WithHeader(h map[string]string) func(*IndicesGetRequest) {
 	}
 }
 
+// WithHTTPHeader adds the headers to the HTTP request, keeping the multiple values of a header.
+//
+func (f IndicesGet) WithHTTPHeader(h http.Header) func(*IndicesGetRequest) {
+	return func(r *IndicesGetRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, vv := range h {
+			for _, v := range vv {
+				r.Header.Add(k, v)
+			}
+		}
+	}
+}
+
+// WithAccept sets the media type of the response, eg. "application/yaml"; the default is JSON.
+//
+func (f IndicesGet) WithAccept(v string) func(*IndicesGetRequest) {
+	return func(r *IndicesGetRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Accept", v)
+	}
+}
+
 // WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
 //
 func (f IndicesGet) WithOpaqueID(s string) func(*IndicesGetRequest) {
@@ -312,3 +345,14 @@ func (f IndicesGet) WithOpaqueID(s string) func(*IndicesGetRequest) {
 		r.Header.Set("X-Opaque-Id", s)
 	}
 }
+
+// WithBasicAuth sets the credentials of the request, overriding the credentials of the client.
+//
+func (f IndicesGet) WithBasicAuth(username, password string) func(*IndicesGetRequest) {
+	return func(r *IndicesGetRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Authorization", basicAuth(username, password))
+	}
+}
@@ -129,7 +129,7 @@ func (r IndicesGetAliasRequest) Do(ctx context.Context, transport Transport) (*R
 		params["filter_path"] = strings.Join(r.FilterPath, ",")
 	}
 
-	req, err := newRequest(method, path.String(), nil)
+	req, err := newRequest(transport, method, path.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -143,13 +143,9 @@ func (r IndicesGetAliasRequest) Do(ctx context.Context, transport Transport) (*R
 	}
 
 	if len(r.Header) > 0 {
-		if len(req.Header) == 0 {
-			req.Header = r.Header
-		} else {
-			for k, vv := range r.Header {
-				for _, v := range vv {
-					req.Header.Add(k, v)
-				}
+		for k, vv := range r.Header {
+			for _, v := range vv {
+				req.Header.Add(k, v)
 			}
 		}
 	}
@@ -167,6 +163,7 @@ func (r IndicesGetAliasRequest) Do(ctx context.Context, transport Transport) (*R
 		StatusCode: res.StatusCode,
 		Body:       res.Body,
 		Header:     res.Header,
+		codec:      JSONCodecOf(transport),
 	}
 
 	return &response, response.Err()
@@ -252,6 +249,16 @@ func (f IndicesGetAlias) WithErrorTrace() func(*IndicesGetAliasRequest) {
 	}
 }
 
+// WithDebug makes the response body pretty-printed and human-readable, and includes the stack trace for errors.
+//
+func (f IndicesGetAlias) WithDebug() func(*IndicesGetAliasRequest) {
+	return func(r *IndicesGetAliasRequest) {
+		r.Pretty = true
+		r.Human = true
+		r.ErrorTrace = true
+	}
+}
+
 // WithFilterPath filters the properties of the response body.
 //
 func (f IndicesGetAlias) WithFilterPath(v ...string) func(*IndicesGetAliasRequest) {
@@ -273,6 +280,32 @@ func (f IndicesGetAlias) WithHeader(h map[string]string) func(*IndicesGetAliasRe
 	}
 }
 
+// WithHTTPHeader adds the headers to the HTTP request, keeping the multiple values of a header.
+//
+func (f IndicesGetAlias) WithHTTPHeader(h http.Header) func(*IndicesGetAliasRequest) {
+	return func(r *IndicesGetAliasRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, vv := range h {
+			for _, v := range vv {
+				r.Header.Add(k, v)
+			}
+		}
+	}
+}
+
+// WithAccept sets the media type of the response, eg. "application/yaml"; the default is JSON.
+//
+func (f IndicesGetAlias) WithAccept(v string) func(*IndicesGetAliasRequest) {
+	return func(r *IndicesGetAliasRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Accept", v)
+	}
+}
+
 // WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
 //
 func (f IndicesGetAlias) WithOpaqueID(s string) func(*IndicesGetAliasRequest) {
@@ -283,3 +316,14 @@ func (f IndicesGetAlias) WithOpaqueID(s string) func(*IndicesGetAliasRequest) {
 		r.Header.Set("X-Opaque-Id", s)
 	}
 }
+
+// WithBasicAuth sets the credentials of the request, overriding the credentials of the client.
+//
+func (f IndicesGetAlias) WithBasicAuth(username, password string) func(*IndicesGetAliasRequest) {
+	return func(r *IndicesGetAliasRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Authorization", basicAuth(username, password))
+	}
+}
@@ -84,7 +84,7 @@ func (r IndicesGetDataStreamStatsRequest) Do(ctx context.Context, transport Tran
 		params["filter_path"] = strings.Join(r.FilterPath, ",")
 	}
 
-	req, err := newRequest(method, path.String(), nil)
+	req, err := newRequest(transport, method, path.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -98,13 +98,9 @@ func (r IndicesGetDataStreamStatsRequest) Do(ctx context.Context, transport Tran
 	}
 
 	if len(r.Header) > 0 {
-		if len(req.Header) == 0 {
-			req.Header = r.Header
-		} else {
-			for k, vv := range r.Header {
-				for _, v := range vv {
-					req.Header.Add(k, v)
-				}
+		for k, vv := range r.Header {
+			for _, v := range vv {
+				req.Header.Add(k, v)
 			}
 		}
 	}
@@ -122,6 +118,7 @@ func (r IndicesGetDataStreamStatsRequest) Do(ctx context.Context, transport Tran
 		StatusCode: res.StatusCode,
 		Body:       res.Body,
 		Header:     res.Header,
+		codec:      JSONCodecOf(transport),
 	}
 
 	return &response, nil
@@ -169,6 +166,15 @@ func (f IndicesGetDataStreamStats) WithErrorTrace() func(*IndicesGetDataStreamSt
 	}
 }
 
+// WithDebug makes the response body pretty-printed and human-readable, and includes the stack trace for errors.
+func (f IndicesGetDataStreamStats) WithDebug() func(*IndicesGetDataStreamStatsRequest) {
+	return func(r *IndicesGetDataStreamStatsRequest) {
+		r.Pretty = true
+		r.Human = true
+		r.ErrorTrace = true
+	}
+}
+
 // WithFilterPath filters the properties of the response body.
 func (f IndicesGetDataStreamStats) WithFilterPath(v ...string) func(*IndicesGetDataStreamStatsRequest) {
 	return func(r *IndicesGetDataStreamStatsRequest) {
@@ -188,6 +194,30 @@ func (f IndicesGetDataStreamStats) WithHeader(h map[string]string) func(*Indices
 	}
 }
 
+// WithHTTPHeader adds the headers to the HTTP request, keeping the multiple values of a header.
+func (f IndicesGetDataStreamStats) WithHTTPHeader(h http.Header) func(*IndicesGetDataStreamStatsRequest) {
+	return func(r *IndicesGetDataStreamStatsRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, vv := range h {
+			for _, v := range vv {
+				r.Header.Add(k, v)
+			}
+		}
+	}
+}
+
+// WithAccept sets the media type of the response, eg. "application/yaml"; the default is JSON.
+func (f IndicesGetDataStreamStats) WithAccept(v string) func(*IndicesGetDataStreamStatsRequest) {
+	return func(r *IndicesGetDataStreamStatsRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Accept", v)
+	}
+}
+
 // WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
 func (f IndicesGetDataStreamStats) WithOpaqueID(s string) func(*IndicesGetDataStreamStatsRequest) {
 	return func(r *IndicesGetDataStreamStatsRequest) {
@@ -197,3 +227,13 @@ func (f IndicesGetDataStreamStats) WithOpaqueID(s string) func(*IndicesGetDataSt
 		r.Header.Set("X-Opaque-Id", s)
 	}
 }
+
+// WithBasicAuth sets the credentials of the request, overriding the credentials of the client.
+func (f IndicesGetDataStreamStats) WithBasicAuth(username, password string) func(*IndicesGetDataStreamStatsRequest) {
+	return func(r *IndicesGetDataStreamStatsRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Authorization", basicAuth(username, password))
+	}
+}
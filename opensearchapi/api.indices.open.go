@@ -118,6 +118,9 @@ func (r IndicesOpenRequest) Do(ctx context.Context, transport Transport) (*Respo
 	}
 
 	if r.WaitForActiveShards != "" {
+		if err := ValidateWaitForActiveShards(r.WaitForActiveShards); err != nil {
+			return nil, err
+		}
 		params["wait_for_active_shards"] = r.WaitForActiveShards
 	}
 
@@ -137,7 +140,7 @@ func (r IndicesOpenRequest) Do(ctx context.Context, transport Transport) (*Respo
 		params["filter_path"] = strings.Join(r.FilterPath, ",")
 	}
 
-	req, err := newRequest(method, path.String(), nil)
+	req, err := newRequest(transport, method, path.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -151,13 +154,9 @@ func (r IndicesOpenRequest) Do(ctx context.Context, transport Transport) (*Respo
 	}
 
 	if len(r.Header) > 0 {
-		if len(req.Header) == 0 {
-			req.Header = r.Header
-		} else {
-			for k, vv := range r.Header {
-				for _, v := range vv {
-					req.Header.Add(k, v)
-				}
+		for k, vv := range r.Header {
+			for _, v := range vv {
+				req.Header.Add(k, v)
 			}
 		}
 	}
@@ -175,6 +174,7 @@ func (r IndicesOpenRequest) Do(ctx context.Context, transport Transport) (*Respo
 		StatusCode: res.StatusCode,
 		Body:       res.Body,
 		Header:     res.Header,
+		codec:      JSONCodecOf(transport),
 	}
 
 	return &response, response.Err()
@@ -270,6 +270,16 @@ func (f IndicesOpen) WithErrorTrace() func(*IndicesOpenRequest) {
 	}
 }
 
+// WithDebug makes the response body pretty-printed and human-readable, and includes the stack trace for errors.
+//
+func (f IndicesOpen) WithDebug() func(*IndicesOpenRequest) {
+	return func(r *IndicesOpenRequest) {
+		r.Pretty = true
+		r.Human = true
+		r.ErrorTrace = true
+	}
+}
+
 // WithFilterPath filters the properties of the response body.
 //
 func (f IndicesOpen) WithFilterPath(v ...string) func(*IndicesOpenRequest) {
@@ -291,6 +301,32 @@ func (f IndicesOpen) WithHeader(h map[string]string) func(*IndicesOpenRequest) {
 	}
 }
 
+// WithHTTPHeader adds the headers to the HTTP request, keeping the multiple values of a header.
+//
+func (f IndicesOpen) WithHTTPHeader(h http.Header) func(*IndicesOpenRequest) {
+	return func(r *IndicesOpenRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, vv := range h {
+			for _, v := range vv {
+				r.Header.Add(k, v)
+			}
+		}
+	}
+}
+
+// WithAccept sets the media type of the response, eg. "application/yaml"; the default is JSON.
+//
+func (f IndicesOpen) WithAccept(v string) func(*IndicesOpenRequest) {
+	return func(r *IndicesOpenRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Accept", v)
+	}
+}
+
 // WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
 //
 func (f IndicesOpen) WithOpaqueID(s string) func(*IndicesOpenRequest) {
@@ -301,3 +337,14 @@ func (f IndicesOpen) WithOpaqueID(s string) func(*IndicesOpenRequest) {
 		r.Header.Set("X-Opaque-Id", s)
 	}
 }
+
+// WithBasicAuth sets the credentials of the request, overriding the credentials of the client.
+//
+func (f IndicesOpen) WithBasicAuth(username, password string) func(*IndicesOpenRequest) {
+	return func(r *IndicesOpenRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Authorization", basicAuth(username, password))
+	}
+}
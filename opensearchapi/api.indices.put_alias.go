@@ -123,7 +123,7 @@ func (r IndicesPutAliasRequest) Do(ctx context.Context, transport Transport) (*R
 		params["filter_path"] = strings.Join(r.FilterPath, ",")
 	}
 
-	req, err := newRequest(method, path.String(), r.Body)
+	req, err := newRequest(transport, method, path.String(), r.Body)
 	if err != nil {
 		return nil, err
 	}
@@ -136,18 +136,14 @@ func (r IndicesPutAliasRequest) Do(ctx context.Context, transport Transport) (*R
 		req.URL.RawQuery = q.Encode()
 	}
 
-	if r.Body != nil {
+	if r.Body != nil && r.Header.Get(headerContentType) == "" {
 		req.Header[headerContentType] = headerContentTypeJSON
 	}
 
 	if len(r.Header) > 0 {
-		if len(req.Header) == 0 {
-			req.Header = r.Header
-		} else {
-			for k, vv := range r.Header {
-				for _, v := range vv {
-					req.Header.Add(k, v)
-				}
+		for k, vv := range r.Header {
+			for _, v := range vv {
+				req.Header.Add(k, v)
 			}
 		}
 	}
@@ -165,6 +161,7 @@ func (r IndicesPutAliasRequest) Do(ctx context.Context, transport Transport) (*R
 		StatusCode: res.StatusCode,
 		Body:       res.Body,
 		Header:     res.Header,
+		codec:      JSONCodecOf(transport),
 	}
 
 	return &response, response.Err()
@@ -186,6 +183,14 @@ func (f IndicesPutAlias) WithBody(v io.Reader) func(*IndicesPutAliasRequest) {
 	}
 }
 
+// WithJSONBody - the request body, encoded to JSON with the JSON codec of the client; it replaces the body set with WithBody.
+//
+func (f IndicesPutAlias) WithJSONBody(v interface{}) func(*IndicesPutAliasRequest) {
+	return func(r *IndicesPutAliasRequest) {
+		r.Body = JSONBody(v)
+	}
+}
+
 // WithMasterTimeout - explicit operation timeout for connection to cluster-manager node.
 //
 // Deprecated: To promote inclusive language, use WithClusterManagerTimeout instead.
@@ -236,6 +241,16 @@ func (f IndicesPutAlias) WithErrorTrace() func(*IndicesPutAliasRequest) {
 	}
 }
 
+// WithDebug makes the response body pretty-printed and human-readable, and includes the stack trace for errors.
+//
+func (f IndicesPutAlias) WithDebug() func(*IndicesPutAliasRequest) {
+	return func(r *IndicesPutAliasRequest) {
+		r.Pretty = true
+		r.Human = true
+		r.ErrorTrace = true
+	}
+}
+
 // WithFilterPath filters the properties of the response body.
 //
 func (f IndicesPutAlias) WithFilterPath(v ...string) func(*IndicesPutAliasRequest) {
@@ -257,6 +272,32 @@ func (f IndicesPutAlias) WithHeader(h map[string]string) func(*IndicesPutAliasRe
 	}
 }
 
+// WithHTTPHeader adds the headers to the HTTP request, keeping the multiple values of a header.
+//
+func (f IndicesPutAlias) WithHTTPHeader(h http.Header) func(*IndicesPutAliasRequest) {
+	return func(r *IndicesPutAliasRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, vv := range h {
+			for _, v := range vv {
+				r.Header.Add(k, v)
+			}
+		}
+	}
+}
+
+// WithAccept sets the media type of the response, eg. "application/yaml"; the default is JSON.
+//
+func (f IndicesPutAlias) WithAccept(v string) func(*IndicesPutAliasRequest) {
+	return func(r *IndicesPutAliasRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Accept", v)
+	}
+}
+
 // WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
 //
 func (f IndicesPutAlias) WithOpaqueID(s string) func(*IndicesPutAliasRequest) {
@@ -267,3 +308,25 @@ func (f IndicesPutAlias) WithOpaqueID(s string) func(*IndicesPutAliasRequest) {
 		r.Header.Set("X-Opaque-Id", s)
 	}
 }
+
+// WithContentType sets the Content-Type header of the request, overriding the default of the request body.
+//
+func (f IndicesPutAlias) WithContentType(v string) func(*IndicesPutAliasRequest) {
+	return func(r *IndicesPutAliasRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Content-Type", v)
+	}
+}
+
+// WithBasicAuth sets the credentials of the request, overriding the credentials of the client.
+//
+func (f IndicesPutAlias) WithBasicAuth(username, password string) func(*IndicesPutAliasRequest) {
+	return func(r *IndicesPutAliasRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Authorization", basicAuth(username, password))
+	}
+}
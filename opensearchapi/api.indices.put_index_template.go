@@ -27,7 +27,10 @@
 package opensearchapi
 
 import (
+	"bytes"
 	"context"
+	"errors"
+	"fmt"
 	"io"
 	"net/http"
 	"strconv"
@@ -55,7 +58,8 @@ type IndicesPutIndexTemplate func(name string, body io.Reader, o ...func(*Indice
 // IndicesPutIndexTemplateRequest configures the Indices Put Index Template API request.
 //
 type IndicesPutIndexTemplateRequest struct {
-	Body io.Reader
+	Body         io.Reader
+	TemplateBody *IndexTemplateBody
 
 	Name string
 
@@ -74,6 +78,26 @@ type IndicesPutIndexTemplateRequest struct {
 	ctx context.Context
 }
 
+// IndexTemplateBody represents the body of the Indices Put Index Template API request.
+//
+type IndexTemplateBody struct {
+	IndexPatterns []string               `json:"index_patterns"`
+	Template      *IndexTemplateTemplate `json:"template,omitempty"`
+	ComposedOf    []string               `json:"composed_of,omitempty"`
+	Priority      *int                   `json:"priority,omitempty"`
+	Version       *int                   `json:"version,omitempty"`
+	Meta          map[string]interface{} `json:"_meta,omitempty"`
+	DataStream    map[string]interface{} `json:"data_stream,omitempty"`
+}
+
+// IndexTemplateTemplate represents the settings, mappings and aliases applied by an index template.
+//
+type IndexTemplateTemplate struct {
+	Settings map[string]interface{} `json:"settings,omitempty"`
+	Mappings map[string]interface{} `json:"mappings,omitempty"`
+	Aliases  map[string]interface{} `json:"aliases,omitempty"`
+}
+
 // Do executes the request and returns response or error.
 //
 func (r IndicesPutIndexTemplateRequest) Do(ctx context.Context, transport Transport) (*Response, error) {
@@ -125,7 +149,19 @@ func (r IndicesPutIndexTemplateRequest) Do(ctx context.Context, transport Transp
 		params["filter_path"] = strings.Join(r.FilterPath, ",")
 	}
 
-	req, err := newRequest(method, path.String(), r.Body)
+	var body io.Reader = r.Body
+	if r.TemplateBody != nil {
+		if len(r.TemplateBody.IndexPatterns) == 0 {
+			return nil, errors.New("invalid index template: index_patterns is required")
+		}
+		b, err := JSONCodecOf(transport).Marshal(r.TemplateBody)
+		if err != nil {
+			return nil, fmt.Errorf("cannot encode index template: %s", err)
+		}
+		body = bytes.NewReader(b)
+	}
+
+	req, err := newRequest(transport, method, path.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -138,18 +174,14 @@ func (r IndicesPutIndexTemplateRequest) Do(ctx context.Context, transport Transp
 		req.URL.RawQuery = q.Encode()
 	}
 
-	if r.Body != nil {
+	if body != nil && r.Header.Get(headerContentType) == "" {
 		req.Header[headerContentType] = headerContentTypeJSON
 	}
 
 	if len(r.Header) > 0 {
-		if len(req.Header) == 0 {
-			req.Header = r.Header
-		} else {
-			for k, vv := range r.Header {
-				for _, v := range vv {
-					req.Header.Add(k, v)
-				}
+		for k, vv := range r.Header {
+			for _, v := range vv {
+				req.Header.Add(k, v)
 			}
 		}
 	}
@@ -167,6 +199,7 @@ func (r IndicesPutIndexTemplateRequest) Do(ctx context.Context, transport Transp
 		StatusCode: res.StatusCode,
 		Body:       res.Body,
 		Header:     res.Header,
+		codec:      JSONCodecOf(transport),
 	}
 
 	return &response, response.Err()
@@ -180,6 +213,22 @@ func (f IndicesPutIndexTemplate) WithContext(v context.Context) func(*IndicesPut
 	}
 }
 
+// WithJSONBody - the request body, encoded to JSON with the JSON codec of the client; it replaces the body passed to the function.
+//
+func (f IndicesPutIndexTemplate) WithJSONBody(v interface{}) func(*IndicesPutIndexTemplateRequest) {
+	return func(r *IndicesPutIndexTemplateRequest) {
+		r.Body = JSONBody(v)
+	}
+}
+
+// WithTemplateBody - the index template, encoded as the request body; it replaces the body passed to the function.
+//
+func (f IndicesPutIndexTemplate) WithTemplateBody(v IndexTemplateBody) func(*IndicesPutIndexTemplateRequest) {
+	return func(r *IndicesPutIndexTemplateRequest) {
+		r.TemplateBody = &v
+	}
+}
+
 // WithCause - user defined reason for creating/updating the index template.
 //
 func (f IndicesPutIndexTemplate) WithCause(v string) func(*IndicesPutIndexTemplateRequest) {
@@ -238,6 +287,16 @@ func (f IndicesPutIndexTemplate) WithErrorTrace() func(*IndicesPutIndexTemplateR
 	}
 }
 
+// WithDebug makes the response body pretty-printed and human-readable, and includes the stack trace for errors.
+//
+func (f IndicesPutIndexTemplate) WithDebug() func(*IndicesPutIndexTemplateRequest) {
+	return func(r *IndicesPutIndexTemplateRequest) {
+		r.Pretty = true
+		r.Human = true
+		r.ErrorTrace = true
+	}
+}
+
 // WithFilterPath filters the properties of the response body.
 //
 func (f IndicesPutIndexTemplate) WithFilterPath(v ...string) func(*IndicesPutIndexTemplateRequest) {
@@ -259,6 +318,32 @@ func (f IndicesPutIndexTemplate) WithHeader(h map[string]string) func(*IndicesPu
 	}
 }
 
+// WithHTTPHeader adds the headers to the HTTP request, keeping the multiple values of a header.
+//
+func (f IndicesPutIndexTemplate) WithHTTPHeader(h http.Header) func(*IndicesPutIndexTemplateRequest) {
+	return func(r *IndicesPutIndexTemplateRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, vv := range h {
+			for _, v := range vv {
+				r.Header.Add(k, v)
+			}
+		}
+	}
+}
+
+// WithAccept sets the media type of the response, eg. "application/yaml"; the default is JSON.
+//
+func (f IndicesPutIndexTemplate) WithAccept(v string) func(*IndicesPutIndexTemplateRequest) {
+	return func(r *IndicesPutIndexTemplateRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Accept", v)
+	}
+}
+
 // WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
 //
 func (f IndicesPutIndexTemplate) WithOpaqueID(s string) func(*IndicesPutIndexTemplateRequest) {
@@ -269,3 +354,25 @@ func (f IndicesPutIndexTemplate) WithOpaqueID(s string) func(*IndicesPutIndexTem
 		r.Header.Set("X-Opaque-Id", s)
 	}
 }
+
+// WithContentType sets the Content-Type header of the request, overriding the default of the request body.
+//
+func (f IndicesPutIndexTemplate) WithContentType(v string) func(*IndicesPutIndexTemplateRequest) {
+	return func(r *IndicesPutIndexTemplateRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Content-Type", v)
+	}
+}
+
+// WithBasicAuth sets the credentials of the request, overriding the credentials of the client.
+//
+func (f IndicesPutIndexTemplate) WithBasicAuth(username, password string) func(*IndicesPutIndexTemplateRequest) {
+	return func(r *IndicesPutIndexTemplateRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Authorization", basicAuth(username, password))
+	}
+}
@@ -0,0 +1,62 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+//go:build !integration
+// +build !integration
+
+package opensearchapi
+
+import (
+	"context"
+	"io/ioutil"
+	"testing"
+	"time"
+)
+
+func TestIndicesPutIndexTemplateBody(t *testing.T) {
+	priority := 100
+	put := IndicesPutIndexTemplate(nil)
+
+	r := IndicesPutIndexTemplateRequest{Name: "logs"}
+	for _, o := range []func(*IndicesPutIndexTemplateRequest){
+		put.WithTemplateBody(IndexTemplateBody{
+			IndexPatterns: []string{"logs-*"},
+			Template: &IndexTemplateTemplate{
+				Settings: map[string]interface{}{"number_of_shards": 1},
+				Aliases:  map[string]interface{}{"logs": map[string]interface{}{}},
+			},
+			ComposedOf: []string{"base"},
+			Priority:   &priority,
+		}),
+		put.WithClusterManagerTimeout(time.Minute),
+	} {
+		o(&r)
+	}
+
+	req, err := BuildRequest(context.Background(), r)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	if req.URL.String() != "/_index_template/logs?cluster_manager_timeout=60000ms" {
+		t.Errorf("Unexpected URL: %s", req.URL)
+	}
+	if req.Header.Get("Content-Type") != "application/json" {
+		t.Errorf("Unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+	}
+	body, _ := ioutil.ReadAll(req.Body)
+	want := `{"index_patterns":["logs-*"],"template":{"settings":{"number_of_shards":1},"aliases":{"logs":{}}},"composed_of":["base"],"priority":100}`
+	if string(body) != want {
+		t.Errorf("Unexpected body:\nwant: %s\ngot:  %s", want, body)
+	}
+
+	if _, err := BuildRequest(context.Background(), IndicesPutIndexTemplateRequest{Name: "logs", TemplateBody: &IndexTemplateBody{}}); err == nil {
+		t.Errorf("Expected error for missing index patterns")
+	}
+}
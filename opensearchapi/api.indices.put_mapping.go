@@ -142,7 +142,7 @@ func (r IndicesPutMappingRequest) Do(ctx context.Context, transport Transport) (
 		params["filter_path"] = strings.Join(r.FilterPath, ",")
 	}
 
-	req, err := newRequest(method, path.String(), r.Body)
+	req, err := newRequest(transport, method, path.String(), r.Body)
 	if err != nil {
 		return nil, err
 	}
@@ -155,18 +155,14 @@ func (r IndicesPutMappingRequest) Do(ctx context.Context, transport Transport) (
 		req.URL.RawQuery = q.Encode()
 	}
 
-	if r.Body != nil {
+	if r.Body != nil && r.Header.Get(headerContentType) == "" {
 		req.Header[headerContentType] = headerContentTypeJSON
 	}
 
 	if len(r.Header) > 0 {
-		if len(req.Header) == 0 {
-			req.Header = r.Header
-		} else {
-			for k, vv := range r.Header {
-				for _, v := range vv {
-					req.Header.Add(k, v)
-				}
+		for k, vv := range r.Header {
+			for _, v := range vv {
+				req.Header.Add(k, v)
 			}
 		}
 	}
@@ -184,6 +180,7 @@ func (r IndicesPutMappingRequest) Do(ctx context.Context, transport Transport) (
 		StatusCode: res.StatusCode,
 		Body:       res.Body,
 		Header:     res.Header,
+		codec:      JSONCodecOf(transport),
 	}
 
 	return &response, response.Err()
@@ -197,6 +194,14 @@ func (f IndicesPutMapping) WithContext(v context.Context) func(*IndicesPutMappin
 	}
 }
 
+// WithJSONBody - the request body, encoded to JSON with the JSON codec of the client; it replaces the body passed to the function.
+//
+func (f IndicesPutMapping) WithJSONBody(v interface{}) func(*IndicesPutMappingRequest) {
+	return func(r *IndicesPutMappingRequest) {
+		r.Body = JSONBody(v)
+	}
+}
+
 // WithIndex - a list of index names the mapping should be added to (supports wildcards); use `_all` or omit to add the mapping on all indices..
 //
 func (f IndicesPutMapping) WithIndex(v ...string) func(*IndicesPutMappingRequest) {
@@ -287,6 +292,16 @@ func (f IndicesPutMapping) WithErrorTrace() func(*IndicesPutMappingRequest) {
 	}
 }
 
+// WithDebug makes the response body pretty-printed and human-readable, and includes the stack trace for errors.
+//
+func (f IndicesPutMapping) WithDebug() func(*IndicesPutMappingRequest) {
+	return func(r *IndicesPutMappingRequest) {
+		r.Pretty = true
+		r.Human = true
+		r.ErrorTrace = true
+	}
+}
+
 // WithFilterPath filters the properties of the response body.
 //
 func (f IndicesPutMapping) WithFilterPath(v ...string) func(*IndicesPutMappingRequest) {
@@ -308,6 +323,32 @@ func (f IndicesPutMapping) WithHeader(h map[string]string) func(*IndicesPutMappi
 	}
 }
 
+// WithHTTPHeader adds the headers to the HTTP request, keeping the multiple values of a header.
+//
+func (f IndicesPutMapping) WithHTTPHeader(h http.Header) func(*IndicesPutMappingRequest) {
+	return func(r *IndicesPutMappingRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, vv := range h {
+			for _, v := range vv {
+				r.Header.Add(k, v)
+			}
+		}
+	}
+}
+
+// WithAccept sets the media type of the response, eg. "application/yaml"; the default is JSON.
+//
+func (f IndicesPutMapping) WithAccept(v string) func(*IndicesPutMappingRequest) {
+	return func(r *IndicesPutMappingRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Accept", v)
+	}
+}
+
 // WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
 //
 func (f IndicesPutMapping) WithOpaqueID(s string) func(*IndicesPutMappingRequest) {
@@ -318,3 +359,25 @@ func (f IndicesPutMapping) WithOpaqueID(s string) func(*IndicesPutMappingRequest
 		r.Header.Set("X-Opaque-Id", s)
 	}
 }
+
+// WithContentType sets the Content-Type header of the request, overriding the default of the request body.
+//
+func (f IndicesPutMapping) WithContentType(v string) func(*IndicesPutMappingRequest) {
+	return func(r *IndicesPutMappingRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Content-Type", v)
+	}
+}
+
+// WithBasicAuth sets the credentials of the request, overriding the credentials of the client.
+//
+func (f IndicesPutMapping) WithBasicAuth(username, password string) func(*IndicesPutMappingRequest) {
+	return func(r *IndicesPutMappingRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Authorization", basicAuth(username, password))
+	}
+}
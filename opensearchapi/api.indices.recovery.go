@@ -55,8 +55,11 @@ type IndicesRecovery func(o ...func(*IndicesRecoveryRequest)) (*Response, error)
 type IndicesRecoveryRequest struct {
 	Index []string
 
-	ActiveOnly *bool
-	Detailed   *bool
+	ActiveOnly        *bool
+	AllowNoIndices    *bool
+	Detailed          *bool
+	ExpandWildcards   string
+	IgnoreUnavailable *bool
 
 	Pretty     bool
 	Human      bool
@@ -93,10 +96,22 @@ func (r IndicesRecoveryRequest) Do(ctx context.Context, transport Transport) (*R
 		params["active_only"] = strconv.FormatBool(*r.ActiveOnly)
 	}
 
+	if r.AllowNoIndices != nil {
+		params["allow_no_indices"] = strconv.FormatBool(*r.AllowNoIndices)
+	}
+
 	if r.Detailed != nil {
 		params["detailed"] = strconv.FormatBool(*r.Detailed)
 	}
 
+	if r.ExpandWildcards != "" {
+		params["expand_wildcards"] = r.ExpandWildcards
+	}
+
+	if r.IgnoreUnavailable != nil {
+		params["ignore_unavailable"] = strconv.FormatBool(*r.IgnoreUnavailable)
+	}
+
 	if r.Pretty {
 		params["pretty"] = "true"
 	}
@@ -113,7 +128,7 @@ func (r IndicesRecoveryRequest) Do(ctx context.Context, transport Transport) (*R
 		params["filter_path"] = strings.Join(r.FilterPath, ",")
 	}
 
-	req, err := newRequest(method, path.String(), nil)
+	req, err := newRequest(transport, method, path.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -127,13 +142,9 @@ func (r IndicesRecoveryRequest) Do(ctx context.Context, transport Transport) (*R
 	}
 
 	if len(r.Header) > 0 {
-		if len(req.Header) == 0 {
-			req.Header = r.Header
-		} else {
-			for k, vv := range r.Header {
-				for _, v := range vv {
-					req.Header.Add(k, v)
-				}
+		for k, vv := range r.Header {
+			for _, v := range vv {
+				req.Header.Add(k, v)
 			}
 		}
 	}
@@ -151,6 +162,7 @@ func (r IndicesRecoveryRequest) Do(ctx context.Context, transport Transport) (*R
 		StatusCode: res.StatusCode,
 		Body:       res.Body,
 		Header:     res.Header,
+		codec:      JSONCodecOf(transport),
 	}
 
 	return &response, response.Err()
@@ -180,6 +192,14 @@ func (f IndicesRecovery) WithActiveOnly(v bool) func(*IndicesRecoveryRequest) {
 	}
 }
 
+// WithAllowNoIndices - whether to ignore if a wildcard indices expression resolves into no concrete indices. (this includes `_all` string or when no indices have been specified).
+//
+func (f IndicesRecovery) WithAllowNoIndices(v bool) func(*IndicesRecoveryRequest) {
+	return func(r *IndicesRecoveryRequest) {
+		r.AllowNoIndices = &v
+	}
+}
+
 // WithDetailed - whether to display detailed information about shard recovery.
 //
 func (f IndicesRecovery) WithDetailed(v bool) func(*IndicesRecoveryRequest) {
@@ -188,6 +208,22 @@ func (f IndicesRecovery) WithDetailed(v bool) func(*IndicesRecoveryRequest) {
 	}
 }
 
+// WithExpandWildcards - whether to expand wildcard expression to concrete indices that are open, closed or both..
+//
+func (f IndicesRecovery) WithExpandWildcards(v string) func(*IndicesRecoveryRequest) {
+	return func(r *IndicesRecoveryRequest) {
+		r.ExpandWildcards = v
+	}
+}
+
+// WithIgnoreUnavailable - whether specified concrete indices should be ignored when unavailable (missing or closed).
+//
+func (f IndicesRecovery) WithIgnoreUnavailable(v bool) func(*IndicesRecoveryRequest) {
+	return func(r *IndicesRecoveryRequest) {
+		r.IgnoreUnavailable = &v
+	}
+}
+
 // WithPretty makes the response body pretty-printed.
 //
 func (f IndicesRecovery) WithPretty() func(*IndicesRecoveryRequest) {
@@ -212,6 +248,16 @@ func (f IndicesRecovery) WithErrorTrace() func(*IndicesRecoveryRequest) {
 	}
 }
 
+// WithDebug makes the response body pretty-printed and human-readable, and includes the stack trace for errors.
+//
+func (f IndicesRecovery) WithDebug() func(*IndicesRecoveryRequest) {
+	return func(r *IndicesRecoveryRequest) {
+		r.Pretty = true
+		r.Human = true
+		r.ErrorTrace = true
+	}
+}
+
 // WithFilterPath filters the properties of the response body.
 //
 func (f IndicesRecovery) WithFilterPath(v ...string) func(*IndicesRecoveryRequest) {
@@ -233,6 +279,32 @@ func (f IndicesRecovery) WithHeader(h map[string]string) func(*IndicesRecoveryRe
 	}
 }
 
+// WithHTTPHeader adds the headers to the HTTP request, keeping the multiple values of a header.
+//
+func (f IndicesRecovery) WithHTTPHeader(h http.Header) func(*IndicesRecoveryRequest) {
+	return func(r *IndicesRecoveryRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, vv := range h {
+			for _, v := range vv {
+				r.Header.Add(k, v)
+			}
+		}
+	}
+}
+
+// WithAccept sets the media type of the response, eg. "application/yaml"; the default is JSON.
+//
+func (f IndicesRecovery) WithAccept(v string) func(*IndicesRecoveryRequest) {
+	return func(r *IndicesRecoveryRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Accept", v)
+	}
+}
+
 // WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
 //
 func (f IndicesRecovery) WithOpaqueID(s string) func(*IndicesRecoveryRequest) {
@@ -243,3 +315,14 @@ func (f IndicesRecovery) WithOpaqueID(s string) func(*IndicesRecoveryRequest) {
 		r.Header.Set("X-Opaque-Id", s)
 	}
 }
+
+// WithBasicAuth sets the credentials of the request, overriding the credentials of the client.
+//
+func (f IndicesRecovery) WithBasicAuth(username, password string) func(*IndicesRecoveryRequest) {
+	return func(r *IndicesRecoveryRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Authorization", basicAuth(username, password))
+	}
+}
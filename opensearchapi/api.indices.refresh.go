@@ -118,7 +118,7 @@ func (r IndicesRefreshRequest) Do(ctx context.Context, transport Transport) (*Re
 		params["filter_path"] = strings.Join(r.FilterPath, ",")
 	}
 
-	req, err := newRequest(method, path.String(), nil)
+	req, err := newRequest(transport, method, path.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -132,13 +132,9 @@ func (r IndicesRefreshRequest) Do(ctx context.Context, transport Transport) (*Re
 	}
 
 	if len(r.Header) > 0 {
-		if len(req.Header) == 0 {
-			req.Header = r.Header
-		} else {
-			for k, vv := range r.Header {
-				for _, v := range vv {
-					req.Header.Add(k, v)
-				}
+		for k, vv := range r.Header {
+			for _, v := range vv {
+				req.Header.Add(k, v)
 			}
 		}
 	}
@@ -156,6 +152,7 @@ func (r IndicesRefreshRequest) Do(ctx context.Context, transport Transport) (*Re
 		StatusCode: res.StatusCode,
 		Body:       res.Body,
 		Header:     res.Header,
+		codec:      JSONCodecOf(transport),
 	}
 
 	return &response, response.Err()
@@ -225,6 +222,16 @@ func (f IndicesRefresh) WithErrorTrace() func(*IndicesRefreshRequest) {
 	}
 }
 
+// WithDebug makes the response body pretty-printed and human-readable, and includes the stack trace for errors.
+//
+func (f IndicesRefresh) WithDebug() func(*IndicesRefreshRequest) {
+	return func(r *IndicesRefreshRequest) {
+		r.Pretty = true
+		r.Human = true
+		r.ErrorTrace = true
+	}
+}
+
 // WithFilterPath filters the properties of the response body.
 //
 func (f IndicesRefresh) WithFilterPath(v ...string) func(*IndicesRefreshRequest) {
@@ -246,6 +253,32 @@ func (f IndicesRefresh) WithHeader(h map[string]string) func(*IndicesRefreshRequ
 	}
 }
 
+// WithHTTPHeader adds the headers to the HTTP request, keeping the multiple values of a header.
+//
+func (f IndicesRefresh) WithHTTPHeader(h http.Header) func(*IndicesRefreshRequest) {
+	return func(r *IndicesRefreshRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, vv := range h {
+			for _, v := range vv {
+				r.Header.Add(k, v)
+			}
+		}
+	}
+}
+
+// WithAccept sets the media type of the response, eg. "application/yaml"; the default is JSON.
+//
+func (f IndicesRefresh) WithAccept(v string) func(*IndicesRefreshRequest) {
+	return func(r *IndicesRefreshRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Accept", v)
+	}
+}
+
 // WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
 //
 func (f IndicesRefresh) WithOpaqueID(s string) func(*IndicesRefreshRequest) {
@@ -256,3 +289,14 @@ func (f IndicesRefresh) WithOpaqueID(s string) func(*IndicesRefreshRequest) {
 		r.Header.Set("X-Opaque-Id", s)
 	}
 }
+
+// WithBasicAuth sets the credentials of the request, overriding the credentials of the client.
+//
+func (f IndicesRefresh) WithBasicAuth(username, password string) func(*IndicesRefreshRequest) {
+	return func(r *IndicesRefreshRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Authorization", basicAuth(username, password))
+	}
+}
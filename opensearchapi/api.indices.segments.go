@@ -123,7 +123,7 @@ func (r IndicesSegmentsRequest) Do(ctx context.Context, transport Transport) (*R
 		params["filter_path"] = strings.Join(r.FilterPath, ",")
 	}
 
-	req, err := newRequest(method, path.String(), nil)
+	req, err := newRequest(transport, method, path.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -137,13 +137,9 @@ func (r IndicesSegmentsRequest) Do(ctx context.Context, transport Transport) (*R
 	}
 
 	if len(r.Header) > 0 {
-		if len(req.Header) == 0 {
-			req.Header = r.Header
-		} else {
-			for k, vv := range r.Header {
-				for _, v := range vv {
-					req.Header.Add(k, v)
-				}
+		for k, vv := range r.Header {
+			for _, v := range vv {
+				req.Header.Add(k, v)
 			}
 		}
 	}
@@ -161,6 +157,7 @@ func (r IndicesSegmentsRequest) Do(ctx context.Context, transport Transport) (*R
 		StatusCode: res.StatusCode,
 		Body:       res.Body,
 		Header:     res.Header,
+		codec:      JSONCodecOf(transport),
 	}
 
 	return &response, response.Err()
@@ -238,6 +235,16 @@ func (f IndicesSegments) WithErrorTrace() func(*IndicesSegmentsRequest) {
 	}
 }
 
+// WithDebug makes the response body pretty-printed and human-readable, and includes the stack trace for errors.
+//
+func (f IndicesSegments) WithDebug() func(*IndicesSegmentsRequest) {
+	return func(r *IndicesSegmentsRequest) {
+		r.Pretty = true
+		r.Human = true
+		r.ErrorTrace = true
+	}
+}
+
 // WithFilterPath filters the properties of the response body.
 //
 func (f IndicesSegments) WithFilterPath(v ...string) func(*IndicesSegmentsRequest) {
@@ -259,6 +266,32 @@ func (f IndicesSegments) WithHeader(h map[string]string) func(*IndicesSegmentsRe
 	}
 }
 
+// WithHTTPHeader adds the headers to the HTTP request, keeping the multiple values of a header.
+//
+func (f IndicesSegments) WithHTTPHeader(h http.Header) func(*IndicesSegmentsRequest) {
+	return func(r *IndicesSegmentsRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, vv := range h {
+			for _, v := range vv {
+				r.Header.Add(k, v)
+			}
+		}
+	}
+}
+
+// WithAccept sets the media type of the response, eg. "application/yaml"; the default is JSON.
+//
+func (f IndicesSegments) WithAccept(v string) func(*IndicesSegmentsRequest) {
+	return func(r *IndicesSegmentsRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Accept", v)
+	}
+}
+
 // WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
 //
 func (f IndicesSegments) WithOpaqueID(s string) func(*IndicesSegmentsRequest) {
@@ -269,3 +302,14 @@ func (f IndicesSegments) WithOpaqueID(s string) func(*IndicesSegmentsRequest) {
 		r.Header.Set("X-Opaque-Id", s)
 	}
 }
+
+// WithBasicAuth sets the credentials of the request, overriding the credentials of the client.
+//
+func (f IndicesSegments) WithBasicAuth(username, password string) func(*IndicesSegmentsRequest) {
+	return func(r *IndicesSegmentsRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Authorization", basicAuth(username, password))
+	}
+}
@@ -123,7 +123,7 @@ func (r IndicesShardStoresRequest) Do(ctx context.Context, transport Transport)
 		params["filter_path"] = strings.Join(r.FilterPath, ",")
 	}
 
-	req, err := newRequest(method, path.String(), nil)
+	req, err := newRequest(transport, method, path.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -137,13 +137,9 @@ func (r IndicesShardStoresRequest) Do(ctx context.Context, transport Transport)
 	}
 
 	if len(r.Header) > 0 {
-		if len(req.Header) == 0 {
-			req.Header = r.Header
-		} else {
-			for k, vv := range r.Header {
-				for _, v := range vv {
-					req.Header.Add(k, v)
-				}
+		for k, vv := range r.Header {
+			for _, v := range vv {
+				req.Header.Add(k, v)
 			}
 		}
 	}
@@ -161,6 +157,7 @@ func (r IndicesShardStoresRequest) Do(ctx context.Context, transport Transport)
 		StatusCode: res.StatusCode,
 		Body:       res.Body,
 		Header:     res.Header,
+		codec:      JSONCodecOf(transport),
 	}
 
 	return &response, response.Err()
@@ -238,6 +235,16 @@ func (f IndicesShardStores) WithErrorTrace() func(*IndicesShardStoresRequest) {
 	}
 }
 
+// WithDebug makes the response body pretty-printed and human-readable, and includes the stack trace for errors.
+//
+func (f IndicesShardStores) WithDebug() func(*IndicesShardStoresRequest) {
+	return func(r *IndicesShardStoresRequest) {
+		r.Pretty = true
+		r.Human = true
+		r.ErrorTrace = true
+	}
+}
+
 // WithFilterPath filters the properties of the response body.
 //
 func (f IndicesShardStores) WithFilterPath(v ...string) func(*IndicesShardStoresRequest) {
@@ -259,6 +266,32 @@ func (f IndicesShardStores) WithHeader(h map[string]string) func(*IndicesShardSt
 	}
 }
 
+// WithHTTPHeader adds the headers to the HTTP request, keeping the multiple values of a header.
+//
+func (f IndicesShardStores) WithHTTPHeader(h http.Header) func(*IndicesShardStoresRequest) {
+	return func(r *IndicesShardStoresRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, vv := range h {
+			for _, v := range vv {
+				r.Header.Add(k, v)
+			}
+		}
+	}
+}
+
+// WithAccept sets the media type of the response, eg. "application/yaml"; the default is JSON.
+//
+func (f IndicesShardStores) WithAccept(v string) func(*IndicesShardStoresRequest) {
+	return func(r *IndicesShardStoresRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Accept", v)
+	}
+}
+
 // WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
 //
 func (f IndicesShardStores) WithOpaqueID(s string) func(*IndicesShardStoresRequest) {
@@ -269,3 +302,14 @@ func (f IndicesShardStores) WithOpaqueID(s string) func(*IndicesShardStoresReque
 		r.Header.Set("X-Opaque-Id", s)
 	}
 }
+
+// WithBasicAuth sets the credentials of the request, overriding the credentials of the client.
+//
+func (f IndicesShardStores) WithBasicAuth(username, password string) func(*IndicesShardStoresRequest) {
+	return func(r *IndicesShardStoresRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Authorization", basicAuth(username, password))
+	}
+}
@@ -115,6 +115,9 @@ func (r IndicesShrinkRequest) Do(ctx context.Context, transport Transport) (*Res
 	}
 
 	if r.WaitForActiveShards != "" {
+		if err := ValidateWaitForActiveShards(r.WaitForActiveShards); err != nil {
+			return nil, err
+		}
 		params["wait_for_active_shards"] = r.WaitForActiveShards
 	}
 
@@ -134,7 +137,7 @@ func (r IndicesShrinkRequest) Do(ctx context.Context, transport Transport) (*Res
 		params["filter_path"] = strings.Join(r.FilterPath, ",")
 	}
 
-	req, err := newRequest(method, path.String(), r.Body)
+	req, err := newRequest(transport, method, path.String(), r.Body)
 	if err != nil {
 		return nil, err
 	}
@@ -147,18 +150,14 @@ func (r IndicesShrinkRequest) Do(ctx context.Context, transport Transport) (*Res
 		req.URL.RawQuery = q.Encode()
 	}
 
-	if r.Body != nil {
+	if r.Body != nil && r.Header.Get(headerContentType) == "" {
 		req.Header[headerContentType] = headerContentTypeJSON
 	}
 
 	if len(r.Header) > 0 {
-		if len(req.Header) == 0 {
-			req.Header = r.Header
-		} else {
-			for k, vv := range r.Header {
-				for _, v := range vv {
-					req.Header.Add(k, v)
-				}
+		for k, vv := range r.Header {
+			for _, v := range vv {
+				req.Header.Add(k, v)
 			}
 		}
 	}
@@ -176,6 +175,7 @@ func (r IndicesShrinkRequest) Do(ctx context.Context, transport Transport) (*Res
 		StatusCode: res.StatusCode,
 		Body:       res.Body,
 		Header:     res.Header,
+		codec:      JSONCodecOf(transport),
 	}
 
 	return &response, response.Err()
@@ -197,6 +197,14 @@ func (f IndicesShrink) WithBody(v io.Reader) func(*IndicesShrinkRequest) {
 	}
 }
 
+// WithJSONBody - the request body, encoded to JSON with the JSON codec of the client; it replaces the body set with WithBody.
+//
+func (f IndicesShrink) WithJSONBody(v interface{}) func(*IndicesShrinkRequest) {
+	return func(r *IndicesShrinkRequest) {
+		r.Body = JSONBody(v)
+	}
+}
+
 // WithCopySettings - whether or not to copy settings from the source index (defaults to false).
 //
 func (f IndicesShrink) WithCopySettings(v bool) func(*IndicesShrinkRequest) {
@@ -263,6 +271,16 @@ func (f IndicesShrink) WithErrorTrace() func(*IndicesShrinkRequest) {
 	}
 }
 
+// WithDebug makes the response body pretty-printed and human-readable, and includes the stack trace for errors.
+//
+func (f IndicesShrink) WithDebug() func(*IndicesShrinkRequest) {
+	return func(r *IndicesShrinkRequest) {
+		r.Pretty = true
+		r.Human = true
+		r.ErrorTrace = true
+	}
+}
+
 // WithFilterPath filters the properties of the response body.
 //
 func (f IndicesShrink) WithFilterPath(v ...string) func(*IndicesShrinkRequest) {
@@ -284,6 +302,32 @@ func (f IndicesShrink) WithHeader(h map[string]string) func(*IndicesShrinkReques
 	}
 }
 
+// WithHTTPHeader adds the headers to the HTTP request, keeping the multiple values of a header.
+//
+func (f IndicesShrink) WithHTTPHeader(h http.Header) func(*IndicesShrinkRequest) {
+	return func(r *IndicesShrinkRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, vv := range h {
+			for _, v := range vv {
+				r.Header.Add(k, v)
+			}
+		}
+	}
+}
+
+// WithAccept sets the media type of the response, eg. "application/yaml"; the default is JSON.
+//
+func (f IndicesShrink) WithAccept(v string) func(*IndicesShrinkRequest) {
+	return func(r *IndicesShrinkRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Accept", v)
+	}
+}
+
 // WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
 //
 func (f IndicesShrink) WithOpaqueID(s string) func(*IndicesShrinkRequest) {
@@ -294,3 +338,25 @@ func (f IndicesShrink) WithOpaqueID(s string) func(*IndicesShrinkRequest) {
 		r.Header.Set("X-Opaque-Id", s)
 	}
 }
+
+// WithContentType sets the Content-Type header of the request, overriding the default of the request body.
+//
+func (f IndicesShrink) WithContentType(v string) func(*IndicesShrinkRequest) {
+	return func(r *IndicesShrinkRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Content-Type", v)
+	}
+}
+
+// WithBasicAuth sets the credentials of the request, overriding the credentials of the client.
+//
+func (f IndicesShrink) WithBasicAuth(username, password string) func(*IndicesShrinkRequest) {
+	return func(r *IndicesShrinkRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Authorization", basicAuth(username, password))
+	}
+}
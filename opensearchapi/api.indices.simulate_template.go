@@ -129,7 +129,7 @@ func (r IndicesSimulateTemplateRequest) Do(ctx context.Context, transport Transp
 		params["filter_path"] = strings.Join(r.FilterPath, ",")
 	}
 
-	req, err := newRequest(method, path.String(), r.Body)
+	req, err := newRequest(transport, method, path.String(), r.Body)
 	if err != nil {
 		return nil, err
 	}
@@ -142,18 +142,14 @@ func (r IndicesSimulateTemplateRequest) Do(ctx context.Context, transport Transp
 		req.URL.RawQuery = q.Encode()
 	}
 
-	if r.Body != nil {
+	if r.Body != nil && r.Header.Get(headerContentType) == "" {
 		req.Header[headerContentType] = headerContentTypeJSON
 	}
 
 	if len(r.Header) > 0 {
-		if len(req.Header) == 0 {
-			req.Header = r.Header
-		} else {
-			for k, vv := range r.Header {
-				for _, v := range vv {
-					req.Header.Add(k, v)
-				}
+		for k, vv := range r.Header {
+			for _, v := range vv {
+				req.Header.Add(k, v)
 			}
 		}
 	}
@@ -171,6 +167,7 @@ func (r IndicesSimulateTemplateRequest) Do(ctx context.Context, transport Transp
 		StatusCode: res.StatusCode,
 		Body:       res.Body,
 		Header:     res.Header,
+		codec:      JSONCodecOf(transport),
 	}
 
 	return &response, response.Err()
@@ -192,6 +189,14 @@ func (f IndicesSimulateTemplate) WithBody(v io.Reader) func(*IndicesSimulateTemp
 	}
 }
 
+// WithJSONBody - the request body, encoded to JSON with the JSON codec of the client; it replaces the body set with WithBody.
+//
+func (f IndicesSimulateTemplate) WithJSONBody(v interface{}) func(*IndicesSimulateTemplateRequest) {
+	return func(r *IndicesSimulateTemplateRequest) {
+		r.Body = JSONBody(v)
+	}
+}
+
 // WithName - the name of the index template.
 //
 func (f IndicesSimulateTemplate) WithName(v string) func(*IndicesSimulateTemplateRequest) {
@@ -258,6 +263,16 @@ func (f IndicesSimulateTemplate) WithErrorTrace() func(*IndicesSimulateTemplateR
 	}
 }
 
+// WithDebug makes the response body pretty-printed and human-readable, and includes the stack trace for errors.
+//
+func (f IndicesSimulateTemplate) WithDebug() func(*IndicesSimulateTemplateRequest) {
+	return func(r *IndicesSimulateTemplateRequest) {
+		r.Pretty = true
+		r.Human = true
+		r.ErrorTrace = true
+	}
+}
+
 // WithFilterPath filters the properties of the response body.
 //
 func (f IndicesSimulateTemplate) WithFilterPath(v ...string) func(*IndicesSimulateTemplateRequest) {
@@ -279,6 +294,32 @@ func (f IndicesSimulateTemplate) WithHeader(h map[string]string) func(*IndicesSi
 	}
 }
 
+// WithHTTPHeader adds the headers to the HTTP request, keeping the multiple values of a header.
+//
+func (f IndicesSimulateTemplate) WithHTTPHeader(h http.Header) func(*IndicesSimulateTemplateRequest) {
+	return func(r *IndicesSimulateTemplateRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, vv := range h {
+			for _, v := range vv {
+				r.Header.Add(k, v)
+			}
+		}
+	}
+}
+
+// WithAccept sets the media type of the response, eg. "application/yaml"; the default is JSON.
+//
+func (f IndicesSimulateTemplate) WithAccept(v string) func(*IndicesSimulateTemplateRequest) {
+	return func(r *IndicesSimulateTemplateRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Accept", v)
+	}
+}
+
 // WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
 //
 func (f IndicesSimulateTemplate) WithOpaqueID(s string) func(*IndicesSimulateTemplateRequest) {
@@ -289,3 +330,25 @@ func (f IndicesSimulateTemplate) WithOpaqueID(s string) func(*IndicesSimulateTem
 		r.Header.Set("X-Opaque-Id", s)
 	}
 }
+
+// WithContentType sets the Content-Type header of the request, overriding the default of the request body.
+//
+func (f IndicesSimulateTemplate) WithContentType(v string) func(*IndicesSimulateTemplateRequest) {
+	return func(r *IndicesSimulateTemplateRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Content-Type", v)
+	}
+}
+
+// WithBasicAuth sets the credentials of the request, overriding the credentials of the client.
+//
+func (f IndicesSimulateTemplate) WithBasicAuth(username, password string) func(*IndicesSimulateTemplateRequest) {
+	return func(r *IndicesSimulateTemplateRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Authorization", basicAuth(username, password))
+	}
+}
@@ -115,6 +115,9 @@ func (r IndicesSplitRequest) Do(ctx context.Context, transport Transport) (*Resp
 	}
 
 	if r.WaitForActiveShards != "" {
+		if err := ValidateWaitForActiveShards(r.WaitForActiveShards); err != nil {
+			return nil, err
+		}
 		params["wait_for_active_shards"] = r.WaitForActiveShards
 	}
 
@@ -134,7 +137,7 @@ func (r IndicesSplitRequest) Do(ctx context.Context, transport Transport) (*Resp
 		params["filter_path"] = strings.Join(r.FilterPath, ",")
 	}
 
-	req, err := newRequest(method, path.String(), r.Body)
+	req, err := newRequest(transport, method, path.String(), r.Body)
 	if err != nil {
 		return nil, err
 	}
@@ -147,18 +150,14 @@ func (r IndicesSplitRequest) Do(ctx context.Context, transport Transport) (*Resp
 		req.URL.RawQuery = q.Encode()
 	}
 
-	if r.Body != nil {
+	if r.Body != nil && r.Header.Get(headerContentType) == "" {
 		req.Header[headerContentType] = headerContentTypeJSON
 	}
 
 	if len(r.Header) > 0 {
-		if len(req.Header) == 0 {
-			req.Header = r.Header
-		} else {
-			for k, vv := range r.Header {
-				for _, v := range vv {
-					req.Header.Add(k, v)
-				}
+		for k, vv := range r.Header {
+			for _, v := range vv {
+				req.Header.Add(k, v)
 			}
 		}
 	}
@@ -176,6 +175,7 @@ func (r IndicesSplitRequest) Do(ctx context.Context, transport Transport) (*Resp
 		StatusCode: res.StatusCode,
 		Body:       res.Body,
 		Header:     res.Header,
+		codec:      JSONCodecOf(transport),
 	}
 
 	return &response, response.Err()
@@ -197,6 +197,14 @@ func (f IndicesSplit) WithBody(v io.Reader) func(*IndicesSplitRequest) {
 	}
 }
 
+// WithJSONBody - the request body, encoded to JSON with the JSON codec of the client; it replaces the body set with WithBody.
+//
+func (f IndicesSplit) WithJSONBody(v interface{}) func(*IndicesSplitRequest) {
+	return func(r *IndicesSplitRequest) {
+		r.Body = JSONBody(v)
+	}
+}
+
 // WithCopySettings - whether or not to copy settings from the source index (defaults to false).
 //
 func (f IndicesSplit) WithCopySettings(v bool) func(*IndicesSplitRequest) {
@@ -263,6 +271,16 @@ func (f IndicesSplit) WithErrorTrace() func(*IndicesSplitRequest) {
 	}
 }
 
+// WithDebug makes the response body pretty-printed and human-readable, and includes the stack trace for errors.
+//
+func (f IndicesSplit) WithDebug() func(*IndicesSplitRequest) {
+	return func(r *IndicesSplitRequest) {
+		r.Pretty = true
+		r.Human = true
+		r.ErrorTrace = true
+	}
+}
+
 // WithFilterPath filters the properties of the response body.
 //
 func (f IndicesSplit) WithFilterPath(v ...string) func(*IndicesSplitRequest) {
@@ -284,6 +302,32 @@ func (f IndicesSplit) WithHeader(h map[string]string) func(*IndicesSplitRequest)
 	}
 }
 
+// WithHTTPHeader adds the headers to the HTTP request, keeping the multiple values of a header.
+//
+func (f IndicesSplit) WithHTTPHeader(h http.Header) func(*IndicesSplitRequest) {
+	return func(r *IndicesSplitRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, vv := range h {
+			for _, v := range vv {
+				r.Header.Add(k, v)
+			}
+		}
+	}
+}
+
+// WithAccept sets the media type of the response, eg. "application/yaml"; the default is JSON.
+//
+func (f IndicesSplit) WithAccept(v string) func(*IndicesSplitRequest) {
+	return func(r *IndicesSplitRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Accept", v)
+	}
+}
+
 // WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
 //
 func (f IndicesSplit) WithOpaqueID(s string) func(*IndicesSplitRequest) {
@@ -294,3 +338,25 @@ func (f IndicesSplit) WithOpaqueID(s string) func(*IndicesSplitRequest) {
 		r.Header.Set("X-Opaque-Id", s)
 	}
 }
+
+// WithContentType sets the Content-Type header of the request, overriding the default of the request body.
+//
+func (f IndicesSplit) WithContentType(v string) func(*IndicesSplitRequest) {
+	return func(r *IndicesSplitRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Content-Type", v)
+	}
+}
+
+// WithBasicAuth sets the credentials of the request, overriding the credentials of the client.
+//
+func (f IndicesSplit) WithBasicAuth(username, password string) func(*IndicesSplitRequest) {
+	return func(r *IndicesSplitRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Authorization", basicAuth(username, password))
+	}
+}
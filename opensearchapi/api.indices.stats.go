@@ -57,12 +57,14 @@ type IndicesStatsRequest struct {
 
 	Metric []string
 
+	AllowNoIndices          *bool
 	CompletionFields        []string
 	ExpandWildcards         string
 	FielddataFields         []string
 	Fields                  []string
 	ForbidClosedIndices     *bool
 	Groups                  []string
+	IgnoreUnavailable       *bool
 	IncludeSegmentFileSizes *bool
 	IncludeUnloadedSegments *bool
 	Level                   string
@@ -103,6 +105,10 @@ func (r IndicesStatsRequest) Do(ctx context.Context, transport Transport) (*Resp
 
 	params = make(map[string]string)
 
+	if r.AllowNoIndices != nil {
+		params["allow_no_indices"] = strconv.FormatBool(*r.AllowNoIndices)
+	}
+
 	if len(r.CompletionFields) > 0 {
 		params["completion_fields"] = strings.Join(r.CompletionFields, ",")
 	}
@@ -127,6 +133,10 @@ func (r IndicesStatsRequest) Do(ctx context.Context, transport Transport) (*Resp
 		params["groups"] = strings.Join(r.Groups, ",")
 	}
 
+	if r.IgnoreUnavailable != nil {
+		params["ignore_unavailable"] = strconv.FormatBool(*r.IgnoreUnavailable)
+	}
+
 	if r.IncludeSegmentFileSizes != nil {
 		params["include_segment_file_sizes"] = strconv.FormatBool(*r.IncludeSegmentFileSizes)
 	}
@@ -159,7 +169,7 @@ func (r IndicesStatsRequest) Do(ctx context.Context, transport Transport) (*Resp
 		params["filter_path"] = strings.Join(r.FilterPath, ",")
 	}
 
-	req, err := newRequest(method, path.String(), nil)
+	req, err := newRequest(transport, method, path.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -173,13 +183,9 @@ func (r IndicesStatsRequest) Do(ctx context.Context, transport Transport) (*Resp
 	}
 
 	if len(r.Header) > 0 {
-		if len(req.Header) == 0 {
-			req.Header = r.Header
-		} else {
-			for k, vv := range r.Header {
-				for _, v := range vv {
-					req.Header.Add(k, v)
-				}
+		for k, vv := range r.Header {
+			for _, v := range vv {
+				req.Header.Add(k, v)
 			}
 		}
 	}
@@ -197,6 +203,7 @@ func (r IndicesStatsRequest) Do(ctx context.Context, transport Transport) (*Resp
 		StatusCode: res.StatusCode,
 		Body:       res.Body,
 		Header:     res.Header,
+		codec:      JSONCodecOf(transport),
 	}
 
 	return &response, response.Err()
@@ -226,6 +233,14 @@ func (f IndicesStats) WithMetric(v ...string) func(*IndicesStatsRequest) {
 	}
 }
 
+// WithAllowNoIndices - whether to ignore if a wildcard indices expression resolves into no concrete indices. (this includes `_all` string or when no indices have been specified).
+//
+func (f IndicesStats) WithAllowNoIndices(v bool) func(*IndicesStatsRequest) {
+	return func(r *IndicesStatsRequest) {
+		r.AllowNoIndices = &v
+	}
+}
+
 // WithCompletionFields - a list of fields for `fielddata` and `suggest` index metric (supports wildcards).
 //
 func (f IndicesStats) WithCompletionFields(v ...string) func(*IndicesStatsRequest) {
@@ -274,6 +289,14 @@ func (f IndicesStats) WithGroups(v ...string) func(*IndicesStatsRequest) {
 	}
 }
 
+// WithIgnoreUnavailable - whether specified concrete indices should be ignored when unavailable (missing or closed).
+//
+func (f IndicesStats) WithIgnoreUnavailable(v bool) func(*IndicesStatsRequest) {
+	return func(r *IndicesStatsRequest) {
+		r.IgnoreUnavailable = &v
+	}
+}
+
 // WithIncludeSegmentFileSizes - whether to report the aggregated disk usage of each one of the lucene index files (only applies if segment stats are requested).
 //
 func (f IndicesStats) WithIncludeSegmentFileSizes(v bool) func(*IndicesStatsRequest) {
@@ -330,6 +353,16 @@ func (f IndicesStats) WithErrorTrace() func(*IndicesStatsRequest) {
 	}
 }
 
+// WithDebug makes the response body pretty-printed and human-readable, and includes the stack trace for errors.
+//
+func (f IndicesStats) WithDebug() func(*IndicesStatsRequest) {
+	return func(r *IndicesStatsRequest) {
+		r.Pretty = true
+		r.Human = true
+		r.ErrorTrace = true
+	}
+}
+
 // WithFilterPath filters the properties of the response body.
 //
 func (f IndicesStats) WithFilterPath(v ...string) func(*IndicesStatsRequest) {
@@ -351,6 +384,32 @@ func (f IndicesStats) WithHeader(h map[string]string) func(*IndicesStatsRequest)
 	}
 }
 
+// WithHTTPHeader adds the headers to the HTTP request, keeping the multiple values of a header.
+//
+func (f IndicesStats) WithHTTPHeader(h http.Header) func(*IndicesStatsRequest) {
+	return func(r *IndicesStatsRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, vv := range h {
+			for _, v := range vv {
+				r.Header.Add(k, v)
+			}
+		}
+	}
+}
+
+// WithAccept sets the media type of the response, eg. "application/yaml"; the default is JSON.
+//
+func (f IndicesStats) WithAccept(v string) func(*IndicesStatsRequest) {
+	return func(r *IndicesStatsRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Accept", v)
+	}
+}
+
 // WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
 //
 func (f IndicesStats) WithOpaqueID(s string) func(*IndicesStatsRequest) {
@@ -361,3 +420,14 @@ func (f IndicesStats) WithOpaqueID(s string) func(*IndicesStatsRequest) {
 		r.Header.Set("X-Opaque-Id", s)
 	}
 }
+
+// WithBasicAuth sets the credentials of the request, overriding the credentials of the client.
+//
+func (f IndicesStats) WithBasicAuth(username, password string) func(*IndicesStatsRequest) {
+	return func(r *IndicesStatsRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Authorization", basicAuth(username, password))
+	}
+}
@@ -27,7 +27,10 @@
 package opensearchapi
 
 import (
+	"bytes"
 	"context"
+	"errors"
+	"fmt"
 	"io"
 	"net/http"
 	"strings"
@@ -54,7 +57,8 @@ type IndicesUpdateAliases func(body io.Reader, o ...func(*IndicesUpdateAliasesRe
 // IndicesUpdateAliasesRequest configures the Indices Update Aliases API request.
 //
 type IndicesUpdateAliasesRequest struct {
-	Body io.Reader
+	Body    io.Reader
+	Actions *AliasActions
 
 	MasterTimeout         time.Duration
 	ClusterManagerTimeout time.Duration
@@ -70,6 +74,86 @@ type IndicesUpdateAliasesRequest struct {
 	ctx context.Context
 }
 
+// AliasActions represents the body of the Indices Update Aliases API request.
+//
+// The actions are applied atomically, eg. to swap an alias from an index to another:
+//
+//	actions := new(opensearchapi.AliasActions).
+//		Remove(opensearchapi.AliasActionParams{Index: "logs-1", Alias: "logs"}).
+//		Add(opensearchapi.AliasActionParams{Index: "logs-2", Alias: "logs", IsWriteIndex: opensearchapi.BoolPtr(true)})
+//	res, err := client.Indices.UpdateAliases(nil, client.Indices.UpdateAliases.WithActions(*actions))
+//
+type AliasActions struct {
+	Actions []AliasAction `json:"actions"`
+}
+
+// AliasAction represents a single action; exactly one of Add, Remove and RemoveIndex is set.
+//
+type AliasAction struct {
+	Add         *AliasActionParams `json:"add,omitempty"`
+	Remove      *AliasActionParams `json:"remove,omitempty"`
+	RemoveIndex *AliasActionParams `json:"remove_index,omitempty"`
+}
+
+// AliasActionParams represents the parameters of an alias action.
+//
+type AliasActionParams struct {
+	Index        string      `json:"index"`
+	Alias        string      `json:"alias,omitempty"`
+	Filter       interface{} `json:"filter,omitempty"`
+	IsWriteIndex *bool       `json:"is_write_index,omitempty"`
+	Routing      string      `json:"routing,omitempty"`
+}
+
+// Add appends an action adding the alias to the index.
+//
+func (a *AliasActions) Add(p AliasActionParams) *AliasActions {
+	a.Actions = append(a.Actions, AliasAction{Add: &p})
+	return a
+}
+
+// Remove appends an action removing the alias from the index.
+//
+func (a *AliasActions) Remove(p AliasActionParams) *AliasActions {
+	a.Actions = append(a.Actions, AliasAction{Remove: &p})
+	return a
+}
+
+// RemoveIndex appends an action deleting the index.
+//
+func (a *AliasActions) RemoveIndex(index string) *AliasActions {
+	a.Actions = append(a.Actions, AliasAction{RemoveIndex: &AliasActionParams{Index: index}})
+	return a
+}
+
+// Validate returns an error when an action is not valid, eg. an action without alias.
+//
+func (a AliasActions) Validate() error {
+	if len(a.Actions) == 0 {
+		return errors.New("invalid alias actions: no actions")
+	}
+	for i, action := range a.Actions {
+		var n int
+		for _, p := range []*AliasActionParams{action.Add, action.Remove, action.RemoveIndex} {
+			if p != nil {
+				n++
+			}
+		}
+		if n != 1 {
+			return fmt.Errorf("invalid alias action %d: exactly one of add, remove and remove_index is required", i)
+		}
+		switch {
+		case action.Add != nil && (action.Add.Index == "" || action.Add.Alias == ""):
+			return fmt.Errorf("invalid alias action %d: add requires index and alias", i)
+		case action.Remove != nil && (action.Remove.Index == "" || action.Remove.Alias == ""):
+			return fmt.Errorf("invalid alias action %d: remove requires index and alias", i)
+		case action.RemoveIndex != nil && action.RemoveIndex.Index == "":
+			return fmt.Errorf("invalid alias action %d: remove_index requires index", i)
+		}
+	}
+	return nil
+}
+
 // Do executes the request and returns response or error.
 //
 func (r IndicesUpdateAliasesRequest) Do(ctx context.Context, transport Transport) (*Response, error) {
@@ -114,7 +198,19 @@ func (r IndicesUpdateAliasesRequest) Do(ctx context.Context, transport Transport
 		params["filter_path"] = strings.Join(r.FilterPath, ",")
 	}
 
-	req, err := newRequest(method, path.String(), r.Body)
+	var body io.Reader = r.Body
+	if r.Actions != nil {
+		if err := r.Actions.Validate(); err != nil {
+			return nil, err
+		}
+		b, err := JSONCodecOf(transport).Marshal(r.Actions)
+		if err != nil {
+			return nil, fmt.Errorf("cannot encode alias actions: %s", err)
+		}
+		body = bytes.NewReader(b)
+	}
+
+	req, err := newRequest(transport, method, path.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -127,18 +223,14 @@ func (r IndicesUpdateAliasesRequest) Do(ctx context.Context, transport Transport
 		req.URL.RawQuery = q.Encode()
 	}
 
-	if r.Body != nil {
+	if body != nil && r.Header.Get(headerContentType) == "" {
 		req.Header[headerContentType] = headerContentTypeJSON
 	}
 
 	if len(r.Header) > 0 {
-		if len(req.Header) == 0 {
-			req.Header = r.Header
-		} else {
-			for k, vv := range r.Header {
-				for _, v := range vv {
-					req.Header.Add(k, v)
-				}
+		for k, vv := range r.Header {
+			for _, v := range vv {
+				req.Header.Add(k, v)
 			}
 		}
 	}
@@ -156,6 +248,7 @@ func (r IndicesUpdateAliasesRequest) Do(ctx context.Context, transport Transport
 		StatusCode: res.StatusCode,
 		Body:       res.Body,
 		Header:     res.Header,
+		codec:      JSONCodecOf(transport),
 	}
 
 	return &response, response.Err()
@@ -169,6 +262,22 @@ func (f IndicesUpdateAliases) WithContext(v context.Context) func(*IndicesUpdate
 	}
 }
 
+// WithJSONBody - the request body, encoded to JSON with the JSON codec of the client; it replaces the body passed to the function.
+//
+func (f IndicesUpdateAliases) WithJSONBody(v interface{}) func(*IndicesUpdateAliasesRequest) {
+	return func(r *IndicesUpdateAliasesRequest) {
+		r.Body = JSONBody(v)
+	}
+}
+
+// WithActions - the alias actions, validated and encoded as the request body; it replaces the body passed to the function.
+//
+func (f IndicesUpdateAliases) WithActions(v AliasActions) func(*IndicesUpdateAliasesRequest) {
+	return func(r *IndicesUpdateAliasesRequest) {
+		r.Actions = &v
+	}
+}
+
 // WithMasterTimeout - explicit operation timeout for connection to cluster-manager node.
 //
 // Deprecated: To promote inclusive language, use WithClusterManagerTimeout instead.
@@ -219,6 +328,16 @@ func (f IndicesUpdateAliases) WithErrorTrace() func(*IndicesUpdateAliasesRequest
 	}
 }
 
+// WithDebug makes the response body pretty-printed and human-readable, and includes the stack trace for errors.
+//
+func (f IndicesUpdateAliases) WithDebug() func(*IndicesUpdateAliasesRequest) {
+	return func(r *IndicesUpdateAliasesRequest) {
+		r.Pretty = true
+		r.Human = true
+		r.ErrorTrace = true
+	}
+}
+
 // WithFilterPath filters the properties of the response body.
 //
 func (f IndicesUpdateAliases) WithFilterPath(v ...string) func(*IndicesUpdateAliasesRequest) {
@@ -240,6 +359,32 @@ func (f IndicesUpdateAliases) WithHeader(h map[string]string) func(*IndicesUpdat
 	}
 }
 
+// WithHTTPHeader adds the headers to the HTTP request, keeping the multiple values of a header.
+//
+func (f IndicesUpdateAliases) WithHTTPHeader(h http.Header) func(*IndicesUpdateAliasesRequest) {
+	return func(r *IndicesUpdateAliasesRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, vv := range h {
+			for _, v := range vv {
+				r.Header.Add(k, v)
+			}
+		}
+	}
+}
+
+// WithAccept sets the media type of the response, eg. "application/yaml"; the default is JSON.
+//
+func (f IndicesUpdateAliases) WithAccept(v string) func(*IndicesUpdateAliasesRequest) {
+	return func(r *IndicesUpdateAliasesRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Accept", v)
+	}
+}
+
 // WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
 //
 func (f IndicesUpdateAliases) WithOpaqueID(s string) func(*IndicesUpdateAliasesRequest) {
@@ -250,3 +395,25 @@ func (f IndicesUpdateAliases) WithOpaqueID(s string) func(*IndicesUpdateAliasesR
 		r.Header.Set("X-Opaque-Id", s)
 	}
 }
+
+// WithContentType sets the Content-Type header of the request, overriding the default of the request body.
+//
+func (f IndicesUpdateAliases) WithContentType(v string) func(*IndicesUpdateAliasesRequest) {
+	return func(r *IndicesUpdateAliasesRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Content-Type", v)
+	}
+}
+
+// WithBasicAuth sets the credentials of the request, overriding the credentials of the client.
+//
+func (f IndicesUpdateAliases) WithBasicAuth(username, password string) func(*IndicesUpdateAliasesRequest) {
+	return func(r *IndicesUpdateAliasesRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Authorization", basicAuth(username, password))
+	}
+}
@@ -0,0 +1,64 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+//go:build !integration
+// +build !integration
+
+package opensearchapi
+
+import (
+	"context"
+	"io/ioutil"
+	"testing"
+)
+
+func TestAliasActions(t *testing.T) {
+	t.Run("Swap", func(t *testing.T) {
+		actions := new(AliasActions).
+			Remove(AliasActionParams{Index: "logs-1", Alias: "logs"}).
+			Add(AliasActionParams{Index: "logs-2", Alias: "logs", IsWriteIndex: BoolPtr(false), Routing: "1"}).
+			RemoveIndex("logs-0")
+
+		req, err := BuildRequest(context.Background(), IndicesUpdateAliasesRequest{Actions: actions})
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+
+		if req.Method != "POST" || req.URL.Path != "/_aliases" {
+			t.Errorf("Unexpected request: %s %s", req.Method, req.URL.Path)
+		}
+		body, _ := ioutil.ReadAll(req.Body)
+		want := `{"actions":[` +
+			`{"remove":{"index":"logs-1","alias":"logs"}},` +
+			`{"add":{"index":"logs-2","alias":"logs","is_write_index":false,"routing":"1"}},` +
+			`{"remove_index":{"index":"logs-0"}}]}`
+		if string(body) != want {
+			t.Errorf("Unexpected body:\nwant: %s\ngot:  %s", want, body)
+		}
+	})
+
+	t.Run("Validate", func(t *testing.T) {
+		tests := []struct {
+			name    string
+			actions AliasActions
+		}{
+			{"Empty", AliasActions{}},
+			{"No action", AliasActions{Actions: []AliasAction{{}}}},
+			{"Two actions", AliasActions{Actions: []AliasAction{{Add: &AliasActionParams{Index: "a", Alias: "b"}, Remove: &AliasActionParams{Index: "a", Alias: "b"}}}}},
+			{"No alias", *new(AliasActions).Add(AliasActionParams{Index: "a"})},
+			{"No index", *new(AliasActions).RemoveIndex("")},
+		}
+
+		for _, tt := range tests {
+			if err := tt.actions.Validate(); err == nil {
+				t.Errorf("%s: expected error", tt.name)
+			}
+		}
+	})
+}
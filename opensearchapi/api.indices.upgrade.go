@@ -128,7 +128,7 @@ func (r IndicesUpgradeRequest) Do(ctx context.Context, transport Transport) (*Re
 		params["filter_path"] = strings.Join(r.FilterPath, ",")
 	}
 
-	req, err := newRequest(method, path.String(), nil)
+	req, err := newRequest(transport, method, path.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -142,13 +142,9 @@ func (r IndicesUpgradeRequest) Do(ctx context.Context, transport Transport) (*Re
 	}
 
 	if len(r.Header) > 0 {
-		if len(req.Header) == 0 {
-			req.Header = r.Header
-		} else {
-			for k, vv := range r.Header {
-				for _, v := range vv {
-					req.Header.Add(k, v)
-				}
+		for k, vv := range r.Header {
+			for _, v := range vv {
+				req.Header.Add(k, v)
 			}
 		}
 	}
@@ -166,6 +162,7 @@ func (r IndicesUpgradeRequest) Do(ctx context.Context, transport Transport) (*Re
 		StatusCode: res.StatusCode,
 		Body:       res.Body,
 		Header:     res.Header,
+		codec:      JSONCodecOf(transport),
 	}
 
 	return &response, response.Err()
@@ -251,6 +248,16 @@ func (f IndicesUpgrade) WithErrorTrace() func(*IndicesUpgradeRequest) {
 	}
 }
 
+// WithDebug makes the response body pretty-printed and human-readable, and includes the stack trace for errors.
+//
+func (f IndicesUpgrade) WithDebug() func(*IndicesUpgradeRequest) {
+	return func(r *IndicesUpgradeRequest) {
+		r.Pretty = true
+		r.Human = true
+		r.ErrorTrace = true
+	}
+}
+
 // WithFilterPath filters the properties of the response body.
 //
 func (f IndicesUpgrade) WithFilterPath(v ...string) func(*IndicesUpgradeRequest) {
@@ -272,6 +279,32 @@ func (f IndicesUpgrade) WithHeader(h map[string]string) func(*IndicesUpgradeRequ
 	}
 }
 
+// WithHTTPHeader adds the headers to the HTTP request, keeping the multiple values of a header.
+//
+func (f IndicesUpgrade) WithHTTPHeader(h http.Header) func(*IndicesUpgradeRequest) {
+	return func(r *IndicesUpgradeRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, vv := range h {
+			for _, v := range vv {
+				r.Header.Add(k, v)
+			}
+		}
+	}
+}
+
+// WithAccept sets the media type of the response, eg. "application/yaml"; the default is JSON.
+//
+func (f IndicesUpgrade) WithAccept(v string) func(*IndicesUpgradeRequest) {
+	return func(r *IndicesUpgradeRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Accept", v)
+	}
+}
+
 // WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
 //
 func (f IndicesUpgrade) WithOpaqueID(s string) func(*IndicesUpgradeRequest) {
@@ -282,3 +315,14 @@ func (f IndicesUpgrade) WithOpaqueID(s string) func(*IndicesUpgradeRequest) {
 		r.Header.Set("X-Opaque-Id", s)
 	}
 }
+
+// WithBasicAuth sets the credentials of the request, overriding the credentials of the client.
+//
+func (f IndicesUpgrade) WithBasicAuth(username, password string) func(*IndicesUpgradeRequest) {
+	return func(r *IndicesUpgradeRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Authorization", basicAuth(username, password))
+	}
+}
@@ -28,6 +28,8 @@ package opensearchapi
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"net/http"
 	"strings"
 )
@@ -78,7 +80,39 @@ type InfoResp struct {
 	Tagline string `json:"tagline"`
 }
 
+// Distributions of the cluster, in the version of the Info API response.
+const (
+	DistributionOpenSearch    = "opensearch"
+	DistributionElasticsearch = "elasticsearch"
+)
+
+// ParseInfoResponse decodes the body of an Info API response and closes it.
+//
+// The distribution is set to DistributionElasticsearch when the cluster doesn't report one,
+// as Elasticsearch doesn't.
+func ParseInfoResponse(res *Response) (*InfoResp, error) {
+	if res == nil || res.Body == nil {
+		return nil, errors.New("cannot parse info response: empty response")
+	}
+	defer res.Close()
+
+	if err := res.Err(); err != nil {
+		return nil, err
+	}
+
+	var ir InfoResp
+	if err := decodeBody(res, &ir); err != nil {
+		return nil, fmt.Errorf("cannot parse info response: %s", err)
+	}
+	if ir.Version.Distribution == "" {
+		ir.Version.Distribution = DistributionElasticsearch
+	}
+
+	return &ir, nil
+}
+
 // Do executes the request and returns response or error.
+//
 func (r InfoRequest) Do(ctx context.Context, transport Transport) (*Response, error) {
 	var (
 		method string
@@ -109,7 +143,7 @@ func (r InfoRequest) Do(ctx context.Context, transport Transport) (*Response, er
 		params["filter_path"] = strings.Join(r.FilterPath, ",")
 	}
 
-	req, err := newRequest(method, path.String(), nil)
+	req, err := newRequest(transport, method, path.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -123,13 +157,9 @@ func (r InfoRequest) Do(ctx context.Context, transport Transport) (*Response, er
 	}
 
 	if len(r.Header) > 0 {
-		if len(req.Header) == 0 {
-			req.Header = r.Header
-		} else {
-			for k, vv := range r.Header {
-				for _, v := range vv {
-					req.Header.Add(k, v)
-				}
+		for k, vv := range r.Header {
+			for _, v := range vv {
+				req.Header.Add(k, v)
 			}
 		}
 	}
@@ -147,12 +177,14 @@ func (r InfoRequest) Do(ctx context.Context, transport Transport) (*Response, er
 		StatusCode: res.StatusCode,
 		Body:       res.Body,
 		Header:     res.Header,
+		codec:      JSONCodecOf(transport),
 	}
 
 	return &response, response.Err()
 }
 
 // WithContext sets the request context.
+//
 func (f Info) WithContext(v context.Context) func(*InfoRequest) {
 	return func(r *InfoRequest) {
 		r.ctx = v
@@ -160,6 +192,7 @@ func (f Info) WithContext(v context.Context) func(*InfoRequest) {
 }
 
 // WithHuman makes statistical values human-readable.
+//
 func (f Info) WithHuman() func(*InfoRequest) {
 	return func(r *InfoRequest) {
 		r.Human = true
@@ -167,13 +200,25 @@ func (f Info) WithHuman() func(*InfoRequest) {
 }
 
 // WithErrorTrace includes the stack trace for errors in the response body.
+//
 func (f Info) WithErrorTrace() func(*InfoRequest) {
 	return func(r *InfoRequest) {
 		r.ErrorTrace = true
 	}
 }
 
+// WithDebug makes the response body pretty-printed and human-readable, and includes the stack trace for errors.
+//
+func (f Info) WithDebug() func(*InfoRequest) {
+	return func(r *InfoRequest) {
+		r.Pretty = true
+		r.Human = true
+		r.ErrorTrace = true
+	}
+}
+
 // WithFilterPath filters the properties of the response body.
+//
 func (f Info) WithFilterPath(v ...string) func(*InfoRequest) {
 	return func(r *InfoRequest) {
 		r.FilterPath = v
@@ -181,6 +226,7 @@ func (f Info) WithFilterPath(v ...string) func(*InfoRequest) {
 }
 
 // WithHeader adds the headers to the HTTP request.
+//
 func (f Info) WithHeader(h map[string]string) func(*InfoRequest) {
 	return func(r *InfoRequest) {
 		if r.Header == nil {
@@ -192,7 +238,34 @@ func (f Info) WithHeader(h map[string]string) func(*InfoRequest) {
 	}
 }
 
+// WithHTTPHeader adds the headers to the HTTP request, keeping the multiple values of a header.
+//
+func (f Info) WithHTTPHeader(h http.Header) func(*InfoRequest) {
+	return func(r *InfoRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, vv := range h {
+			for _, v := range vv {
+				r.Header.Add(k, v)
+			}
+		}
+	}
+}
+
+// WithAccept sets the media type of the response, eg. "application/yaml"; the default is JSON.
+//
+func (f Info) WithAccept(v string) func(*InfoRequest) {
+	return func(r *InfoRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Accept", v)
+	}
+}
+
 // WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
+//
 func (f Info) WithOpaqueID(s string) func(*InfoRequest) {
 	return func(r *InfoRequest) {
 		if r.Header == nil {
@@ -201,3 +274,14 @@ func (f Info) WithOpaqueID(s string) func(*InfoRequest) {
 		r.Header.Set("X-Opaque-Id", s)
 	}
 }
+
+// WithBasicAuth sets the credentials of the request, overriding the credentials of the client.
+//
+func (f Info) WithBasicAuth(username, password string) func(*InfoRequest) {
+	return func(r *InfoRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Authorization", basicAuth(username, password))
+	}
+}
@@ -94,7 +94,7 @@ func (r IngestProcessorGrokRequest) Do(ctx context.Context, transport Transport)
 		params["filter_path"] = strings.Join(r.FilterPath, ",")
 	}
 
-	req, err := newRequest(method, path.String(), nil)
+	req, err := newRequest(transport, method, path.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -108,13 +108,9 @@ func (r IngestProcessorGrokRequest) Do(ctx context.Context, transport Transport)
 	}
 
 	if len(r.Header) > 0 {
-		if len(req.Header) == 0 {
-			req.Header = r.Header
-		} else {
-			for k, vv := range r.Header {
-				for _, v := range vv {
-					req.Header.Add(k, v)
-				}
+		for k, vv := range r.Header {
+			for _, v := range vv {
+				req.Header.Add(k, v)
 			}
 		}
 	}
@@ -132,6 +128,7 @@ func (r IngestProcessorGrokRequest) Do(ctx context.Context, transport Transport)
 		StatusCode: res.StatusCode,
 		Body:       res.Body,
 		Header:     res.Header,
+		codec:      JSONCodecOf(transport),
 	}
 
 	return &response, response.Err()
@@ -169,6 +166,16 @@ func (f IngestProcessorGrok) WithErrorTrace() func(*IngestProcessorGrokRequest)
 	}
 }
 
+// WithDebug makes the response body pretty-printed and human-readable, and includes the stack trace for errors.
+//
+func (f IngestProcessorGrok) WithDebug() func(*IngestProcessorGrokRequest) {
+	return func(r *IngestProcessorGrokRequest) {
+		r.Pretty = true
+		r.Human = true
+		r.ErrorTrace = true
+	}
+}
+
 // WithFilterPath filters the properties of the response body.
 //
 func (f IngestProcessorGrok) WithFilterPath(v ...string) func(*IngestProcessorGrokRequest) {
@@ -190,6 +197,32 @@ func (f IngestProcessorGrok) WithHeader(h map[string]string) func(*IngestProcess
 	}
 }
 
+// WithHTTPHeader adds the headers to the HTTP request, keeping the multiple values of a header.
+//
+func (f IngestProcessorGrok) WithHTTPHeader(h http.Header) func(*IngestProcessorGrokRequest) {
+	return func(r *IngestProcessorGrokRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, vv := range h {
+			for _, v := range vv {
+				r.Header.Add(k, v)
+			}
+		}
+	}
+}
+
+// WithAccept sets the media type of the response, eg. "application/yaml"; the default is JSON.
+//
+func (f IngestProcessorGrok) WithAccept(v string) func(*IngestProcessorGrokRequest) {
+	return func(r *IngestProcessorGrokRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Accept", v)
+	}
+}
+
 // WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
 //
 func (f IngestProcessorGrok) WithOpaqueID(s string) func(*IngestProcessorGrokRequest) {
@@ -200,3 +233,14 @@ func (f IngestProcessorGrok) WithOpaqueID(s string) func(*IngestProcessorGrokReq
 		r.Header.Set("X-Opaque-Id", s)
 	}
 }
+
+// WithBasicAuth sets the credentials of the request, overriding the credentials of the client.
+//
+func (f IngestProcessorGrok) WithBasicAuth(username, password string) func(*IngestProcessorGrokRequest) {
+	return func(r *IngestProcessorGrokRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Authorization", basicAuth(username, password))
+	}
+}
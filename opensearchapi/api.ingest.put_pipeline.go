@@ -121,7 +121,7 @@ func (r IngestPutPipelineRequest) Do(ctx context.Context, transport Transport) (
 		params["filter_path"] = strings.Join(r.FilterPath, ",")
 	}
 
-	req, err := newRequest(method, path.String(), r.Body)
+	req, err := newRequest(transport, method, path.String(), r.Body)
 	if err != nil {
 		return nil, err
 	}
@@ -134,18 +134,14 @@ func (r IngestPutPipelineRequest) Do(ctx context.Context, transport Transport) (
 		req.URL.RawQuery = q.Encode()
 	}
 
-	if r.Body != nil {
+	if r.Body != nil && r.Header.Get(headerContentType) == "" {
 		req.Header[headerContentType] = headerContentTypeJSON
 	}
 
 	if len(r.Header) > 0 {
-		if len(req.Header) == 0 {
-			req.Header = r.Header
-		} else {
-			for k, vv := range r.Header {
-				for _, v := range vv {
-					req.Header.Add(k, v)
-				}
+		for k, vv := range r.Header {
+			for _, v := range vv {
+				req.Header.Add(k, v)
 			}
 		}
 	}
@@ -163,6 +159,7 @@ func (r IngestPutPipelineRequest) Do(ctx context.Context, transport Transport) (
 		StatusCode: res.StatusCode,
 		Body:       res.Body,
 		Header:     res.Header,
+		codec:      JSONCodecOf(transport),
 	}
 
 	return &response, response.Err()
@@ -176,6 +173,14 @@ func (f IngestPutPipeline) WithContext(v context.Context) func(*IngestPutPipelin
 	}
 }
 
+// WithJSONBody - the request body, encoded to JSON with the JSON codec of the client; it replaces the body passed to the function.
+//
+func (f IngestPutPipeline) WithJSONBody(v interface{}) func(*IngestPutPipelineRequest) {
+	return func(r *IngestPutPipelineRequest) {
+		r.Body = JSONBody(v)
+	}
+}
+
 // WithMasterTimeout - explicit operation timeout for connection to cluster-manager node.
 //
 // Deprecated: To promote inclusive language, use WithClusterManagerTimeout instead.
@@ -226,6 +231,16 @@ func (f IngestPutPipeline) WithErrorTrace() func(*IngestPutPipelineRequest) {
 	}
 }
 
+// WithDebug makes the response body pretty-printed and human-readable, and includes the stack trace for errors.
+//
+func (f IngestPutPipeline) WithDebug() func(*IngestPutPipelineRequest) {
+	return func(r *IngestPutPipelineRequest) {
+		r.Pretty = true
+		r.Human = true
+		r.ErrorTrace = true
+	}
+}
+
 // WithFilterPath filters the properties of the response body.
 //
 func (f IngestPutPipeline) WithFilterPath(v ...string) func(*IngestPutPipelineRequest) {
@@ -247,6 +262,32 @@ func (f IngestPutPipeline) WithHeader(h map[string]string) func(*IngestPutPipeli
 	}
 }
 
+// WithHTTPHeader adds the headers to the HTTP request, keeping the multiple values of a header.
+//
+func (f IngestPutPipeline) WithHTTPHeader(h http.Header) func(*IngestPutPipelineRequest) {
+	return func(r *IngestPutPipelineRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, vv := range h {
+			for _, v := range vv {
+				r.Header.Add(k, v)
+			}
+		}
+	}
+}
+
+// WithAccept sets the media type of the response, eg. "application/yaml"; the default is JSON.
+//
+func (f IngestPutPipeline) WithAccept(v string) func(*IngestPutPipelineRequest) {
+	return func(r *IngestPutPipelineRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Accept", v)
+	}
+}
+
 // WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
 //
 func (f IngestPutPipeline) WithOpaqueID(s string) func(*IngestPutPipelineRequest) {
@@ -257,3 +298,25 @@ func (f IngestPutPipeline) WithOpaqueID(s string) func(*IngestPutPipelineRequest
 		r.Header.Set("X-Opaque-Id", s)
 	}
 }
+
+// WithContentType sets the Content-Type header of the request, overriding the default of the request body.
+//
+func (f IngestPutPipeline) WithContentType(v string) func(*IngestPutPipelineRequest) {
+	return func(r *IngestPutPipelineRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Content-Type", v)
+	}
+}
+
+// WithBasicAuth sets the credentials of the request, overriding the credentials of the client.
+//
+func (f IngestPutPipeline) WithBasicAuth(username, password string) func(*IngestPutPipelineRequest) {
+	return func(r *IngestPutPipelineRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Authorization", basicAuth(username, password))
+	}
+}
@@ -115,7 +115,7 @@ func (r IngestSimulateRequest) Do(ctx context.Context, transport Transport) (*Re
 		params["filter_path"] = strings.Join(r.FilterPath, ",")
 	}
 
-	req, err := newRequest(method, path.String(), r.Body)
+	req, err := newRequest(transport, method, path.String(), r.Body)
 	if err != nil {
 		return nil, err
 	}
@@ -128,18 +128,14 @@ func (r IngestSimulateRequest) Do(ctx context.Context, transport Transport) (*Re
 		req.URL.RawQuery = q.Encode()
 	}
 
-	if r.Body != nil {
+	if r.Body != nil && r.Header.Get(headerContentType) == "" {
 		req.Header[headerContentType] = headerContentTypeJSON
 	}
 
 	if len(r.Header) > 0 {
-		if len(req.Header) == 0 {
-			req.Header = r.Header
-		} else {
-			for k, vv := range r.Header {
-				for _, v := range vv {
-					req.Header.Add(k, v)
-				}
+		for k, vv := range r.Header {
+			for _, v := range vv {
+				req.Header.Add(k, v)
 			}
 		}
 	}
@@ -157,6 +153,7 @@ func (r IngestSimulateRequest) Do(ctx context.Context, transport Transport) (*Re
 		StatusCode: res.StatusCode,
 		Body:       res.Body,
 		Header:     res.Header,
+		codec:      JSONCodecOf(transport),
 	}
 
 	return &response, response.Err()
@@ -170,6 +167,14 @@ func (f IngestSimulate) WithContext(v context.Context) func(*IngestSimulateReque
 	}
 }
 
+// WithJSONBody - the request body, encoded to JSON with the JSON codec of the client; it replaces the body passed to the function.
+//
+func (f IngestSimulate) WithJSONBody(v interface{}) func(*IngestSimulateRequest) {
+	return func(r *IngestSimulateRequest) {
+		r.Body = JSONBody(v)
+	}
+}
+
 // WithPipelineID - pipeline ID.
 //
 func (f IngestSimulate) WithPipelineID(v string) func(*IngestSimulateRequest) {
@@ -210,6 +215,16 @@ func (f IngestSimulate) WithErrorTrace() func(*IngestSimulateRequest) {
 	}
 }
 
+// WithDebug makes the response body pretty-printed and human-readable, and includes the stack trace for errors.
+//
+func (f IngestSimulate) WithDebug() func(*IngestSimulateRequest) {
+	return func(r *IngestSimulateRequest) {
+		r.Pretty = true
+		r.Human = true
+		r.ErrorTrace = true
+	}
+}
+
 // WithFilterPath filters the properties of the response body.
 //
 func (f IngestSimulate) WithFilterPath(v ...string) func(*IngestSimulateRequest) {
@@ -231,6 +246,32 @@ func (f IngestSimulate) WithHeader(h map[string]string) func(*IngestSimulateRequ
 	}
 }
 
+// WithHTTPHeader adds the headers to the HTTP request, keeping the multiple values of a header.
+//
+func (f IngestSimulate) WithHTTPHeader(h http.Header) func(*IngestSimulateRequest) {
+	return func(r *IngestSimulateRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, vv := range h {
+			for _, v := range vv {
+				r.Header.Add(k, v)
+			}
+		}
+	}
+}
+
+// WithAccept sets the media type of the response, eg. "application/yaml"; the default is JSON.
+//
+func (f IngestSimulate) WithAccept(v string) func(*IngestSimulateRequest) {
+	return func(r *IngestSimulateRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Accept", v)
+	}
+}
+
 // WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
 //
 func (f IngestSimulate) WithOpaqueID(s string) func(*IngestSimulateRequest) {
@@ -241,3 +282,25 @@ func (f IngestSimulate) WithOpaqueID(s string) func(*IngestSimulateRequest) {
 		r.Header.Set("X-Opaque-Id", s)
 	}
 }
+
+// WithContentType sets the Content-Type header of the request, overriding the default of the request body.
+//
+func (f IngestSimulate) WithContentType(v string) func(*IngestSimulateRequest) {
+	return func(r *IngestSimulateRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Content-Type", v)
+	}
+}
+
+// WithBasicAuth sets the credentials of the request, overriding the credentials of the client.
+//
+func (f IngestSimulate) WithBasicAuth(username, password string) func(*IngestSimulateRequest) {
+	return func(r *IngestSimulateRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Authorization", basicAuth(username, password))
+	}
+}
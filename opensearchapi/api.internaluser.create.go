@@ -0,0 +1,341 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchapi
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+func newInternalUserCreateFunc(t Transport) InternalUserCreate {
+	return func(username string, o ...func(*InternalUserCreateRequest)) (*Response, error) {
+		var r = InternalUserCreateRequest{Username: username}
+		for _, f := range o {
+			f(&r)
+		}
+		return r.Do(r.ctx, t)
+	}
+}
+
+// ----- API Definition -------------------------------------------------------
+
+// InternalUserCreate creates or replaces an internal user.
+//
+// The security plugin has no optimistic concurrency control for its resources: the request
+// doesn't support if_seq_no and if_primary_term, and concurrent updates are last-write-wins.
+// The updates of a resource by several writers must be serialized by the callers.
+type InternalUserCreate func(username string, o ...func(*InternalUserCreateRequest)) (*Response, error)
+
+// InternalUserCreateRequest configures the Internal User Create API request.
+type InternalUserCreateRequest struct {
+	Username string
+
+	Body     io.Reader
+	UserBody *InternalUserBody
+
+	QueryParams map[string]string
+
+	Pretty     bool
+	Human      bool
+	ErrorTrace bool
+	FilterPath []string
+
+	Header http.Header
+
+	ctx context.Context
+}
+
+// InternalUserBody represents the definition of an internal user, used as the body of the Internal User Create API request.
+//
+// Either Password or Hash must be set; Hash is a bcrypt hash of the password.
+// Empty fields are omitted from the serialized JSON.
+type InternalUserBody struct {
+	Password     string            `json:"password,omitempty"`
+	Hash         string            `json:"hash,omitempty"`
+	BackendRoles []string          `json:"backend_roles,omitempty"`
+	Attributes   map[string]string `json:"attributes,omitempty"`
+}
+
+// Validate returns an error when both the password and the hash are set, or when the body
+// contains an empty backend role or attribute name. The error names the offending field.
+func (b InternalUserBody) Validate() error {
+	if b.Password != "" && b.Hash != "" {
+		return errors.New("invalid internal user: password and hash are exclusive")
+	}
+	for i, r := range b.BackendRoles {
+		if strings.TrimSpace(r) == "" {
+			return fmt.Errorf("invalid internal user: backend_roles[%d]: empty backend role", i)
+		}
+	}
+	for k := range b.Attributes {
+		if strings.TrimSpace(k) == "" {
+			return fmt.Errorf("invalid internal user: attributes: empty attribute name %q", k)
+		}
+	}
+	return nil
+}
+
+// Do executes the request and returns response or error.
+func (r InternalUserCreateRequest) Do(ctx context.Context, transport Transport) (*Response, error) {
+	var (
+		method string
+		path   strings.Builder
+		params map[string]string
+	)
+
+	method = "PUT"
+
+	username, err := escapeName("user", r.Username)
+	if err != nil {
+		return nil, err
+	}
+
+	var body io.Reader = r.Body
+	if r.UserBody != nil {
+		if err := r.UserBody.Validate(); err != nil {
+			return nil, fmt.Errorf("user %q: %w", r.Username, err)
+		}
+		b, err := JSONCodecOf(transport).Marshal(r.UserBody)
+		if err != nil {
+			return nil, fmt.Errorf("cannot encode user: %s", err)
+		}
+		body = bytes.NewReader(b)
+	}
+
+	path.Grow(39 + len(username))
+	path.WriteString("/_plugins/_security/api/internalusers/")
+	path.WriteString(username)
+
+	params = make(map[string]string)
+
+	if r.Pretty {
+		params["pretty"] = "true"
+	}
+
+	if r.Human {
+		params["human"] = "true"
+	}
+
+	if r.ErrorTrace {
+		params["error_trace"] = "true"
+	}
+
+	if len(r.FilterPath) > 0 {
+		params["filter_path"] = strings.Join(r.FilterPath, ",")
+	}
+
+	for k, v := range r.QueryParams {
+		if _, ok := params[k]; !ok {
+			params[k] = v
+		}
+	}
+
+	req, err := newRequest(transport, method, path.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(params) > 0 {
+		q := req.URL.Query()
+		for k, v := range params {
+			q.Set(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	if body != nil && r.Header.Get(headerContentType) == "" {
+		req.Header[headerContentType] = headerContentTypeJSON
+	}
+
+	if len(r.Header) > 0 {
+		for k, vv := range r.Header {
+			for _, v := range vv {
+				req.Header.Add(k, v)
+			}
+		}
+	}
+
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	res, err := transport.Perform(req)
+	if err != nil {
+		return nil, err
+	}
+
+	response := Response{
+		StatusCode: res.StatusCode,
+		Body:       res.Body,
+		Header:     res.Header,
+		codec:      JSONCodecOf(transport),
+	}
+
+	return &response, nil
+}
+
+// WithContext sets the request context.
+func (f InternalUserCreate) WithContext(v context.Context) func(*InternalUserCreateRequest) {
+	return func(r *InternalUserCreateRequest) {
+		r.ctx = v
+	}
+}
+
+// WithBody - The definition of the user, see InternalUserBody.
+func (f InternalUserCreate) WithBody(v io.Reader) func(*InternalUserCreateRequest) {
+	return func(r *InternalUserCreateRequest) {
+		r.Body = v
+	}
+}
+
+// WithJSONBody - the request body, encoded to JSON with the JSON codec of the client; it replaces the body set with WithBody.
+func (f InternalUserCreate) WithJSONBody(v interface{}) func(*InternalUserCreateRequest) {
+	return func(r *InternalUserCreateRequest) {
+		r.Body = JSONBody(v)
+	}
+}
+
+// WithUserBody - The user, validated and encoded as the request body; it replaces the body set with WithBody.
+func (f InternalUserCreate) WithUserBody(v InternalUserBody) func(*InternalUserCreateRequest) {
+	return func(r *InternalUserCreateRequest) {
+		r.UserBody = &v
+	}
+}
+
+// WithQueryParam adds a query parameter which has no dedicated option.
+//
+// Parameters set by the other options take precedence.
+func (f InternalUserCreate) WithQueryParam(key, value string) func(*InternalUserCreateRequest) {
+	return func(r *InternalUserCreateRequest) {
+		if r.QueryParams == nil {
+			r.QueryParams = make(map[string]string)
+		}
+		r.QueryParams[key] = value
+	}
+}
+
+// WithPretty makes the response body pretty-printed.
+func (f InternalUserCreate) WithPretty() func(*InternalUserCreateRequest) {
+	return func(r *InternalUserCreateRequest) {
+		r.Pretty = true
+	}
+}
+
+// WithHuman makes statistical values human-readable.
+func (f InternalUserCreate) WithHuman() func(*InternalUserCreateRequest) {
+	return func(r *InternalUserCreateRequest) {
+		r.Human = true
+	}
+}
+
+// WithErrorTrace includes the stack trace for errors in the response body.
+func (f InternalUserCreate) WithErrorTrace() func(*InternalUserCreateRequest) {
+	return func(r *InternalUserCreateRequest) {
+		r.ErrorTrace = true
+	}
+}
+
+// WithDebug makes the response body pretty-printed and human-readable, and includes the stack trace for errors.
+func (f InternalUserCreate) WithDebug() func(*InternalUserCreateRequest) {
+	return func(r *InternalUserCreateRequest) {
+		r.Pretty = true
+		r.Human = true
+		r.ErrorTrace = true
+	}
+}
+
+// WithFilterPath filters the properties of the response body.
+func (f InternalUserCreate) WithFilterPath(v ...string) func(*InternalUserCreateRequest) {
+	return func(r *InternalUserCreateRequest) {
+		r.FilterPath = v
+	}
+}
+
+// WithHeader adds the headers to the HTTP request.
+func (f InternalUserCreate) WithHeader(h map[string]string) func(*InternalUserCreateRequest) {
+	return func(r *InternalUserCreateRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, v := range h {
+			r.Header.Add(k, v)
+		}
+	}
+}
+
+// WithHTTPHeader adds the headers to the HTTP request, keeping the multiple values of a header.
+func (f InternalUserCreate) WithHTTPHeader(h http.Header) func(*InternalUserCreateRequest) {
+	return func(r *InternalUserCreateRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, vv := range h {
+			for _, v := range vv {
+				r.Header.Add(k, v)
+			}
+		}
+	}
+}
+
+// WithAccept sets the media type of the response, eg. "application/yaml"; the default is JSON.
+func (f InternalUserCreate) WithAccept(v string) func(*InternalUserCreateRequest) {
+	return func(r *InternalUserCreateRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Accept", v)
+	}
+}
+
+// WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
+func (f InternalUserCreate) WithOpaqueID(s string) func(*InternalUserCreateRequest) {
+	return func(r *InternalUserCreateRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("X-Opaque-Id", s)
+	}
+}
+
+// WithContentType sets the Content-Type header of the request, overriding the default of the request body.
+func (f InternalUserCreate) WithContentType(v string) func(*InternalUserCreateRequest) {
+	return func(r *InternalUserCreateRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Content-Type", v)
+	}
+}
+
+// WithBasicAuth sets the credentials of the request, overriding the credentials of the client.
+func (f InternalUserCreate) WithBasicAuth(username, password string) func(*InternalUserCreateRequest) {
+	return func(r *InternalUserCreateRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Authorization", basicAuth(username, password))
+	}
+}
+
+// WithMethodOverride sends the request as POST, with the X-HTTP-Method-Override header set to PUT,
+// for the proxies which block PUT requests.
+func (f InternalUserCreate) WithMethodOverride() func(*InternalUserCreateRequest) {
+	return func(r *InternalUserCreateRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("X-HTTP-Method-Override", "PUT")
+	}
+}
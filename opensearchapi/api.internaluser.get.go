@@ -0,0 +1,261 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchapi
+
+import (
+	"context"
+	"net/http"
+	"strings"
+)
+
+func newInternalUserGetFunc(t Transport) InternalUserGet {
+	return func(o ...func(*InternalUserGetRequest)) (*Response, error) {
+		var r = InternalUserGetRequest{}
+		for _, f := range o {
+			f(&r)
+		}
+		return r.Do(r.ctx, t)
+	}
+}
+
+// ----- API Definition -------------------------------------------------------
+
+// InternalUserGet returns an internal user, or all internal users when no user is specified.
+type InternalUserGet func(o ...func(*InternalUserGetRequest)) (*Response, error)
+
+// InternalUserGetRequest configures the Internal User Get API request.
+type InternalUserGetRequest struct {
+	Username string
+
+	QueryParams map[string]string
+
+	Pretty     bool
+	Human      bool
+	ErrorTrace bool
+	FilterPath []string
+
+	Header http.Header
+
+	ctx context.Context
+}
+
+// Do executes the request and returns response or error.
+func (r InternalUserGetRequest) Do(ctx context.Context, transport Transport) (*Response, error) {
+	var (
+		method string
+		path   strings.Builder
+		params map[string]string
+	)
+
+	method = "GET"
+
+	path.Grow(39 + len(r.Username))
+	path.WriteString("/_plugins/_security/api/internalusers")
+	if r.Username != "" {
+		username, err := escapeName("user", r.Username)
+		if err != nil {
+			return nil, err
+		}
+		path.WriteString("/")
+		path.WriteString(username)
+	}
+
+	params = make(map[string]string)
+
+	if r.Pretty {
+		params["pretty"] = "true"
+	}
+
+	if r.Human {
+		params["human"] = "true"
+	}
+
+	if r.ErrorTrace {
+		params["error_trace"] = "true"
+	}
+
+	if len(r.FilterPath) > 0 {
+		params["filter_path"] = strings.Join(r.FilterPath, ",")
+	}
+
+	for k, v := range r.QueryParams {
+		if _, ok := params[k]; !ok {
+			params[k] = v
+		}
+	}
+
+	req, err := newRequest(transport, method, path.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(params) > 0 {
+		q := req.URL.Query()
+		for k, v := range params {
+			q.Set(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	if len(r.Header) > 0 {
+		for k, vv := range r.Header {
+			for _, v := range vv {
+				req.Header.Add(k, v)
+			}
+		}
+	}
+
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	res, err := transport.Perform(req)
+	if err != nil {
+		return nil, err
+	}
+
+	response := Response{
+		StatusCode: res.StatusCode,
+		Body:       res.Body,
+		Header:     res.Header,
+		codec:      JSONCodecOf(transport),
+	}
+
+	return &response, nil
+}
+
+// WithContext sets the request context.
+func (f InternalUserGet) WithContext(v context.Context) func(*InternalUserGetRequest) {
+	return func(r *InternalUserGetRequest) {
+		r.ctx = v
+	}
+}
+
+// WithUsername - the name of the user; all internal users are returned when not set.
+func (f InternalUserGet) WithUsername(v string) func(*InternalUserGetRequest) {
+	return func(r *InternalUserGetRequest) {
+		r.Username = v
+	}
+}
+
+// WithQueryParam adds a query parameter which has no dedicated option.
+//
+// Parameters set by the other options take precedence.
+func (f InternalUserGet) WithQueryParam(key, value string) func(*InternalUserGetRequest) {
+	return func(r *InternalUserGetRequest) {
+		if r.QueryParams == nil {
+			r.QueryParams = make(map[string]string)
+		}
+		r.QueryParams[key] = value
+	}
+}
+
+// WithPretty makes the response body pretty-printed.
+func (f InternalUserGet) WithPretty() func(*InternalUserGetRequest) {
+	return func(r *InternalUserGetRequest) {
+		r.Pretty = true
+	}
+}
+
+// WithHuman makes statistical values human-readable.
+func (f InternalUserGet) WithHuman() func(*InternalUserGetRequest) {
+	return func(r *InternalUserGetRequest) {
+		r.Human = true
+	}
+}
+
+// WithErrorTrace includes the stack trace for errors in the response body.
+func (f InternalUserGet) WithErrorTrace() func(*InternalUserGetRequest) {
+	return func(r *InternalUserGetRequest) {
+		r.ErrorTrace = true
+	}
+}
+
+// WithDebug makes the response body pretty-printed and human-readable, and includes the stack trace for errors.
+func (f InternalUserGet) WithDebug() func(*InternalUserGetRequest) {
+	return func(r *InternalUserGetRequest) {
+		r.Pretty = true
+		r.Human = true
+		r.ErrorTrace = true
+	}
+}
+
+// WithFilterPath filters the properties of the response body.
+func (f InternalUserGet) WithFilterPath(v ...string) func(*InternalUserGetRequest) {
+	return func(r *InternalUserGetRequest) {
+		r.FilterPath = v
+	}
+}
+
+// WithHeader adds the headers to the HTTP request.
+func (f InternalUserGet) WithHeader(h map[string]string) func(*InternalUserGetRequest) {
+	return func(r *InternalUserGetRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, v := range h {
+			r.Header.Add(k, v)
+		}
+	}
+}
+
+// WithHTTPHeader adds the headers to the HTTP request, keeping the multiple values of a header.
+func (f InternalUserGet) WithHTTPHeader(h http.Header) func(*InternalUserGetRequest) {
+	return func(r *InternalUserGetRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, vv := range h {
+			for _, v := range vv {
+				r.Header.Add(k, v)
+			}
+		}
+	}
+}
+
+// WithAccept sets the media type of the response, eg. "application/yaml"; the default is JSON.
+func (f InternalUserGet) WithAccept(v string) func(*InternalUserGetRequest) {
+	return func(r *InternalUserGetRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Accept", v)
+	}
+}
+
+// WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
+func (f InternalUserGet) WithOpaqueID(s string) func(*InternalUserGetRequest) {
+	return func(r *InternalUserGetRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("X-Opaque-Id", s)
+	}
+}
+
+// WithBasicAuth sets the credentials of the request, overriding the credentials of the client.
+func (f InternalUserGet) WithBasicAuth(username, password string) func(*InternalUserGetRequest) {
+	return func(r *InternalUserGetRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Authorization", basicAuth(username, password))
+	}
+}
+
+// WithNoCache bypasses the response cache of the client, when enabled.
+func (f InternalUserGet) WithNoCache() func(*InternalUserGetRequest) {
+	return func(r *InternalUserGetRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Cache-Control", "no-cache")
+	}
+}
@@ -0,0 +1,308 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchapi
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+func newISMPolicyCreateFunc(t Transport) ISMPolicyCreate {
+	return func(policyID string, body io.Reader, o ...func(*ISMPolicyCreateRequest)) (*Response, error) {
+		var r = ISMPolicyCreateRequest{PolicyID: policyID, Body: body}
+		for _, f := range o {
+			f(&r)
+		}
+		return r.Do(r.ctx, t)
+	}
+}
+
+// ----- API Definition -------------------------------------------------------
+
+// ISMPolicyCreate creates or updates an Index State Management policy.
+type ISMPolicyCreate func(policyID string, body io.Reader, o ...func(*ISMPolicyCreateRequest)) (*Response, error)
+
+// ISMPolicyCreateRequest configures the ISM Policy Create API request.
+type ISMPolicyCreateRequest struct {
+	PolicyID string
+
+	Body io.Reader
+
+	IfSeqNo       *int
+	IfPrimaryTerm *int
+
+	QueryParams map[string]string
+
+	Pretty     bool
+	Human      bool
+	ErrorTrace bool
+	FilterPath []string
+
+	Header http.Header
+
+	ctx context.Context
+}
+
+// Do executes the request and returns response or error.
+func (r ISMPolicyCreateRequest) Do(ctx context.Context, transport Transport) (*Response, error) {
+	var (
+		method string
+		path   strings.Builder
+		params map[string]string
+	)
+
+	method = "PUT"
+
+	policy, err := escapeName("policy", r.PolicyID)
+	if err != nil {
+		return nil, err
+	}
+
+	path.Grow(24 + len(policy))
+	path.WriteString("/_plugins/_ism/policies/")
+	path.WriteString(policy)
+
+	params = make(map[string]string)
+
+	if r.IfSeqNo != nil {
+		params["if_seq_no"] = strconv.Itoa(*r.IfSeqNo)
+	}
+
+	if r.IfPrimaryTerm != nil {
+		params["if_primary_term"] = strconv.Itoa(*r.IfPrimaryTerm)
+	}
+
+	if r.Pretty {
+		params["pretty"] = "true"
+	}
+
+	if r.Human {
+		params["human"] = "true"
+	}
+
+	if r.ErrorTrace {
+		params["error_trace"] = "true"
+	}
+
+	if len(r.FilterPath) > 0 {
+		params["filter_path"] = strings.Join(r.FilterPath, ",")
+	}
+
+	for k, v := range r.QueryParams {
+		if _, ok := params[k]; !ok {
+			params[k] = v
+		}
+	}
+
+	req, err := newRequest(transport, method, path.String(), r.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(params) > 0 {
+		q := req.URL.Query()
+		for k, v := range params {
+			q.Set(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	if r.Body != nil && r.Header.Get(headerContentType) == "" {
+		req.Header[headerContentType] = headerContentTypeJSON
+	}
+
+	if len(r.Header) > 0 {
+		for k, vv := range r.Header {
+			for _, v := range vv {
+				req.Header.Add(k, v)
+			}
+		}
+	}
+
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	res, err := transport.Perform(req)
+	if err != nil {
+		return nil, err
+	}
+
+	response := Response{
+		StatusCode: res.StatusCode,
+		Body:       res.Body,
+		Header:     res.Header,
+		codec:      JSONCodecOf(transport),
+	}
+
+	return &response, nil
+}
+
+// WithContext sets the request context.
+func (f ISMPolicyCreate) WithContext(v context.Context) func(*ISMPolicyCreateRequest) {
+	return func(r *ISMPolicyCreateRequest) {
+		r.ctx = v
+	}
+}
+
+// WithBody - The policy definition.
+func (f ISMPolicyCreate) WithBody(v io.Reader) func(*ISMPolicyCreateRequest) {
+	return func(r *ISMPolicyCreateRequest) {
+		r.Body = v
+	}
+}
+
+// WithJSONBody - the request body, encoded to JSON with the JSON codec of the client; it replaces the body set with WithBody.
+func (f ISMPolicyCreate) WithJSONBody(v interface{}) func(*ISMPolicyCreateRequest) {
+	return func(r *ISMPolicyCreateRequest) {
+		r.Body = JSONBody(v)
+	}
+}
+
+// WithIfSeqNo - only update the policy if its last change has the specified sequence number.
+func (f ISMPolicyCreate) WithIfSeqNo(v int) func(*ISMPolicyCreateRequest) {
+	return func(r *ISMPolicyCreateRequest) {
+		r.IfSeqNo = &v
+	}
+}
+
+// WithIfPrimaryTerm - only update the policy if its last change has the specified primary term.
+func (f ISMPolicyCreate) WithIfPrimaryTerm(v int) func(*ISMPolicyCreateRequest) {
+	return func(r *ISMPolicyCreateRequest) {
+		r.IfPrimaryTerm = &v
+	}
+}
+
+// WithSeqNoPrimaryTerm - only update the policy if its last change has the specified sequence number and primary term,
+// as returned by ISMPolicyGet; updates of existing policies require them.
+func (f ISMPolicyCreate) WithSeqNoPrimaryTerm(seqNo, primaryTerm int) func(*ISMPolicyCreateRequest) {
+	return func(r *ISMPolicyCreateRequest) {
+		r.IfSeqNo = &seqNo
+		r.IfPrimaryTerm = &primaryTerm
+	}
+}
+
+// WithQueryParam adds a query parameter which has no dedicated option.
+//
+// Parameters set by the other options take precedence.
+func (f ISMPolicyCreate) WithQueryParam(key, value string) func(*ISMPolicyCreateRequest) {
+	return func(r *ISMPolicyCreateRequest) {
+		if r.QueryParams == nil {
+			r.QueryParams = make(map[string]string)
+		}
+		r.QueryParams[key] = value
+	}
+}
+
+// WithPretty makes the response body pretty-printed.
+func (f ISMPolicyCreate) WithPretty() func(*ISMPolicyCreateRequest) {
+	return func(r *ISMPolicyCreateRequest) {
+		r.Pretty = true
+	}
+}
+
+// WithHuman makes statistical values human-readable.
+func (f ISMPolicyCreate) WithHuman() func(*ISMPolicyCreateRequest) {
+	return func(r *ISMPolicyCreateRequest) {
+		r.Human = true
+	}
+}
+
+// WithErrorTrace includes the stack trace for errors in the response body.
+func (f ISMPolicyCreate) WithErrorTrace() func(*ISMPolicyCreateRequest) {
+	return func(r *ISMPolicyCreateRequest) {
+		r.ErrorTrace = true
+	}
+}
+
+// WithDebug makes the response body pretty-printed and human-readable, and includes the stack trace for errors.
+func (f ISMPolicyCreate) WithDebug() func(*ISMPolicyCreateRequest) {
+	return func(r *ISMPolicyCreateRequest) {
+		r.Pretty = true
+		r.Human = true
+		r.ErrorTrace = true
+	}
+}
+
+// WithFilterPath filters the properties of the response body.
+func (f ISMPolicyCreate) WithFilterPath(v ...string) func(*ISMPolicyCreateRequest) {
+	return func(r *ISMPolicyCreateRequest) {
+		r.FilterPath = v
+	}
+}
+
+// WithHeader adds the headers to the HTTP request.
+func (f ISMPolicyCreate) WithHeader(h map[string]string) func(*ISMPolicyCreateRequest) {
+	return func(r *ISMPolicyCreateRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, v := range h {
+			r.Header.Add(k, v)
+		}
+	}
+}
+
+// WithHTTPHeader adds the headers to the HTTP request, keeping the multiple values of a header.
+func (f ISMPolicyCreate) WithHTTPHeader(h http.Header) func(*ISMPolicyCreateRequest) {
+	return func(r *ISMPolicyCreateRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, vv := range h {
+			for _, v := range vv {
+				r.Header.Add(k, v)
+			}
+		}
+	}
+}
+
+// WithAccept sets the media type of the response, eg. "application/yaml"; the default is JSON.
+func (f ISMPolicyCreate) WithAccept(v string) func(*ISMPolicyCreateRequest) {
+	return func(r *ISMPolicyCreateRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Accept", v)
+	}
+}
+
+// WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
+func (f ISMPolicyCreate) WithOpaqueID(s string) func(*ISMPolicyCreateRequest) {
+	return func(r *ISMPolicyCreateRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("X-Opaque-Id", s)
+	}
+}
+
+// WithContentType sets the Content-Type header of the request, overriding the default of the request body.
+func (f ISMPolicyCreate) WithContentType(v string) func(*ISMPolicyCreateRequest) {
+	return func(r *ISMPolicyCreateRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Content-Type", v)
+	}
+}
+
+// WithBasicAuth sets the credentials of the request, overriding the credentials of the client.
+func (f ISMPolicyCreate) WithBasicAuth(username, password string) func(*ISMPolicyCreateRequest) {
+	return func(r *ISMPolicyCreateRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Authorization", basicAuth(username, password))
+	}
+}
@@ -0,0 +1,251 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchapi
+
+import (
+	"context"
+	"net/http"
+	"strings"
+)
+
+func newISMPolicyGetFunc(t Transport) ISMPolicyGet {
+	return func(o ...func(*ISMPolicyGetRequest)) (*Response, error) {
+		var r = ISMPolicyGetRequest{}
+		for _, f := range o {
+			f(&r)
+		}
+		return r.Do(r.ctx, t)
+	}
+}
+
+// ----- API Definition -------------------------------------------------------
+
+// ISMPolicyGet returns an Index State Management policy, or all policies when no policy is specified.
+type ISMPolicyGet func(o ...func(*ISMPolicyGetRequest)) (*Response, error)
+
+// ISMPolicyGetRequest configures the ISM Policy Get API request.
+type ISMPolicyGetRequest struct {
+	PolicyID string
+
+	QueryParams map[string]string
+
+	Pretty     bool
+	Human      bool
+	ErrorTrace bool
+	FilterPath []string
+
+	Header http.Header
+
+	ctx context.Context
+}
+
+// Do executes the request and returns response or error.
+func (r ISMPolicyGetRequest) Do(ctx context.Context, transport Transport) (*Response, error) {
+	var (
+		method string
+		path   strings.Builder
+		params map[string]string
+	)
+
+	method = "GET"
+
+	path.Grow(24 + len(r.PolicyID))
+	path.WriteString("/_plugins/_ism/policies")
+	if r.PolicyID != "" {
+		policy, err := escapeName("policy", r.PolicyID)
+		if err != nil {
+			return nil, err
+		}
+		path.WriteString("/")
+		path.WriteString(policy)
+	}
+
+	params = make(map[string]string)
+
+	if r.Pretty {
+		params["pretty"] = "true"
+	}
+
+	if r.Human {
+		params["human"] = "true"
+	}
+
+	if r.ErrorTrace {
+		params["error_trace"] = "true"
+	}
+
+	if len(r.FilterPath) > 0 {
+		params["filter_path"] = strings.Join(r.FilterPath, ",")
+	}
+
+	for k, v := range r.QueryParams {
+		if _, ok := params[k]; !ok {
+			params[k] = v
+		}
+	}
+
+	req, err := newRequest(transport, method, path.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(params) > 0 {
+		q := req.URL.Query()
+		for k, v := range params {
+			q.Set(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	if len(r.Header) > 0 {
+		for k, vv := range r.Header {
+			for _, v := range vv {
+				req.Header.Add(k, v)
+			}
+		}
+	}
+
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	res, err := transport.Perform(req)
+	if err != nil {
+		return nil, err
+	}
+
+	response := Response{
+		StatusCode: res.StatusCode,
+		Body:       res.Body,
+		Header:     res.Header,
+		codec:      JSONCodecOf(transport),
+	}
+
+	return &response, nil
+}
+
+// WithContext sets the request context.
+func (f ISMPolicyGet) WithContext(v context.Context) func(*ISMPolicyGetRequest) {
+	return func(r *ISMPolicyGetRequest) {
+		r.ctx = v
+	}
+}
+
+// WithPolicyID - the ID of the policy; all policies are returned when not set.
+func (f ISMPolicyGet) WithPolicyID(v string) func(*ISMPolicyGetRequest) {
+	return func(r *ISMPolicyGetRequest) {
+		r.PolicyID = v
+	}
+}
+
+// WithQueryParam adds a query parameter which has no dedicated option.
+//
+// Parameters set by the other options take precedence.
+func (f ISMPolicyGet) WithQueryParam(key, value string) func(*ISMPolicyGetRequest) {
+	return func(r *ISMPolicyGetRequest) {
+		if r.QueryParams == nil {
+			r.QueryParams = make(map[string]string)
+		}
+		r.QueryParams[key] = value
+	}
+}
+
+// WithPretty makes the response body pretty-printed.
+func (f ISMPolicyGet) WithPretty() func(*ISMPolicyGetRequest) {
+	return func(r *ISMPolicyGetRequest) {
+		r.Pretty = true
+	}
+}
+
+// WithHuman makes statistical values human-readable.
+func (f ISMPolicyGet) WithHuman() func(*ISMPolicyGetRequest) {
+	return func(r *ISMPolicyGetRequest) {
+		r.Human = true
+	}
+}
+
+// WithErrorTrace includes the stack trace for errors in the response body.
+func (f ISMPolicyGet) WithErrorTrace() func(*ISMPolicyGetRequest) {
+	return func(r *ISMPolicyGetRequest) {
+		r.ErrorTrace = true
+	}
+}
+
+// WithDebug makes the response body pretty-printed and human-readable, and includes the stack trace for errors.
+func (f ISMPolicyGet) WithDebug() func(*ISMPolicyGetRequest) {
+	return func(r *ISMPolicyGetRequest) {
+		r.Pretty = true
+		r.Human = true
+		r.ErrorTrace = true
+	}
+}
+
+// WithFilterPath filters the properties of the response body.
+func (f ISMPolicyGet) WithFilterPath(v ...string) func(*ISMPolicyGetRequest) {
+	return func(r *ISMPolicyGetRequest) {
+		r.FilterPath = v
+	}
+}
+
+// WithHeader adds the headers to the HTTP request.
+func (f ISMPolicyGet) WithHeader(h map[string]string) func(*ISMPolicyGetRequest) {
+	return func(r *ISMPolicyGetRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, v := range h {
+			r.Header.Add(k, v)
+		}
+	}
+}
+
+// WithHTTPHeader adds the headers to the HTTP request, keeping the multiple values of a header.
+func (f ISMPolicyGet) WithHTTPHeader(h http.Header) func(*ISMPolicyGetRequest) {
+	return func(r *ISMPolicyGetRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, vv := range h {
+			for _, v := range vv {
+				r.Header.Add(k, v)
+			}
+		}
+	}
+}
+
+// WithAccept sets the media type of the response, eg. "application/yaml"; the default is JSON.
+func (f ISMPolicyGet) WithAccept(v string) func(*ISMPolicyGetRequest) {
+	return func(r *ISMPolicyGetRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Accept", v)
+	}
+}
+
+// WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
+func (f ISMPolicyGet) WithOpaqueID(s string) func(*ISMPolicyGetRequest) {
+	return func(r *ISMPolicyGetRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("X-Opaque-Id", s)
+	}
+}
+
+// WithBasicAuth sets the credentials of the request, overriding the credentials of the client.
+func (f ISMPolicyGet) WithBasicAuth(username, password string) func(*ISMPolicyGetRequest) {
+	return func(r *ISMPolicyGetRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Authorization", basicAuth(username, password))
+	}
+}
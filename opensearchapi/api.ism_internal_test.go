@@ -0,0 +1,85 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+//go:build !integration
+// +build !integration
+
+package opensearchapi
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestISM(t *testing.T) {
+	var req *http.Request
+	tp := &mockTransport{RoundTripFunc: func(r *http.Request) (*http.Response, error) {
+		req = r
+		return &http.Response{StatusCode: 200}, nil
+	}}
+	api := New(tp)
+
+	t.Run("Create policy", func(t *testing.T) {
+		create := api.ISM.CreatePolicy
+		_, err := create("rollover", strings.NewReader(`{"policy":{}}`), create.WithSeqNoPrimaryTerm(7, 1))
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if req.Method != "PUT" || req.URL.Path != "/_plugins/_ism/policies/rollover" {
+			t.Errorf("Unexpected request: %s %s", req.Method, req.URL.Path)
+		}
+		if req.URL.RawQuery != "if_primary_term=1&if_seq_no=7" {
+			t.Errorf("Unexpected query: %s", req.URL.RawQuery)
+		}
+		if req.Header.Get("Content-Type") != "application/json" {
+			t.Errorf("Unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		}
+	})
+
+	t.Run("Get policy", func(t *testing.T) {
+		get := api.ISM.GetPolicy
+		if _, err := get(); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if req.Method != "GET" || req.URL.Path != "/_plugins/_ism/policies" {
+			t.Errorf("Unexpected request: %s %s", req.Method, req.URL.Path)
+		}
+
+		if _, err := get(get.WithPolicyID("rollover")); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if req.URL.Path != "/_plugins/_ism/policies/rollover" {
+			t.Errorf("Unexpected path: %s", req.URL.Path)
+		}
+	})
+
+	t.Run("Delete policy", func(t *testing.T) {
+		if _, err := api.ISM.DeletePolicy("rollover"); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if req.Method != "DELETE" || req.URL.Path != "/_plugins/_ism/policies/rollover" {
+			t.Errorf("Unexpected request: %s %s", req.Method, req.URL.Path)
+		}
+
+		if _, err := api.ISM.DeletePolicy(""); err == nil {
+			t.Errorf("Expected error for empty policy ID")
+		}
+	})
+
+	t.Run("Explain", func(t *testing.T) {
+		explain := api.ISM.Explain
+		if _, err := explain(explain.WithIndex("logs-1", "logs-2")); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if req.Method != "GET" || req.URL.Path != "/_plugins/_ism/explain/logs-1,logs-2" {
+			t.Errorf("Unexpected request: %s %s", req.Method, req.URL.Path)
+		}
+	})
+}
@@ -150,7 +150,7 @@ func (r MgetRequest) Do(ctx context.Context, transport Transport) (*Response, er
 		params["filter_path"] = strings.Join(r.FilterPath, ",")
 	}
 
-	req, err := newRequest(method, path.String(), r.Body)
+	req, err := newRequest(transport, method, path.String(), r.Body)
 	if err != nil {
 		return nil, err
 	}
@@ -163,18 +163,14 @@ func (r MgetRequest) Do(ctx context.Context, transport Transport) (*Response, er
 		req.URL.RawQuery = q.Encode()
 	}
 
-	if r.Body != nil {
+	if r.Body != nil && r.Header.Get(headerContentType) == "" {
 		req.Header[headerContentType] = headerContentTypeJSON
 	}
 
 	if len(r.Header) > 0 {
-		if len(req.Header) == 0 {
-			req.Header = r.Header
-		} else {
-			for k, vv := range r.Header {
-				for _, v := range vv {
-					req.Header.Add(k, v)
-				}
+		for k, vv := range r.Header {
+			for _, v := range vv {
+				req.Header.Add(k, v)
 			}
 		}
 	}
@@ -192,6 +188,7 @@ func (r MgetRequest) Do(ctx context.Context, transport Transport) (*Response, er
 		StatusCode: res.StatusCode,
 		Body:       res.Body,
 		Header:     res.Header,
+		codec:      JSONCodecOf(transport),
 	}
 
 	return &response, response.Err()
@@ -205,6 +202,14 @@ func (f Mget) WithContext(v context.Context) func(*MgetRequest) {
 	}
 }
 
+// WithJSONBody - the request body, encoded to JSON with the JSON codec of the client; it replaces the body passed to the function.
+//
+func (f Mget) WithJSONBody(v interface{}) func(*MgetRequest) {
+	return func(r *MgetRequest) {
+		r.Body = JSONBody(v)
+	}
+}
+
 // WithIndex - the name of the index.
 //
 func (f Mget) WithIndex(v string) func(*MgetRequest) {
@@ -301,6 +306,16 @@ func (f Mget) WithErrorTrace() func(*MgetRequest) {
 	}
 }
 
+// WithDebug makes the response body pretty-printed and human-readable, and includes the stack trace for errors.
+//
+func (f Mget) WithDebug() func(*MgetRequest) {
+	return func(r *MgetRequest) {
+		r.Pretty = true
+		r.Human = true
+		r.ErrorTrace = true
+	}
+}
+
 // WithFilterPath filters the properties of the response body.
 //
 func (f Mget) WithFilterPath(v ...string) func(*MgetRequest) {
@@ -322,6 +337,32 @@ func (f Mget) WithHeader(h map[string]string) func(*MgetRequest) {
 	}
 }
 
+// WithHTTPHeader adds the headers to the HTTP request, keeping the multiple values of a header.
+//
+func (f Mget) WithHTTPHeader(h http.Header) func(*MgetRequest) {
+	return func(r *MgetRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, vv := range h {
+			for _, v := range vv {
+				r.Header.Add(k, v)
+			}
+		}
+	}
+}
+
+// WithAccept sets the media type of the response, eg. "application/yaml"; the default is JSON.
+//
+func (f Mget) WithAccept(v string) func(*MgetRequest) {
+	return func(r *MgetRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Accept", v)
+	}
+}
+
 // WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
 //
 func (f Mget) WithOpaqueID(s string) func(*MgetRequest) {
@@ -332,3 +373,25 @@ func (f Mget) WithOpaqueID(s string) func(*MgetRequest) {
 		r.Header.Set("X-Opaque-Id", s)
 	}
 }
+
+// WithContentType sets the Content-Type header of the request, overriding the default of the request body.
+//
+func (f Mget) WithContentType(v string) func(*MgetRequest) {
+	return func(r *MgetRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Content-Type", v)
+	}
+}
+
+// WithBasicAuth sets the credentials of the request, overriding the credentials of the client.
+//
+func (f Mget) WithBasicAuth(username, password string) func(*MgetRequest) {
+	return func(r *MgetRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Authorization", basicAuth(username, password))
+	}
+}
@@ -133,7 +133,7 @@ func (r NodesHotThreadsRequest) Do(ctx context.Context, transport Transport) (*R
 		params["filter_path"] = strings.Join(r.FilterPath, ",")
 	}
 
-	req, err := newRequest(method, path.String(), nil)
+	req, err := newRequest(transport, method, path.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -147,13 +147,9 @@ func (r NodesHotThreadsRequest) Do(ctx context.Context, transport Transport) (*R
 	}
 
 	if len(r.Header) > 0 {
-		if len(req.Header) == 0 {
-			req.Header = r.Header
-		} else {
-			for k, vv := range r.Header {
-				for _, v := range vv {
-					req.Header.Add(k, v)
-				}
+		for k, vv := range r.Header {
+			for _, v := range vv {
+				req.Header.Add(k, v)
 			}
 		}
 	}
@@ -171,6 +167,7 @@ func (r NodesHotThreadsRequest) Do(ctx context.Context, transport Transport) (*R
 		StatusCode: res.StatusCode,
 		Body:       res.Body,
 		Header:     res.Header,
+		codec:      JSONCodecOf(transport),
 	}
 
 	return &response, response.Err()
@@ -256,6 +253,16 @@ func (f NodesHotThreads) WithErrorTrace() func(*NodesHotThreadsRequest) {
 	}
 }
 
+// WithDebug makes the response body pretty-printed and human-readable, and includes the stack trace for errors.
+//
+func (f NodesHotThreads) WithDebug() func(*NodesHotThreadsRequest) {
+	return func(r *NodesHotThreadsRequest) {
+		r.Pretty = true
+		r.Human = true
+		r.ErrorTrace = true
+	}
+}
+
 // WithFilterPath filters the properties of the response body.
 //
 func (f NodesHotThreads) WithFilterPath(v ...string) func(*NodesHotThreadsRequest) {
@@ -277,6 +284,32 @@ func (f NodesHotThreads) WithHeader(h map[string]string) func(*NodesHotThreadsRe
 	}
 }
 
+// WithHTTPHeader adds the headers to the HTTP request, keeping the multiple values of a header.
+//
+func (f NodesHotThreads) WithHTTPHeader(h http.Header) func(*NodesHotThreadsRequest) {
+	return func(r *NodesHotThreadsRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, vv := range h {
+			for _, v := range vv {
+				r.Header.Add(k, v)
+			}
+		}
+	}
+}
+
+// WithAccept sets the media type of the response, eg. "application/yaml"; the default is JSON.
+//
+func (f NodesHotThreads) WithAccept(v string) func(*NodesHotThreadsRequest) {
+	return func(r *NodesHotThreadsRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Accept", v)
+	}
+}
+
 // WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
 //
 func (f NodesHotThreads) WithOpaqueID(s string) func(*NodesHotThreadsRequest) {
@@ -287,3 +320,14 @@ func (f NodesHotThreads) WithOpaqueID(s string) func(*NodesHotThreadsRequest) {
 		r.Header.Set("X-Opaque-Id", s)
 	}
 }
+
+// WithBasicAuth sets the credentials of the request, overriding the credentials of the client.
+//
+func (f NodesHotThreads) WithBasicAuth(username, password string) func(*NodesHotThreadsRequest) {
+	return func(r *NodesHotThreadsRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Authorization", basicAuth(username, password))
+	}
+}
@@ -119,7 +119,7 @@ func (r NodesInfoRequest) Do(ctx context.Context, transport Transport) (*Respons
 		params["filter_path"] = strings.Join(r.FilterPath, ",")
 	}
 
-	req, err := newRequest(method, path.String(), nil)
+	req, err := newRequest(transport, method, path.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -133,13 +133,9 @@ func (r NodesInfoRequest) Do(ctx context.Context, transport Transport) (*Respons
 	}
 
 	if len(r.Header) > 0 {
-		if len(req.Header) == 0 {
-			req.Header = r.Header
-		} else {
-			for k, vv := range r.Header {
-				for _, v := range vv {
-					req.Header.Add(k, v)
-				}
+		for k, vv := range r.Header {
+			for _, v := range vv {
+				req.Header.Add(k, v)
 			}
 		}
 	}
@@ -157,6 +153,7 @@ func (r NodesInfoRequest) Do(ctx context.Context, transport Transport) (*Respons
 		StatusCode: res.StatusCode,
 		Body:       res.Body,
 		Header:     res.Header,
+		codec:      JSONCodecOf(transport),
 	}
 
 	return &response, response.Err()
@@ -226,6 +223,16 @@ func (f NodesInfo) WithErrorTrace() func(*NodesInfoRequest) {
 	}
 }
 
+// WithDebug makes the response body pretty-printed and human-readable, and includes the stack trace for errors.
+//
+func (f NodesInfo) WithDebug() func(*NodesInfoRequest) {
+	return func(r *NodesInfoRequest) {
+		r.Pretty = true
+		r.Human = true
+		r.ErrorTrace = true
+	}
+}
+
 // WithFilterPath filters the properties of the response body.
 //
 func (f NodesInfo) WithFilterPath(v ...string) func(*NodesInfoRequest) {
@@ -247,6 +254,32 @@ func (f NodesInfo) WithHeader(h map[string]string) func(*NodesInfoRequest) {
 	}
 }
 
+// WithHTTPHeader adds the headers to the HTTP request, keeping the multiple values of a header.
+//
+func (f NodesInfo) WithHTTPHeader(h http.Header) func(*NodesInfoRequest) {
+	return func(r *NodesInfoRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, vv := range h {
+			for _, v := range vv {
+				r.Header.Add(k, v)
+			}
+		}
+	}
+}
+
+// WithAccept sets the media type of the response, eg. "application/yaml"; the default is JSON.
+//
+func (f NodesInfo) WithAccept(v string) func(*NodesInfoRequest) {
+	return func(r *NodesInfoRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Accept", v)
+	}
+}
+
 // WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
 //
 func (f NodesInfo) WithOpaqueID(s string) func(*NodesInfoRequest) {
@@ -257,3 +290,14 @@ func (f NodesInfo) WithOpaqueID(s string) func(*NodesInfoRequest) {
 		r.Header.Set("X-Opaque-Id", s)
 	}
 }
+
+// WithBasicAuth sets the credentials of the request, overriding the credentials of the client.
+//
+func (f NodesInfo) WithBasicAuth(username, password string) func(*NodesInfoRequest) {
+	return func(r *NodesInfoRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Authorization", basicAuth(username, password))
+	}
+}
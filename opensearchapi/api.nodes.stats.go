@@ -161,7 +161,7 @@ func (r NodesStatsRequest) Do(ctx context.Context, transport Transport) (*Respon
 		params["filter_path"] = strings.Join(r.FilterPath, ",")
 	}
 
-	req, err := newRequest(method, path.String(), nil)
+	req, err := newRequest(transport, method, path.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -175,13 +175,9 @@ func (r NodesStatsRequest) Do(ctx context.Context, transport Transport) (*Respon
 	}
 
 	if len(r.Header) > 0 {
-		if len(req.Header) == 0 {
-			req.Header = r.Header
-		} else {
-			for k, vv := range r.Header {
-				for _, v := range vv {
-					req.Header.Add(k, v)
-				}
+		for k, vv := range r.Header {
+			for _, v := range vv {
+				req.Header.Add(k, v)
 			}
 		}
 	}
@@ -199,6 +195,7 @@ func (r NodesStatsRequest) Do(ctx context.Context, transport Transport) (*Respon
 		StatusCode: res.StatusCode,
 		Body:       res.Body,
 		Header:     res.Header,
+		codec:      JSONCodecOf(transport),
 	}
 
 	return &response, response.Err()
@@ -332,6 +329,16 @@ func (f NodesStats) WithErrorTrace() func(*NodesStatsRequest) {
 	}
 }
 
+// WithDebug makes the response body pretty-printed and human-readable, and includes the stack trace for errors.
+//
+func (f NodesStats) WithDebug() func(*NodesStatsRequest) {
+	return func(r *NodesStatsRequest) {
+		r.Pretty = true
+		r.Human = true
+		r.ErrorTrace = true
+	}
+}
+
 // WithFilterPath filters the properties of the response body.
 //
 func (f NodesStats) WithFilterPath(v ...string) func(*NodesStatsRequest) {
@@ -353,6 +360,32 @@ func (f NodesStats) WithHeader(h map[string]string) func(*NodesStatsRequest) {
 	}
 }
 
+// WithHTTPHeader adds the headers to the HTTP request, keeping the multiple values of a header.
+//
+func (f NodesStats) WithHTTPHeader(h http.Header) func(*NodesStatsRequest) {
+	return func(r *NodesStatsRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, vv := range h {
+			for _, v := range vv {
+				r.Header.Add(k, v)
+			}
+		}
+	}
+}
+
+// WithAccept sets the media type of the response, eg. "application/yaml"; the default is JSON.
+//
+func (f NodesStats) WithAccept(v string) func(*NodesStatsRequest) {
+	return func(r *NodesStatsRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Accept", v)
+	}
+}
+
 // WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
 //
 func (f NodesStats) WithOpaqueID(s string) func(*NodesStatsRequest) {
@@ -363,3 +396,14 @@ func (f NodesStats) WithOpaqueID(s string) func(*NodesStatsRequest) {
 		r.Header.Set("X-Opaque-Id", s)
 	}
 }
+
+// WithBasicAuth sets the credentials of the request, overriding the credentials of the client.
+//
+func (f NodesStats) WithBasicAuth(username, password string) func(*NodesStatsRequest) {
+	return func(r *NodesStatsRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Authorization", basicAuth(username, password))
+	}
+}
@@ -115,7 +115,7 @@ func (r NodesUsageRequest) Do(ctx context.Context, transport Transport) (*Respon
 		params["filter_path"] = strings.Join(r.FilterPath, ",")
 	}
 
-	req, err := newRequest(method, path.String(), nil)
+	req, err := newRequest(transport, method, path.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -129,13 +129,9 @@ func (r NodesUsageRequest) Do(ctx context.Context, transport Transport) (*Respon
 	}
 
 	if len(r.Header) > 0 {
-		if len(req.Header) == 0 {
-			req.Header = r.Header
-		} else {
-			for k, vv := range r.Header {
-				for _, v := range vv {
-					req.Header.Add(k, v)
-				}
+		for k, vv := range r.Header {
+			for _, v := range vv {
+				req.Header.Add(k, v)
 			}
 		}
 	}
@@ -153,6 +149,7 @@ func (r NodesUsageRequest) Do(ctx context.Context, transport Transport) (*Respon
 		StatusCode: res.StatusCode,
 		Body:       res.Body,
 		Header:     res.Header,
+		codec:      JSONCodecOf(transport),
 	}
 
 	return &response, response.Err()
@@ -214,6 +211,16 @@ func (f NodesUsage) WithErrorTrace() func(*NodesUsageRequest) {
 	}
 }
 
+// WithDebug makes the response body pretty-printed and human-readable, and includes the stack trace for errors.
+//
+func (f NodesUsage) WithDebug() func(*NodesUsageRequest) {
+	return func(r *NodesUsageRequest) {
+		r.Pretty = true
+		r.Human = true
+		r.ErrorTrace = true
+	}
+}
+
 // WithFilterPath filters the properties of the response body.
 //
 func (f NodesUsage) WithFilterPath(v ...string) func(*NodesUsageRequest) {
@@ -235,6 +242,32 @@ func (f NodesUsage) WithHeader(h map[string]string) func(*NodesUsageRequest) {
 	}
 }
 
+// WithHTTPHeader adds the headers to the HTTP request, keeping the multiple values of a header.
+//
+func (f NodesUsage) WithHTTPHeader(h http.Header) func(*NodesUsageRequest) {
+	return func(r *NodesUsageRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, vv := range h {
+			for _, v := range vv {
+				r.Header.Add(k, v)
+			}
+		}
+	}
+}
+
+// WithAccept sets the media type of the response, eg. "application/yaml"; the default is JSON.
+//
+func (f NodesUsage) WithAccept(v string) func(*NodesUsageRequest) {
+	return func(r *NodesUsageRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Accept", v)
+	}
+}
+
 // WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
 //
 func (f NodesUsage) WithOpaqueID(s string) func(*NodesUsageRequest) {
@@ -245,3 +278,14 @@ func (f NodesUsage) WithOpaqueID(s string) func(*NodesUsageRequest) {
 		r.Header.Set("X-Opaque-Id", s)
 	}
 }
+
+// WithBasicAuth sets the credentials of the request, overriding the credentials of the client.
+//
+func (f NodesUsage) WithBasicAuth(username, password string) func(*NodesUsageRequest) {
+	return func(r *NodesUsageRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Authorization", basicAuth(username, password))
+	}
+}
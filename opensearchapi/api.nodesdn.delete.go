@@ -0,0 +1,253 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchapi
+
+import (
+	"context"
+	"net/http"
+	"strings"
+)
+
+func newNodesDNDeleteFunc(t Transport) NodesDNDelete {
+	return func(clusterName string, o ...func(*NodesDNDeleteRequest)) (*Response, error) {
+		var r = NodesDNDeleteRequest{ClusterName: clusterName}
+		for _, f := range o {
+			f(&r)
+		}
+		return r.Do(r.ctx, t)
+	}
+}
+
+// ----- API Definition -------------------------------------------------------
+
+// NodesDNDelete removes the distinguished names of the nodes allowed for a cluster.
+type NodesDNDelete func(clusterName string, o ...func(*NodesDNDeleteRequest)) (*Response, error)
+
+// NodesDNDeleteRequest configures the Nodes DN Delete API request.
+type NodesDNDeleteRequest struct {
+	ClusterName string
+
+	QueryParams map[string]string
+
+	Pretty     bool
+	Human      bool
+	ErrorTrace bool
+	FilterPath []string
+
+	Header http.Header
+
+	ctx context.Context
+}
+
+// Do executes the request and returns response or error.
+func (r NodesDNDeleteRequest) Do(ctx context.Context, transport Transport) (*Response, error) {
+	var (
+		method string
+		path   strings.Builder
+		params map[string]string
+	)
+
+	method = "DELETE"
+
+	cluster, err := escapeName("cluster", r.ClusterName)
+	if err != nil {
+		return nil, err
+	}
+
+	path.Grow(33 + len(cluster))
+	path.WriteString("/_plugins/_security/api/nodesdn/")
+	path.WriteString(cluster)
+
+	params = make(map[string]string)
+
+	if r.Pretty {
+		params["pretty"] = "true"
+	}
+
+	if r.Human {
+		params["human"] = "true"
+	}
+
+	if r.ErrorTrace {
+		params["error_trace"] = "true"
+	}
+
+	if len(r.FilterPath) > 0 {
+		params["filter_path"] = strings.Join(r.FilterPath, ",")
+	}
+
+	for k, v := range r.QueryParams {
+		if _, ok := params[k]; !ok {
+			params[k] = v
+		}
+	}
+
+	req, err := newRequest(transport, method, path.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(params) > 0 {
+		q := req.URL.Query()
+		for k, v := range params {
+			q.Set(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	if len(r.Header) > 0 {
+		for k, vv := range r.Header {
+			for _, v := range vv {
+				req.Header.Add(k, v)
+			}
+		}
+	}
+
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	res, err := transport.Perform(req)
+	if err != nil {
+		return nil, err
+	}
+
+	response := Response{
+		StatusCode: res.StatusCode,
+		Body:       res.Body,
+		Header:     res.Header,
+		codec:      JSONCodecOf(transport),
+	}
+
+	return &response, nil
+}
+
+// WithContext sets the request context.
+func (f NodesDNDelete) WithContext(v context.Context) func(*NodesDNDeleteRequest) {
+	return func(r *NodesDNDeleteRequest) {
+		r.ctx = v
+	}
+}
+
+// WithQueryParam adds a query parameter which has no dedicated option.
+//
+// Parameters set by the other options take precedence.
+func (f NodesDNDelete) WithQueryParam(key, value string) func(*NodesDNDeleteRequest) {
+	return func(r *NodesDNDeleteRequest) {
+		if r.QueryParams == nil {
+			r.QueryParams = make(map[string]string)
+		}
+		r.QueryParams[key] = value
+	}
+}
+
+// WithPretty makes the response body pretty-printed.
+func (f NodesDNDelete) WithPretty() func(*NodesDNDeleteRequest) {
+	return func(r *NodesDNDeleteRequest) {
+		r.Pretty = true
+	}
+}
+
+// WithHuman makes statistical values human-readable.
+func (f NodesDNDelete) WithHuman() func(*NodesDNDeleteRequest) {
+	return func(r *NodesDNDeleteRequest) {
+		r.Human = true
+	}
+}
+
+// WithErrorTrace includes the stack trace for errors in the response body.
+func (f NodesDNDelete) WithErrorTrace() func(*NodesDNDeleteRequest) {
+	return func(r *NodesDNDeleteRequest) {
+		r.ErrorTrace = true
+	}
+}
+
+// WithDebug makes the response body pretty-printed and human-readable, and includes the stack trace for errors.
+func (f NodesDNDelete) WithDebug() func(*NodesDNDeleteRequest) {
+	return func(r *NodesDNDeleteRequest) {
+		r.Pretty = true
+		r.Human = true
+		r.ErrorTrace = true
+	}
+}
+
+// WithFilterPath filters the properties of the response body.
+func (f NodesDNDelete) WithFilterPath(v ...string) func(*NodesDNDeleteRequest) {
+	return func(r *NodesDNDeleteRequest) {
+		r.FilterPath = v
+	}
+}
+
+// WithHeader adds the headers to the HTTP request.
+func (f NodesDNDelete) WithHeader(h map[string]string) func(*NodesDNDeleteRequest) {
+	return func(r *NodesDNDeleteRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, v := range h {
+			r.Header.Add(k, v)
+		}
+	}
+}
+
+// WithHTTPHeader adds the headers to the HTTP request, keeping the multiple values of a header.
+func (f NodesDNDelete) WithHTTPHeader(h http.Header) func(*NodesDNDeleteRequest) {
+	return func(r *NodesDNDeleteRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, vv := range h {
+			for _, v := range vv {
+				r.Header.Add(k, v)
+			}
+		}
+	}
+}
+
+// WithAccept sets the media type of the response, eg. "application/yaml"; the default is JSON.
+func (f NodesDNDelete) WithAccept(v string) func(*NodesDNDeleteRequest) {
+	return func(r *NodesDNDeleteRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Accept", v)
+	}
+}
+
+// WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
+func (f NodesDNDelete) WithOpaqueID(s string) func(*NodesDNDeleteRequest) {
+	return func(r *NodesDNDeleteRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("X-Opaque-Id", s)
+	}
+}
+
+// WithBasicAuth sets the credentials of the request, overriding the credentials of the client.
+func (f NodesDNDelete) WithBasicAuth(username, password string) func(*NodesDNDeleteRequest) {
+	return func(r *NodesDNDeleteRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Authorization", basicAuth(username, password))
+	}
+}
+
+// WithMethodOverride sends the request as POST, with the X-HTTP-Method-Override header set to DELETE,
+// for the proxies which block DELETE requests.
+func (f NodesDNDelete) WithMethodOverride() func(*NodesDNDeleteRequest) {
+	return func(r *NodesDNDeleteRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("X-HTTP-Method-Override", "DELETE")
+	}
+}
@@ -0,0 +1,275 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchapi
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+func newNodesDNGetFunc(t Transport) NodesDNGet {
+	return func(o ...func(*NodesDNGetRequest)) (*Response, error) {
+		var r = NodesDNGetRequest{}
+		for _, f := range o {
+			f(&r)
+		}
+		return r.Do(r.ctx, t)
+	}
+}
+
+// ----- API Definition -------------------------------------------------------
+
+// NodesDNGet returns the distinguished names of the nodes allowed for the clusters.
+type NodesDNGet func(o ...func(*NodesDNGetRequest)) (*Response, error)
+
+// NodesDNGetRequest configures the Nodes DN Get API request.
+type NodesDNGetRequest struct {
+	ClusterName string
+
+	ShowAll *bool
+
+	QueryParams map[string]string
+
+	Pretty     bool
+	Human      bool
+	ErrorTrace bool
+	FilterPath []string
+
+	Header http.Header
+
+	ctx context.Context
+}
+
+// Do executes the request and returns response or error.
+func (r NodesDNGetRequest) Do(ctx context.Context, transport Transport) (*Response, error) {
+	var (
+		method string
+		path   strings.Builder
+		params map[string]string
+	)
+
+	method = "GET"
+
+	path.Grow(33 + len(r.ClusterName))
+	path.WriteString("/_plugins/_security/api/nodesdn")
+	if r.ClusterName != "" {
+		cluster, err := escapeName("cluster", r.ClusterName)
+		if err != nil {
+			return nil, err
+		}
+		path.WriteString("/")
+		path.WriteString(cluster)
+	}
+
+	params = make(map[string]string)
+
+	if r.ShowAll != nil {
+		params["show_all"] = strconv.FormatBool(*r.ShowAll)
+	}
+
+	if r.Pretty {
+		params["pretty"] = "true"
+	}
+
+	if r.Human {
+		params["human"] = "true"
+	}
+
+	if r.ErrorTrace {
+		params["error_trace"] = "true"
+	}
+
+	if len(r.FilterPath) > 0 {
+		params["filter_path"] = strings.Join(r.FilterPath, ",")
+	}
+
+	for k, v := range r.QueryParams {
+		if _, ok := params[k]; !ok {
+			params[k] = v
+		}
+	}
+
+	req, err := newRequest(transport, method, path.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(params) > 0 {
+		q := req.URL.Query()
+		for k, v := range params {
+			q.Set(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	if len(r.Header) > 0 {
+		for k, vv := range r.Header {
+			for _, v := range vv {
+				req.Header.Add(k, v)
+			}
+		}
+	}
+
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	res, err := transport.Perform(req)
+	if err != nil {
+		return nil, err
+	}
+
+	response := Response{
+		StatusCode: res.StatusCode,
+		Body:       res.Body,
+		Header:     res.Header,
+		codec:      JSONCodecOf(transport),
+	}
+
+	return &response, nil
+}
+
+// WithContext sets the request context.
+func (f NodesDNGet) WithContext(v context.Context) func(*NodesDNGetRequest) {
+	return func(r *NodesDNGetRequest) {
+		r.ctx = v
+	}
+}
+
+// WithClusterName - the name of the cluster; all clusters are returned when not set.
+func (f NodesDNGet) WithClusterName(v string) func(*NodesDNGetRequest) {
+	return func(r *NodesDNGetRequest) {
+		r.ClusterName = v
+	}
+}
+
+// WithShowAll - whether to include the distinguished names from the static configuration.
+func (f NodesDNGet) WithShowAll(v bool) func(*NodesDNGetRequest) {
+	return func(r *NodesDNGetRequest) {
+		r.ShowAll = &v
+	}
+}
+
+// WithQueryParam adds a query parameter which has no dedicated option.
+//
+// Parameters set by the other options take precedence.
+func (f NodesDNGet) WithQueryParam(key, value string) func(*NodesDNGetRequest) {
+	return func(r *NodesDNGetRequest) {
+		if r.QueryParams == nil {
+			r.QueryParams = make(map[string]string)
+		}
+		r.QueryParams[key] = value
+	}
+}
+
+// WithPretty makes the response body pretty-printed.
+func (f NodesDNGet) WithPretty() func(*NodesDNGetRequest) {
+	return func(r *NodesDNGetRequest) {
+		r.Pretty = true
+	}
+}
+
+// WithHuman makes statistical values human-readable.
+func (f NodesDNGet) WithHuman() func(*NodesDNGetRequest) {
+	return func(r *NodesDNGetRequest) {
+		r.Human = true
+	}
+}
+
+// WithErrorTrace includes the stack trace for errors in the response body.
+func (f NodesDNGet) WithErrorTrace() func(*NodesDNGetRequest) {
+	return func(r *NodesDNGetRequest) {
+		r.ErrorTrace = true
+	}
+}
+
+// WithDebug makes the response body pretty-printed and human-readable, and includes the stack trace for errors.
+func (f NodesDNGet) WithDebug() func(*NodesDNGetRequest) {
+	return func(r *NodesDNGetRequest) {
+		r.Pretty = true
+		r.Human = true
+		r.ErrorTrace = true
+	}
+}
+
+// WithFilterPath filters the properties of the response body.
+func (f NodesDNGet) WithFilterPath(v ...string) func(*NodesDNGetRequest) {
+	return func(r *NodesDNGetRequest) {
+		r.FilterPath = v
+	}
+}
+
+// WithHeader adds the headers to the HTTP request.
+func (f NodesDNGet) WithHeader(h map[string]string) func(*NodesDNGetRequest) {
+	return func(r *NodesDNGetRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, v := range h {
+			r.Header.Add(k, v)
+		}
+	}
+}
+
+// WithHTTPHeader adds the headers to the HTTP request, keeping the multiple values of a header.
+func (f NodesDNGet) WithHTTPHeader(h http.Header) func(*NodesDNGetRequest) {
+	return func(r *NodesDNGetRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, vv := range h {
+			for _, v := range vv {
+				r.Header.Add(k, v)
+			}
+		}
+	}
+}
+
+// WithAccept sets the media type of the response, eg. "application/yaml"; the default is JSON.
+func (f NodesDNGet) WithAccept(v string) func(*NodesDNGetRequest) {
+	return func(r *NodesDNGetRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Accept", v)
+	}
+}
+
+// WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
+func (f NodesDNGet) WithOpaqueID(s string) func(*NodesDNGetRequest) {
+	return func(r *NodesDNGetRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("X-Opaque-Id", s)
+	}
+}
+
+// WithBasicAuth sets the credentials of the request, overriding the credentials of the client.
+func (f NodesDNGet) WithBasicAuth(username, password string) func(*NodesDNGetRequest) {
+	return func(r *NodesDNGetRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Authorization", basicAuth(username, password))
+	}
+}
+
+// WithNoCache bypasses the response cache of the client, when enabled.
+func (f NodesDNGet) WithNoCache() func(*NodesDNGetRequest) {
+	return func(r *NodesDNGetRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Cache-Control", "no-cache")
+	}
+}
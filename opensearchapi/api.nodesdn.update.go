@@ -0,0 +1,301 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchapi
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+func newNodesDNUpdateFunc(t Transport) NodesDNUpdate {
+	return func(clusterName string, nodesDN []string, o ...func(*NodesDNUpdateRequest)) (*Response, error) {
+		var r = NodesDNUpdateRequest{ClusterName: clusterName, NodesDN: nodesDN}
+		for _, f := range o {
+			f(&r)
+		}
+		return r.Do(r.ctx, t)
+	}
+}
+
+// ----- API Definition -------------------------------------------------------
+
+// NodesDNUpdate sets the distinguished names of the nodes allowed for a cluster.
+type NodesDNUpdate func(clusterName string, nodesDN []string, o ...func(*NodesDNUpdateRequest)) (*Response, error)
+
+// NodesDNUpdateRequest configures the Nodes DN Update API request.
+type NodesDNUpdateRequest struct {
+	ClusterName string
+	NodesDN     []string
+
+	Body io.Reader
+
+	QueryParams map[string]string
+
+	Pretty     bool
+	Human      bool
+	ErrorTrace bool
+	FilterPath []string
+
+	Header http.Header
+
+	ctx context.Context
+}
+
+// NodesDNBody represents the body of the Nodes DN Update API request.
+type NodesDNBody struct {
+	NodesDN []string `json:"nodes_dn"`
+}
+
+// Do executes the request and returns response or error.
+func (r NodesDNUpdateRequest) Do(ctx context.Context, transport Transport) (*Response, error) {
+	var (
+		method string
+		path   strings.Builder
+		params map[string]string
+	)
+
+	method = "PUT"
+
+	cluster, err := escapeName("cluster", r.ClusterName)
+	if err != nil {
+		return nil, err
+	}
+
+	path.Grow(33 + len(cluster))
+	path.WriteString("/_plugins/_security/api/nodesdn/")
+	path.WriteString(cluster)
+
+	params = make(map[string]string)
+
+	if r.Pretty {
+		params["pretty"] = "true"
+	}
+
+	if r.Human {
+		params["human"] = "true"
+	}
+
+	if r.ErrorTrace {
+		params["error_trace"] = "true"
+	}
+
+	if len(r.FilterPath) > 0 {
+		params["filter_path"] = strings.Join(r.FilterPath, ",")
+	}
+
+	for k, v := range r.QueryParams {
+		if _, ok := params[k]; !ok {
+			params[k] = v
+		}
+	}
+
+	var body io.Reader = r.Body
+	if body == nil {
+		b, err := JSONCodecOf(transport).Marshal(NodesDNBody{NodesDN: r.NodesDN})
+		if err != nil {
+			return nil, fmt.Errorf("cannot encode nodes dn: %s", err)
+		}
+		body = bytes.NewReader(b)
+	}
+
+	req, err := newRequest(transport, method, path.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(params) > 0 {
+		q := req.URL.Query()
+		for k, v := range params {
+			q.Set(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	if r.Header.Get(headerContentType) == "" {
+		req.Header[headerContentType] = headerContentTypeJSON
+	}
+
+	if len(r.Header) > 0 {
+		for k, vv := range r.Header {
+			for _, v := range vv {
+				req.Header.Add(k, v)
+			}
+		}
+	}
+
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	res, err := transport.Perform(req)
+	if err != nil {
+		return nil, err
+	}
+
+	response := Response{
+		StatusCode: res.StatusCode,
+		Body:       res.Body,
+		Header:     res.Header,
+		codec:      JSONCodecOf(transport),
+	}
+
+	return &response, nil
+}
+
+// WithContext sets the request context.
+func (f NodesDNUpdate) WithContext(v context.Context) func(*NodesDNUpdateRequest) {
+	return func(r *NodesDNUpdateRequest) {
+		r.ctx = v
+	}
+}
+
+// WithBody - The request body, it replaces the nodes DN passed to the function.
+func (f NodesDNUpdate) WithBody(v io.Reader) func(*NodesDNUpdateRequest) {
+	return func(r *NodesDNUpdateRequest) {
+		r.Body = v
+	}
+}
+
+// WithJSONBody - the request body, encoded to JSON with the JSON codec of the client; it replaces the body set with WithBody.
+func (f NodesDNUpdate) WithJSONBody(v interface{}) func(*NodesDNUpdateRequest) {
+	return func(r *NodesDNUpdateRequest) {
+		r.Body = JSONBody(v)
+	}
+}
+
+// WithQueryParam adds a query parameter which has no dedicated option.
+//
+// Parameters set by the other options take precedence.
+func (f NodesDNUpdate) WithQueryParam(key, value string) func(*NodesDNUpdateRequest) {
+	return func(r *NodesDNUpdateRequest) {
+		if r.QueryParams == nil {
+			r.QueryParams = make(map[string]string)
+		}
+		r.QueryParams[key] = value
+	}
+}
+
+// WithPretty makes the response body pretty-printed.
+func (f NodesDNUpdate) WithPretty() func(*NodesDNUpdateRequest) {
+	return func(r *NodesDNUpdateRequest) {
+		r.Pretty = true
+	}
+}
+
+// WithHuman makes statistical values human-readable.
+func (f NodesDNUpdate) WithHuman() func(*NodesDNUpdateRequest) {
+	return func(r *NodesDNUpdateRequest) {
+		r.Human = true
+	}
+}
+
+// WithErrorTrace includes the stack trace for errors in the response body.
+func (f NodesDNUpdate) WithErrorTrace() func(*NodesDNUpdateRequest) {
+	return func(r *NodesDNUpdateRequest) {
+		r.ErrorTrace = true
+	}
+}
+
+// WithDebug makes the response body pretty-printed and human-readable, and includes the stack trace for errors.
+func (f NodesDNUpdate) WithDebug() func(*NodesDNUpdateRequest) {
+	return func(r *NodesDNUpdateRequest) {
+		r.Pretty = true
+		r.Human = true
+		r.ErrorTrace = true
+	}
+}
+
+// WithFilterPath filters the properties of the response body.
+func (f NodesDNUpdate) WithFilterPath(v ...string) func(*NodesDNUpdateRequest) {
+	return func(r *NodesDNUpdateRequest) {
+		r.FilterPath = v
+	}
+}
+
+// WithHeader adds the headers to the HTTP request.
+func (f NodesDNUpdate) WithHeader(h map[string]string) func(*NodesDNUpdateRequest) {
+	return func(r *NodesDNUpdateRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, v := range h {
+			r.Header.Add(k, v)
+		}
+	}
+}
+
+// WithHTTPHeader adds the headers to the HTTP request, keeping the multiple values of a header.
+func (f NodesDNUpdate) WithHTTPHeader(h http.Header) func(*NodesDNUpdateRequest) {
+	return func(r *NodesDNUpdateRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, vv := range h {
+			for _, v := range vv {
+				r.Header.Add(k, v)
+			}
+		}
+	}
+}
+
+// WithAccept sets the media type of the response, eg. "application/yaml"; the default is JSON.
+func (f NodesDNUpdate) WithAccept(v string) func(*NodesDNUpdateRequest) {
+	return func(r *NodesDNUpdateRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Accept", v)
+	}
+}
+
+// WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
+func (f NodesDNUpdate) WithOpaqueID(s string) func(*NodesDNUpdateRequest) {
+	return func(r *NodesDNUpdateRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("X-Opaque-Id", s)
+	}
+}
+
+// WithContentType sets the Content-Type header of the request, overriding the default of the request body.
+func (f NodesDNUpdate) WithContentType(v string) func(*NodesDNUpdateRequest) {
+	return func(r *NodesDNUpdateRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Content-Type", v)
+	}
+}
+
+// WithBasicAuth sets the credentials of the request, overriding the credentials of the client.
+func (f NodesDNUpdate) WithBasicAuth(username, password string) func(*NodesDNUpdateRequest) {
+	return func(r *NodesDNUpdateRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Authorization", basicAuth(username, password))
+	}
+}
+
+// WithMethodOverride sends the request as POST, with the X-HTTP-Method-Override header set to PUT,
+// for the proxies which block PUT requests.
+func (f NodesDNUpdate) WithMethodOverride() func(*NodesDNUpdateRequest) {
+	return func(r *NodesDNUpdateRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("X-HTTP-Method-Override", "PUT")
+	}
+}
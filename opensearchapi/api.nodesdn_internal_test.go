@@ -0,0 +1,80 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+//go:build !integration
+// +build !integration
+
+package opensearchapi
+
+import (
+	"io/ioutil"
+	"net/http"
+	"testing"
+)
+
+func TestNodesDN(t *testing.T) {
+	var (
+		req  *http.Request
+		body string
+	)
+	tp := &mockTransport{RoundTripFunc: func(r *http.Request) (*http.Response, error) {
+		req, body = r, ""
+		if r.Body != nil {
+			b, _ := ioutil.ReadAll(r.Body)
+			body = string(b)
+		}
+		return &http.Response{StatusCode: 200}, nil
+	}}
+	api := New(tp)
+
+	t.Run("Get", func(t *testing.T) {
+		get := api.Security.GetNodesDN
+		if _, err := get(); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if req.Method != "GET" || req.URL.Path != "/_plugins/_security/api/nodesdn" {
+			t.Errorf("Unexpected request: %s %s", req.Method, req.URL.Path)
+		}
+
+		if _, err := get(get.WithClusterName("remote-1"), get.WithShowAll(true)); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if req.URL.Path != "/_plugins/_security/api/nodesdn/remote-1" || req.URL.RawQuery != "show_all=true" {
+			t.Errorf("Unexpected request: %s", req.URL)
+		}
+	})
+
+	t.Run("Update", func(t *testing.T) {
+		if _, err := api.Security.UpdateNodesDN("remote-1", []string{"CN=node-1.example.com,O=Example"}); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if req.Method != "PUT" || req.URL.Path != "/_plugins/_security/api/nodesdn/remote-1" {
+			t.Errorf("Unexpected request: %s %s", req.Method, req.URL.Path)
+		}
+		if body != `{"nodes_dn":["CN=node-1.example.com,O=Example"]}` {
+			t.Errorf("Unexpected body: %s", body)
+		}
+		if ct := req.Header.Get("Content-Type"); ct != "application/json" {
+			t.Errorf("Unexpected content type: %s", ct)
+		}
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		if _, err := api.Security.DeleteNodesDN("remote-1"); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if req.Method != "DELETE" || req.URL.Path != "/_plugins/_security/api/nodesdn/remote-1" {
+			t.Errorf("Unexpected request: %s %s", req.Method, req.URL.Path)
+		}
+
+		if _, err := api.Security.DeleteNodesDN(""); err == nil {
+			t.Errorf("Expected error for empty cluster name")
+		}
+	})
+}
@@ -30,6 +30,7 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
+	"strconv"
 	"strings"
 	"time"
 )
@@ -58,6 +59,8 @@ type PointInTimeCreateRequest struct {
 	Routing                 string
 	ExpandWildcards         string
 	AllowPartialPitCreation bool
+	AllowNoIndices          *bool
+	IgnoreUnavailable       *bool
 
 	Pretty     bool
 	Human      bool
@@ -118,6 +121,14 @@ func (r PointInTimeCreateRequest) Do(ctx context.Context, transport Transport) (
 		params["allow_partial_pit_creation"] = "true"
 	}
 
+	if r.AllowNoIndices != nil {
+		params["allow_no_indices"] = strconv.FormatBool(*r.AllowNoIndices)
+	}
+
+	if r.IgnoreUnavailable != nil {
+		params["ignore_unavailable"] = strconv.FormatBool(*r.IgnoreUnavailable)
+	}
+
 	if r.Pretty {
 		params["pretty"] = "true"
 	}
@@ -134,7 +145,7 @@ func (r PointInTimeCreateRequest) Do(ctx context.Context, transport Transport) (
 		params["filter_path"] = strings.Join(r.FilterPath, ",")
 	}
 
-	req, err := newRequest(method, path.String(), nil)
+	req, err := newRequest(transport, method, path.String(), nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -148,13 +159,9 @@ func (r PointInTimeCreateRequest) Do(ctx context.Context, transport Transport) (
 	}
 
 	if len(r.Header) > 0 {
-		if len(req.Header) == 0 {
-			req.Header = r.Header
-		} else {
-			for k, vv := range r.Header {
-				for _, v := range vv {
-					req.Header.Add(k, v)
-				}
+		for k, vv := range r.Header {
+			for _, v := range vv {
+				req.Header.Add(k, v)
 			}
 		}
 	}
@@ -172,6 +179,7 @@ func (r PointInTimeCreateRequest) Do(ctx context.Context, transport Transport) (
 		StatusCode: res.StatusCode,
 		Body:       res.Body,
 		Header:     res.Header,
+		codec:      JSONCodecOf(transport),
 	}
 
 	if err = response.Err(); err != nil {
@@ -209,6 +217,34 @@ func (f PointInTimeCreate) WithKeepAlive(v time.Duration) func(*PointInTimeCreat
 	}
 }
 
+// WithRouting - specific routing value.
+func (f PointInTimeCreate) WithRouting(v string) func(*PointInTimeCreateRequest) {
+	return func(r *PointInTimeCreateRequest) {
+		r.Routing = v
+	}
+}
+
+// WithExpandWildcards - whether to expand wildcard expression to concrete indices that are open, closed or both..
+func (f PointInTimeCreate) WithExpandWildcards(v string) func(*PointInTimeCreateRequest) {
+	return func(r *PointInTimeCreateRequest) {
+		r.ExpandWildcards = v
+	}
+}
+
+// WithAllowNoIndices - whether to ignore if a wildcard indices expression resolves into no concrete indices. (this includes `_all` string or when no indices have been specified).
+func (f PointInTimeCreate) WithAllowNoIndices(v bool) func(*PointInTimeCreateRequest) {
+	return func(r *PointInTimeCreateRequest) {
+		r.AllowNoIndices = &v
+	}
+}
+
+// WithIgnoreUnavailable - whether specified concrete indices should be ignored when unavailable (missing or closed).
+func (f PointInTimeCreate) WithIgnoreUnavailable(v bool) func(*PointInTimeCreateRequest) {
+	return func(r *PointInTimeCreateRequest) {
+		r.IgnoreUnavailable = &v
+	}
+}
+
 // WithPretty makes the response body pretty-printed.
 func (f PointInTimeCreate) WithPretty() func(*PointInTimeCreateRequest) {
 	return func(r *PointInTimeCreateRequest) {
@@ -230,6 +266,15 @@ func (f PointInTimeCreate) WithErrorTrace() func(*PointInTimeCreateRequest) {
 	}
 }
 
+// WithDebug makes the response body pretty-printed and human-readable, and includes the stack trace for errors.
+func (f PointInTimeCreate) WithDebug() func(*PointInTimeCreateRequest) {
+	return func(r *PointInTimeCreateRequest) {
+		r.Pretty = true
+		r.Human = true
+		r.ErrorTrace = true
+	}
+}
+
 // WithFilterPath filters the properties of the response body.
 func (f PointInTimeCreate) WithFilterPath(v ...string) func(*PointInTimeCreateRequest) {
 	return func(r *PointInTimeCreateRequest) {
@@ -249,6 +294,30 @@ func (f PointInTimeCreate) WithHeader(h map[string]string) func(*PointInTimeCrea
 	}
 }
 
+// WithHTTPHeader adds the headers to the HTTP request, keeping the multiple values of a header.
+func (f PointInTimeCreate) WithHTTPHeader(h http.Header) func(*PointInTimeCreateRequest) {
+	return func(r *PointInTimeCreateRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, vv := range h {
+			for _, v := range vv {
+				r.Header.Add(k, v)
+			}
+		}
+	}
+}
+
+// WithAccept sets the media type of the response, eg. "application/yaml"; the default is JSON.
+func (f PointInTimeCreate) WithAccept(v string) func(*PointInTimeCreateRequest) {
+	return func(r *PointInTimeCreateRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Accept", v)
+	}
+}
+
 // WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
 func (f PointInTimeCreate) WithOpaqueID(s string) func(*PointInTimeCreateRequest) {
 	return func(r *PointInTimeCreateRequest) {
@@ -258,3 +327,13 @@ func (f PointInTimeCreate) WithOpaqueID(s string) func(*PointInTimeCreateRequest
 		r.Header.Set("X-Opaque-Id", s)
 	}
 }
+
+// WithBasicAuth sets the credentials of the request, overriding the credentials of the client.
+func (f PointInTimeCreate) WithBasicAuth(username, password string) func(*PointInTimeCreateRequest) {
+	return func(r *PointInTimeCreateRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Authorization", basicAuth(username, password))
+	}
+}
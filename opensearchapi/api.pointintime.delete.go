@@ -118,7 +118,7 @@ func (r PointInTimeDeleteRequest) Do(ctx context.Context, transport Transport) (
 		params["filter_path"] = strings.Join(r.FilterPath, ",")
 	}
 
-	req, err := newRequest(method, path.String(), body)
+	req, err := newRequest(transport, method, path.String(), body)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -131,18 +131,14 @@ func (r PointInTimeDeleteRequest) Do(ctx context.Context, transport Transport) (
 		req.URL.RawQuery = q.Encode()
 	}
 
-	if body != nil {
+	if body != nil && r.Header.Get(headerContentType) == "" {
 		req.Header[headerContentType] = headerContentTypeJSON
 	}
 
 	if len(r.Header) > 0 {
-		if len(req.Header) == 0 {
-			req.Header = r.Header
-		} else {
-			for k, vv := range r.Header {
-				for _, v := range vv {
-					req.Header.Add(k, v)
-				}
+		for k, vv := range r.Header {
+			for _, v := range vv {
+				req.Header.Add(k, v)
 			}
 		}
 	}
@@ -160,6 +156,7 @@ func (r PointInTimeDeleteRequest) Do(ctx context.Context, transport Transport) (
 		StatusCode: res.StatusCode,
 		Body:       res.Body,
 		Header:     res.Header,
+		codec:      JSONCodecOf(transport),
 	}
 
 	if err = response.Err(); err != nil {
@@ -211,6 +208,15 @@ func (f PointInTimeDelete) WithErrorTrace() func(*PointInTimeDeleteRequest) {
 	}
 }
 
+// WithDebug makes the response body pretty-printed and human-readable, and includes the stack trace for errors.
+func (f PointInTimeDelete) WithDebug() func(*PointInTimeDeleteRequest) {
+	return func(r *PointInTimeDeleteRequest) {
+		r.Pretty = true
+		r.Human = true
+		r.ErrorTrace = true
+	}
+}
+
 // WithFilterPath filters the properties of the response body.
 func (f PointInTimeDelete) WithFilterPath(v ...string) func(*PointInTimeDeleteRequest) {
 	return func(r *PointInTimeDeleteRequest) {
@@ -230,6 +236,30 @@ func (f PointInTimeDelete) WithHeader(h map[string]string) func(*PointInTimeDele
 	}
 }
 
+// WithHTTPHeader adds the headers to the HTTP request, keeping the multiple values of a header.
+func (f PointInTimeDelete) WithHTTPHeader(h http.Header) func(*PointInTimeDeleteRequest) {
+	return func(r *PointInTimeDeleteRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, vv := range h {
+			for _, v := range vv {
+				r.Header.Add(k, v)
+			}
+		}
+	}
+}
+
+// WithAccept sets the media type of the response, eg. "application/yaml"; the default is JSON.
+func (f PointInTimeDelete) WithAccept(v string) func(*PointInTimeDeleteRequest) {
+	return func(r *PointInTimeDeleteRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Accept", v)
+	}
+}
+
 // WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
 func (f PointInTimeDelete) WithOpaqueID(s string) func(*PointInTimeDeleteRequest) {
 	return func(r *PointInTimeDeleteRequest) {
@@ -239,3 +269,23 @@ func (f PointInTimeDelete) WithOpaqueID(s string) func(*PointInTimeDeleteRequest
 		r.Header.Set("X-Opaque-Id", s)
 	}
 }
+
+// WithContentType sets the Content-Type header of the request, overriding the default of the request body.
+func (f PointInTimeDelete) WithContentType(v string) func(*PointInTimeDeleteRequest) {
+	return func(r *PointInTimeDeleteRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Content-Type", v)
+	}
+}
+
+// WithBasicAuth sets the credentials of the request, overriding the credentials of the client.
+func (f PointInTimeDelete) WithBasicAuth(username, password string) func(*PointInTimeDeleteRequest) {
+	return func(r *PointInTimeDeleteRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Authorization", basicAuth(username, password))
+	}
+}
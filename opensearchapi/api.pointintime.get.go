@@ -101,7 +101,7 @@ func (r PointInTimeGetRequest) Do(ctx context.Context, transport Transport) (*Re
 		params["filter_path"] = strings.Join(r.FilterPath, ",")
 	}
 
-	req, err := newRequest(method, path.String(), nil)
+	req, err := newRequest(transport, method, path.String(), nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -115,13 +115,9 @@ func (r PointInTimeGetRequest) Do(ctx context.Context, transport Transport) (*Re
 	}
 
 	if len(r.Header) > 0 {
-		if len(req.Header) == 0 {
-			req.Header = r.Header
-		} else {
-			for k, vv := range r.Header {
-				for _, v := range vv {
-					req.Header.Add(k, v)
-				}
+		for k, vv := range r.Header {
+			for _, v := range vv {
+				req.Header.Add(k, v)
 			}
 		}
 	}
@@ -139,6 +135,7 @@ func (r PointInTimeGetRequest) Do(ctx context.Context, transport Transport) (*Re
 		StatusCode: res.StatusCode,
 		Body:       res.Body,
 		Header:     res.Header,
+		codec:      JSONCodecOf(transport),
 	}
 
 	if err = response.Err(); err != nil {
@@ -183,6 +180,15 @@ func (f PointInTimeGet) WithErrorTrace() func(*PointInTimeGetRequest) {
 	}
 }
 
+// WithDebug makes the response body pretty-printed and human-readable, and includes the stack trace for errors.
+func (f PointInTimeGet) WithDebug() func(*PointInTimeGetRequest) {
+	return func(r *PointInTimeGetRequest) {
+		r.Pretty = true
+		r.Human = true
+		r.ErrorTrace = true
+	}
+}
+
 // WithFilterPath filters the properties of the response body.
 func (f PointInTimeGet) WithFilterPath(v ...string) func(*PointInTimeGetRequest) {
 	return func(r *PointInTimeGetRequest) {
@@ -202,6 +208,30 @@ func (f PointInTimeGet) WithHeader(h map[string]string) func(*PointInTimeGetRequ
 	}
 }
 
+// WithHTTPHeader adds the headers to the HTTP request, keeping the multiple values of a header.
+func (f PointInTimeGet) WithHTTPHeader(h http.Header) func(*PointInTimeGetRequest) {
+	return func(r *PointInTimeGetRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, vv := range h {
+			for _, v := range vv {
+				r.Header.Add(k, v)
+			}
+		}
+	}
+}
+
+// WithAccept sets the media type of the response, eg. "application/yaml"; the default is JSON.
+func (f PointInTimeGet) WithAccept(v string) func(*PointInTimeGetRequest) {
+	return func(r *PointInTimeGetRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Accept", v)
+	}
+}
+
 // WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
 func (f PointInTimeGet) WithOpaqueID(s string) func(*PointInTimeGetRequest) {
 	return func(r *PointInTimeGetRequest) {
@@ -211,3 +241,13 @@ func (f PointInTimeGet) WithOpaqueID(s string) func(*PointInTimeGetRequest) {
 		r.Header.Set("X-Opaque-Id", s)
 	}
 }
+
+// WithBasicAuth sets the credentials of the request, overriding the credentials of the client.
+func (f PointInTimeGet) WithBasicAuth(username, password string) func(*PointInTimeGetRequest) {
+	return func(r *PointInTimeGetRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Authorization", basicAuth(username, password))
+	}
+}
@@ -129,7 +129,7 @@ func (r PutScriptRequest) Do(ctx context.Context, transport Transport) (*Respons
 		params["filter_path"] = strings.Join(r.FilterPath, ",")
 	}
 
-	req, err := newRequest(method, path.String(), r.Body)
+	req, err := newRequest(transport, method, path.String(), r.Body)
 	if err != nil {
 		return nil, err
 	}
@@ -142,18 +142,14 @@ func (r PutScriptRequest) Do(ctx context.Context, transport Transport) (*Respons
 		req.URL.RawQuery = q.Encode()
 	}
 
-	if r.Body != nil {
+	if r.Body != nil && r.Header.Get(headerContentType) == "" {
 		req.Header[headerContentType] = headerContentTypeJSON
 	}
 
 	if len(r.Header) > 0 {
-		if len(req.Header) == 0 {
-			req.Header = r.Header
-		} else {
-			for k, vv := range r.Header {
-				for _, v := range vv {
-					req.Header.Add(k, v)
-				}
+		for k, vv := range r.Header {
+			for _, v := range vv {
+				req.Header.Add(k, v)
 			}
 		}
 	}
@@ -171,6 +167,7 @@ func (r PutScriptRequest) Do(ctx context.Context, transport Transport) (*Respons
 		StatusCode: res.StatusCode,
 		Body:       res.Body,
 		Header:     res.Header,
+		codec:      JSONCodecOf(transport),
 	}
 
 	return &response, response.Err()
@@ -184,6 +181,14 @@ func (f PutScript) WithContext(v context.Context) func(*PutScriptRequest) {
 	}
 }
 
+// WithJSONBody - the request body, encoded to JSON with the JSON codec of the client; it replaces the body passed to the function.
+//
+func (f PutScript) WithJSONBody(v interface{}) func(*PutScriptRequest) {
+	return func(r *PutScriptRequest) {
+		r.Body = JSONBody(v)
+	}
+}
+
 // WithScriptContext - script context.
 //
 func (f PutScript) WithScriptContext(v string) func(*PutScriptRequest) {
@@ -242,6 +247,16 @@ func (f PutScript) WithErrorTrace() func(*PutScriptRequest) {
 	}
 }
 
+// WithDebug makes the response body pretty-printed and human-readable, and includes the stack trace for errors.
+//
+func (f PutScript) WithDebug() func(*PutScriptRequest) {
+	return func(r *PutScriptRequest) {
+		r.Pretty = true
+		r.Human = true
+		r.ErrorTrace = true
+	}
+}
+
 // WithFilterPath filters the properties of the response body.
 //
 func (f PutScript) WithFilterPath(v ...string) func(*PutScriptRequest) {
@@ -263,6 +278,32 @@ func (f PutScript) WithHeader(h map[string]string) func(*PutScriptRequest) {
 	}
 }
 
+// WithHTTPHeader adds the headers to the HTTP request, keeping the multiple values of a header.
+//
+func (f PutScript) WithHTTPHeader(h http.Header) func(*PutScriptRequest) {
+	return func(r *PutScriptRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, vv := range h {
+			for _, v := range vv {
+				r.Header.Add(k, v)
+			}
+		}
+	}
+}
+
+// WithAccept sets the media type of the response, eg. "application/yaml"; the default is JSON.
+//
+func (f PutScript) WithAccept(v string) func(*PutScriptRequest) {
+	return func(r *PutScriptRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Accept", v)
+	}
+}
+
 // WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
 //
 func (f PutScript) WithOpaqueID(s string) func(*PutScriptRequest) {
@@ -273,3 +314,25 @@ func (f PutScript) WithOpaqueID(s string) func(*PutScriptRequest) {
 		r.Header.Set("X-Opaque-Id", s)
 	}
 }
+
+// WithContentType sets the Content-Type header of the request, overriding the default of the request body.
+//
+func (f PutScript) WithContentType(v string) func(*PutScriptRequest) {
+	return func(r *PutScriptRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Content-Type", v)
+	}
+}
+
+// WithBasicAuth sets the credentials of the request, overriding the credentials of the client.
+//
+func (f PutScript) WithBasicAuth(username, password string) func(*PutScriptRequest) {
+	return func(r *PutScriptRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Authorization", basicAuth(username, password))
+	}
+}
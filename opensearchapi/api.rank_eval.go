@@ -128,7 +128,7 @@ func (r RankEvalRequest) Do(ctx context.Context, transport Transport) (*Response
 		params["filter_path"] = strings.Join(r.FilterPath, ",")
 	}
 
-	req, err := newRequest(method, path.String(), r.Body)
+	req, err := newRequest(transport, method, path.String(), r.Body)
 	if err != nil {
 		return nil, err
 	}
@@ -141,18 +141,14 @@ func (r RankEvalRequest) Do(ctx context.Context, transport Transport) (*Response
 		req.URL.RawQuery = q.Encode()
 	}
 
-	if r.Body != nil {
+	if r.Body != nil && r.Header.Get(headerContentType) == "" {
 		req.Header[headerContentType] = headerContentTypeJSON
 	}
 
 	if len(r.Header) > 0 {
-		if len(req.Header) == 0 {
-			req.Header = r.Header
-		} else {
-			for k, vv := range r.Header {
-				for _, v := range vv {
-					req.Header.Add(k, v)
-				}
+		for k, vv := range r.Header {
+			for _, v := range vv {
+				req.Header.Add(k, v)
 			}
 		}
 	}
@@ -170,6 +166,7 @@ func (r RankEvalRequest) Do(ctx context.Context, transport Transport) (*Response
 		StatusCode: res.StatusCode,
 		Body:       res.Body,
 		Header:     res.Header,
+		codec:      JSONCodecOf(transport),
 	}
 
 	return &response, response.Err()
@@ -183,6 +180,14 @@ func (f RankEval) WithContext(v context.Context) func(*RankEvalRequest) {
 	}
 }
 
+// WithJSONBody - the request body, encoded to JSON with the JSON codec of the client; it replaces the body passed to the function.
+//
+func (f RankEval) WithJSONBody(v interface{}) func(*RankEvalRequest) {
+	return func(r *RankEvalRequest) {
+		r.Body = JSONBody(v)
+	}
+}
+
 // WithIndex - a list of index names to search; use _all to perform the operation on all indices.
 //
 func (f RankEval) WithIndex(v ...string) func(*RankEvalRequest) {
@@ -247,6 +252,16 @@ func (f RankEval) WithErrorTrace() func(*RankEvalRequest) {
 	}
 }
 
+// WithDebug makes the response body pretty-printed and human-readable, and includes the stack trace for errors.
+//
+func (f RankEval) WithDebug() func(*RankEvalRequest) {
+	return func(r *RankEvalRequest) {
+		r.Pretty = true
+		r.Human = true
+		r.ErrorTrace = true
+	}
+}
+
 // WithFilterPath filters the properties of the response body.
 //
 func (f RankEval) WithFilterPath(v ...string) func(*RankEvalRequest) {
@@ -268,6 +283,32 @@ func (f RankEval) WithHeader(h map[string]string) func(*RankEvalRequest) {
 	}
 }
 
+// WithHTTPHeader adds the headers to the HTTP request, keeping the multiple values of a header.
+//
+func (f RankEval) WithHTTPHeader(h http.Header) func(*RankEvalRequest) {
+	return func(r *RankEvalRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, vv := range h {
+			for _, v := range vv {
+				r.Header.Add(k, v)
+			}
+		}
+	}
+}
+
+// WithAccept sets the media type of the response, eg. "application/yaml"; the default is JSON.
+//
+func (f RankEval) WithAccept(v string) func(*RankEvalRequest) {
+	return func(r *RankEvalRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Accept", v)
+	}
+}
+
 // WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
 //
 func (f RankEval) WithOpaqueID(s string) func(*RankEvalRequest) {
@@ -278,3 +319,25 @@ func (f RankEval) WithOpaqueID(s string) func(*RankEvalRequest) {
 		r.Header.Set("X-Opaque-Id", s)
 	}
 }
+
+// WithContentType sets the Content-Type header of the request, overriding the default of the request body.
+//
+func (f RankEval) WithContentType(v string) func(*RankEvalRequest) {
+	return func(r *RankEvalRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Content-Type", v)
+	}
+}
+
+// WithBasicAuth sets the credentials of the request, overriding the credentials of the client.
+//
+func (f RankEval) WithBasicAuth(username, password string) func(*RankEvalRequest) {
+	return func(r *RankEvalRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Authorization", basicAuth(username, password))
+	}
+}
@@ -27,7 +27,9 @@
 package opensearchapi
 
 import (
+	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -58,7 +60,8 @@ type Reindex func(body io.Reader, o ...func(*ReindexRequest)) (*Response, error)
 // ReindexRequest configures the Reindex API request.
 //
 type ReindexRequest struct {
-	Body io.Reader
+	Body        io.Reader
+	ReindexBody *ReindexBody
 
 	MaxDocs             *int
 	Refresh             *bool
@@ -79,6 +82,32 @@ type ReindexRequest struct {
 	ctx context.Context
 }
 
+// ReindexBody represents the body of the Reindex API request.
+//
+type ReindexBody struct {
+	Source    ReindexSource          `json:"source"`
+	Dest      ReindexDest            `json:"dest"`
+	Conflicts string                 `json:"conflicts,omitempty"`
+	MaxDocs   *int                   `json:"max_docs,omitempty"`
+	Script    map[string]interface{} `json:"script,omitempty"`
+}
+
+// ReindexSource represents the source of a reindex operation.
+//
+type ReindexSource struct {
+	Index []string               `json:"index"`
+	Query map[string]interface{} `json:"query,omitempty"`
+	Size  *int                   `json:"size,omitempty"`
+}
+
+// ReindexDest represents the destination of a reindex operation.
+//
+type ReindexDest struct {
+	Index    string `json:"index"`
+	OpType   string `json:"op_type,omitempty"`
+	Pipeline string `json:"pipeline,omitempty"`
+}
+
 // Do executes the request and returns response or error.
 //
 func (r ReindexRequest) Do(ctx context.Context, transport Transport) (*Response, error) {
@@ -120,6 +149,9 @@ func (r ReindexRequest) Do(ctx context.Context, transport Transport) (*Response,
 	}
 
 	if r.WaitForActiveShards != "" {
+		if err := ValidateWaitForActiveShards(r.WaitForActiveShards); err != nil {
+			return nil, err
+		}
 		params["wait_for_active_shards"] = r.WaitForActiveShards
 	}
 
@@ -143,7 +175,19 @@ func (r ReindexRequest) Do(ctx context.Context, transport Transport) (*Response,
 		params["filter_path"] = strings.Join(r.FilterPath, ",")
 	}
 
-	req, err := newRequest(method, path.String(), r.Body)
+	var body io.Reader = r.Body
+	if r.ReindexBody != nil {
+		if len(r.ReindexBody.Source.Index) == 0 || r.ReindexBody.Dest.Index == "" {
+			return nil, errors.New("invalid reindex: source and dest index are required")
+		}
+		b, err := JSONCodecOf(transport).Marshal(r.ReindexBody)
+		if err != nil {
+			return nil, fmt.Errorf("cannot encode reindex: %s", err)
+		}
+		body = bytes.NewReader(b)
+	}
+
+	req, err := newRequest(transport, method, path.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -156,18 +200,14 @@ func (r ReindexRequest) Do(ctx context.Context, transport Transport) (*Response,
 		req.URL.RawQuery = q.Encode()
 	}
 
-	if r.Body != nil {
+	if body != nil && r.Header.Get(headerContentType) == "" {
 		req.Header[headerContentType] = headerContentTypeJSON
 	}
 
 	if len(r.Header) > 0 {
-		if len(req.Header) == 0 {
-			req.Header = r.Header
-		} else {
-			for k, vv := range r.Header {
-				for _, v := range vv {
-					req.Header.Add(k, v)
-				}
+		for k, vv := range r.Header {
+			for _, v := range vv {
+				req.Header.Add(k, v)
 			}
 		}
 	}
@@ -185,6 +225,7 @@ func (r ReindexRequest) Do(ctx context.Context, transport Transport) (*Response,
 		StatusCode: res.StatusCode,
 		Body:       res.Body,
 		Header:     res.Header,
+		codec:      JSONCodecOf(transport),
 	}
 
 	return &response, response.Err()
@@ -198,6 +239,22 @@ func (f Reindex) WithContext(v context.Context) func(*ReindexRequest) {
 	}
 }
 
+// WithJSONBody - the request body, encoded to JSON with the JSON codec of the client; it replaces the body passed to the function.
+//
+func (f Reindex) WithJSONBody(v interface{}) func(*ReindexRequest) {
+	return func(r *ReindexRequest) {
+		r.Body = JSONBody(v)
+	}
+}
+
+// WithReindexBody - the reindex definition, encoded as the request body; it replaces the body passed to the function.
+//
+func (f Reindex) WithReindexBody(v ReindexBody) func(*ReindexRequest) {
+	return func(r *ReindexRequest) {
+		r.ReindexBody = &v
+	}
+}
+
 // WithMaxDocs - maximum number of documents to process (default: all documents).
 //
 func (f Reindex) WithMaxDocs(v int) func(*ReindexRequest) {
@@ -286,6 +343,16 @@ func (f Reindex) WithErrorTrace() func(*ReindexRequest) {
 	}
 }
 
+// WithDebug makes the response body pretty-printed and human-readable, and includes the stack trace for errors.
+//
+func (f Reindex) WithDebug() func(*ReindexRequest) {
+	return func(r *ReindexRequest) {
+		r.Pretty = true
+		r.Human = true
+		r.ErrorTrace = true
+	}
+}
+
 // WithFilterPath filters the properties of the response body.
 //
 func (f Reindex) WithFilterPath(v ...string) func(*ReindexRequest) {
@@ -307,6 +374,32 @@ func (f Reindex) WithHeader(h map[string]string) func(*ReindexRequest) {
 	}
 }
 
+// WithHTTPHeader adds the headers to the HTTP request, keeping the multiple values of a header.
+//
+func (f Reindex) WithHTTPHeader(h http.Header) func(*ReindexRequest) {
+	return func(r *ReindexRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, vv := range h {
+			for _, v := range vv {
+				r.Header.Add(k, v)
+			}
+		}
+	}
+}
+
+// WithAccept sets the media type of the response, eg. "application/yaml"; the default is JSON.
+//
+func (f Reindex) WithAccept(v string) func(*ReindexRequest) {
+	return func(r *ReindexRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Accept", v)
+	}
+}
+
 // WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
 //
 func (f Reindex) WithOpaqueID(s string) func(*ReindexRequest) {
@@ -317,3 +410,25 @@ func (f Reindex) WithOpaqueID(s string) func(*ReindexRequest) {
 		r.Header.Set("X-Opaque-Id", s)
 	}
 }
+
+// WithContentType sets the Content-Type header of the request, overriding the default of the request body.
+//
+func (f Reindex) WithContentType(v string) func(*ReindexRequest) {
+	return func(r *ReindexRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Content-Type", v)
+	}
+}
+
+// WithBasicAuth sets the credentials of the request, overriding the credentials of the client.
+//
+func (f Reindex) WithBasicAuth(username, password string) func(*ReindexRequest) {
+	return func(r *ReindexRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Authorization", basicAuth(username, password))
+	}
+}
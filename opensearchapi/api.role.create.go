@@ -27,7 +27,9 @@
 package opensearchapi
 
 import (
+	"bytes"
 	"context"
+	"fmt"
 	"io"
 	"net/http"
 	"strings"
@@ -47,18 +49,26 @@ func newRoleCreateFunc(t Transport) RoleCreate {
 // ----- API Definition -------------------------------------------------------
 
 // RoleCreate creates an role with optional settings and mappings.
+//
+// The security plugin has no optimistic concurrency control for its resources: the request
+// doesn't support if_seq_no and if_primary_term, and concurrent updates are last-write-wins.
+// The updates of a resource by several writers must be serialized by the callers.
 type RoleCreate func(role string, o ...func(*RoleCreateRequest)) (*Response, error)
 
 // RoleCreateRequest configures the Role Create API request.
 type RoleCreateRequest struct {
 	Role string
 
-	Body io.Reader
+	Body     io.Reader
+	RoleBody *RoleBody
 
 	MasterTimeout         time.Duration
 	ClusterManagerTimeout time.Duration
 	Timeout               time.Duration
 	WaitForActiveShards   string
+	CreateOnly            bool
+
+	QueryParams map[string]string
 
 	Pretty     bool
 	Human      bool
@@ -70,6 +80,88 @@ type RoleCreateRequest struct {
 	ctx context.Context
 }
 
+// RoleBody represents the definition of a role, used as the body of the Role Create API request.
+//
+// Empty fields are omitted from the serialized JSON.
+type RoleBody struct {
+	Description        string                 `json:"description,omitempty"`
+	ClusterPermissions []string               `json:"cluster_permissions,omitempty"`
+	IndexPermissions   []RoleIndexPermission  `json:"index_permissions,omitempty"`
+	TenantPermissions  []RoleTenantPermission `json:"tenant_permissions,omitempty"`
+}
+
+// RoleIndexPermission represents the permissions of a role on a set of indices.
+type RoleIndexPermission struct {
+	IndexPatterns  []string `json:"index_patterns,omitempty"`
+	DLS            string   `json:"dls,omitempty"`
+	FLS            []string `json:"fls,omitempty"`
+	MaskedFields   []string `json:"masked_fields,omitempty"`
+	AllowedActions []string `json:"allowed_actions,omitempty"`
+}
+
+// RoleTenantPermission represents the permissions of a role on a set of tenants.
+type RoleTenantPermission struct {
+	TenantPatterns []string `json:"tenant_patterns,omitempty"`
+	AllowedActions []string `json:"allowed_actions,omitempty"`
+}
+
+// Validate returns an error when the body contains an empty permission, or a permission block
+// without patterns or allowed actions. The error names the offending field, eg. "index_permissions[1].index_patterns".
+func (b RoleBody) Validate() error {
+	for i, p := range b.ClusterPermissions {
+		if strings.TrimSpace(p) == "" {
+			return fmt.Errorf("invalid role: cluster_permissions[%d]: empty permission", i)
+		}
+	}
+	for i, p := range b.IndexPermissions {
+		if err := validatePermissionBlock(fmt.Sprintf("index_permissions[%d]", i), "index_patterns", p.IndexPatterns, p.AllowedActions); err != nil {
+			return err
+		}
+	}
+	for i, p := range b.TenantPermissions {
+		if err := validatePermissionBlock(fmt.Sprintf("tenant_permissions[%d]", i), "tenant_patterns", p.TenantPatterns, p.AllowedActions); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validatePermissionBlock returns an error when the block has no patterns or no allowed actions, or empty ones.
+func validatePermissionBlock(block, patternsField string, patterns, actions []string) error {
+	if len(patterns) == 0 {
+		return fmt.Errorf("invalid role: %s.%s: at least one pattern is required", block, patternsField)
+	}
+	for i, p := range patterns {
+		if strings.TrimSpace(p) == "" {
+			return fmt.Errorf("invalid role: %s.%s[%d]: empty pattern", block, patternsField, i)
+		}
+	}
+	if len(actions) == 0 {
+		return fmt.Errorf("invalid role: %s.allowed_actions: at least one action or action group is required", block)
+	}
+	for i, a := range actions {
+		if strings.TrimSpace(a) == "" {
+			return fmt.Errorf("invalid role: %s.allowed_actions[%d]: empty action", block, i)
+		}
+	}
+	return nil
+}
+
+// RoleCreateResponse represents the Role Create API response.
+type RoleCreateResponse struct {
+	Status  SecurityStatus `json:"status"`
+	Message string         `json:"message"`
+}
+
+// ParseRoleCreateResponse decodes the body of a Role Create API response and closes it.
+func ParseRoleCreateResponse(res *Response) (*RoleCreateResponse, error) {
+	var rr RoleCreateResponse
+	if err := parseSecurityResponse(res, "role create", &rr); err != nil {
+		return nil, err
+	}
+	return &rr, nil
+}
+
 // Do executes the request and returns response or error.
 func (r RoleCreateRequest) Do(ctx context.Context, transport Transport) (*Response, error) {
 	var (
@@ -80,9 +172,42 @@ func (r RoleCreateRequest) Do(ctx context.Context, transport Transport) (*Respon
 
 	method = "PUT"
 
-	path.Grow(30 + len(r.Role))
+	role, err := escapeRoleName(r.Role)
+	if err != nil {
+		return nil, err
+	}
+
+	var body io.Reader = r.Body
+	if r.RoleBody != nil {
+		if err := r.RoleBody.Validate(); err != nil {
+			return nil, fmt.Errorf("role %q: %w", r.Role, err)
+		}
+		b, err := JSONCodecOf(transport).Marshal(r.RoleBody)
+		if err != nil {
+			return nil, fmt.Errorf("cannot encode role: %s", err)
+		}
+		body = bytes.NewReader(b)
+	}
+
+	if r.CreateOnly {
+		res, err := RoleGetRequest{Role: r.Role, Header: noCacheHeader(r.Header)}.Do(ctx, transport)
+		if err != nil {
+			return nil, err
+		}
+		switch res.StatusCode {
+		case http.StatusOK:
+			res.Body.Close()
+			return nil, fmt.Errorf("role %q: %w", r.Role, ErrAlreadyExists)
+		case http.StatusNotFound:
+			res.Body.Close()
+		default:
+			return res, nil
+		}
+	}
+
+	path.Grow(30 + len(role))
 	path.WriteString("/_plugins/_security/api/roles/")
-	path.WriteString(r.Role)
+	path.WriteString(role)
 
 	params = make(map[string]string)
 
@@ -99,6 +224,9 @@ func (r RoleCreateRequest) Do(ctx context.Context, transport Transport) (*Respon
 	}
 
 	if r.WaitForActiveShards != "" {
+		if err := ValidateWaitForActiveShards(r.WaitForActiveShards); err != nil {
+			return nil, err
+		}
 		params["wait_for_active_shards"] = r.WaitForActiveShards
 	}
 
@@ -118,7 +246,13 @@ func (r RoleCreateRequest) Do(ctx context.Context, transport Transport) (*Respon
 		params["filter_path"] = strings.Join(r.FilterPath, ",")
 	}
 
-	req, err := newRequest(method, path.String(), r.Body)
+	for k, v := range r.QueryParams {
+		if _, ok := params[k]; !ok {
+			params[k] = v
+		}
+	}
+
+	req, err := newRequest(transport, method, path.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -131,18 +265,14 @@ func (r RoleCreateRequest) Do(ctx context.Context, transport Transport) (*Respon
 		req.URL.RawQuery = q.Encode()
 	}
 
-	if r.Body != nil {
+	if body != nil && r.Header.Get(headerContentType) == "" {
 		req.Header[headerContentType] = headerContentTypeJSON
 	}
 
 	if len(r.Header) > 0 {
-		if len(req.Header) == 0 {
-			req.Header = r.Header
-		} else {
-			for k, vv := range r.Header {
-				for _, v := range vv {
-					req.Header.Add(k, v)
-				}
+		for k, vv := range r.Header {
+			for _, v := range vv {
+				req.Header.Add(k, v)
 			}
 		}
 	}
@@ -160,6 +290,7 @@ func (r RoleCreateRequest) Do(ctx context.Context, transport Transport) (*Respon
 		StatusCode: res.StatusCode,
 		Body:       res.Body,
 		Header:     res.Header,
+		codec:      JSONCodecOf(transport),
 	}
 
 	return &response, nil
@@ -179,6 +310,30 @@ func (f RoleCreate) WithBody(v io.Reader) func(*RoleCreateRequest) {
 	}
 }
 
+// WithJSONBody - the request body, encoded to JSON with the JSON codec of the client; it replaces the body set with WithBody.
+func (f RoleCreate) WithJSONBody(v interface{}) func(*RoleCreateRequest) {
+	return func(r *RoleCreateRequest) {
+		r.Body = JSONBody(v)
+	}
+}
+
+// WithRoleBody - The role, validated and encoded as the request body; it replaces the body set with WithBody.
+func (f RoleCreate) WithRoleBody(v RoleBody) func(*RoleCreateRequest) {
+	return func(r *RoleCreateRequest) {
+		r.RoleBody = &v
+	}
+}
+
+// WithCreateOnly - create the role only when it doesn't exist, and return ErrAlreadyExists otherwise.
+//
+// The existence check and the creation are separate requests, so the operation is not atomic;
+// when the check fails, its response is returned.
+func (f RoleCreate) WithCreateOnly() func(*RoleCreateRequest) {
+	return func(r *RoleCreateRequest) {
+		r.CreateOnly = true
+	}
+}
+
 // WithMasterTimeout - explicit operation timeout for connection to cluster-manager node.
 //
 // Deprecated: To promote inclusive language, use WithClusterManagerTimeout instead.
@@ -209,6 +364,18 @@ func (f RoleCreate) WithWaitForActiveShards(v string) func(*RoleCreateRequest) {
 	}
 }
 
+// WithQueryParam adds a query parameter which has no dedicated option.
+//
+// Parameters set by the other options take precedence.
+func (f RoleCreate) WithQueryParam(key, value string) func(*RoleCreateRequest) {
+	return func(r *RoleCreateRequest) {
+		if r.QueryParams == nil {
+			r.QueryParams = make(map[string]string)
+		}
+		r.QueryParams[key] = value
+	}
+}
+
 // WithPretty makes the response body pretty-printed.
 func (f RoleCreate) WithPretty() func(*RoleCreateRequest) {
 	return func(r *RoleCreateRequest) {
@@ -230,6 +397,15 @@ func (f RoleCreate) WithErrorTrace() func(*RoleCreateRequest) {
 	}
 }
 
+// WithDebug makes the response body pretty-printed and human-readable, and includes the stack trace for errors.
+func (f RoleCreate) WithDebug() func(*RoleCreateRequest) {
+	return func(r *RoleCreateRequest) {
+		r.Pretty = true
+		r.Human = true
+		r.ErrorTrace = true
+	}
+}
+
 // WithFilterPath filters the properties of the response body.
 func (f RoleCreate) WithFilterPath(v ...string) func(*RoleCreateRequest) {
 	return func(r *RoleCreateRequest) {
@@ -249,6 +425,30 @@ func (f RoleCreate) WithHeader(h map[string]string) func(*RoleCreateRequest) {
 	}
 }
 
+// WithHTTPHeader adds the headers to the HTTP request, keeping the multiple values of a header.
+func (f RoleCreate) WithHTTPHeader(h http.Header) func(*RoleCreateRequest) {
+	return func(r *RoleCreateRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, vv := range h {
+			for _, v := range vv {
+				r.Header.Add(k, v)
+			}
+		}
+	}
+}
+
+// WithAccept sets the media type of the response, eg. "application/yaml"; the default is JSON.
+func (f RoleCreate) WithAccept(v string) func(*RoleCreateRequest) {
+	return func(r *RoleCreateRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Accept", v)
+	}
+}
+
 // WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
 func (f RoleCreate) WithOpaqueID(s string) func(*RoleCreateRequest) {
 	return func(r *RoleCreateRequest) {
@@ -258,3 +458,34 @@ func (f RoleCreate) WithOpaqueID(s string) func(*RoleCreateRequest) {
 		r.Header.Set("X-Opaque-Id", s)
 	}
 }
+
+// WithContentType sets the Content-Type header of the request, overriding the default of the request body.
+func (f RoleCreate) WithContentType(v string) func(*RoleCreateRequest) {
+	return func(r *RoleCreateRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Content-Type", v)
+	}
+}
+
+// WithBasicAuth sets the credentials of the request, overriding the credentials of the client.
+func (f RoleCreate) WithBasicAuth(username, password string) func(*RoleCreateRequest) {
+	return func(r *RoleCreateRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Authorization", basicAuth(username, password))
+	}
+}
+
+// WithMethodOverride sends the request as POST, with the X-HTTP-Method-Override header set to PUT,
+// for the proxies which block PUT requests.
+func (f RoleCreate) WithMethodOverride() func(*RoleCreateRequest) {
+	return func(r *RoleCreateRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("X-HTTP-Method-Override", "PUT")
+	}
+}
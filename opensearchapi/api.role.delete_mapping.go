@@ -60,6 +60,8 @@ type RoleMappingDeleteRequest struct {
 	Timeout               time.Duration
 	WaitForActiveShards   string
 
+	QueryParams map[string]string
+
 	Pretty     bool
 	Human      bool
 	ErrorTrace bool
@@ -75,19 +77,84 @@ func (r RoleMappingDeleteRequest) Do(ctx context.Context, transport Transport) (
 	var (
 		method string
 		path   strings.Builder
+		params map[string]string
 	)
 
 	method = "DELETE"
 
-	path.Grow(37 + len(r.Role))
+	role, err := escapeRoleName(r.Role)
+	if err != nil {
+		return nil, err
+	}
+
+	path.Grow(37 + len(role))
 	path.WriteString("/_plugins/_security/api/rolesmapping/")
-	path.WriteString(r.Role)
+	path.WriteString(role)
+
+	params = make(map[string]string)
+
+	if r.MasterTimeout != 0 {
+		params["master_timeout"] = formatDuration(r.MasterTimeout)
+	}
+
+	if r.ClusterManagerTimeout != 0 {
+		params["cluster_manager_timeout"] = formatDuration(r.ClusterManagerTimeout)
+	}
+
+	if r.Timeout != 0 {
+		params["timeout"] = formatDuration(r.Timeout)
+	}
+
+	if r.WaitForActiveShards != "" {
+		if err := ValidateWaitForActiveShards(r.WaitForActiveShards); err != nil {
+			return nil, err
+		}
+		params["wait_for_active_shards"] = r.WaitForActiveShards
+	}
+
+	if r.Pretty {
+		params["pretty"] = "true"
+	}
+
+	if r.Human {
+		params["human"] = "true"
+	}
 
-	req, err := newRequest(method, path.String(), nil)
+	if r.ErrorTrace {
+		params["error_trace"] = "true"
+	}
+
+	if len(r.FilterPath) > 0 {
+		params["filter_path"] = strings.Join(r.FilterPath, ",")
+	}
+
+	for k, v := range r.QueryParams {
+		if _, ok := params[k]; !ok {
+			params[k] = v
+		}
+	}
+
+	req, err := newRequest(transport, method, path.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
+	if len(params) > 0 {
+		q := req.URL.Query()
+		for k, v := range params {
+			q.Set(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	if len(r.Header) > 0 {
+		for k, vv := range r.Header {
+			for _, v := range vv {
+				req.Header.Add(k, v)
+			}
+		}
+	}
+
 	if ctx != nil {
 		req = req.WithContext(ctx)
 	}
@@ -101,6 +168,7 @@ func (r RoleMappingDeleteRequest) Do(ctx context.Context, transport Transport) (
 		StatusCode: res.StatusCode,
 		Body:       res.Body,
 		Header:     res.Header,
+		codec:      JSONCodecOf(transport),
 	}
 
 	return &response, nil
@@ -150,6 +218,18 @@ func (f RoleMappingDelete) WithWaitForActiveShards(v string) func(*RoleMappingDe
 	}
 }
 
+// WithQueryParam adds a query parameter which has no dedicated option.
+//
+// Parameters set by the other options take precedence.
+func (f RoleMappingDelete) WithQueryParam(key, value string) func(*RoleMappingDeleteRequest) {
+	return func(r *RoleMappingDeleteRequest) {
+		if r.QueryParams == nil {
+			r.QueryParams = make(map[string]string)
+		}
+		r.QueryParams[key] = value
+	}
+}
+
 // WithPretty makes the response body pretty-printed.
 func (f RoleMappingDelete) WithPretty() func(*RoleMappingDeleteRequest) {
 	return func(r *RoleMappingDeleteRequest) {
@@ -171,6 +251,15 @@ func (f RoleMappingDelete) WithErrorTrace() func(*RoleMappingDeleteRequest) {
 	}
 }
 
+// WithDebug makes the response body pretty-printed and human-readable, and includes the stack trace for errors.
+func (f RoleMappingDelete) WithDebug() func(*RoleMappingDeleteRequest) {
+	return func(r *RoleMappingDeleteRequest) {
+		r.Pretty = true
+		r.Human = true
+		r.ErrorTrace = true
+	}
+}
+
 // WithFilterPath filters the properties of the response body.
 func (f RoleMappingDelete) WithFilterPath(v ...string) func(*RoleMappingDeleteRequest) {
 	return func(r *RoleMappingDeleteRequest) {
@@ -190,6 +279,30 @@ func (f RoleMappingDelete) WithHeader(h map[string]string) func(*RoleMappingDele
 	}
 }
 
+// WithHTTPHeader adds the headers to the HTTP request, keeping the multiple values of a header.
+func (f RoleMappingDelete) WithHTTPHeader(h http.Header) func(*RoleMappingDeleteRequest) {
+	return func(r *RoleMappingDeleteRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, vv := range h {
+			for _, v := range vv {
+				r.Header.Add(k, v)
+			}
+		}
+	}
+}
+
+// WithAccept sets the media type of the response, eg. "application/yaml"; the default is JSON.
+func (f RoleMappingDelete) WithAccept(v string) func(*RoleMappingDeleteRequest) {
+	return func(r *RoleMappingDeleteRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Accept", v)
+	}
+}
+
 // WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
 func (f RoleMappingDelete) WithOpaqueID(s string) func(*RoleMappingDeleteRequest) {
 	return func(r *RoleMappingDeleteRequest) {
@@ -199,3 +312,24 @@ func (f RoleMappingDelete) WithOpaqueID(s string) func(*RoleMappingDeleteRequest
 		r.Header.Set("X-Opaque-Id", s)
 	}
 }
+
+// WithBasicAuth sets the credentials of the request, overriding the credentials of the client.
+func (f RoleMappingDelete) WithBasicAuth(username, password string) func(*RoleMappingDeleteRequest) {
+	return func(r *RoleMappingDeleteRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Authorization", basicAuth(username, password))
+	}
+}
+
+// WithMethodOverride sends the request as POST, with the X-HTTP-Method-Override header set to DELETE,
+// for the proxies which block DELETE requests.
+func (f RoleMappingDelete) WithMethodOverride() func(*RoleMappingDeleteRequest) {
+	return func(r *RoleMappingDeleteRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("X-HTTP-Method-Override", "DELETE")
+	}
+}
@@ -0,0 +1,264 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchapi
+
+import (
+	"context"
+	"net/http"
+	"strings"
+)
+
+func newRoleGetFunc(t Transport) RoleGet {
+	return func(o ...func(*RoleGetRequest)) (*Response, error) {
+		var r = RoleGetRequest{}
+		for _, f := range o {
+			f(&r)
+		}
+		return r.Do(r.ctx, t)
+	}
+}
+
+// ----- API Definition -------------------------------------------------------
+
+// RoleGet returns a role, or all roles when no role is specified.
+//
+// Like the other reads of the security configuration, the request has no local parameter:
+// the node receiving it reads the security index itself, without going through the cluster manager.
+type RoleGet func(o ...func(*RoleGetRequest)) (*Response, error)
+
+// RoleGetRequest configures the Role Get API request.
+type RoleGetRequest struct {
+	Role string
+
+	QueryParams map[string]string
+
+	Pretty     bool
+	Human      bool
+	ErrorTrace bool
+	FilterPath []string
+
+	Header http.Header
+
+	ctx context.Context
+}
+
+// Do executes the request and returns response or error.
+func (r RoleGetRequest) Do(ctx context.Context, transport Transport) (*Response, error) {
+	var (
+		method string
+		path   strings.Builder
+		params map[string]string
+	)
+
+	method = "GET"
+
+	path.Grow(31 + len(r.Role))
+	path.WriteString("/_plugins/_security/api/roles")
+	if r.Role != "" {
+		role, err := escapeRoleName(r.Role)
+		if err != nil {
+			return nil, err
+		}
+		path.WriteString("/")
+		path.WriteString(role)
+	}
+
+	params = make(map[string]string)
+
+	if r.Pretty {
+		params["pretty"] = "true"
+	}
+
+	if r.Human {
+		params["human"] = "true"
+	}
+
+	if r.ErrorTrace {
+		params["error_trace"] = "true"
+	}
+
+	if len(r.FilterPath) > 0 {
+		params["filter_path"] = strings.Join(r.FilterPath, ",")
+	}
+
+	for k, v := range r.QueryParams {
+		if _, ok := params[k]; !ok {
+			params[k] = v
+		}
+	}
+
+	req, err := newRequest(transport, method, path.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(params) > 0 {
+		q := req.URL.Query()
+		for k, v := range params {
+			q.Set(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	if len(r.Header) > 0 {
+		for k, vv := range r.Header {
+			for _, v := range vv {
+				req.Header.Add(k, v)
+			}
+		}
+	}
+
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	res, err := transport.Perform(req)
+	if err != nil {
+		return nil, err
+	}
+
+	response := Response{
+		StatusCode: res.StatusCode,
+		Body:       res.Body,
+		Header:     res.Header,
+		codec:      JSONCodecOf(transport),
+	}
+
+	return &response, nil
+}
+
+// WithContext sets the request context.
+func (f RoleGet) WithContext(v context.Context) func(*RoleGetRequest) {
+	return func(r *RoleGetRequest) {
+		r.ctx = v
+	}
+}
+
+// WithRole - the name of the role; all roles are returned when not set.
+func (f RoleGet) WithRole(v string) func(*RoleGetRequest) {
+	return func(r *RoleGetRequest) {
+		r.Role = v
+	}
+}
+
+// WithQueryParam adds a query parameter which has no dedicated option.
+//
+// Parameters set by the other options take precedence.
+func (f RoleGet) WithQueryParam(key, value string) func(*RoleGetRequest) {
+	return func(r *RoleGetRequest) {
+		if r.QueryParams == nil {
+			r.QueryParams = make(map[string]string)
+		}
+		r.QueryParams[key] = value
+	}
+}
+
+// WithPretty makes the response body pretty-printed.
+func (f RoleGet) WithPretty() func(*RoleGetRequest) {
+	return func(r *RoleGetRequest) {
+		r.Pretty = true
+	}
+}
+
+// WithHuman makes statistical values human-readable.
+func (f RoleGet) WithHuman() func(*RoleGetRequest) {
+	return func(r *RoleGetRequest) {
+		r.Human = true
+	}
+}
+
+// WithErrorTrace includes the stack trace for errors in the response body.
+func (f RoleGet) WithErrorTrace() func(*RoleGetRequest) {
+	return func(r *RoleGetRequest) {
+		r.ErrorTrace = true
+	}
+}
+
+// WithDebug makes the response body pretty-printed and human-readable, and includes the stack trace for errors.
+func (f RoleGet) WithDebug() func(*RoleGetRequest) {
+	return func(r *RoleGetRequest) {
+		r.Pretty = true
+		r.Human = true
+		r.ErrorTrace = true
+	}
+}
+
+// WithFilterPath filters the properties of the response body.
+func (f RoleGet) WithFilterPath(v ...string) func(*RoleGetRequest) {
+	return func(r *RoleGetRequest) {
+		r.FilterPath = v
+	}
+}
+
+// WithHeader adds the headers to the HTTP request.
+func (f RoleGet) WithHeader(h map[string]string) func(*RoleGetRequest) {
+	return func(r *RoleGetRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, v := range h {
+			r.Header.Add(k, v)
+		}
+	}
+}
+
+// WithHTTPHeader adds the headers to the HTTP request, keeping the multiple values of a header.
+func (f RoleGet) WithHTTPHeader(h http.Header) func(*RoleGetRequest) {
+	return func(r *RoleGetRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, vv := range h {
+			for _, v := range vv {
+				r.Header.Add(k, v)
+			}
+		}
+	}
+}
+
+// WithAccept sets the media type of the response, eg. "application/yaml"; the default is JSON.
+func (f RoleGet) WithAccept(v string) func(*RoleGetRequest) {
+	return func(r *RoleGetRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Accept", v)
+	}
+}
+
+// WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
+func (f RoleGet) WithOpaqueID(s string) func(*RoleGetRequest) {
+	return func(r *RoleGetRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("X-Opaque-Id", s)
+	}
+}
+
+// WithBasicAuth sets the credentials of the request, overriding the credentials of the client.
+func (f RoleGet) WithBasicAuth(username, password string) func(*RoleGetRequest) {
+	return func(r *RoleGetRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Authorization", basicAuth(username, password))
+	}
+}
+
+// WithNoCache bypasses the response cache of the client, when enabled.
+func (f RoleGet) WithNoCache() func(*RoleGetRequest) {
+	return func(r *RoleGetRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Cache-Control", "no-cache")
+	}
+}
@@ -0,0 +1,261 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchapi
+
+import (
+	"context"
+	"net/http"
+	"strings"
+)
+
+func newRoleMappingGetFunc(t Transport) RoleMappingGet {
+	return func(o ...func(*RoleMappingGetRequest)) (*Response, error) {
+		var r = RoleMappingGetRequest{}
+		for _, f := range o {
+			f(&r)
+		}
+		return r.Do(r.ctx, t)
+	}
+}
+
+// ----- API Definition -------------------------------------------------------
+
+// RoleMappingGet returns a role mapping, or all role mappings when no role is specified.
+type RoleMappingGet func(o ...func(*RoleMappingGetRequest)) (*Response, error)
+
+// RoleMappingGetRequest configures the Role Mapping Get API request.
+type RoleMappingGetRequest struct {
+	Role string
+
+	QueryParams map[string]string
+
+	Pretty     bool
+	Human      bool
+	ErrorTrace bool
+	FilterPath []string
+
+	Header http.Header
+
+	ctx context.Context
+}
+
+// Do executes the request and returns response or error.
+func (r RoleMappingGetRequest) Do(ctx context.Context, transport Transport) (*Response, error) {
+	var (
+		method string
+		path   strings.Builder
+		params map[string]string
+	)
+
+	method = "GET"
+
+	path.Grow(38 + len(r.Role))
+	path.WriteString("/_plugins/_security/api/rolesmapping")
+	if r.Role != "" {
+		role, err := escapeRoleName(r.Role)
+		if err != nil {
+			return nil, err
+		}
+		path.WriteString("/")
+		path.WriteString(role)
+	}
+
+	params = make(map[string]string)
+
+	if r.Pretty {
+		params["pretty"] = "true"
+	}
+
+	if r.Human {
+		params["human"] = "true"
+	}
+
+	if r.ErrorTrace {
+		params["error_trace"] = "true"
+	}
+
+	if len(r.FilterPath) > 0 {
+		params["filter_path"] = strings.Join(r.FilterPath, ",")
+	}
+
+	for k, v := range r.QueryParams {
+		if _, ok := params[k]; !ok {
+			params[k] = v
+		}
+	}
+
+	req, err := newRequest(transport, method, path.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(params) > 0 {
+		q := req.URL.Query()
+		for k, v := range params {
+			q.Set(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	if len(r.Header) > 0 {
+		for k, vv := range r.Header {
+			for _, v := range vv {
+				req.Header.Add(k, v)
+			}
+		}
+	}
+
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	res, err := transport.Perform(req)
+	if err != nil {
+		return nil, err
+	}
+
+	response := Response{
+		StatusCode: res.StatusCode,
+		Body:       res.Body,
+		Header:     res.Header,
+		codec:      JSONCodecOf(transport),
+	}
+
+	return &response, nil
+}
+
+// WithContext sets the request context.
+func (f RoleMappingGet) WithContext(v context.Context) func(*RoleMappingGetRequest) {
+	return func(r *RoleMappingGetRequest) {
+		r.ctx = v
+	}
+}
+
+// WithRole - the name of the mapped role; all role mappings are returned when not set.
+func (f RoleMappingGet) WithRole(v string) func(*RoleMappingGetRequest) {
+	return func(r *RoleMappingGetRequest) {
+		r.Role = v
+	}
+}
+
+// WithQueryParam adds a query parameter which has no dedicated option.
+//
+// Parameters set by the other options take precedence.
+func (f RoleMappingGet) WithQueryParam(key, value string) func(*RoleMappingGetRequest) {
+	return func(r *RoleMappingGetRequest) {
+		if r.QueryParams == nil {
+			r.QueryParams = make(map[string]string)
+		}
+		r.QueryParams[key] = value
+	}
+}
+
+// WithPretty makes the response body pretty-printed.
+func (f RoleMappingGet) WithPretty() func(*RoleMappingGetRequest) {
+	return func(r *RoleMappingGetRequest) {
+		r.Pretty = true
+	}
+}
+
+// WithHuman makes statistical values human-readable.
+func (f RoleMappingGet) WithHuman() func(*RoleMappingGetRequest) {
+	return func(r *RoleMappingGetRequest) {
+		r.Human = true
+	}
+}
+
+// WithErrorTrace includes the stack trace for errors in the response body.
+func (f RoleMappingGet) WithErrorTrace() func(*RoleMappingGetRequest) {
+	return func(r *RoleMappingGetRequest) {
+		r.ErrorTrace = true
+	}
+}
+
+// WithDebug makes the response body pretty-printed and human-readable, and includes the stack trace for errors.
+func (f RoleMappingGet) WithDebug() func(*RoleMappingGetRequest) {
+	return func(r *RoleMappingGetRequest) {
+		r.Pretty = true
+		r.Human = true
+		r.ErrorTrace = true
+	}
+}
+
+// WithFilterPath filters the properties of the response body.
+func (f RoleMappingGet) WithFilterPath(v ...string) func(*RoleMappingGetRequest) {
+	return func(r *RoleMappingGetRequest) {
+		r.FilterPath = v
+	}
+}
+
+// WithHeader adds the headers to the HTTP request.
+func (f RoleMappingGet) WithHeader(h map[string]string) func(*RoleMappingGetRequest) {
+	return func(r *RoleMappingGetRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, v := range h {
+			r.Header.Add(k, v)
+		}
+	}
+}
+
+// WithHTTPHeader adds the headers to the HTTP request, keeping the multiple values of a header.
+func (f RoleMappingGet) WithHTTPHeader(h http.Header) func(*RoleMappingGetRequest) {
+	return func(r *RoleMappingGetRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, vv := range h {
+			for _, v := range vv {
+				r.Header.Add(k, v)
+			}
+		}
+	}
+}
+
+// WithAccept sets the media type of the response, eg. "application/yaml"; the default is JSON.
+func (f RoleMappingGet) WithAccept(v string) func(*RoleMappingGetRequest) {
+	return func(r *RoleMappingGetRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Accept", v)
+	}
+}
+
+// WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
+func (f RoleMappingGet) WithOpaqueID(s string) func(*RoleMappingGetRequest) {
+	return func(r *RoleMappingGetRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("X-Opaque-Id", s)
+	}
+}
+
+// WithBasicAuth sets the credentials of the request, overriding the credentials of the client.
+func (f RoleMappingGet) WithBasicAuth(username, password string) func(*RoleMappingGetRequest) {
+	return func(r *RoleMappingGetRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Authorization", basicAuth(username, password))
+	}
+}
+
+// WithNoCache bypasses the response cache of the client, when enabled.
+func (f RoleMappingGet) WithNoCache() func(*RoleMappingGetRequest) {
+	return func(r *RoleMappingGetRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Cache-Control", "no-cache")
+	}
+}
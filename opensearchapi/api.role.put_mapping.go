@@ -27,8 +27,12 @@
 package opensearchapi
 
 import (
+	"bytes"
 	"context"
+	"errors"
+	"fmt"
 	"io"
+	"net"
 	"net/http"
 	"strings"
 	"time"
@@ -53,13 +57,17 @@ type RoleMappingCreate func(role string, o ...func(*RoleMappingCreateRequest)) (
 type RoleMappingCreateRequest struct {
 	Role string
 
-	Body io.Reader
+	Body        io.Reader
+	MappingBody *RoleMappingBody
+	Description string
 
 	MasterTimeout         time.Duration
 	ClusterManagerTimeout time.Duration
 	Timeout               time.Duration
 	WaitForActiveShards   string
 
+	QueryParams map[string]string
+
 	Pretty     bool
 	Human      bool
 	ErrorTrace bool
@@ -70,6 +78,53 @@ type RoleMappingCreateRequest struct {
 	ctx context.Context
 }
 
+// RoleMappingBody represents the body of the Role Mapping Create API request.
+//
+// Empty lists are omitted from the serialized JSON.
+type RoleMappingBody struct {
+	BackendRoles    []string `json:"backend_roles,omitempty"`
+	AndBackendRoles []string `json:"and_backend_roles,omitempty"`
+	Users           []string `json:"users,omitempty"`
+	Hosts           []string `json:"hosts,omitempty"`
+	Description     string   `json:"description,omitempty"`
+}
+
+// Validate returns an error when the body maps no backend role, user or host,
+// or when it contains an invalid host.
+//
+// Hosts are either host names, IP addresses or CIDR blocks; CIDR blocks must parse.
+func (b RoleMappingBody) Validate() error {
+	if len(b.BackendRoles) == 0 && len(b.AndBackendRoles) == 0 && len(b.Users) == 0 && len(b.Hosts) == 0 {
+		return errors.New("invalid role mapping: at least one of backend_roles, and_backend_roles, users or hosts is required")
+	}
+	for _, host := range b.Hosts {
+		if strings.TrimSpace(host) == "" {
+			return fmt.Errorf("invalid role mapping host %q: empty host", host)
+		}
+		if strings.Contains(host, "/") {
+			if _, _, err := net.ParseCIDR(host); err != nil {
+				return fmt.Errorf("invalid role mapping host %q: %s", host, err)
+			}
+		}
+	}
+	return nil
+}
+
+// RoleMappingCreateResponse represents the Role Mapping Create API response.
+type RoleMappingCreateResponse struct {
+	Status  SecurityStatus `json:"status"`
+	Message string         `json:"message"`
+}
+
+// ParseRoleMappingCreateResponse decodes the body of a Role Mapping Create API response and closes it.
+func ParseRoleMappingCreateResponse(res *Response) (*RoleMappingCreateResponse, error) {
+	var rr RoleMappingCreateResponse
+	if err := parseSecurityResponse(res, "role mapping create", &rr); err != nil {
+		return nil, err
+	}
+	return &rr, nil
+}
+
 // Do executes the request and returns response or error.
 func (r RoleMappingCreateRequest) Do(ctx context.Context, transport Transport) (*Response, error) {
 	var (
@@ -80,9 +135,14 @@ func (r RoleMappingCreateRequest) Do(ctx context.Context, transport Transport) (
 
 	method = "PUT"
 
-	path.Grow(37 + len(r.Role))
+	role, err := escapeRoleName(r.Role)
+	if err != nil {
+		return nil, err
+	}
+
+	path.Grow(37 + len(role))
 	path.WriteString("/_plugins/_security/api/rolesmapping/")
-	path.WriteString(r.Role)
+	path.WriteString(role)
 
 	params = make(map[string]string)
 
@@ -99,6 +159,9 @@ func (r RoleMappingCreateRequest) Do(ctx context.Context, transport Transport) (
 	}
 
 	if r.WaitForActiveShards != "" {
+		if err := ValidateWaitForActiveShards(r.WaitForActiveShards); err != nil {
+			return nil, err
+		}
 		params["wait_for_active_shards"] = r.WaitForActiveShards
 	}
 
@@ -118,7 +181,33 @@ func (r RoleMappingCreateRequest) Do(ctx context.Context, transport Transport) (
 		params["filter_path"] = strings.Join(r.FilterPath, ",")
 	}
 
-	req, err := newRequest(method, path.String(), r.Body)
+	for k, v := range r.QueryParams {
+		if _, ok := params[k]; !ok {
+			params[k] = v
+		}
+	}
+
+	if r.Description != "" && r.MappingBody == nil {
+		return nil, errors.New("invalid role mapping: the description requires the role mapping body, see WithMappingBody")
+	}
+
+	var body io.Reader = r.Body
+	if r.MappingBody != nil {
+		mapping := *r.MappingBody
+		if r.Description != "" {
+			mapping.Description = r.Description
+		}
+		if err := mapping.Validate(); err != nil {
+			return nil, err
+		}
+		b, err := JSONCodecOf(transport).Marshal(mapping)
+		if err != nil {
+			return nil, fmt.Errorf("cannot encode role mapping: %s", err)
+		}
+		body = bytes.NewReader(b)
+	}
+
+	req, err := newRequest(transport, method, path.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -131,18 +220,14 @@ func (r RoleMappingCreateRequest) Do(ctx context.Context, transport Transport) (
 		req.URL.RawQuery = q.Encode()
 	}
 
-	if r.Body != nil {
+	if body != nil && r.Header.Get(headerContentType) == "" {
 		req.Header[headerContentType] = headerContentTypeJSON
 	}
 
 	if len(r.Header) > 0 {
-		if len(req.Header) == 0 {
-			req.Header = r.Header
-		} else {
-			for k, vv := range r.Header {
-				for _, v := range vv {
-					req.Header.Add(k, v)
-				}
+		for k, vv := range r.Header {
+			for _, v := range vv {
+				req.Header.Add(k, v)
 			}
 		}
 	}
@@ -160,6 +245,7 @@ func (r RoleMappingCreateRequest) Do(ctx context.Context, transport Transport) (
 		StatusCode: res.StatusCode,
 		Body:       res.Body,
 		Header:     res.Header,
+		codec:      JSONCodecOf(transport),
 	}
 
 	return &response, nil
@@ -179,6 +265,27 @@ func (f RoleMappingCreate) WithBody(v io.Reader) func(*RoleMappingCreateRequest)
 	}
 }
 
+// WithJSONBody - the request body, encoded to JSON with the JSON codec of the client; it replaces the body set with WithBody.
+func (f RoleMappingCreate) WithJSONBody(v interface{}) func(*RoleMappingCreateRequest) {
+	return func(r *RoleMappingCreateRequest) {
+		r.Body = JSONBody(v)
+	}
+}
+
+// WithMappingBody - The role mapping, validated and encoded as the request body; it replaces the body set with WithBody.
+func (f RoleMappingCreate) WithMappingBody(v RoleMappingBody) func(*RoleMappingCreateRequest) {
+	return func(r *RoleMappingCreateRequest) {
+		r.MappingBody = &v
+	}
+}
+
+// WithDescription - the description of the role mapping; it replaces the description of the body set with WithMappingBody.
+func (f RoleMappingCreate) WithDescription(v string) func(*RoleMappingCreateRequest) {
+	return func(r *RoleMappingCreateRequest) {
+		r.Description = v
+	}
+}
+
 // WithMasterTimeout - explicit operation timeout for connection to cluster-manager node.
 //
 // Deprecated: To promote inclusive language, use WithClusterManagerTimeout instead.
@@ -209,6 +316,18 @@ func (f RoleMappingCreate) WithWaitForActiveShards(v string) func(*RoleMappingCr
 	}
 }
 
+// WithQueryParam adds a query parameter which has no dedicated option.
+//
+// Parameters set by the other options take precedence.
+func (f RoleMappingCreate) WithQueryParam(key, value string) func(*RoleMappingCreateRequest) {
+	return func(r *RoleMappingCreateRequest) {
+		if r.QueryParams == nil {
+			r.QueryParams = make(map[string]string)
+		}
+		r.QueryParams[key] = value
+	}
+}
+
 // WithPretty makes the response body pretty-printed.
 func (f RoleMappingCreate) WithPretty() func(*RoleMappingCreateRequest) {
 	return func(r *RoleMappingCreateRequest) {
@@ -230,6 +349,15 @@ func (f RoleMappingCreate) WithErrorTrace() func(*RoleMappingCreateRequest) {
 	}
 }
 
+// WithDebug makes the response body pretty-printed and human-readable, and includes the stack trace for errors.
+func (f RoleMappingCreate) WithDebug() func(*RoleMappingCreateRequest) {
+	return func(r *RoleMappingCreateRequest) {
+		r.Pretty = true
+		r.Human = true
+		r.ErrorTrace = true
+	}
+}
+
 // WithFilterPath filters the properties of the response body.
 func (f RoleMappingCreate) WithFilterPath(v ...string) func(*RoleMappingCreateRequest) {
 	return func(r *RoleMappingCreateRequest) {
@@ -249,6 +377,30 @@ func (f RoleMappingCreate) WithHeader(h map[string]string) func(*RoleMappingCrea
 	}
 }
 
+// WithHTTPHeader adds the headers to the HTTP request, keeping the multiple values of a header.
+func (f RoleMappingCreate) WithHTTPHeader(h http.Header) func(*RoleMappingCreateRequest) {
+	return func(r *RoleMappingCreateRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, vv := range h {
+			for _, v := range vv {
+				r.Header.Add(k, v)
+			}
+		}
+	}
+}
+
+// WithAccept sets the media type of the response, eg. "application/yaml"; the default is JSON.
+func (f RoleMappingCreate) WithAccept(v string) func(*RoleMappingCreateRequest) {
+	return func(r *RoleMappingCreateRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Accept", v)
+	}
+}
+
 // WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
 func (f RoleMappingCreate) WithOpaqueID(s string) func(*RoleMappingCreateRequest) {
 	return func(r *RoleMappingCreateRequest) {
@@ -258,3 +410,34 @@ func (f RoleMappingCreate) WithOpaqueID(s string) func(*RoleMappingCreateRequest
 		r.Header.Set("X-Opaque-Id", s)
 	}
 }
+
+// WithContentType sets the Content-Type header of the request, overriding the default of the request body.
+func (f RoleMappingCreate) WithContentType(v string) func(*RoleMappingCreateRequest) {
+	return func(r *RoleMappingCreateRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Content-Type", v)
+	}
+}
+
+// WithBasicAuth sets the credentials of the request, overriding the credentials of the client.
+func (f RoleMappingCreate) WithBasicAuth(username, password string) func(*RoleMappingCreateRequest) {
+	return func(r *RoleMappingCreateRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Authorization", basicAuth(username, password))
+	}
+}
+
+// WithMethodOverride sends the request as POST, with the X-HTTP-Method-Override header set to PUT,
+// for the proxies which block PUT requests.
+func (f RoleMappingCreate) WithMethodOverride() func(*RoleMappingCreateRequest) {
+	return func(r *RoleMappingCreateRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("X-HTTP-Method-Override", "PUT")
+	}
+}
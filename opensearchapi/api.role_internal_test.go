@@ -0,0 +1,398 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+//go:build !integration
+// +build !integration
+
+package opensearchapi
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestRoleQueryParams(t *testing.T) {
+	var req *http.Request
+	tp := &mockTransport{RoundTripFunc: func(r *http.Request) (*http.Response, error) {
+		req = r
+		return &http.Response{StatusCode: 200}, nil
+	}}
+
+	t.Run("Escape hatch", func(t *testing.T) {
+		del := newRoleMappingDeleteFunc(tp)
+		_, err := del("test",
+			del.WithQueryParam("foo", "bar"),
+			del.WithQueryParam("pretty", "false"),
+			del.WithPretty(),
+		)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if v := req.URL.Query().Get("foo"); v != "bar" {
+			t.Errorf("Unexpected foo param: %q", v)
+		}
+		if v := req.URL.Query().Get("pretty"); v != "true" {
+			t.Errorf("Expected typed option to take precedence, got pretty=%q", v)
+		}
+	})
+
+	t.Run("Delete params and headers", func(t *testing.T) {
+		del := newRoleDeleteFunc(tp)
+		_, err := del("test", del.WithFilterPath("status"), del.WithOpaqueID("abc"))
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if v := req.URL.Query().Get("filter_path"); v != "status" {
+			t.Errorf("Unexpected filter_path param: %q", v)
+		}
+		if v := req.Header.Get("X-Opaque-Id"); v != "abc" {
+			t.Errorf("Unexpected X-Opaque-Id header: %q", v)
+		}
+	})
+
+	t.Run("Method override", func(t *testing.T) {
+		create := newRoleCreateFunc(tp)
+		if _, err := create("test", create.WithMethodOverride()); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if req.Method != "PUT" {
+			t.Errorf("Expected the transport to tunnel the method, got: %s", req.Method)
+		}
+		if v := req.Header.Get("X-HTTP-Method-Override"); v != "PUT" {
+			t.Errorf("Unexpected X-HTTP-Method-Override header: %q", v)
+		}
+
+		del := newRoleMappingDeleteFunc(tp)
+		if _, err := del("test", del.WithMethodOverride()); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if v := req.Header.Get("X-HTTP-Method-Override"); req.Method != "DELETE" || v != "DELETE" {
+			t.Errorf("Unexpected X-HTTP-Method-Override header for %s: %q", req.Method, v)
+		}
+	})
+}
+
+func TestRoleMappingBody(t *testing.T) {
+	t.Run("Omit empty", func(t *testing.T) {
+		b, err := json.Marshal(RoleMappingBody{Hosts: []string{"10.0.0.0/8"}, Description: "internal"})
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if string(b) != `{"hosts":["10.0.0.0/8"],"description":"internal"}` {
+			t.Errorf("Unexpected JSON: %s", b)
+		}
+	})
+
+	t.Run("Validate", func(t *testing.T) {
+		tests := []struct {
+			hosts   []string
+			wantErr bool
+		}{
+			{[]string{"10.0.0.0/8", "2001:db8::/32", "192.168.1.1", "*.example.com"}, false},
+			{[]string{"10.0.0.0/33"}, true},
+			{[]string{"example.com/24"}, true},
+			{[]string{" "}, true},
+			{nil, true},
+		}
+
+		for _, tt := range tests {
+			err := RoleMappingBody{Hosts: tt.hosts}.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Unexpected result for %v: %v", tt.hosts, err)
+			}
+		}
+	})
+}
+
+func TestRoleMappingCreateMappingBody(t *testing.T) {
+	var (
+		req  *http.Request
+		body []byte
+	)
+	tp := &mockTransport{RoundTripFunc: func(r *http.Request) (*http.Response, error) {
+		req = r
+		body, _ = ioutil.ReadAll(r.Body)
+		return &http.Response{StatusCode: 200}, nil
+	}}
+	create := newRoleMappingCreateFunc(tp)
+
+	t.Run("Encoded", func(t *testing.T) {
+		_, err := create("readers", create.WithMappingBody(RoleMappingBody{BackendRoles: []string{"readers"}, Users: []string{"foo"}}))
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if string(body) != `{"backend_roles":["readers"],"users":["foo"]}` {
+			t.Errorf("Unexpected body: %s", body)
+		}
+		if v := req.Header.Get("Content-Type"); v != "application/json" {
+			t.Errorf("Unexpected Content-Type header: %q", v)
+		}
+	})
+
+	t.Run("Empty", func(t *testing.T) {
+		req = nil
+		_, err := create("readers", create.WithMappingBody(RoleMappingBody{Description: "nobody"}))
+		if err == nil {
+			t.Fatalf("Expected error")
+		}
+		if req != nil {
+			t.Errorf("Expected no request to be sent")
+		}
+	})
+
+	t.Run("Description", func(t *testing.T) {
+		_, err := create("readers",
+			create.WithDescription("LDAP readers"),
+			create.WithMappingBody(RoleMappingBody{BackendRoles: []string{"readers"}, Description: "readers"}),
+		)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if string(body) != `{"backend_roles":["readers"],"description":"LDAP readers"}` {
+			t.Errorf("Unexpected body: %s", body)
+		}
+
+		req = nil
+		if _, err := create("readers", create.WithDescription("LDAP readers")); err == nil {
+			t.Fatalf("Expected error")
+		}
+		if req != nil {
+			t.Errorf("Expected no request to be sent")
+		}
+	})
+}
+
+func TestRoleCreateRoleBody(t *testing.T) {
+	t.Run("Validate", func(t *testing.T) {
+		read := []string{"read"}
+		tests := []struct {
+			body    RoleBody
+			wantErr string
+		}{
+			{RoleBody{ClusterPermissions: []string{"cluster_monitor"}, IndexPermissions: []RoleIndexPermission{{IndexPatterns: []string{"logs-*"}, AllowedActions: read}}}, ""},
+			{RoleBody{Description: "no permissions"}, ""},
+			{RoleBody{ClusterPermissions: []string{""}}, "cluster_permissions[0]"},
+			{RoleBody{IndexPermissions: []RoleIndexPermission{{IndexPatterns: []string{"a"}, AllowedActions: read}, {AllowedActions: read}}}, "index_permissions[1].index_patterns"},
+			{RoleBody{IndexPermissions: []RoleIndexPermission{{IndexPatterns: []string{"a", " "}, AllowedActions: read}}}, "index_permissions[0].index_patterns[1]"},
+			{RoleBody{IndexPermissions: []RoleIndexPermission{{IndexPatterns: []string{"a"}}}}, "index_permissions[0].allowed_actions"},
+			{RoleBody{TenantPermissions: []RoleTenantPermission{{TenantPatterns: []string{"global"}, AllowedActions: []string{""}}}}, "tenant_permissions[0].allowed_actions[0]"},
+		}
+
+		for _, tt := range tests {
+			err := tt.body.Validate()
+			if tt.wantErr == "" && err != nil {
+				t.Errorf("Unexpected error for %+v: %s", tt.body, err)
+			}
+			if tt.wantErr != "" && (err == nil || !strings.Contains(err.Error(), tt.wantErr+":")) {
+				t.Errorf("Expected error for %s, got: %v", tt.wantErr, err)
+			}
+		}
+	})
+
+	var (
+		req  *http.Request
+		body []byte
+	)
+	tp := &mockTransport{RoundTripFunc: func(r *http.Request) (*http.Response, error) {
+		req = r
+		body, _ = ioutil.ReadAll(r.Body)
+		return &http.Response{StatusCode: 200}, nil
+	}}
+	create := newRoleCreateFunc(tp)
+
+	t.Run("Encoded", func(t *testing.T) {
+		_, err := create("readers", create.WithRoleBody(RoleBody{ClusterPermissions: []string{"cluster_monitor"}}))
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if string(body) != `{"cluster_permissions":["cluster_monitor"]}` {
+			t.Errorf("Unexpected body: %s", body)
+		}
+		if v := req.Header.Get("Content-Type"); v != "application/json" {
+			t.Errorf("Unexpected Content-Type header: %q", v)
+		}
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		req = nil
+		_, err := create("readers", create.WithRoleBody(RoleBody{IndexPermissions: []RoleIndexPermission{{AllowedActions: []string{"read"}}}}))
+		if err == nil || !strings.Contains(err.Error(), `role "readers"`) {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if req != nil {
+			t.Errorf("Expected no request to be sent")
+		}
+	})
+}
+
+func TestInternalUserCreateUserBody(t *testing.T) {
+	for _, body := range []InternalUserBody{
+		{Password: "s3cr3t!", Hash: "$2y$12$abc"},
+		{BackendRoles: []string{"ingest", ""}},
+		{Attributes: map[string]string{"": "logs"}},
+	} {
+		if err := body.Validate(); err == nil {
+			t.Errorf("Expected error for %+v", body)
+		}
+	}
+
+	var sent []byte
+	tp := &mockTransport{RoundTripFunc: func(r *http.Request) (*http.Response, error) {
+		sent, _ = ioutil.ReadAll(r.Body)
+		return &http.Response{StatusCode: 200}, nil
+	}}
+	create := newInternalUserCreateFunc(tp)
+
+	if _, err := create("svc", create.WithUserBody(InternalUserBody{Password: "s3cr3t!", BackendRoles: []string{"ingest"}})); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if string(sent) != `{"password":"s3cr3t!","backend_roles":["ingest"]}` {
+		t.Errorf("Unexpected body: %s", sent)
+	}
+
+	sent = nil
+	if _, err := create("svc", create.WithUserBody(InternalUserBody{Password: "s3cr3t!", Hash: "$2y$12$abc"})); err == nil {
+		t.Errorf("Expected error")
+	}
+	if sent != nil {
+		t.Errorf("Expected no request to be sent")
+	}
+}
+
+func TestRoleName(t *testing.T) {
+	var req *http.Request
+	tp := &mockTransport{RoundTripFunc: func(r *http.Request) (*http.Response, error) {
+		req = r
+		return &http.Response{StatusCode: 200}, nil
+	}}
+
+	t.Run("Escaped", func(t *testing.T) {
+		create := newRoleCreateFunc(tp)
+		if _, err := create("team a/b:c"); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if p := req.URL.EscapedPath(); p != "/_plugins/_security/api/roles/team%20a%2Fb:c" {
+			t.Errorf("Unexpected path: %s", p)
+		}
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		req = nil
+		del := newRoleDeleteFunc(tp)
+		for _, name := range []string{"", " ", ".."} {
+			_, err := del(name)
+			if err == nil || !strings.Contains(err.Error(), "invalid role name") {
+				t.Errorf("Expected error for %q, got: %v", name, err)
+			}
+		}
+		if req != nil {
+			t.Errorf("Expected no request to be performed")
+		}
+	})
+}
+
+func TestRoleCreateOnly(t *testing.T) {
+	newCreate := func(status int, calls *[]string) RoleCreate {
+		return newRoleCreateFunc(&mockTransport{RoundTripFunc: func(r *http.Request) (*http.Response, error) {
+			*calls = append(*calls, r.Method+" "+r.Header.Get("Cache-Control"))
+			if r.Method == "GET" {
+				return &http.Response{StatusCode: status, Body: ioutil.NopCloser(strings.NewReader(`{}`))}, nil
+			}
+			return &http.Response{StatusCode: 201, Body: ioutil.NopCloser(strings.NewReader(`{}`))}, nil
+		}})
+	}
+
+	t.Run("Absent", func(t *testing.T) {
+		var calls []string
+		create := newCreate(404, &calls)
+
+		res, err := create("test", create.WithCreateOnly())
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if res.StatusCode != 201 {
+			t.Errorf("Unexpected status: %d", res.StatusCode)
+		}
+		if strings.Join(calls, ",") != "GET no-cache,PUT " {
+			t.Errorf("Unexpected calls: %q", calls)
+		}
+	})
+
+	t.Run("Present", func(t *testing.T) {
+		var calls []string
+		create := newCreate(200, &calls)
+
+		_, err := create("test", create.WithCreateOnly())
+		if !errors.Is(err, ErrAlreadyExists) {
+			t.Errorf("Expected ErrAlreadyExists, got: %v", err)
+		}
+		if len(calls) != 1 {
+			t.Errorf("Unexpected calls: %q", calls)
+		}
+	})
+
+	t.Run("Check failed", func(t *testing.T) {
+		var calls []string
+		create := newCreate(403, &calls)
+
+		res, err := create("test", create.WithCreateOnly())
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if res.StatusCode != 403 || len(calls) != 1 {
+			t.Errorf("Unexpected result: status=%d, calls=%q", res.StatusCode, calls)
+		}
+	})
+}
+
+func TestParseRoleCreateResponse(t *testing.T) {
+	newResponse := func(status int, body string) *Response {
+		return &Response{StatusCode: status, Body: ioutil.NopCloser(strings.NewReader(body))}
+	}
+
+	tests := []struct {
+		body   string
+		status SecurityStatus
+	}{
+		{`{"status":"CREATED","message":"'test' created."}`, SecurityStatusCreated},
+		{`{"status":"OK","message":"'test' updated."}`, SecurityStatusUpdated},
+		{`{"status":"ACCEPTED"}`, SecurityStatusUnknown},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.status.String(), func(t *testing.T) {
+			rr, err := ParseRoleCreateResponse(newResponse(200, tt.body))
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if rr.Status != tt.status {
+				t.Errorf("Unexpected status: want=%s, got=%s", tt.status, rr.Status)
+			}
+
+			mr, err := ParseRoleMappingCreateResponse(newResponse(201, tt.body))
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if mr.Status != tt.status || mr.Message != rr.Message {
+				t.Errorf("Unexpected role mapping response: %+v", mr)
+			}
+		})
+	}
+
+	t.Run("Error", func(t *testing.T) {
+		_, err := ParseRoleCreateResponse(newResponse(400, `{"status":"BAD_REQUEST","message":"invalid"}`))
+		if err == nil {
+			t.Errorf("Expected error")
+		}
+	})
+}
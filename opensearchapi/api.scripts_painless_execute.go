@@ -99,7 +99,7 @@ func (r ScriptsPainlessExecuteRequest) Do(ctx context.Context, transport Transpo
 		params["filter_path"] = strings.Join(r.FilterPath, ",")
 	}
 
-	req, err := newRequest(method, path.String(), r.Body)
+	req, err := newRequest(transport, method, path.String(), r.Body)
 	if err != nil {
 		return nil, err
 	}
@@ -112,18 +112,14 @@ func (r ScriptsPainlessExecuteRequest) Do(ctx context.Context, transport Transpo
 		req.URL.RawQuery = q.Encode()
 	}
 
-	if r.Body != nil {
+	if r.Body != nil && r.Header.Get(headerContentType) == "" {
 		req.Header[headerContentType] = headerContentTypeJSON
 	}
 
 	if len(r.Header) > 0 {
-		if len(req.Header) == 0 {
-			req.Header = r.Header
-		} else {
-			for k, vv := range r.Header {
-				for _, v := range vv {
-					req.Header.Add(k, v)
-				}
+		for k, vv := range r.Header {
+			for _, v := range vv {
+				req.Header.Add(k, v)
 			}
 		}
 	}
@@ -141,6 +137,7 @@ func (r ScriptsPainlessExecuteRequest) Do(ctx context.Context, transport Transpo
 		StatusCode: res.StatusCode,
 		Body:       res.Body,
 		Header:     res.Header,
+		codec:      JSONCodecOf(transport),
 	}
 
 	return &response, response.Err()
@@ -162,6 +159,14 @@ func (f ScriptsPainlessExecute) WithBody(v io.Reader) func(*ScriptsPainlessExecu
 	}
 }
 
+// WithJSONBody - the request body, encoded to JSON with the JSON codec of the client; it replaces the body set with WithBody.
+//
+func (f ScriptsPainlessExecute) WithJSONBody(v interface{}) func(*ScriptsPainlessExecuteRequest) {
+	return func(r *ScriptsPainlessExecuteRequest) {
+		r.Body = JSONBody(v)
+	}
+}
+
 // WithPretty makes the response body pretty-printed.
 //
 func (f ScriptsPainlessExecute) WithPretty() func(*ScriptsPainlessExecuteRequest) {
@@ -186,6 +191,16 @@ func (f ScriptsPainlessExecute) WithErrorTrace() func(*ScriptsPainlessExecuteReq
 	}
 }
 
+// WithDebug makes the response body pretty-printed and human-readable, and includes the stack trace for errors.
+//
+func (f ScriptsPainlessExecute) WithDebug() func(*ScriptsPainlessExecuteRequest) {
+	return func(r *ScriptsPainlessExecuteRequest) {
+		r.Pretty = true
+		r.Human = true
+		r.ErrorTrace = true
+	}
+}
+
 // WithFilterPath filters the properties of the response body.
 //
 func (f ScriptsPainlessExecute) WithFilterPath(v ...string) func(*ScriptsPainlessExecuteRequest) {
@@ -207,6 +222,32 @@ func (f ScriptsPainlessExecute) WithHeader(h map[string]string) func(*ScriptsPai
 	}
 }
 
+// WithHTTPHeader adds the headers to the HTTP request, keeping the multiple values of a header.
+//
+func (f ScriptsPainlessExecute) WithHTTPHeader(h http.Header) func(*ScriptsPainlessExecuteRequest) {
+	return func(r *ScriptsPainlessExecuteRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, vv := range h {
+			for _, v := range vv {
+				r.Header.Add(k, v)
+			}
+		}
+	}
+}
+
+// WithAccept sets the media type of the response, eg. "application/yaml"; the default is JSON.
+//
+func (f ScriptsPainlessExecute) WithAccept(v string) func(*ScriptsPainlessExecuteRequest) {
+	return func(r *ScriptsPainlessExecuteRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Accept", v)
+	}
+}
+
 // WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
 //
 func (f ScriptsPainlessExecute) WithOpaqueID(s string) func(*ScriptsPainlessExecuteRequest) {
@@ -217,3 +258,25 @@ func (f ScriptsPainlessExecute) WithOpaqueID(s string) func(*ScriptsPainlessExec
 		r.Header.Set("X-Opaque-Id", s)
 	}
 }
+
+// WithContentType sets the Content-Type header of the request, overriding the default of the request body.
+//
+func (f ScriptsPainlessExecute) WithContentType(v string) func(*ScriptsPainlessExecuteRequest) {
+	return func(r *ScriptsPainlessExecuteRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Content-Type", v)
+	}
+}
+
+// WithBasicAuth sets the credentials of the request, overriding the credentials of the client.
+//
+func (f ScriptsPainlessExecute) WithBasicAuth(username, password string) func(*ScriptsPainlessExecuteRequest) {
+	return func(r *ScriptsPainlessExecuteRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Authorization", basicAuth(username, password))
+	}
+}
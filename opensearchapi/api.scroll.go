@@ -116,7 +116,7 @@ func (r ScrollRequest) Do(ctx context.Context, transport Transport) (*Response,
 		params["filter_path"] = strings.Join(r.FilterPath, ",")
 	}
 
-	req, err := newRequest(method, path.String(), r.Body)
+	req, err := newRequest(transport, method, path.String(), r.Body)
 	if err != nil {
 		return nil, err
 	}
@@ -129,18 +129,14 @@ func (r ScrollRequest) Do(ctx context.Context, transport Transport) (*Response,
 		req.URL.RawQuery = q.Encode()
 	}
 
-	if r.Body != nil {
+	if r.Body != nil && r.Header.Get(headerContentType) == "" {
 		req.Header[headerContentType] = headerContentTypeJSON
 	}
 
 	if len(r.Header) > 0 {
-		if len(req.Header) == 0 {
-			req.Header = r.Header
-		} else {
-			for k, vv := range r.Header {
-				for _, v := range vv {
-					req.Header.Add(k, v)
-				}
+		for k, vv := range r.Header {
+			for _, v := range vv {
+				req.Header.Add(k, v)
 			}
 		}
 	}
@@ -158,6 +154,7 @@ func (r ScrollRequest) Do(ctx context.Context, transport Transport) (*Response,
 		StatusCode: res.StatusCode,
 		Body:       res.Body,
 		Header:     res.Header,
+		codec:      JSONCodecOf(transport),
 	}
 
 	return &response, response.Err()
@@ -179,6 +176,14 @@ func (f Scroll) WithBody(v io.Reader) func(*ScrollRequest) {
 	}
 }
 
+// WithJSONBody - the request body, encoded to JSON with the JSON codec of the client; it replaces the body set with WithBody.
+//
+func (f Scroll) WithJSONBody(v interface{}) func(*ScrollRequest) {
+	return func(r *ScrollRequest) {
+		r.Body = JSONBody(v)
+	}
+}
+
 // WithScrollID - the scroll ID.
 //
 func (f Scroll) WithScrollID(v string) func(*ScrollRequest) {
@@ -227,6 +232,16 @@ func (f Scroll) WithErrorTrace() func(*ScrollRequest) {
 	}
 }
 
+// WithDebug makes the response body pretty-printed and human-readable, and includes the stack trace for errors.
+//
+func (f Scroll) WithDebug() func(*ScrollRequest) {
+	return func(r *ScrollRequest) {
+		r.Pretty = true
+		r.Human = true
+		r.ErrorTrace = true
+	}
+}
+
 // WithFilterPath filters the properties of the response body.
 //
 func (f Scroll) WithFilterPath(v ...string) func(*ScrollRequest) {
@@ -248,6 +263,32 @@ func (f Scroll) WithHeader(h map[string]string) func(*ScrollRequest) {
 	}
 }
 
+// WithHTTPHeader adds the headers to the HTTP request, keeping the multiple values of a header.
+//
+func (f Scroll) WithHTTPHeader(h http.Header) func(*ScrollRequest) {
+	return func(r *ScrollRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, vv := range h {
+			for _, v := range vv {
+				r.Header.Add(k, v)
+			}
+		}
+	}
+}
+
+// WithAccept sets the media type of the response, eg. "application/yaml"; the default is JSON.
+//
+func (f Scroll) WithAccept(v string) func(*ScrollRequest) {
+	return func(r *ScrollRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Accept", v)
+	}
+}
+
 // WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
 //
 func (f Scroll) WithOpaqueID(s string) func(*ScrollRequest) {
@@ -258,3 +299,25 @@ func (f Scroll) WithOpaqueID(s string) func(*ScrollRequest) {
 		r.Header.Set("X-Opaque-Id", s)
 	}
 }
+
+// WithContentType sets the Content-Type header of the request, overriding the default of the request body.
+//
+func (f Scroll) WithContentType(v string) func(*ScrollRequest) {
+	return func(r *ScrollRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Content-Type", v)
+	}
+}
+
+// WithBasicAuth sets the credentials of the request, overriding the credentials of the client.
+//
+func (f Scroll) WithBasicAuth(username, password string) func(*ScrollRequest) {
+	return func(r *ScrollRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Authorization", basicAuth(username, password))
+	}
+}
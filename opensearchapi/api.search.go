@@ -27,7 +27,10 @@
 package opensearchapi
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -114,6 +117,210 @@ type SearchRequest struct {
 	ctx context.Context
 }
 
+// SearchResponse represents the response of the Search API.
+//
+type SearchResponse struct {
+	Took            int                  `json:"took"`
+	TimedOut        bool                 `json:"timed_out"`
+	TerminatedEarly bool                 `json:"terminated_early,omitempty"`
+	Shards          SearchResponseShards `json:"_shards"`
+	Hits            struct {
+		Total    SearchResponseTotal `json:"total"`
+		MaxScore *float64            `json:"max_score"`
+		Hits     []SearchResponseHit `json:"hits"`
+	} `json:"hits"`
+	Aggregations json.RawMessage `json:"aggregations,omitempty"`
+	ScrollID     string          `json:"_scroll_id,omitempty"`
+	PitID        string          `json:"pit_id,omitempty"`
+}
+
+// SearchResponseTotal represents the total number of hits matching the query.
+//
+// It is decoded from both the object format, eg. {"value":10,"relation":"eq"},
+// and the number format returned with rest_total_hits_as_int, in which case Relation is "eq".
+// The total is zero when the search is executed with track_total_hits set to false.
+//
+type SearchResponseTotal struct {
+	Value    int64  `json:"value"`
+	Relation string `json:"relation"`
+}
+
+// UnmarshalJSON decodes the total from the object or the number format.
+//
+func (t *SearchResponseTotal) UnmarshalJSON(b []byte) error {
+	b = bytes.TrimSpace(b)
+	if len(b) > 0 && b[0] != '{' {
+		if bytes.Equal(b, []byte("null")) {
+			return nil
+		}
+		if err := DefaultJSONCodec.Unmarshal(b, &t.Value); err != nil {
+			return err
+		}
+		t.Relation = "eq"
+		return nil
+	}
+
+	type total SearchResponseTotal
+	return DefaultJSONCodec.Unmarshal(b, (*total)(t))
+}
+
+// SearchResponseShards represents the shard summary of a search response.
+//
+type SearchResponseShards struct {
+	Total      int                  `json:"total"`
+	Successful int                  `json:"successful"`
+	Skipped    int                  `json:"skipped"`
+	Failed     int                  `json:"failed"`
+	Failures   []SearchShardFailure `json:"failures,omitempty"`
+}
+
+// SearchShardFailure represents the failure of a single shard during a search.
+//
+type SearchShardFailure struct {
+	Shard  int       `json:"shard"`
+	Index  string    `json:"index"`
+	Node   string    `json:"node"`
+	Reason RootCause `json:"reason"`
+}
+
+// SearchResponseHit represents a single hit of a search response.
+//
+type SearchResponseHit struct {
+	Index  string            `json:"_index"`
+	ID     string            `json:"_id"`
+	Score  *float64          `json:"_score"`
+	Source json.RawMessage   `json:"_source"`
+	Fields json.RawMessage   `json:"fields,omitempty"`
+	Sort   []json.RawMessage `json:"sort,omitempty"`
+}
+
+// IsPartial returns true when the results don't cover all shards,
+// because some shards failed or the search timed out.
+//
+// Partial results are only returned when allow_partial_search_results is enabled,
+// see Search.WithAllowPartialSearchResults.
+//
+func (r *SearchResponse) IsPartial() bool {
+	return r.TimedOut || r.Shards.Failed > 0
+}
+
+// ShardFailures returns the failures of the shards which didn't contribute to the results.
+//
+func (r *SearchResponse) ShardFailures() []SearchShardFailure {
+	return r.Shards.Failures
+}
+
+// ParseSearchResponse decodes the body of a Search API response and closes it.
+//
+func ParseSearchResponse(res *Response) (*SearchResponse, error) {
+	if res == nil || res.Body == nil {
+		return nil, errors.New("cannot parse search response: empty response")
+	}
+	defer res.Close()
+
+	if err := res.Err(); err != nil {
+		return nil, err
+	}
+
+	var sr SearchResponse
+	if err := decodeBody(res, &sr); err != nil {
+		return nil, fmt.Errorf("cannot parse search response: %s", err)
+	}
+
+	return &sr, nil
+}
+
+// Preference values for the Search, Count and Multi Search APIs.
+//
+// Any other string not starting with "_" is a custom preference: the searches with the same
+// custom preference are routed to the same shard copies, eg. to keep the scoring consistent
+// across the searches of a user session.
+//
+const (
+	PreferenceLocal        = "_local"
+	PreferenceOnlyLocal    = "_only_local"
+	PreferencePrimary      = "_primary"
+	PreferencePrimaryFirst = "_primary_first"
+)
+
+// ValidatePreference returns an error when the preference starts with "_" but is not a known value:
+// one of the Preference constants, "_shards:<shards>" optionally followed by "|" and another preference,
+// "_only_nodes:<nodes>" or "_prefer_nodes:<nodes>".
+//
+func ValidatePreference(preference string) error {
+	if !strings.HasPrefix(preference, "_") {
+		return nil
+	}
+
+	if strings.HasPrefix(preference, "_shards:") {
+		shards := strings.TrimPrefix(preference, "_shards:")
+		var next string
+		if i := strings.IndexByte(shards, '|'); i >= 0 {
+			shards, next = shards[:i], shards[i+1:]
+			if next == "" {
+				return fmt.Errorf("invalid preference %q: missing preference after %q", preference, "|")
+			}
+		}
+		for _, s := range strings.Split(shards, ",") {
+			if _, err := strconv.Atoi(strings.TrimSpace(s)); err != nil {
+				return fmt.Errorf("invalid preference %q: invalid shard %q", preference, s)
+			}
+		}
+		if next != "" {
+			return ValidatePreference(next)
+		}
+		return nil
+	}
+
+	for _, prefix := range []string{"_only_nodes:", "_prefer_nodes:"} {
+		if strings.HasPrefix(preference, prefix) {
+			if strings.TrimPrefix(preference, prefix) == "" {
+				return fmt.Errorf("invalid preference %q: missing nodes", preference)
+			}
+			return nil
+		}
+	}
+
+	switch preference {
+	case PreferenceLocal, PreferenceOnlyLocal, PreferencePrimary, PreferencePrimaryFirst:
+		return nil
+	default:
+		return fmt.Errorf("invalid preference %q: custom preferences must not start with %q", preference, "_")
+	}
+}
+
+// formatTrackTotalHits returns the track_total_hits parameter for a bool or an integer value,
+// including a string holding one, eg. "10000".
+//
+func formatTrackTotalHits(v interface{}) (string, error) {
+	switch v := v.(type) {
+	case bool:
+		return strconv.FormatBool(v), nil
+	case int:
+		return strconv.FormatInt(int64(v), 10), nil
+	case int32:
+		return strconv.FormatInt(int64(v), 10), nil
+	case int64:
+		return strconv.FormatInt(v, 10), nil
+	case *bool:
+		if v != nil {
+			return strconv.FormatBool(*v), nil
+		}
+	case *int:
+		if v != nil {
+			return strconv.Itoa(*v), nil
+		}
+	case string:
+		if v == "true" || v == "false" {
+			return v, nil
+		}
+		if _, err := strconv.Atoi(v); err == nil {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid track total hits %v: must be a bool or an integer", v)
+}
+
 // Do executes the request and returns response or error.
 //
 func (r SearchRequest) Do(ctx context.Context, transport Transport) (*Response, error) {
@@ -204,6 +411,9 @@ func (r SearchRequest) Do(ctx context.Context, transport Transport) (*Response,
 	}
 
 	if r.Preference != "" {
+		if err := ValidatePreference(r.Preference); err != nil {
+			return nil, err
+		}
 		params["preference"] = r.Preference
 	}
 
@@ -300,7 +510,11 @@ func (r SearchRequest) Do(ctx context.Context, transport Transport) (*Response,
 	}
 
 	if r.TrackTotalHits != nil {
-		params["track_total_hits"] = fmt.Sprintf("%v", r.TrackTotalHits)
+		v, err := formatTrackTotalHits(r.TrackTotalHits)
+		if err != nil {
+			return nil, err
+		}
+		params["track_total_hits"] = v
 	}
 
 	if r.TypedKeys != nil {
@@ -327,7 +541,7 @@ func (r SearchRequest) Do(ctx context.Context, transport Transport) (*Response,
 		params["filter_path"] = strings.Join(r.FilterPath, ",")
 	}
 
-	req, err := newRequest(method, path.String(), r.Body)
+	req, err := newRequest(transport, method, path.String(), r.Body)
 	if err != nil {
 		return nil, err
 	}
@@ -340,18 +554,14 @@ func (r SearchRequest) Do(ctx context.Context, transport Transport) (*Response,
 		req.URL.RawQuery = q.Encode()
 	}
 
-	if r.Body != nil {
+	if r.Body != nil && r.Header.Get(headerContentType) == "" {
 		req.Header[headerContentType] = headerContentTypeJSON
 	}
 
 	if len(r.Header) > 0 {
-		if len(req.Header) == 0 {
-			req.Header = r.Header
-		} else {
-			for k, vv := range r.Header {
-				for _, v := range vv {
-					req.Header.Add(k, v)
-				}
+		for k, vv := range r.Header {
+			for _, v := range vv {
+				req.Header.Add(k, v)
 			}
 		}
 	}
@@ -369,6 +579,7 @@ func (r SearchRequest) Do(ctx context.Context, transport Transport) (*Response,
 		StatusCode: res.StatusCode,
 		Body:       res.Body,
 		Header:     res.Header,
+		codec:      JSONCodecOf(transport),
 	}
 
 	return &response, response.Err()
@@ -390,6 +601,14 @@ func (f Search) WithBody(v io.Reader) func(*SearchRequest) {
 	}
 }
 
+// WithJSONBody - the request body, encoded to JSON with the JSON codec of the client; it replaces the body set with WithBody.
+//
+func (f Search) WithJSONBody(v interface{}) func(*SearchRequest) {
+	return func(r *SearchRequest) {
+		r.Body = JSONBody(v)
+	}
+}
+
 // WithIndex - a list of index names to search; use _all to perform the operation on all indices.
 //
 func (f Search) WithIndex(v ...string) func(*SearchRequest) {
@@ -408,6 +627,8 @@ func (f Search) WithAllowNoIndices(v bool) func(*SearchRequest) {
 
 // WithAllowPartialSearchResults - indicate if an error should be returned if there is a partial search failure or timeout.
 //
+// Use SearchResponse.IsPartial and SearchResponse.ShardFailures to detect incomplete results.
+//
 func (f Search) WithAllowPartialSearchResults(v bool) func(*SearchRequest) {
 	return func(r *SearchRequest) {
 		r.AllowPartialSearchResults = &v
@@ -536,6 +757,8 @@ func (f Search) WithMinCompatibleShardNode(v string) func(*SearchRequest) {
 
 // WithPreference - specify the node or shard the operation should be performed on (default: random).
 //
+// See ValidatePreference for the valid values.
+//
 func (f Search) WithPreference(v string) func(*SearchRequest) {
 	return func(r *SearchRequest) {
 		r.Preference = v
@@ -696,6 +919,9 @@ func (f Search) WithSuggestText(v string) func(*SearchRequest) {
 
 // WithTerminateAfter - the maximum number of documents to collect for each shard, upon reaching which the query execution will terminate early..
 //
+// The parameter takes precedence over the terminate_after field of the body.
+// SearchResponse.TerminatedEarly reports whether the limit was reached.
+//
 func (f Search) WithTerminateAfter(v int) func(*SearchRequest) {
 	return func(r *SearchRequest) {
 		r.TerminateAfter = &v
@@ -720,6 +946,13 @@ func (f Search) WithTrackScores(v bool) func(*SearchRequest) {
 
 // WithTrackTotalHits - indicate if the number of documents that match the query should be tracked.
 //
+// The value is either a bool, true to count all the matching documents and false to skip counting,
+// or an integer, to count the matching documents up to that number: the total is then a lower bound,
+// with the "gte" relation, when more documents match. The default counts up to 10000.
+//
+// The value is sent as the track_total_hits parameter, which takes precedence over the
+// track_total_hits field of the body; the body field is only used when the option isn't set.
+//
 func (f Search) WithTrackTotalHits(v interface{}) func(*SearchRequest) {
 	return func(r *SearchRequest) {
 		r.TrackTotalHits = v
@@ -766,6 +999,16 @@ func (f Search) WithErrorTrace() func(*SearchRequest) {
 	}
 }
 
+// WithDebug makes the response body pretty-printed and human-readable, and includes the stack trace for errors.
+//
+func (f Search) WithDebug() func(*SearchRequest) {
+	return func(r *SearchRequest) {
+		r.Pretty = true
+		r.Human = true
+		r.ErrorTrace = true
+	}
+}
+
 // WithFilterPath filters the properties of the response body.
 //
 func (f Search) WithFilterPath(v ...string) func(*SearchRequest) {
@@ -787,6 +1030,32 @@ func (f Search) WithHeader(h map[string]string) func(*SearchRequest) {
 	}
 }
 
+// WithHTTPHeader adds the headers to the HTTP request, keeping the multiple values of a header.
+//
+func (f Search) WithHTTPHeader(h http.Header) func(*SearchRequest) {
+	return func(r *SearchRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, vv := range h {
+			for _, v := range vv {
+				r.Header.Add(k, v)
+			}
+		}
+	}
+}
+
+// WithAccept sets the media type of the response, eg. "application/yaml"; the default is JSON.
+//
+func (f Search) WithAccept(v string) func(*SearchRequest) {
+	return func(r *SearchRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Accept", v)
+	}
+}
+
 // WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
 //
 func (f Search) WithOpaqueID(s string) func(*SearchRequest) {
@@ -797,3 +1066,25 @@ func (f Search) WithOpaqueID(s string) func(*SearchRequest) {
 		r.Header.Set("X-Opaque-Id", s)
 	}
 }
+
+// WithContentType sets the Content-Type header of the request, overriding the default of the request body.
+//
+func (f Search) WithContentType(v string) func(*SearchRequest) {
+	return func(r *SearchRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Content-Type", v)
+	}
+}
+
+// WithBasicAuth sets the credentials of the request, overriding the credentials of the client.
+//
+func (f Search) WithBasicAuth(username, password string) func(*SearchRequest) {
+	return func(r *SearchRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Authorization", basicAuth(username, password))
+	}
+}
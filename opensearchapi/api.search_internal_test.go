@@ -0,0 +1,191 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+//go:build !integration
+// +build !integration
+
+package opensearchapi
+
+import (
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestSearchPartialResults(t *testing.T) {
+	t.Run("Param", func(t *testing.T) {
+		var query string
+		tp := &mockTransport{RoundTripFunc: func(req *http.Request) (*http.Response, error) {
+			query = req.URL.RawQuery
+			return &http.Response{StatusCode: 200, Body: ioutil.NopCloser(strings.NewReader(`{}`))}, nil
+		}}
+		search := newSearchFunc(tp)
+
+		if _, err := search(search.WithAllowPartialSearchResults(true)); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if query != "allow_partial_search_results=true" {
+			t.Errorf("Unexpected query: %s", query)
+		}
+
+		if _, err := search(search.WithAllowPartialSearchResults(false)); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if query != "allow_partial_search_results=false" {
+			t.Errorf("Unexpected query: %s", query)
+		}
+	})
+
+	t.Run("Partial", func(t *testing.T) {
+		body := `{"took":5,"timed_out":false,
+			"_shards":{"total":3,"successful":2,"skipped":0,"failed":1,"failures":[
+				{"shard":1,"index":"test","node":"n1","reason":{"type":"node_not_connected_exception","reason":"node disconnected"}}
+			]},
+			"hits":{"total":{"value":1,"relation":"eq"},"max_score":1.0,"hits":[
+				{"_index":"test","_id":"1","_score":1.0,"_source":{"title":"foo"}}
+			]}}`
+		res := &Response{StatusCode: 200, Body: ioutil.NopCloser(strings.NewReader(body))}
+
+		sr, err := ParseSearchResponse(res)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+
+		if !sr.IsPartial() {
+			t.Errorf("Expected partial results")
+		}
+		failures := sr.ShardFailures()
+		if len(failures) != 1 || failures[0].Shard != 1 || failures[0].Reason.Type != "node_not_connected_exception" {
+			t.Errorf("Unexpected shard failures: %+v", failures)
+		}
+		if sr.Hits.Total.Value != 1 || len(sr.Hits.Hits) != 1 || sr.Hits.Hits[0].ID != "1" {
+			t.Errorf("Unexpected hits: %+v", sr.Hits)
+		}
+	})
+
+	t.Run("Complete", func(t *testing.T) {
+		body := `{"took":1,"timed_out":false,"_shards":{"total":1,"successful":1,"skipped":0,"failed":0},"hits":{"total":{"value":0,"relation":"eq"},"hits":[]}}`
+		res := &Response{StatusCode: 200, Body: ioutil.NopCloser(strings.NewReader(body))}
+
+		sr, err := ParseSearchResponse(res)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if sr.IsPartial() || len(sr.ShardFailures()) != 0 {
+			t.Errorf("Unexpected partial results: %+v", sr.Shards)
+		}
+	})
+
+	t.Run("Timed out", func(t *testing.T) {
+		body := `{"took":100,"timed_out":true,"_shards":{"total":1,"successful":1,"skipped":0,"failed":0},"hits":{"hits":[]}}`
+		res := &Response{StatusCode: 200, Body: ioutil.NopCloser(strings.NewReader(body))}
+
+		sr, err := ParseSearchResponse(res)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if !sr.IsPartial() {
+			t.Errorf("Expected partial results")
+		}
+	})
+
+	t.Run("Total as integer", func(t *testing.T) {
+		body := `{"took":1,"timed_out":false,"_shards":{"total":1,"successful":1,"skipped":0,"failed":0},"hits":{"total":42,"hits":[]}}`
+		res := &Response{StatusCode: 200, Body: ioutil.NopCloser(strings.NewReader(body))}
+
+		sr, err := ParseSearchResponse(res)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if sr.Hits.Total.Value != 42 || sr.Hits.Total.Relation != "eq" {
+			t.Errorf("Unexpected total: %+v", sr.Hits.Total)
+		}
+	})
+}
+
+func TestSearchPreference(t *testing.T) {
+	var query string
+	tp := &mockTransport{RoundTripFunc: func(req *http.Request) (*http.Response, error) {
+		query = req.URL.RawQuery
+		return &http.Response{StatusCode: 200, Body: ioutil.NopCloser(strings.NewReader(`{}`))}, nil
+	}}
+	search := newSearchFunc(tp)
+	count := newCountFunc(tp)
+
+	if _, err := search(search.WithPreference("session-42")); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if query != "preference=session-42" {
+		t.Errorf("Unexpected query: %s", query)
+	}
+	if _, err := count(count.WithPreference(PreferenceLocal)); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if query != "preference=_local" {
+		t.Errorf("Unexpected query: %s", query)
+	}
+
+	if _, err := search(search.WithPreference("_locale")); err == nil {
+		t.Errorf("Expected error for an invalid preference")
+	}
+	if _, err := count(count.WithPreference("_primery")); err == nil {
+		t.Errorf("Expected error for an invalid preference")
+	}
+}
+
+func TestValidatePreference(t *testing.T) {
+	for _, v := range []string{
+		"session-42", "user_1", PreferenceLocal, PreferenceOnlyLocal, PreferencePrimary, PreferencePrimaryFirst,
+		"_shards:0", "_shards:1,2|_local", "_shards:0|session-42", "_only_nodes:node-1,node-2", "_prefer_nodes:node-1",
+	} {
+		if err := ValidatePreference(v); err != nil {
+			t.Errorf("Unexpected error for %q: %s", v, err)
+		}
+	}
+
+	for _, v := range []string{"_locale", "_replica", "_shards:", "_shards:a", "_shards:1|", "_shards:1|_bad", "_only_nodes:", "_custom"} {
+		if err := ValidatePreference(v); err == nil {
+			t.Errorf("Expected error for %q", v)
+		}
+	}
+}
+
+func TestSearchTrackTotalHits(t *testing.T) {
+	var query string
+	tp := &mockTransport{RoundTripFunc: func(req *http.Request) (*http.Response, error) {
+		query = req.URL.RawQuery
+		return &http.Response{StatusCode: 200, Body: ioutil.NopCloser(strings.NewReader(`{}`))}, nil
+	}}
+	search := newSearchFunc(tp)
+
+	for _, tt := range []struct {
+		value interface{}
+		want  string
+	}{
+		{true, "track_total_hits=true"},
+		{false, "track_total_hits=false"},
+		{10000, "track_total_hits=10000"},
+		{int64(500), "track_total_hits=500"},
+		{"10000", "track_total_hits=10000"},
+	} {
+		if _, err := search(search.WithTrackTotalHits(tt.value), search.WithTerminateAfter(100)); err != nil {
+			t.Fatalf("Unexpected error for %v: %s", tt.value, err)
+		}
+		if query != "terminate_after=100&"+tt.want {
+			t.Errorf("Unexpected query for %v: %s", tt.value, query)
+		}
+	}
+
+	for _, v := range []interface{}{1.5, "yes", []int{1}} {
+		if _, err := search(search.WithTrackTotalHits(v)); err == nil {
+			t.Errorf("Expected error for %v", v)
+		}
+	}
+}
@@ -0,0 +1,237 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchapi
+
+import (
+	"context"
+	"net/http"
+	"strings"
+)
+
+func newSecurityMigrateFunc(t Transport) SecurityMigrate {
+	return func(o ...func(*SecurityMigrateRequest)) (*Response, error) {
+		var r = SecurityMigrateRequest{}
+		for _, f := range o {
+			f(&r)
+		}
+		return r.Do(r.ctx, t)
+	}
+}
+
+// ----- API Definition -------------------------------------------------------
+
+// SecurityMigrate migrates the security configuration to the current format.
+//
+// The request must be authenticated with the admin certificate, eg. with a client
+// created by opensearch.NewAdminClient; it's rejected with 403 Forbidden for basic auth.
+type SecurityMigrate func(o ...func(*SecurityMigrateRequest)) (*Response, error)
+
+// SecurityMigrateRequest configures the Security Migrate API request.
+type SecurityMigrateRequest struct {
+	QueryParams map[string]string
+
+	Pretty     bool
+	Human      bool
+	ErrorTrace bool
+	FilterPath []string
+
+	Header http.Header
+
+	ctx context.Context
+}
+
+// Do executes the request and returns response or error.
+func (r SecurityMigrateRequest) Do(ctx context.Context, transport Transport) (*Response, error) {
+	var (
+		method string
+		path   strings.Builder
+		params map[string]string
+	)
+
+	method = "POST"
+
+	path.Grow(len("/_plugins/_security/api/migrate"))
+	path.WriteString("/_plugins/_security/api/migrate")
+
+	params = make(map[string]string)
+
+	if r.Pretty {
+		params["pretty"] = "true"
+	}
+
+	if r.Human {
+		params["human"] = "true"
+	}
+
+	if r.ErrorTrace {
+		params["error_trace"] = "true"
+	}
+
+	if len(r.FilterPath) > 0 {
+		params["filter_path"] = strings.Join(r.FilterPath, ",")
+	}
+
+	for k, v := range r.QueryParams {
+		if _, ok := params[k]; !ok {
+			params[k] = v
+		}
+	}
+
+	req, err := newRequest(transport, method, path.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(params) > 0 {
+		q := req.URL.Query()
+		for k, v := range params {
+			q.Set(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	if len(r.Header) > 0 {
+		for k, vv := range r.Header {
+			for _, v := range vv {
+				req.Header.Add(k, v)
+			}
+		}
+	}
+
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	res, err := transport.Perform(req)
+	if err != nil {
+		return nil, err
+	}
+
+	response := Response{
+		StatusCode: res.StatusCode,
+		Body:       res.Body,
+		Header:     res.Header,
+		codec:      JSONCodecOf(transport),
+	}
+
+	return &response, nil
+}
+
+// WithContext sets the request context.
+func (f SecurityMigrate) WithContext(v context.Context) func(*SecurityMigrateRequest) {
+	return func(r *SecurityMigrateRequest) {
+		r.ctx = v
+	}
+}
+
+// WithQueryParam adds a query parameter which has no dedicated option.
+//
+// Parameters set by the other options take precedence.
+func (f SecurityMigrate) WithQueryParam(key, value string) func(*SecurityMigrateRequest) {
+	return func(r *SecurityMigrateRequest) {
+		if r.QueryParams == nil {
+			r.QueryParams = make(map[string]string)
+		}
+		r.QueryParams[key] = value
+	}
+}
+
+// WithPretty makes the response body pretty-printed.
+func (f SecurityMigrate) WithPretty() func(*SecurityMigrateRequest) {
+	return func(r *SecurityMigrateRequest) {
+		r.Pretty = true
+	}
+}
+
+// WithHuman makes statistical values human-readable.
+func (f SecurityMigrate) WithHuman() func(*SecurityMigrateRequest) {
+	return func(r *SecurityMigrateRequest) {
+		r.Human = true
+	}
+}
+
+// WithErrorTrace includes the stack trace for errors in the response body.
+func (f SecurityMigrate) WithErrorTrace() func(*SecurityMigrateRequest) {
+	return func(r *SecurityMigrateRequest) {
+		r.ErrorTrace = true
+	}
+}
+
+// WithDebug makes the response body pretty-printed and human-readable, and includes the stack trace for errors.
+func (f SecurityMigrate) WithDebug() func(*SecurityMigrateRequest) {
+	return func(r *SecurityMigrateRequest) {
+		r.Pretty = true
+		r.Human = true
+		r.ErrorTrace = true
+	}
+}
+
+// WithFilterPath filters the properties of the response body.
+func (f SecurityMigrate) WithFilterPath(v ...string) func(*SecurityMigrateRequest) {
+	return func(r *SecurityMigrateRequest) {
+		r.FilterPath = v
+	}
+}
+
+// WithHeader adds the headers to the HTTP request.
+func (f SecurityMigrate) WithHeader(h map[string]string) func(*SecurityMigrateRequest) {
+	return func(r *SecurityMigrateRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, v := range h {
+			r.Header.Add(k, v)
+		}
+	}
+}
+
+// WithHTTPHeader adds the headers to the HTTP request, keeping the multiple values of a header.
+func (f SecurityMigrate) WithHTTPHeader(h http.Header) func(*SecurityMigrateRequest) {
+	return func(r *SecurityMigrateRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, vv := range h {
+			for _, v := range vv {
+				r.Header.Add(k, v)
+			}
+		}
+	}
+}
+
+// WithAccept sets the media type of the response, eg. "application/yaml"; the default is JSON.
+func (f SecurityMigrate) WithAccept(v string) func(*SecurityMigrateRequest) {
+	return func(r *SecurityMigrateRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Accept", v)
+	}
+}
+
+// WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
+func (f SecurityMigrate) WithOpaqueID(s string) func(*SecurityMigrateRequest) {
+	return func(r *SecurityMigrateRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("X-Opaque-Id", s)
+	}
+}
+
+// WithBasicAuth sets the credentials of the request, overriding the credentials of the client.
+func (f SecurityMigrate) WithBasicAuth(username, password string) func(*SecurityMigrateRequest) {
+	return func(r *SecurityMigrateRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Authorization", basicAuth(username, password))
+	}
+}
@@ -0,0 +1,159 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+//go:build !integration
+// +build !integration
+
+package opensearchapi
+
+import (
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestSecurityMigrateValidate(t *testing.T) {
+	var req *http.Request
+	tp := &mockTransport{RoundTripFunc: func(r *http.Request) (*http.Response, error) {
+		req = r
+		return &http.Response{StatusCode: 200}, nil
+	}}
+	api := New(tp)
+
+	t.Run("Migrate", func(t *testing.T) {
+		if _, err := api.Security.Migrate(); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if req.Method != "POST" || req.URL.Path != "/_plugins/_security/api/migrate" {
+			t.Errorf("Unexpected request: %s %s", req.Method, req.URL.Path)
+		}
+	})
+
+	t.Run("Validate", func(t *testing.T) {
+		validate := api.Security.Validate
+		if _, err := validate(validate.WithAcceptInvalid(false)); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if req.Method != "GET" || req.URL.Path != "/_plugins/_security/api/validate" {
+			t.Errorf("Unexpected request: %s %s", req.Method, req.URL.Path)
+		}
+		if req.URL.RawQuery != "accept_invalid=false" {
+			t.Errorf("Unexpected query: %s", req.URL.RawQuery)
+		}
+	})
+}
+
+func TestTenancyConfig(t *testing.T) {
+	var (
+		req  *http.Request
+		body string
+	)
+	tp := &mockTransport{RoundTripFunc: func(r *http.Request) (*http.Response, error) {
+		req, body = r, ""
+		if r.Body != nil {
+			b, _ := ioutil.ReadAll(r.Body)
+			body = string(b)
+		}
+		return &http.Response{StatusCode: 200}, nil
+	}}
+	api := New(tp)
+
+	t.Run("Get", func(t *testing.T) {
+		if _, err := api.Security.GetTenancyConfig(); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if req.Method != "GET" || req.URL.Path != "/_plugins/_security/api/tenancy/config" {
+			t.Errorf("Unexpected request: %s %s", req.Method, req.URL.Path)
+		}
+	})
+
+	t.Run("Update", func(t *testing.T) {
+		_, err := api.Security.UpdateTenancyConfig(TenancyConfigBody{PrivateTenantEnabled: BoolPtr(false), DefaultTenant: "global"})
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if req.Method != "PUT" || req.URL.Path != "/_plugins/_security/api/tenancy/config" {
+			t.Errorf("Unexpected request: %s %s", req.Method, req.URL.Path)
+		}
+		if body != `{"private_tenant_enabled":false,"default_tenant":"global"}` {
+			t.Errorf("Unexpected body: %s", body)
+		}
+	})
+}
+
+func TestAuditConfig(t *testing.T) {
+	var (
+		req  *http.Request
+		body string
+	)
+	tp := &mockTransport{RoundTripFunc: func(r *http.Request) (*http.Response, error) {
+		req, body = r, ""
+		if r.Body != nil {
+			b, _ := ioutil.ReadAll(r.Body)
+			body = string(b)
+		}
+		return &http.Response{StatusCode: 200, Body: ioutil.NopCloser(strings.NewReader(
+			`{"_readonly":["enabled"],"config":{"enabled":true,"audit":{"enable_rest":true,"disabled_rest_categories":["AUTHENTICATED","GRANTED_PRIVILEGES"],` +
+				`"enable_transport":false,"ignore_users":["kibanaserver"],"resolve_bulk_requests":true},"compliance":{"enabled":false}}}`))}, nil
+	}}
+	api := New(tp)
+
+	t.Run("Get", func(t *testing.T) {
+		res, err := api.Security.GetAuditConfig()
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if req.Method != "GET" || req.URL.Path != "/_plugins/_security/api/audit" {
+			t.Errorf("Unexpected request: %s %s", req.Method, req.URL.Path)
+		}
+
+		ar, err := ParseAuditConfigGetResponse(res)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if len(ar.ReadOnly) != 1 || !ar.Config.Enabled || !ar.Config.Audit.ResolveBulkRequests ||
+			len(ar.Config.Audit.IgnoreUsers) != 1 || ar.Config.Compliance.Enabled {
+			t.Errorf("Unexpected config: %+v", ar)
+		}
+
+		for category, want := range map[string]bool{
+			"FAILED_LOGIN":       true,
+			"failed_login":       true,
+			"AUTHENTICATED":      false,
+			"GRANTED_PRIVILEGES": false,
+		} {
+			if got := ar.Config.IsCategoryEnabled(category); got != want {
+				t.Errorf("Unexpected IsCategoryEnabled(%q): %v", category, got)
+			}
+		}
+
+		ar.Config.Audit.EnableTransport = true
+		if !ar.Config.IsCategoryEnabled("AUTHENTICATED") {
+			t.Errorf("Expected the category to be enabled for the transport layer")
+		}
+		ar.Config.Enabled = false
+		if ar.Config.IsCategoryEnabled("FAILED_LOGIN") {
+			t.Errorf("Expected the categories to be disabled with the audit logging")
+		}
+	})
+
+	t.Run("Update", func(t *testing.T) {
+		config := AuditConfig{Enabled: true, Audit: AuditSettings{EnableREST: true, DisabledRESTCategories: []string{"AUTHENTICATED"}}}
+		if _, err := api.Security.UpdateAuditConfig(config); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if req.Method != "PUT" || req.URL.Path != "/_plugins/_security/api/audit/config" {
+			t.Errorf("Unexpected request: %s %s", req.Method, req.URL.Path)
+		}
+		if !strings.HasPrefix(body, `{"enabled":true,"audit":{"enable_rest":true,"disabled_rest_categories":["AUTHENTICATED"],"enable_transport":false,`) {
+			t.Errorf("Unexpected body: %s", body)
+		}
+	})
+}
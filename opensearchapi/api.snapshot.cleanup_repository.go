@@ -118,7 +118,7 @@ func (r SnapshotCleanupRepositoryRequest) Do(ctx context.Context, transport Tran
 		params["filter_path"] = strings.Join(r.FilterPath, ",")
 	}
 
-	req, err := newRequest(method, path.String(), nil)
+	req, err := newRequest(transport, method, path.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -132,13 +132,9 @@ func (r SnapshotCleanupRepositoryRequest) Do(ctx context.Context, transport Tran
 	}
 
 	if len(r.Header) > 0 {
-		if len(req.Header) == 0 {
-			req.Header = r.Header
-		} else {
-			for k, vv := range r.Header {
-				for _, v := range vv {
-					req.Header.Add(k, v)
-				}
+		for k, vv := range r.Header {
+			for _, v := range vv {
+				req.Header.Add(k, v)
 			}
 		}
 	}
@@ -156,6 +152,7 @@ func (r SnapshotCleanupRepositoryRequest) Do(ctx context.Context, transport Tran
 		StatusCode: res.StatusCode,
 		Body:       res.Body,
 		Header:     res.Header,
+		codec:      JSONCodecOf(transport),
 	}
 
 	return &response, response.Err()
@@ -219,6 +216,16 @@ func (f SnapshotCleanupRepository) WithErrorTrace() func(*SnapshotCleanupReposit
 	}
 }
 
+// WithDebug makes the response body pretty-printed and human-readable, and includes the stack trace for errors.
+//
+func (f SnapshotCleanupRepository) WithDebug() func(*SnapshotCleanupRepositoryRequest) {
+	return func(r *SnapshotCleanupRepositoryRequest) {
+		r.Pretty = true
+		r.Human = true
+		r.ErrorTrace = true
+	}
+}
+
 // WithFilterPath filters the properties of the response body.
 //
 func (f SnapshotCleanupRepository) WithFilterPath(v ...string) func(*SnapshotCleanupRepositoryRequest) {
@@ -240,6 +247,32 @@ func (f SnapshotCleanupRepository) WithHeader(h map[string]string) func(*Snapsho
 	}
 }
 
+// WithHTTPHeader adds the headers to the HTTP request, keeping the multiple values of a header.
+//
+func (f SnapshotCleanupRepository) WithHTTPHeader(h http.Header) func(*SnapshotCleanupRepositoryRequest) {
+	return func(r *SnapshotCleanupRepositoryRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, vv := range h {
+			for _, v := range vv {
+				r.Header.Add(k, v)
+			}
+		}
+	}
+}
+
+// WithAccept sets the media type of the response, eg. "application/yaml"; the default is JSON.
+//
+func (f SnapshotCleanupRepository) WithAccept(v string) func(*SnapshotCleanupRepositoryRequest) {
+	return func(r *SnapshotCleanupRepositoryRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Accept", v)
+	}
+}
+
 // WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
 //
 func (f SnapshotCleanupRepository) WithOpaqueID(s string) func(*SnapshotCleanupRepositoryRequest) {
@@ -250,3 +283,14 @@ func (f SnapshotCleanupRepository) WithOpaqueID(s string) func(*SnapshotCleanupR
 		r.Header.Set("X-Opaque-Id", s)
 	}
 }
+
+// WithBasicAuth sets the credentials of the request, overriding the credentials of the client.
+//
+func (f SnapshotCleanupRepository) WithBasicAuth(username, password string) func(*SnapshotCleanupRepositoryRequest) {
+	return func(r *SnapshotCleanupRepositoryRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Authorization", basicAuth(username, password))
+	}
+}
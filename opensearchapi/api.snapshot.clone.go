@@ -122,7 +122,7 @@ func (r SnapshotCloneRequest) Do(ctx context.Context, transport Transport) (*Res
 		params["filter_path"] = strings.Join(r.FilterPath, ",")
 	}
 
-	req, err := newRequest(method, path.String(), r.Body)
+	req, err := newRequest(transport, method, path.String(), r.Body)
 	if err != nil {
 		return nil, err
 	}
@@ -135,18 +135,14 @@ func (r SnapshotCloneRequest) Do(ctx context.Context, transport Transport) (*Res
 		req.URL.RawQuery = q.Encode()
 	}
 
-	if r.Body != nil {
+	if r.Body != nil && r.Header.Get(headerContentType) == "" {
 		req.Header[headerContentType] = headerContentTypeJSON
 	}
 
 	if len(r.Header) > 0 {
-		if len(req.Header) == 0 {
-			req.Header = r.Header
-		} else {
-			for k, vv := range r.Header {
-				for _, v := range vv {
-					req.Header.Add(k, v)
-				}
+		for k, vv := range r.Header {
+			for _, v := range vv {
+				req.Header.Add(k, v)
 			}
 		}
 	}
@@ -164,6 +160,7 @@ func (r SnapshotCloneRequest) Do(ctx context.Context, transport Transport) (*Res
 		StatusCode: res.StatusCode,
 		Body:       res.Body,
 		Header:     res.Header,
+		codec:      JSONCodecOf(transport),
 	}
 
 	return &response, response.Err()
@@ -177,6 +174,14 @@ func (f SnapshotClone) WithContext(v context.Context) func(*SnapshotCloneRequest
 	}
 }
 
+// WithJSONBody - the request body, encoded to JSON with the JSON codec of the client; it replaces the body passed to the function.
+//
+func (f SnapshotClone) WithJSONBody(v interface{}) func(*SnapshotCloneRequest) {
+	return func(r *SnapshotCloneRequest) {
+		r.Body = JSONBody(v)
+	}
+}
+
 // WithMasterTimeout - explicit operation timeout for connection to cluster-manager node.
 //
 // Deprecated: To promote inclusive language, use WithClusterManagerTimeout instead.
@@ -219,6 +224,16 @@ func (f SnapshotClone) WithErrorTrace() func(*SnapshotCloneRequest) {
 	}
 }
 
+// WithDebug makes the response body pretty-printed and human-readable, and includes the stack trace for errors.
+//
+func (f SnapshotClone) WithDebug() func(*SnapshotCloneRequest) {
+	return func(r *SnapshotCloneRequest) {
+		r.Pretty = true
+		r.Human = true
+		r.ErrorTrace = true
+	}
+}
+
 // WithFilterPath filters the properties of the response body.
 //
 func (f SnapshotClone) WithFilterPath(v ...string) func(*SnapshotCloneRequest) {
@@ -240,6 +255,32 @@ func (f SnapshotClone) WithHeader(h map[string]string) func(*SnapshotCloneReques
 	}
 }
 
+// WithHTTPHeader adds the headers to the HTTP request, keeping the multiple values of a header.
+//
+func (f SnapshotClone) WithHTTPHeader(h http.Header) func(*SnapshotCloneRequest) {
+	return func(r *SnapshotCloneRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, vv := range h {
+			for _, v := range vv {
+				r.Header.Add(k, v)
+			}
+		}
+	}
+}
+
+// WithAccept sets the media type of the response, eg. "application/yaml"; the default is JSON.
+//
+func (f SnapshotClone) WithAccept(v string) func(*SnapshotCloneRequest) {
+	return func(r *SnapshotCloneRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Accept", v)
+	}
+}
+
 // WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
 //
 func (f SnapshotClone) WithOpaqueID(s string) func(*SnapshotCloneRequest) {
@@ -250,3 +291,25 @@ func (f SnapshotClone) WithOpaqueID(s string) func(*SnapshotCloneRequest) {
 		r.Header.Set("X-Opaque-Id", s)
 	}
 }
+
+// WithContentType sets the Content-Type header of the request, overriding the default of the request body.
+//
+func (f SnapshotClone) WithContentType(v string) func(*SnapshotCloneRequest) {
+	return func(r *SnapshotCloneRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Content-Type", v)
+	}
+}
+
+// WithBasicAuth sets the credentials of the request, overriding the credentials of the client.
+//
+func (f SnapshotClone) WithBasicAuth(username, password string) func(*SnapshotCloneRequest) {
+	return func(r *SnapshotCloneRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Authorization", basicAuth(username, password))
+	}
+}
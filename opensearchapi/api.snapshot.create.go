@@ -27,7 +27,9 @@
 package opensearchapi
 
 import (
+	"bytes"
 	"context"
+	"fmt"
 	"io"
 	"net/http"
 	"strconv"
@@ -55,7 +57,8 @@ type SnapshotCreate func(repository string, snapshot string, o ...func(*Snapshot
 // SnapshotCreateRequest configures the Snapshot Create API request.
 //
 type SnapshotCreateRequest struct {
-	Body io.Reader
+	Body         io.Reader
+	SnapshotBody *SnapshotCreateBody
 
 	Repository string
 	Snapshot   string
@@ -74,6 +77,16 @@ type SnapshotCreateRequest struct {
 	ctx context.Context
 }
 
+// SnapshotCreateBody represents the body of the Snapshot Create API request.
+//
+type SnapshotCreateBody struct {
+	Indices            []string               `json:"indices,omitempty"`
+	IgnoreUnavailable  *bool                  `json:"ignore_unavailable,omitempty"`
+	IncludeGlobalState *bool                  `json:"include_global_state,omitempty"`
+	Partial            *bool                  `json:"partial,omitempty"`
+	Metadata           map[string]interface{} `json:"metadata,omitempty"`
+}
+
 // Do executes the request and returns response or error.
 //
 func (r SnapshotCreateRequest) Do(ctx context.Context, transport Transport) (*Response, error) {
@@ -123,7 +136,16 @@ func (r SnapshotCreateRequest) Do(ctx context.Context, transport Transport) (*Re
 		params["filter_path"] = strings.Join(r.FilterPath, ",")
 	}
 
-	req, err := newRequest(method, path.String(), r.Body)
+	var body io.Reader = r.Body
+	if r.SnapshotBody != nil {
+		b, err := JSONCodecOf(transport).Marshal(r.SnapshotBody)
+		if err != nil {
+			return nil, fmt.Errorf("cannot encode snapshot: %s", err)
+		}
+		body = bytes.NewReader(b)
+	}
+
+	req, err := newRequest(transport, method, path.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -136,18 +158,14 @@ func (r SnapshotCreateRequest) Do(ctx context.Context, transport Transport) (*Re
 		req.URL.RawQuery = q.Encode()
 	}
 
-	if r.Body != nil {
+	if body != nil && r.Header.Get(headerContentType) == "" {
 		req.Header[headerContentType] = headerContentTypeJSON
 	}
 
 	if len(r.Header) > 0 {
-		if len(req.Header) == 0 {
-			req.Header = r.Header
-		} else {
-			for k, vv := range r.Header {
-				for _, v := range vv {
-					req.Header.Add(k, v)
-				}
+		for k, vv := range r.Header {
+			for _, v := range vv {
+				req.Header.Add(k, v)
 			}
 		}
 	}
@@ -165,6 +183,7 @@ func (r SnapshotCreateRequest) Do(ctx context.Context, transport Transport) (*Re
 		StatusCode: res.StatusCode,
 		Body:       res.Body,
 		Header:     res.Header,
+		codec:      JSONCodecOf(transport),
 	}
 
 	return &response, response.Err()
@@ -186,6 +205,22 @@ func (f SnapshotCreate) WithBody(v io.Reader) func(*SnapshotCreateRequest) {
 	}
 }
 
+// WithJSONBody - the request body, encoded to JSON with the JSON codec of the client; it replaces the body set with WithBody.
+//
+func (f SnapshotCreate) WithJSONBody(v interface{}) func(*SnapshotCreateRequest) {
+	return func(r *SnapshotCreateRequest) {
+		r.Body = JSONBody(v)
+	}
+}
+
+// WithSnapshotBody - the snapshot definition, encoded as the request body; it replaces the body set with WithBody.
+//
+func (f SnapshotCreate) WithSnapshotBody(v SnapshotCreateBody) func(*SnapshotCreateRequest) {
+	return func(r *SnapshotCreateRequest) {
+		r.SnapshotBody = &v
+	}
+}
+
 // WithMasterTimeout - explicit operation timeout for connection to cluster-manager node.
 //
 // Deprecated: To promote inclusive language, use WithClusterManagerTimeout instead.
@@ -236,6 +271,16 @@ func (f SnapshotCreate) WithErrorTrace() func(*SnapshotCreateRequest) {
 	}
 }
 
+// WithDebug makes the response body pretty-printed and human-readable, and includes the stack trace for errors.
+//
+func (f SnapshotCreate) WithDebug() func(*SnapshotCreateRequest) {
+	return func(r *SnapshotCreateRequest) {
+		r.Pretty = true
+		r.Human = true
+		r.ErrorTrace = true
+	}
+}
+
 // WithFilterPath filters the properties of the response body.
 //
 func (f SnapshotCreate) WithFilterPath(v ...string) func(*SnapshotCreateRequest) {
@@ -257,6 +302,32 @@ func (f SnapshotCreate) WithHeader(h map[string]string) func(*SnapshotCreateRequ
 	}
 }
 
+// WithHTTPHeader adds the headers to the HTTP request, keeping the multiple values of a header.
+//
+func (f SnapshotCreate) WithHTTPHeader(h http.Header) func(*SnapshotCreateRequest) {
+	return func(r *SnapshotCreateRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, vv := range h {
+			for _, v := range vv {
+				r.Header.Add(k, v)
+			}
+		}
+	}
+}
+
+// WithAccept sets the media type of the response, eg. "application/yaml"; the default is JSON.
+//
+func (f SnapshotCreate) WithAccept(v string) func(*SnapshotCreateRequest) {
+	return func(r *SnapshotCreateRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Accept", v)
+	}
+}
+
 // WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
 //
 func (f SnapshotCreate) WithOpaqueID(s string) func(*SnapshotCreateRequest) {
@@ -267,3 +338,25 @@ func (f SnapshotCreate) WithOpaqueID(s string) func(*SnapshotCreateRequest) {
 		r.Header.Set("X-Opaque-Id", s)
 	}
 }
+
+// WithContentType sets the Content-Type header of the request, overriding the default of the request body.
+//
+func (f SnapshotCreate) WithContentType(v string) func(*SnapshotCreateRequest) {
+	return func(r *SnapshotCreateRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Content-Type", v)
+	}
+}
+
+// WithBasicAuth sets the credentials of the request, overriding the credentials of the client.
+//
+func (f SnapshotCreate) WithBasicAuth(username, password string) func(*SnapshotCreateRequest) {
+	return func(r *SnapshotCreateRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Authorization", basicAuth(username, password))
+	}
+}
@@ -0,0 +1,44 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+//go:build !integration
+// +build !integration
+
+package opensearchapi
+
+import (
+	"context"
+	"io/ioutil"
+	"testing"
+)
+
+func TestSnapshotCreateBody(t *testing.T) {
+	req, err := BuildRequest(context.Background(), SnapshotCreateRequest{
+		Repository: "backups",
+		Snapshot:   "nightly",
+		SnapshotBody: &SnapshotCreateBody{
+			Indices:            []string{"logs-*", "metrics"},
+			IgnoreUnavailable:  BoolPtr(true),
+			IncludeGlobalState: BoolPtr(false),
+		},
+		WaitForCompletion: BoolPtr(true),
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	if req.Method != "PUT" || req.URL.String() != "/_snapshot/backups/nightly?wait_for_completion=true" {
+		t.Errorf("Unexpected request: %s %s", req.Method, req.URL)
+	}
+	body, _ := ioutil.ReadAll(req.Body)
+	want := `{"indices":["logs-*","metrics"],"ignore_unavailable":true,"include_global_state":false}`
+	if string(body) != want {
+		t.Errorf("Unexpected body:\nwant: %s\ngot:  %s", want, body)
+	}
+}
@@ -114,7 +114,7 @@ func (r SnapshotDeleteRequest) Do(ctx context.Context, transport Transport) (*Re
 		params["filter_path"] = strings.Join(r.FilterPath, ",")
 	}
 
-	req, err := newRequest(method, path.String(), nil)
+	req, err := newRequest(transport, method, path.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -128,13 +128,9 @@ func (r SnapshotDeleteRequest) Do(ctx context.Context, transport Transport) (*Re
 	}
 
 	if len(r.Header) > 0 {
-		if len(req.Header) == 0 {
-			req.Header = r.Header
-		} else {
-			for k, vv := range r.Header {
-				for _, v := range vv {
-					req.Header.Add(k, v)
-				}
+		for k, vv := range r.Header {
+			for _, v := range vv {
+				req.Header.Add(k, v)
 			}
 		}
 	}
@@ -152,6 +148,7 @@ func (r SnapshotDeleteRequest) Do(ctx context.Context, transport Transport) (*Re
 		StatusCode: res.StatusCode,
 		Body:       res.Body,
 		Header:     res.Header,
+		codec:      JSONCodecOf(transport),
 	}
 
 	return &response, response.Err()
@@ -207,6 +204,16 @@ func (f SnapshotDelete) WithErrorTrace() func(*SnapshotDeleteRequest) {
 	}
 }
 
+// WithDebug makes the response body pretty-printed and human-readable, and includes the stack trace for errors.
+//
+func (f SnapshotDelete) WithDebug() func(*SnapshotDeleteRequest) {
+	return func(r *SnapshotDeleteRequest) {
+		r.Pretty = true
+		r.Human = true
+		r.ErrorTrace = true
+	}
+}
+
 // WithFilterPath filters the properties of the response body.
 //
 func (f SnapshotDelete) WithFilterPath(v ...string) func(*SnapshotDeleteRequest) {
@@ -228,6 +235,32 @@ func (f SnapshotDelete) WithHeader(h map[string]string) func(*SnapshotDeleteRequ
 	}
 }
 
+// WithHTTPHeader adds the headers to the HTTP request, keeping the multiple values of a header.
+//
+func (f SnapshotDelete) WithHTTPHeader(h http.Header) func(*SnapshotDeleteRequest) {
+	return func(r *SnapshotDeleteRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, vv := range h {
+			for _, v := range vv {
+				r.Header.Add(k, v)
+			}
+		}
+	}
+}
+
+// WithAccept sets the media type of the response, eg. "application/yaml"; the default is JSON.
+//
+func (f SnapshotDelete) WithAccept(v string) func(*SnapshotDeleteRequest) {
+	return func(r *SnapshotDeleteRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Accept", v)
+	}
+}
+
 // WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
 //
 func (f SnapshotDelete) WithOpaqueID(s string) func(*SnapshotDeleteRequest) {
@@ -238,3 +271,14 @@ func (f SnapshotDelete) WithOpaqueID(s string) func(*SnapshotDeleteRequest) {
 		r.Header.Set("X-Opaque-Id", s)
 	}
 }
+
+// WithBasicAuth sets the credentials of the request, overriding the credentials of the client.
+//
+func (f SnapshotDelete) WithBasicAuth(username, password string) func(*SnapshotDeleteRequest) {
+	return func(r *SnapshotDeleteRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Authorization", basicAuth(username, password))
+	}
+}
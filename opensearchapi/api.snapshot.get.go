@@ -135,7 +135,7 @@ func (r SnapshotGetRequest) Do(ctx context.Context, transport Transport) (*Respo
 		params["filter_path"] = strings.Join(r.FilterPath, ",")
 	}
 
-	req, err := newRequest(method, path.String(), nil)
+	req, err := newRequest(transport, method, path.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -149,13 +149,9 @@ func (r SnapshotGetRequest) Do(ctx context.Context, transport Transport) (*Respo
 	}
 
 	if len(r.Header) > 0 {
-		if len(req.Header) == 0 {
-			req.Header = r.Header
-		} else {
-			for k, vv := range r.Header {
-				for _, v := range vv {
-					req.Header.Add(k, v)
-				}
+		for k, vv := range r.Header {
+			for _, v := range vv {
+				req.Header.Add(k, v)
 			}
 		}
 	}
@@ -173,6 +169,7 @@ func (r SnapshotGetRequest) Do(ctx context.Context, transport Transport) (*Respo
 		StatusCode: res.StatusCode,
 		Body:       res.Body,
 		Header:     res.Header,
+		codec:      JSONCodecOf(transport),
 	}
 
 	return &response, response.Err()
@@ -260,6 +257,16 @@ func (f SnapshotGet) WithErrorTrace() func(*SnapshotGetRequest) {
 	}
 }
 
+// WithDebug makes the response body pretty-printed and human-readable, and includes the stack trace for errors.
+//
+func (f SnapshotGet) WithDebug() func(*SnapshotGetRequest) {
+	return func(r *SnapshotGetRequest) {
+		r.Pretty = true
+		r.Human = true
+		r.ErrorTrace = true
+	}
+}
+
 // WithFilterPath filters the properties of the response body.
 //
 func (f SnapshotGet) WithFilterPath(v ...string) func(*SnapshotGetRequest) {
@@ -281,6 +288,32 @@ func (f SnapshotGet) WithHeader(h map[string]string) func(*SnapshotGetRequest) {
 	}
 }
 
+// WithHTTPHeader adds the headers to the HTTP request, keeping the multiple values of a header.
+//
+func (f SnapshotGet) WithHTTPHeader(h http.Header) func(*SnapshotGetRequest) {
+	return func(r *SnapshotGetRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, vv := range h {
+			for _, v := range vv {
+				r.Header.Add(k, v)
+			}
+		}
+	}
+}
+
+// WithAccept sets the media type of the response, eg. "application/yaml"; the default is JSON.
+//
+func (f SnapshotGet) WithAccept(v string) func(*SnapshotGetRequest) {
+	return func(r *SnapshotGetRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Accept", v)
+	}
+}
+
 // WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
 //
 func (f SnapshotGet) WithOpaqueID(s string) func(*SnapshotGetRequest) {
@@ -291,3 +324,14 @@ func (f SnapshotGet) WithOpaqueID(s string) func(*SnapshotGetRequest) {
 		r.Header.Set("X-Opaque-Id", s)
 	}
 }
+
+// WithBasicAuth sets the credentials of the request, overriding the credentials of the client.
+//
+func (f SnapshotGet) WithBasicAuth(username, password string) func(*SnapshotGetRequest) {
+	return func(r *SnapshotGetRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Authorization", basicAuth(username, password))
+	}
+}
@@ -126,7 +126,7 @@ func (r SnapshotStatusRequest) Do(ctx context.Context, transport Transport) (*Re
 		params["filter_path"] = strings.Join(r.FilterPath, ",")
 	}
 
-	req, err := newRequest(method, path.String(), nil)
+	req, err := newRequest(transport, method, path.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -140,13 +140,9 @@ func (r SnapshotStatusRequest) Do(ctx context.Context, transport Transport) (*Re
 	}
 
 	if len(r.Header) > 0 {
-		if len(req.Header) == 0 {
-			req.Header = r.Header
-		} else {
-			for k, vv := range r.Header {
-				for _, v := range vv {
-					req.Header.Add(k, v)
-				}
+		for k, vv := range r.Header {
+			for _, v := range vv {
+				req.Header.Add(k, v)
 			}
 		}
 	}
@@ -164,6 +160,7 @@ func (r SnapshotStatusRequest) Do(ctx context.Context, transport Transport) (*Re
 		StatusCode: res.StatusCode,
 		Body:       res.Body,
 		Header:     res.Header,
+		codec:      JSONCodecOf(transport),
 	}
 
 	return &response, response.Err()
@@ -243,6 +240,16 @@ func (f SnapshotStatus) WithErrorTrace() func(*SnapshotStatusRequest) {
 	}
 }
 
+// WithDebug makes the response body pretty-printed and human-readable, and includes the stack trace for errors.
+//
+func (f SnapshotStatus) WithDebug() func(*SnapshotStatusRequest) {
+	return func(r *SnapshotStatusRequest) {
+		r.Pretty = true
+		r.Human = true
+		r.ErrorTrace = true
+	}
+}
+
 // WithFilterPath filters the properties of the response body.
 //
 func (f SnapshotStatus) WithFilterPath(v ...string) func(*SnapshotStatusRequest) {
@@ -264,6 +271,32 @@ func (f SnapshotStatus) WithHeader(h map[string]string) func(*SnapshotStatusRequ
 	}
 }
 
+// WithHTTPHeader adds the headers to the HTTP request, keeping the multiple values of a header.
+//
+func (f SnapshotStatus) WithHTTPHeader(h http.Header) func(*SnapshotStatusRequest) {
+	return func(r *SnapshotStatusRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, vv := range h {
+			for _, v := range vv {
+				r.Header.Add(k, v)
+			}
+		}
+	}
+}
+
+// WithAccept sets the media type of the response, eg. "application/yaml"; the default is JSON.
+//
+func (f SnapshotStatus) WithAccept(v string) func(*SnapshotStatusRequest) {
+	return func(r *SnapshotStatusRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Accept", v)
+	}
+}
+
 // WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
 //
 func (f SnapshotStatus) WithOpaqueID(s string) func(*SnapshotStatusRequest) {
@@ -274,3 +307,14 @@ func (f SnapshotStatus) WithOpaqueID(s string) func(*SnapshotStatusRequest) {
 		r.Header.Set("X-Opaque-Id", s)
 	}
 }
+
+// WithBasicAuth sets the credentials of the request, overriding the credentials of the client.
+//
+func (f SnapshotStatus) WithBasicAuth(username, password string) func(*SnapshotStatusRequest) {
+	return func(r *SnapshotStatusRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Authorization", basicAuth(username, password))
+	}
+}
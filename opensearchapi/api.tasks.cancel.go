@@ -127,7 +127,7 @@ func (r TasksCancelRequest) Do(ctx context.Context, transport Transport) (*Respo
 		params["filter_path"] = strings.Join(r.FilterPath, ",")
 	}
 
-	req, err := newRequest(method, path.String(), nil)
+	req, err := newRequest(transport, method, path.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -141,13 +141,9 @@ func (r TasksCancelRequest) Do(ctx context.Context, transport Transport) (*Respo
 	}
 
 	if len(r.Header) > 0 {
-		if len(req.Header) == 0 {
-			req.Header = r.Header
-		} else {
-			for k, vv := range r.Header {
-				for _, v := range vv {
-					req.Header.Add(k, v)
-				}
+		for k, vv := range r.Header {
+			for _, v := range vv {
+				req.Header.Add(k, v)
 			}
 		}
 	}
@@ -165,6 +161,7 @@ func (r TasksCancelRequest) Do(ctx context.Context, transport Transport) (*Respo
 		StatusCode: res.StatusCode,
 		Body:       res.Body,
 		Header:     res.Header,
+		codec:      JSONCodecOf(transport),
 	}
 
 	return &response, response.Err()
@@ -242,6 +239,16 @@ func (f TasksCancel) WithErrorTrace() func(*TasksCancelRequest) {
 	}
 }
 
+// WithDebug makes the response body pretty-printed and human-readable, and includes the stack trace for errors.
+//
+func (f TasksCancel) WithDebug() func(*TasksCancelRequest) {
+	return func(r *TasksCancelRequest) {
+		r.Pretty = true
+		r.Human = true
+		r.ErrorTrace = true
+	}
+}
+
 // WithFilterPath filters the properties of the response body.
 //
 func (f TasksCancel) WithFilterPath(v ...string) func(*TasksCancelRequest) {
@@ -263,6 +270,32 @@ func (f TasksCancel) WithHeader(h map[string]string) func(*TasksCancelRequest) {
 	}
 }
 
+// WithHTTPHeader adds the headers to the HTTP request, keeping the multiple values of a header.
+//
+func (f TasksCancel) WithHTTPHeader(h http.Header) func(*TasksCancelRequest) {
+	return func(r *TasksCancelRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, vv := range h {
+			for _, v := range vv {
+				r.Header.Add(k, v)
+			}
+		}
+	}
+}
+
+// WithAccept sets the media type of the response, eg. "application/yaml"; the default is JSON.
+//
+func (f TasksCancel) WithAccept(v string) func(*TasksCancelRequest) {
+	return func(r *TasksCancelRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Accept", v)
+	}
+}
+
 // WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
 //
 func (f TasksCancel) WithOpaqueID(s string) func(*TasksCancelRequest) {
@@ -273,3 +306,14 @@ func (f TasksCancel) WithOpaqueID(s string) func(*TasksCancelRequest) {
 		r.Header.Set("X-Opaque-Id", s)
 	}
 }
+
+// WithBasicAuth sets the credentials of the request, overriding the credentials of the client.
+//
+func (f TasksCancel) WithBasicAuth(username, password string) func(*TasksCancelRequest) {
+	return func(r *TasksCancelRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Authorization", basicAuth(username, password))
+	}
+}
@@ -114,7 +114,7 @@ func (r TasksGetRequest) Do(ctx context.Context, transport Transport) (*Response
 		params["filter_path"] = strings.Join(r.FilterPath, ",")
 	}
 
-	req, err := newRequest(method, path.String(), nil)
+	req, err := newRequest(transport, method, path.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -128,13 +128,9 @@ func (r TasksGetRequest) Do(ctx context.Context, transport Transport) (*Response
 	}
 
 	if len(r.Header) > 0 {
-		if len(req.Header) == 0 {
-			req.Header = r.Header
-		} else {
-			for k, vv := range r.Header {
-				for _, v := range vv {
-					req.Header.Add(k, v)
-				}
+		for k, vv := range r.Header {
+			for _, v := range vv {
+				req.Header.Add(k, v)
 			}
 		}
 	}
@@ -152,6 +148,7 @@ func (r TasksGetRequest) Do(ctx context.Context, transport Transport) (*Response
 		StatusCode: res.StatusCode,
 		Body:       res.Body,
 		Header:     res.Header,
+		codec:      JSONCodecOf(transport),
 	}
 
 	return &response, response.Err()
@@ -205,6 +202,16 @@ func (f TasksGet) WithErrorTrace() func(*TasksGetRequest) {
 	}
 }
 
+// WithDebug makes the response body pretty-printed and human-readable, and includes the stack trace for errors.
+//
+func (f TasksGet) WithDebug() func(*TasksGetRequest) {
+	return func(r *TasksGetRequest) {
+		r.Pretty = true
+		r.Human = true
+		r.ErrorTrace = true
+	}
+}
+
 // WithFilterPath filters the properties of the response body.
 //
 func (f TasksGet) WithFilterPath(v ...string) func(*TasksGetRequest) {
@@ -226,6 +233,32 @@ func (f TasksGet) WithHeader(h map[string]string) func(*TasksGetRequest) {
 	}
 }
 
+// WithHTTPHeader adds the headers to the HTTP request, keeping the multiple values of a header.
+//
+func (f TasksGet) WithHTTPHeader(h http.Header) func(*TasksGetRequest) {
+	return func(r *TasksGetRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, vv := range h {
+			for _, v := range vv {
+				r.Header.Add(k, v)
+			}
+		}
+	}
+}
+
+// WithAccept sets the media type of the response, eg. "application/yaml"; the default is JSON.
+//
+func (f TasksGet) WithAccept(v string) func(*TasksGetRequest) {
+	return func(r *TasksGetRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Accept", v)
+	}
+}
+
 // WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
 //
 func (f TasksGet) WithOpaqueID(s string) func(*TasksGetRequest) {
@@ -236,3 +269,14 @@ func (f TasksGet) WithOpaqueID(s string) func(*TasksGetRequest) {
 		r.Header.Set("X-Opaque-Id", s)
 	}
 }
+
+// WithBasicAuth sets the credentials of the request, overriding the credentials of the client.
+//
+func (f TasksGet) WithBasicAuth(username, password string) func(*TasksGetRequest) {
+	return func(r *TasksGetRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Authorization", basicAuth(username, password))
+	}
+}
@@ -0,0 +1,244 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchapi
+
+import (
+	"context"
+	"net/http"
+	"strings"
+)
+
+func newTenancyConfigGetFunc(t Transport) TenancyConfigGet {
+	return func(o ...func(*TenancyConfigGetRequest)) (*Response, error) {
+		var r = TenancyConfigGetRequest{}
+		for _, f := range o {
+			f(&r)
+		}
+		return r.Do(r.ctx, t)
+	}
+}
+
+// ----- API Definition -------------------------------------------------------
+
+// TenancyConfigGet returns the multitenancy configuration of the cluster.
+type TenancyConfigGet func(o ...func(*TenancyConfigGetRequest)) (*Response, error)
+
+// TenancyConfigGetRequest configures the Tenancy Config Get API request.
+type TenancyConfigGetRequest struct {
+	QueryParams map[string]string
+
+	Pretty     bool
+	Human      bool
+	ErrorTrace bool
+	FilterPath []string
+
+	Header http.Header
+
+	ctx context.Context
+}
+
+// Do executes the request and returns response or error.
+func (r TenancyConfigGetRequest) Do(ctx context.Context, transport Transport) (*Response, error) {
+	var (
+		method string
+		path   strings.Builder
+		params map[string]string
+	)
+
+	method = "GET"
+
+	path.Grow(len("/_plugins/_security/api/tenancy/config"))
+	path.WriteString("/_plugins/_security/api/tenancy/config")
+
+	params = make(map[string]string)
+
+	if r.Pretty {
+		params["pretty"] = "true"
+	}
+
+	if r.Human {
+		params["human"] = "true"
+	}
+
+	if r.ErrorTrace {
+		params["error_trace"] = "true"
+	}
+
+	if len(r.FilterPath) > 0 {
+		params["filter_path"] = strings.Join(r.FilterPath, ",")
+	}
+
+	for k, v := range r.QueryParams {
+		if _, ok := params[k]; !ok {
+			params[k] = v
+		}
+	}
+
+	req, err := newRequest(transport, method, path.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(params) > 0 {
+		q := req.URL.Query()
+		for k, v := range params {
+			q.Set(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	if len(r.Header) > 0 {
+		for k, vv := range r.Header {
+			for _, v := range vv {
+				req.Header.Add(k, v)
+			}
+		}
+	}
+
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	res, err := transport.Perform(req)
+	if err != nil {
+		return nil, err
+	}
+
+	response := Response{
+		StatusCode: res.StatusCode,
+		Body:       res.Body,
+		Header:     res.Header,
+		codec:      JSONCodecOf(transport),
+	}
+
+	return &response, nil
+}
+
+// WithContext sets the request context.
+func (f TenancyConfigGet) WithContext(v context.Context) func(*TenancyConfigGetRequest) {
+	return func(r *TenancyConfigGetRequest) {
+		r.ctx = v
+	}
+}
+
+// WithQueryParam adds a query parameter which has no dedicated option.
+//
+// Parameters set by the other options take precedence.
+func (f TenancyConfigGet) WithQueryParam(key, value string) func(*TenancyConfigGetRequest) {
+	return func(r *TenancyConfigGetRequest) {
+		if r.QueryParams == nil {
+			r.QueryParams = make(map[string]string)
+		}
+		r.QueryParams[key] = value
+	}
+}
+
+// WithPretty makes the response body pretty-printed.
+func (f TenancyConfigGet) WithPretty() func(*TenancyConfigGetRequest) {
+	return func(r *TenancyConfigGetRequest) {
+		r.Pretty = true
+	}
+}
+
+// WithHuman makes statistical values human-readable.
+func (f TenancyConfigGet) WithHuman() func(*TenancyConfigGetRequest) {
+	return func(r *TenancyConfigGetRequest) {
+		r.Human = true
+	}
+}
+
+// WithErrorTrace includes the stack trace for errors in the response body.
+func (f TenancyConfigGet) WithErrorTrace() func(*TenancyConfigGetRequest) {
+	return func(r *TenancyConfigGetRequest) {
+		r.ErrorTrace = true
+	}
+}
+
+// WithDebug makes the response body pretty-printed and human-readable, and includes the stack trace for errors.
+func (f TenancyConfigGet) WithDebug() func(*TenancyConfigGetRequest) {
+	return func(r *TenancyConfigGetRequest) {
+		r.Pretty = true
+		r.Human = true
+		r.ErrorTrace = true
+	}
+}
+
+// WithFilterPath filters the properties of the response body.
+func (f TenancyConfigGet) WithFilterPath(v ...string) func(*TenancyConfigGetRequest) {
+	return func(r *TenancyConfigGetRequest) {
+		r.FilterPath = v
+	}
+}
+
+// WithHeader adds the headers to the HTTP request.
+func (f TenancyConfigGet) WithHeader(h map[string]string) func(*TenancyConfigGetRequest) {
+	return func(r *TenancyConfigGetRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, v := range h {
+			r.Header.Add(k, v)
+		}
+	}
+}
+
+// WithHTTPHeader adds the headers to the HTTP request, keeping the multiple values of a header.
+func (f TenancyConfigGet) WithHTTPHeader(h http.Header) func(*TenancyConfigGetRequest) {
+	return func(r *TenancyConfigGetRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, vv := range h {
+			for _, v := range vv {
+				r.Header.Add(k, v)
+			}
+		}
+	}
+}
+
+// WithAccept sets the media type of the response, eg. "application/yaml"; the default is JSON.
+func (f TenancyConfigGet) WithAccept(v string) func(*TenancyConfigGetRequest) {
+	return func(r *TenancyConfigGetRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Accept", v)
+	}
+}
+
+// WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
+func (f TenancyConfigGet) WithOpaqueID(s string) func(*TenancyConfigGetRequest) {
+	return func(r *TenancyConfigGetRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("X-Opaque-Id", s)
+	}
+}
+
+// WithBasicAuth sets the credentials of the request, overriding the credentials of the client.
+func (f TenancyConfigGet) WithBasicAuth(username, password string) func(*TenancyConfigGetRequest) {
+	return func(r *TenancyConfigGetRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Authorization", basicAuth(username, password))
+	}
+}
+
+// WithNoCache bypasses the response cache of the client, when enabled.
+func (f TenancyConfigGet) WithNoCache() func(*TenancyConfigGetRequest) {
+	return func(r *TenancyConfigGetRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Cache-Control", "no-cache")
+	}
+}
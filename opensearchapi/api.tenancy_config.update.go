@@ -0,0 +1,298 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchapi
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+func newTenancyConfigUpdateFunc(t Transport) TenancyConfigUpdate {
+	return func(config TenancyConfigBody, o ...func(*TenancyConfigUpdateRequest)) (*Response, error) {
+		var r = TenancyConfigUpdateRequest{Config: config}
+		for _, f := range o {
+			f(&r)
+		}
+		return r.Do(r.ctx, t)
+	}
+}
+
+// ----- API Definition -------------------------------------------------------
+
+// TenancyConfigUpdate updates the multitenancy configuration of the cluster.
+type TenancyConfigUpdate func(config TenancyConfigBody, o ...func(*TenancyConfigUpdateRequest)) (*Response, error)
+
+// TenancyConfigUpdateRequest configures the Tenancy Config Update API request.
+type TenancyConfigUpdateRequest struct {
+	Config TenancyConfigBody
+
+	Body io.Reader
+
+	QueryParams map[string]string
+
+	Pretty     bool
+	Human      bool
+	ErrorTrace bool
+	FilterPath []string
+
+	Header http.Header
+
+	ctx context.Context
+}
+
+// TenancyConfigBody represents the multitenancy configuration.
+//
+// Unset fields are omitted from the request and keep their current value.
+type TenancyConfigBody struct {
+	MultitenancyEnabled  *bool  `json:"multitenancy_enabled,omitempty"`
+	PrivateTenantEnabled *bool  `json:"private_tenant_enabled,omitempty"`
+	DefaultTenant        string `json:"default_tenant,omitempty"`
+}
+
+// Do executes the request and returns response or error.
+func (r TenancyConfigUpdateRequest) Do(ctx context.Context, transport Transport) (*Response, error) {
+	var (
+		method string
+		path   strings.Builder
+		params map[string]string
+	)
+
+	method = "PUT"
+
+	path.Grow(len("/_plugins/_security/api/tenancy/config"))
+	path.WriteString("/_plugins/_security/api/tenancy/config")
+
+	params = make(map[string]string)
+
+	if r.Pretty {
+		params["pretty"] = "true"
+	}
+
+	if r.Human {
+		params["human"] = "true"
+	}
+
+	if r.ErrorTrace {
+		params["error_trace"] = "true"
+	}
+
+	if len(r.FilterPath) > 0 {
+		params["filter_path"] = strings.Join(r.FilterPath, ",")
+	}
+
+	for k, v := range r.QueryParams {
+		if _, ok := params[k]; !ok {
+			params[k] = v
+		}
+	}
+
+	var body io.Reader = r.Body
+	if body == nil {
+		b, err := JSONCodecOf(transport).Marshal(r.Config)
+		if err != nil {
+			return nil, fmt.Errorf("cannot encode tenancy config: %s", err)
+		}
+		body = bytes.NewReader(b)
+	}
+
+	req, err := newRequest(transport, method, path.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(params) > 0 {
+		q := req.URL.Query()
+		for k, v := range params {
+			q.Set(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	if r.Header.Get(headerContentType) == "" {
+		req.Header[headerContentType] = headerContentTypeJSON
+	}
+
+	if len(r.Header) > 0 {
+		for k, vv := range r.Header {
+			for _, v := range vv {
+				req.Header.Add(k, v)
+			}
+		}
+	}
+
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	res, err := transport.Perform(req)
+	if err != nil {
+		return nil, err
+	}
+
+	response := Response{
+		StatusCode: res.StatusCode,
+		Body:       res.Body,
+		Header:     res.Header,
+		codec:      JSONCodecOf(transport),
+	}
+
+	return &response, nil
+}
+
+// WithContext sets the request context.
+func (f TenancyConfigUpdate) WithContext(v context.Context) func(*TenancyConfigUpdateRequest) {
+	return func(r *TenancyConfigUpdateRequest) {
+		r.ctx = v
+	}
+}
+
+// WithBody - The request body, it replaces the configuration passed to the function.
+func (f TenancyConfigUpdate) WithBody(v io.Reader) func(*TenancyConfigUpdateRequest) {
+	return func(r *TenancyConfigUpdateRequest) {
+		r.Body = v
+	}
+}
+
+// WithJSONBody - the request body, encoded to JSON with the JSON codec of the client; it replaces the body set with WithBody.
+func (f TenancyConfigUpdate) WithJSONBody(v interface{}) func(*TenancyConfigUpdateRequest) {
+	return func(r *TenancyConfigUpdateRequest) {
+		r.Body = JSONBody(v)
+	}
+}
+
+// WithQueryParam adds a query parameter which has no dedicated option.
+//
+// Parameters set by the other options take precedence.
+func (f TenancyConfigUpdate) WithQueryParam(key, value string) func(*TenancyConfigUpdateRequest) {
+	return func(r *TenancyConfigUpdateRequest) {
+		if r.QueryParams == nil {
+			r.QueryParams = make(map[string]string)
+		}
+		r.QueryParams[key] = value
+	}
+}
+
+// WithPretty makes the response body pretty-printed.
+func (f TenancyConfigUpdate) WithPretty() func(*TenancyConfigUpdateRequest) {
+	return func(r *TenancyConfigUpdateRequest) {
+		r.Pretty = true
+	}
+}
+
+// WithHuman makes statistical values human-readable.
+func (f TenancyConfigUpdate) WithHuman() func(*TenancyConfigUpdateRequest) {
+	return func(r *TenancyConfigUpdateRequest) {
+		r.Human = true
+	}
+}
+
+// WithErrorTrace includes the stack trace for errors in the response body.
+func (f TenancyConfigUpdate) WithErrorTrace() func(*TenancyConfigUpdateRequest) {
+	return func(r *TenancyConfigUpdateRequest) {
+		r.ErrorTrace = true
+	}
+}
+
+// WithDebug makes the response body pretty-printed and human-readable, and includes the stack trace for errors.
+func (f TenancyConfigUpdate) WithDebug() func(*TenancyConfigUpdateRequest) {
+	return func(r *TenancyConfigUpdateRequest) {
+		r.Pretty = true
+		r.Human = true
+		r.ErrorTrace = true
+	}
+}
+
+// WithFilterPath filters the properties of the response body.
+func (f TenancyConfigUpdate) WithFilterPath(v ...string) func(*TenancyConfigUpdateRequest) {
+	return func(r *TenancyConfigUpdateRequest) {
+		r.FilterPath = v
+	}
+}
+
+// WithHeader adds the headers to the HTTP request.
+func (f TenancyConfigUpdate) WithHeader(h map[string]string) func(*TenancyConfigUpdateRequest) {
+	return func(r *TenancyConfigUpdateRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, v := range h {
+			r.Header.Add(k, v)
+		}
+	}
+}
+
+// WithHTTPHeader adds the headers to the HTTP request, keeping the multiple values of a header.
+func (f TenancyConfigUpdate) WithHTTPHeader(h http.Header) func(*TenancyConfigUpdateRequest) {
+	return func(r *TenancyConfigUpdateRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, vv := range h {
+			for _, v := range vv {
+				r.Header.Add(k, v)
+			}
+		}
+	}
+}
+
+// WithAccept sets the media type of the response, eg. "application/yaml"; the default is JSON.
+func (f TenancyConfigUpdate) WithAccept(v string) func(*TenancyConfigUpdateRequest) {
+	return func(r *TenancyConfigUpdateRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Accept", v)
+	}
+}
+
+// WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
+func (f TenancyConfigUpdate) WithOpaqueID(s string) func(*TenancyConfigUpdateRequest) {
+	return func(r *TenancyConfigUpdateRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("X-Opaque-Id", s)
+	}
+}
+
+// WithContentType sets the Content-Type header of the request, overriding the default of the request body.
+func (f TenancyConfigUpdate) WithContentType(v string) func(*TenancyConfigUpdateRequest) {
+	return func(r *TenancyConfigUpdateRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Content-Type", v)
+	}
+}
+
+// WithBasicAuth sets the credentials of the request, overriding the credentials of the client.
+func (f TenancyConfigUpdate) WithBasicAuth(username, password string) func(*TenancyConfigUpdateRequest) {
+	return func(r *TenancyConfigUpdateRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Authorization", basicAuth(username, password))
+	}
+}
+
+// WithMethodOverride sends the request as POST, with the X-HTTP-Method-Override header set to PUT,
+// for the proxies which block PUT requests.
+func (f TenancyConfigUpdate) WithMethodOverride() func(*TenancyConfigUpdateRequest) {
+	return func(r *TenancyConfigUpdateRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("X-HTTP-Method-Override", "PUT")
+	}
+}
@@ -104,7 +104,7 @@ func (r TermsEnumRequest) Do(ctx context.Context, transport Transport) (*Respons
 		params["filter_path"] = strings.Join(r.FilterPath, ",")
 	}
 
-	req, err := newRequest(method, path.String(), r.Body)
+	req, err := newRequest(transport, method, path.String(), r.Body)
 	if err != nil {
 		return nil, err
 	}
@@ -117,18 +117,14 @@ func (r TermsEnumRequest) Do(ctx context.Context, transport Transport) (*Respons
 		req.URL.RawQuery = q.Encode()
 	}
 
-	if r.Body != nil {
+	if r.Body != nil && r.Header.Get(headerContentType) == "" {
 		req.Header[headerContentType] = headerContentTypeJSON
 	}
 
 	if len(r.Header) > 0 {
-		if len(req.Header) == 0 {
-			req.Header = r.Header
-		} else {
-			for k, vv := range r.Header {
-				for _, v := range vv {
-					req.Header.Add(k, v)
-				}
+		for k, vv := range r.Header {
+			for _, v := range vv {
+				req.Header.Add(k, v)
 			}
 		}
 	}
@@ -146,6 +142,7 @@ func (r TermsEnumRequest) Do(ctx context.Context, transport Transport) (*Respons
 		StatusCode: res.StatusCode,
 		Body:       res.Body,
 		Header:     res.Header,
+		codec:      JSONCodecOf(transport),
 	}
 
 	return &response, response.Err()
@@ -167,6 +164,14 @@ func (f TermsEnum) WithBody(v io.Reader) func(*TermsEnumRequest) {
 	}
 }
 
+// WithJSONBody - the request body, encoded to JSON with the JSON codec of the client; it replaces the body set with WithBody.
+//
+func (f TermsEnum) WithJSONBody(v interface{}) func(*TermsEnumRequest) {
+	return func(r *TermsEnumRequest) {
+		r.Body = JSONBody(v)
+	}
+}
+
 // WithPretty makes the response body pretty-printed.
 //
 func (f TermsEnum) WithPretty() func(*TermsEnumRequest) {
@@ -191,6 +196,16 @@ func (f TermsEnum) WithErrorTrace() func(*TermsEnumRequest) {
 	}
 }
 
+// WithDebug makes the response body pretty-printed and human-readable, and includes the stack trace for errors.
+//
+func (f TermsEnum) WithDebug() func(*TermsEnumRequest) {
+	return func(r *TermsEnumRequest) {
+		r.Pretty = true
+		r.Human = true
+		r.ErrorTrace = true
+	}
+}
+
 // WithFilterPath filters the properties of the response body.
 //
 func (f TermsEnum) WithFilterPath(v ...string) func(*TermsEnumRequest) {
@@ -212,6 +227,32 @@ func (f TermsEnum) WithHeader(h map[string]string) func(*TermsEnumRequest) {
 	}
 }
 
+// WithHTTPHeader adds the headers to the HTTP request, keeping the multiple values of a header.
+//
+func (f TermsEnum) WithHTTPHeader(h http.Header) func(*TermsEnumRequest) {
+	return func(r *TermsEnumRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, vv := range h {
+			for _, v := range vv {
+				r.Header.Add(k, v)
+			}
+		}
+	}
+}
+
+// WithAccept sets the media type of the response, eg. "application/yaml"; the default is JSON.
+//
+func (f TermsEnum) WithAccept(v string) func(*TermsEnumRequest) {
+	return func(r *TermsEnumRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Accept", v)
+	}
+}
+
 // WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
 //
 func (f TermsEnum) WithOpaqueID(s string) func(*TermsEnumRequest) {
@@ -222,3 +263,25 @@ func (f TermsEnum) WithOpaqueID(s string) func(*TermsEnumRequest) {
 		r.Header.Set("X-Opaque-Id", s)
 	}
 }
+
+// WithContentType sets the Content-Type header of the request, overriding the default of the request body.
+//
+func (f TermsEnum) WithContentType(v string) func(*TermsEnumRequest) {
+	return func(r *TermsEnumRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Content-Type", v)
+	}
+}
+
+// WithBasicAuth sets the credentials of the request, overriding the credentials of the client.
+//
+func (f TermsEnum) WithBasicAuth(username, password string) func(*TermsEnumRequest) {
+	return func(r *TermsEnumRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Authorization", basicAuth(username, password))
+	}
+}
@@ -164,7 +164,7 @@ func (r TermvectorsRequest) Do(ctx context.Context, transport Transport) (*Respo
 		params["filter_path"] = strings.Join(r.FilterPath, ",")
 	}
 
-	req, err := newRequest(method, path.String(), r.Body)
+	req, err := newRequest(transport, method, path.String(), r.Body)
 	if err != nil {
 		return nil, err
 	}
@@ -177,18 +177,14 @@ func (r TermvectorsRequest) Do(ctx context.Context, transport Transport) (*Respo
 		req.URL.RawQuery = q.Encode()
 	}
 
-	if r.Body != nil {
+	if r.Body != nil && r.Header.Get(headerContentType) == "" {
 		req.Header[headerContentType] = headerContentTypeJSON
 	}
 
 	if len(r.Header) > 0 {
-		if len(req.Header) == 0 {
-			req.Header = r.Header
-		} else {
-			for k, vv := range r.Header {
-				for _, v := range vv {
-					req.Header.Add(k, v)
-				}
+		for k, vv := range r.Header {
+			for _, v := range vv {
+				req.Header.Add(k, v)
 			}
 		}
 	}
@@ -206,6 +202,7 @@ func (r TermvectorsRequest) Do(ctx context.Context, transport Transport) (*Respo
 		StatusCode: res.StatusCode,
 		Body:       res.Body,
 		Header:     res.Header,
+		codec:      JSONCodecOf(transport),
 	}
 
 	return &response, response.Err()
@@ -227,6 +224,14 @@ func (f Termvectors) WithBody(v io.Reader) func(*TermvectorsRequest) {
 	}
 }
 
+// WithJSONBody - the request body, encoded to JSON with the JSON codec of the client; it replaces the body set with WithBody.
+//
+func (f Termvectors) WithJSONBody(v interface{}) func(*TermvectorsRequest) {
+	return func(r *TermvectorsRequest) {
+		r.Body = JSONBody(v)
+	}
+}
+
 // WithDocumentID - the ID of the document, when not specified a doc param should be supplied..
 //
 func (f Termvectors) WithDocumentID(v string) func(*TermvectorsRequest) {
@@ -347,6 +352,16 @@ func (f Termvectors) WithErrorTrace() func(*TermvectorsRequest) {
 	}
 }
 
+// WithDebug makes the response body pretty-printed and human-readable, and includes the stack trace for errors.
+//
+func (f Termvectors) WithDebug() func(*TermvectorsRequest) {
+	return func(r *TermvectorsRequest) {
+		r.Pretty = true
+		r.Human = true
+		r.ErrorTrace = true
+	}
+}
+
 // WithFilterPath filters the properties of the response body.
 //
 func (f Termvectors) WithFilterPath(v ...string) func(*TermvectorsRequest) {
@@ -368,6 +383,32 @@ func (f Termvectors) WithHeader(h map[string]string) func(*TermvectorsRequest) {
 	}
 }
 
+// WithHTTPHeader adds the headers to the HTTP request, keeping the multiple values of a header.
+//
+func (f Termvectors) WithHTTPHeader(h http.Header) func(*TermvectorsRequest) {
+	return func(r *TermvectorsRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, vv := range h {
+			for _, v := range vv {
+				r.Header.Add(k, v)
+			}
+		}
+	}
+}
+
+// WithAccept sets the media type of the response, eg. "application/yaml"; the default is JSON.
+//
+func (f Termvectors) WithAccept(v string) func(*TermvectorsRequest) {
+	return func(r *TermvectorsRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Accept", v)
+	}
+}
+
 // WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
 //
 func (f Termvectors) WithOpaqueID(s string) func(*TermvectorsRequest) {
@@ -378,3 +419,25 @@ func (f Termvectors) WithOpaqueID(s string) func(*TermvectorsRequest) {
 		r.Header.Set("X-Opaque-Id", s)
 	}
 }
+
+// WithContentType sets the Content-Type header of the request, overriding the default of the request body.
+//
+func (f Termvectors) WithContentType(v string) func(*TermvectorsRequest) {
+	return func(r *TermvectorsRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Content-Type", v)
+	}
+}
+
+// WithBasicAuth sets the credentials of the request, overriding the credentials of the client.
+//
+func (f Termvectors) WithBasicAuth(username, password string) func(*TermvectorsRequest) {
+	return func(r *TermvectorsRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Authorization", basicAuth(username, password))
+	}
+}
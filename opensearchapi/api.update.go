@@ -117,6 +117,9 @@ func (r UpdateRequest) Do(ctx context.Context, transport Transport) (*Response,
 	}
 
 	if r.Refresh != "" {
+		if err := ValidateRefresh(r.Refresh); err != nil {
+			return nil, err
+		}
 		params["refresh"] = r.Refresh
 	}
 
@@ -153,6 +156,9 @@ func (r UpdateRequest) Do(ctx context.Context, transport Transport) (*Response,
 	}
 
 	if r.WaitForActiveShards != "" {
+		if err := ValidateWaitForActiveShards(r.WaitForActiveShards); err != nil {
+			return nil, err
+		}
 		params["wait_for_active_shards"] = r.WaitForActiveShards
 	}
 
@@ -172,7 +178,7 @@ func (r UpdateRequest) Do(ctx context.Context, transport Transport) (*Response,
 		params["filter_path"] = strings.Join(r.FilterPath, ",")
 	}
 
-	req, err := newRequest(method, path.String(), r.Body)
+	req, err := newRequest(transport, method, path.String(), r.Body)
 	if err != nil {
 		return nil, err
 	}
@@ -185,18 +191,14 @@ func (r UpdateRequest) Do(ctx context.Context, transport Transport) (*Response,
 		req.URL.RawQuery = q.Encode()
 	}
 
-	if r.Body != nil {
+	if r.Body != nil && r.Header.Get(headerContentType) == "" {
 		req.Header[headerContentType] = headerContentTypeJSON
 	}
 
 	if len(r.Header) > 0 {
-		if len(req.Header) == 0 {
-			req.Header = r.Header
-		} else {
-			for k, vv := range r.Header {
-				for _, v := range vv {
-					req.Header.Add(k, v)
-				}
+		for k, vv := range r.Header {
+			for _, v := range vv {
+				req.Header.Add(k, v)
 			}
 		}
 	}
@@ -214,6 +216,7 @@ func (r UpdateRequest) Do(ctx context.Context, transport Transport) (*Response,
 		StatusCode: res.StatusCode,
 		Body:       res.Body,
 		Header:     res.Header,
+		codec:      JSONCodecOf(transport),
 	}
 
 	return &response, response.Err()
@@ -227,6 +230,14 @@ func (f Update) WithContext(v context.Context) func(*UpdateRequest) {
 	}
 }
 
+// WithJSONBody - the request body, encoded to JSON with the JSON codec of the client; it replaces the body passed to the function.
+//
+func (f Update) WithJSONBody(v interface{}) func(*UpdateRequest) {
+	return func(r *UpdateRequest) {
+		r.Body = JSONBody(v)
+	}
+}
+
 // WithIfPrimaryTerm - only perform the update operation if the last operation that has changed the document has the specified primary term.
 //
 func (f Update) WithIfPrimaryTerm(v int) func(*UpdateRequest) {
@@ -253,6 +264,8 @@ func (f Update) WithLang(v string) func(*UpdateRequest) {
 
 // WithRefresh - if `true` then refresh the affected shards to make this operation visible to search, if `wait_for` then wait for a refresh to make this operation visible to search, if `false` (the default) then do nothing with refreshes..
 //
+// See ValidateRefresh for the valid values.
+//
 func (f Update) WithRefresh(v string) func(*UpdateRequest) {
 	return func(r *UpdateRequest) {
 		r.Refresh = v
@@ -347,6 +360,16 @@ func (f Update) WithErrorTrace() func(*UpdateRequest) {
 	}
 }
 
+// WithDebug makes the response body pretty-printed and human-readable, and includes the stack trace for errors.
+//
+func (f Update) WithDebug() func(*UpdateRequest) {
+	return func(r *UpdateRequest) {
+		r.Pretty = true
+		r.Human = true
+		r.ErrorTrace = true
+	}
+}
+
 // WithFilterPath filters the properties of the response body.
 //
 func (f Update) WithFilterPath(v ...string) func(*UpdateRequest) {
@@ -368,6 +391,32 @@ func (f Update) WithHeader(h map[string]string) func(*UpdateRequest) {
 	}
 }
 
+// WithHTTPHeader adds the headers to the HTTP request, keeping the multiple values of a header.
+//
+func (f Update) WithHTTPHeader(h http.Header) func(*UpdateRequest) {
+	return func(r *UpdateRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, vv := range h {
+			for _, v := range vv {
+				r.Header.Add(k, v)
+			}
+		}
+	}
+}
+
+// WithAccept sets the media type of the response, eg. "application/yaml"; the default is JSON.
+//
+func (f Update) WithAccept(v string) func(*UpdateRequest) {
+	return func(r *UpdateRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Accept", v)
+	}
+}
+
 // WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
 //
 func (f Update) WithOpaqueID(s string) func(*UpdateRequest) {
@@ -378,3 +427,25 @@ func (f Update) WithOpaqueID(s string) func(*UpdateRequest) {
 		r.Header.Set("X-Opaque-Id", s)
 	}
 }
+
+// WithContentType sets the Content-Type header of the request, overriding the default of the request body.
+//
+func (f Update) WithContentType(v string) func(*UpdateRequest) {
+	return func(r *UpdateRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Content-Type", v)
+	}
+}
+
+// WithBasicAuth sets the credentials of the request, overriding the credentials of the client.
+//
+func (f Update) WithBasicAuth(username, password string) func(*UpdateRequest) {
+	return func(r *UpdateRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Authorization", basicAuth(username, password))
+	}
+}
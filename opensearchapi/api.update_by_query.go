@@ -97,6 +97,8 @@ type UpdateByQueryRequest struct {
 	WaitForActiveShards string
 	WaitForCompletion   *bool
 
+	RequestsPerSecondFloat *float64
+
 	Pretty     bool
 	Human      bool
 	ErrorTrace bool
@@ -190,7 +192,9 @@ func (r UpdateByQueryRequest) Do(ctx context.Context, transport Transport) (*Res
 		params["request_cache"] = strconv.FormatBool(*r.RequestCache)
 	}
 
-	if r.RequestsPerSecond != nil {
+	if r.RequestsPerSecondFloat != nil {
+		params["requests_per_second"] = strconv.FormatFloat(*r.RequestsPerSecondFloat, 'f', -1, 64)
+	} else if r.RequestsPerSecond != nil {
 		params["requests_per_second"] = strconv.FormatInt(int64(*r.RequestsPerSecond), 10)
 	}
 
@@ -263,6 +267,9 @@ func (r UpdateByQueryRequest) Do(ctx context.Context, transport Transport) (*Res
 	}
 
 	if r.WaitForActiveShards != "" {
+		if err := ValidateWaitForActiveShards(r.WaitForActiveShards); err != nil {
+			return nil, err
+		}
 		params["wait_for_active_shards"] = r.WaitForActiveShards
 	}
 
@@ -286,7 +293,7 @@ func (r UpdateByQueryRequest) Do(ctx context.Context, transport Transport) (*Res
 		params["filter_path"] = strings.Join(r.FilterPath, ",")
 	}
 
-	req, err := newRequest(method, path.String(), r.Body)
+	req, err := newRequest(transport, method, path.String(), r.Body)
 	if err != nil {
 		return nil, err
 	}
@@ -299,18 +306,14 @@ func (r UpdateByQueryRequest) Do(ctx context.Context, transport Transport) (*Res
 		req.URL.RawQuery = q.Encode()
 	}
 
-	if r.Body != nil {
+	if r.Body != nil && r.Header.Get(headerContentType) == "" {
 		req.Header[headerContentType] = headerContentTypeJSON
 	}
 
 	if len(r.Header) > 0 {
-		if len(req.Header) == 0 {
-			req.Header = r.Header
-		} else {
-			for k, vv := range r.Header {
-				for _, v := range vv {
-					req.Header.Add(k, v)
-				}
+		for k, vv := range r.Header {
+			for _, v := range vv {
+				req.Header.Add(k, v)
 			}
 		}
 	}
@@ -328,6 +331,7 @@ func (r UpdateByQueryRequest) Do(ctx context.Context, transport Transport) (*Res
 		StatusCode: res.StatusCode,
 		Body:       res.Body,
 		Header:     res.Header,
+		codec:      JSONCodecOf(transport),
 	}
 
 	return &response, response.Err()
@@ -349,6 +353,14 @@ func (f UpdateByQuery) WithBody(v io.Reader) func(*UpdateByQueryRequest) {
 	}
 }
 
+// WithJSONBody - the request body, encoded to JSON with the JSON codec of the client; it replaces the body set with WithBody.
+//
+func (f UpdateByQuery) WithJSONBody(v interface{}) func(*UpdateByQueryRequest) {
+	return func(r *UpdateByQueryRequest) {
+		r.Body = JSONBody(v)
+	}
+}
+
 // WithAllowNoIndices - whether to ignore if a wildcard indices expression resolves into no concrete indices. (this includes `_all` string or when no indices have been specified).
 //
 func (f UpdateByQuery) WithAllowNoIndices(v bool) func(*UpdateByQueryRequest) {
@@ -485,6 +497,14 @@ func (f UpdateByQuery) WithRequestsPerSecond(v int) func(*UpdateByQueryRequest)
 	}
 }
 
+// WithRequestsPerSecondFloat - the throttle to set on this request in sub-requests per second, as a float, eg. 0.5; it takes precedence over WithRequestsPerSecond.
+//
+func (f UpdateByQuery) WithRequestsPerSecondFloat(v float64) func(*UpdateByQueryRequest) {
+	return func(r *UpdateByQueryRequest) {
+		r.RequestsPerSecondFloat = &v
+	}
+}
+
 // WithRouting - a list of specific routing values.
 //
 func (f UpdateByQuery) WithRouting(v ...string) func(*UpdateByQueryRequest) {
@@ -653,6 +673,16 @@ func (f UpdateByQuery) WithErrorTrace() func(*UpdateByQueryRequest) {
 	}
 }
 
+// WithDebug makes the response body pretty-printed and human-readable, and includes the stack trace for errors.
+//
+func (f UpdateByQuery) WithDebug() func(*UpdateByQueryRequest) {
+	return func(r *UpdateByQueryRequest) {
+		r.Pretty = true
+		r.Human = true
+		r.ErrorTrace = true
+	}
+}
+
 // WithFilterPath filters the properties of the response body.
 //
 func (f UpdateByQuery) WithFilterPath(v ...string) func(*UpdateByQueryRequest) {
@@ -674,6 +704,32 @@ func (f UpdateByQuery) WithHeader(h map[string]string) func(*UpdateByQueryReques
 	}
 }
 
+// WithHTTPHeader adds the headers to the HTTP request, keeping the multiple values of a header.
+//
+func (f UpdateByQuery) WithHTTPHeader(h http.Header) func(*UpdateByQueryRequest) {
+	return func(r *UpdateByQueryRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, vv := range h {
+			for _, v := range vv {
+				r.Header.Add(k, v)
+			}
+		}
+	}
+}
+
+// WithAccept sets the media type of the response, eg. "application/yaml"; the default is JSON.
+//
+func (f UpdateByQuery) WithAccept(v string) func(*UpdateByQueryRequest) {
+	return func(r *UpdateByQueryRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Accept", v)
+	}
+}
+
 // WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
 //
 func (f UpdateByQuery) WithOpaqueID(s string) func(*UpdateByQueryRequest) {
@@ -684,3 +740,25 @@ func (f UpdateByQuery) WithOpaqueID(s string) func(*UpdateByQueryRequest) {
 		r.Header.Set("X-Opaque-Id", s)
 	}
 }
+
+// WithContentType sets the Content-Type header of the request, overriding the default of the request body.
+//
+func (f UpdateByQuery) WithContentType(v string) func(*UpdateByQueryRequest) {
+	return func(r *UpdateByQueryRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Content-Type", v)
+	}
+}
+
+// WithBasicAuth sets the credentials of the request, overriding the credentials of the client.
+//
+func (f UpdateByQuery) WithBasicAuth(username, password string) func(*UpdateByQueryRequest) {
+	return func(r *UpdateByQueryRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Authorization", basicAuth(username, password))
+	}
+}
@@ -108,7 +108,7 @@ func (r UpdateByQueryRethrottleRequest) Do(ctx context.Context, transport Transp
 		params["filter_path"] = strings.Join(r.FilterPath, ",")
 	}
 
-	req, err := newRequest(method, path.String(), nil)
+	req, err := newRequest(transport, method, path.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -122,13 +122,9 @@ func (r UpdateByQueryRethrottleRequest) Do(ctx context.Context, transport Transp
 	}
 
 	if len(r.Header) > 0 {
-		if len(req.Header) == 0 {
-			req.Header = r.Header
-		} else {
-			for k, vv := range r.Header {
-				for _, v := range vv {
-					req.Header.Add(k, v)
-				}
+		for k, vv := range r.Header {
+			for _, v := range vv {
+				req.Header.Add(k, v)
 			}
 		}
 	}
@@ -146,6 +142,7 @@ func (r UpdateByQueryRethrottleRequest) Do(ctx context.Context, transport Transp
 		StatusCode: res.StatusCode,
 		Body:       res.Body,
 		Header:     res.Header,
+		codec:      JSONCodecOf(transport),
 	}
 
 	return &response, response.Err()
@@ -191,6 +188,16 @@ func (f UpdateByQueryRethrottle) WithErrorTrace() func(*UpdateByQueryRethrottleR
 	}
 }
 
+// WithDebug makes the response body pretty-printed and human-readable, and includes the stack trace for errors.
+//
+func (f UpdateByQueryRethrottle) WithDebug() func(*UpdateByQueryRethrottleRequest) {
+	return func(r *UpdateByQueryRethrottleRequest) {
+		r.Pretty = true
+		r.Human = true
+		r.ErrorTrace = true
+	}
+}
+
 // WithFilterPath filters the properties of the response body.
 //
 func (f UpdateByQueryRethrottle) WithFilterPath(v ...string) func(*UpdateByQueryRethrottleRequest) {
@@ -212,6 +219,32 @@ func (f UpdateByQueryRethrottle) WithHeader(h map[string]string) func(*UpdateByQ
 	}
 }
 
+// WithHTTPHeader adds the headers to the HTTP request, keeping the multiple values of a header.
+//
+func (f UpdateByQueryRethrottle) WithHTTPHeader(h http.Header) func(*UpdateByQueryRethrottleRequest) {
+	return func(r *UpdateByQueryRethrottleRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, vv := range h {
+			for _, v := range vv {
+				r.Header.Add(k, v)
+			}
+		}
+	}
+}
+
+// WithAccept sets the media type of the response, eg. "application/yaml"; the default is JSON.
+//
+func (f UpdateByQueryRethrottle) WithAccept(v string) func(*UpdateByQueryRethrottleRequest) {
+	return func(r *UpdateByQueryRethrottleRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Accept", v)
+	}
+}
+
 // WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
 //
 func (f UpdateByQueryRethrottle) WithOpaqueID(s string) func(*UpdateByQueryRethrottleRequest) {
@@ -222,3 +255,14 @@ func (f UpdateByQueryRethrottle) WithOpaqueID(s string) func(*UpdateByQueryRethr
 		r.Header.Set("X-Opaque-Id", s)
 	}
 }
+
+// WithBasicAuth sets the credentials of the request, overriding the credentials of the client.
+//
+func (f UpdateByQueryRethrottle) WithBasicAuth(username, password string) func(*UpdateByQueryRethrottleRequest) {
+	return func(r *UpdateByQueryRethrottleRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("Authorization", basicAuth(username, password))
+	}
+}
@@ -88,6 +88,22 @@ or locally by:
 	go doc github.com/alphastrikelabs/opensearch-go/opensearchapi Index
 	go doc github.com/alphastrikelabs/opensearch-go/opensearchapi IndexRequest
 
+# Request body
+
+The request bodies must be replayable for the request to be retried or redirected.
+The bodies created with bytes.NewReader, bytes.NewBuffer, strings.NewReader and JSONBody
+are replayed at no cost; the other readers are buffered by the transport when the retries
+are enabled. To stream a large body without buffering, wrap the function opening the stream
+with ReplayableBody, which is called again to resend the body:
+
+	body := opensearchapi.ReplayableBody(func() (io.ReadCloser, error) {
+		return os.Open("roles.json")
+	})
+
+The bodies which can't be opened again, eg. generated while the request is sent, are streamed
+with the WithChunked option of the Bulk API, using the chunked transfer encoding; they're
+not buffered, and the request is not retried. See opensearchutil.NDJSONStream.
+
 # Response
 
 The opensearchapi.Response type is a lightweight wrapper around http.Response.
@@ -0,0 +1,220 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Aggregations represents the aggregations of a search response, or the sub-aggregations of a bucket,
+// keyed by name. The typed accessors decode the common shapes; the others can be decoded from the raw JSON.
+type Aggregations map[string]json.RawMessage
+
+// Terms decodes the terms aggregation with the name, eg. a terms, significant_terms or multi_terms aggregation.
+func (a Aggregations) Terms(name string) (*TermsAggResult, error) {
+	var r TermsAggResult
+	if err := a.decode(name, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// DateHistogram decodes the date_histogram aggregation with the name. The buckets must not be keyed.
+func (a Aggregations) DateHistogram(name string) (*DateHistogramAggResult, error) {
+	var r DateHistogramAggResult
+	if err := a.decode(name, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// Metric decodes the single-value metric aggregation with the name, eg. an avg, sum, min, max,
+// value_count or cardinality aggregation.
+func (a Aggregations) Metric(name string) (*MetricAggResult, error) {
+	var r MetricAggResult
+	if err := a.decode(name, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+func (a Aggregations) decode(name string, v interface{}) error {
+	b, ok := a[name]
+	if !ok {
+		return fmt.Errorf("cannot decode aggregation %q: aggregation not found", name)
+	}
+	if err := DefaultJSONCodec.Unmarshal(b, v); err != nil {
+		return fmt.Errorf("cannot decode aggregation %q: %s", name, err)
+	}
+	return nil
+}
+
+// TermsAggResult represents the result of a terms aggregation.
+type TermsAggResult struct {
+	DocCountErrorUpperBound int64            `json:"doc_count_error_upper_bound"`
+	SumOtherDocCount        int64            `json:"sum_other_doc_count"`
+	Buckets                 []TermsAggBucket `json:"buckets"`
+}
+
+// TermsAggBucket represents a bucket of a terms aggregation.
+//
+// The key is the term, or the JSON text of the numeric keys, eg. "42"; KeyAsString is the formatted key
+// returned for the dates and booleans.
+type TermsAggBucket struct {
+	Key          string
+	KeyAsString  string
+	DocCount     int64
+	Aggregations Aggregations // The sub-aggregations
+}
+
+// UnmarshalJSON decodes the bucket and its sub-aggregations.
+func (b *TermsAggBucket) UnmarshalJSON(data []byte) error {
+	var err error
+	b.Aggregations, err = decodeBucket(data, func(k string, v json.RawMessage) (bool, error) {
+		switch k {
+		case "key":
+			if bytes.HasPrefix(v, []byte(`"`)) {
+				return true, DefaultJSONCodec.Unmarshal(v, &b.Key)
+			}
+			b.Key = string(v)
+			return true, nil
+		case "key_as_string":
+			return true, DefaultJSONCodec.Unmarshal(v, &b.KeyAsString)
+		case "doc_count":
+			return true, DefaultJSONCodec.Unmarshal(v, &b.DocCount)
+		}
+		return false, nil
+	})
+	return err
+}
+
+// DateHistogramAggResult represents the result of a date_histogram aggregation.
+type DateHistogramAggResult struct {
+	Buckets []DateHistogramAggBucket `json:"buckets"`
+}
+
+// DateHistogramAggBucket represents a bucket of a date_histogram aggregation.
+type DateHistogramAggBucket struct {
+	Key          int64  // The start of the bucket, in milliseconds since the epoch
+	KeyAsString  string // The start of the bucket, formatted with the format of the aggregation
+	DocCount     int64
+	Aggregations Aggregations // The sub-aggregations
+}
+
+// Time returns the start of the bucket, in UTC.
+func (b *DateHistogramAggBucket) Time() time.Time {
+	return time.Unix(0, b.Key*int64(time.Millisecond)).UTC()
+}
+
+// UnmarshalJSON decodes the bucket and its sub-aggregations.
+func (b *DateHistogramAggBucket) UnmarshalJSON(data []byte) error {
+	var err error
+	b.Aggregations, err = decodeBucket(data, func(k string, v json.RawMessage) (bool, error) {
+		switch k {
+		case "key":
+			return true, DefaultJSONCodec.Unmarshal(v, &b.Key)
+		case "key_as_string":
+			return true, DefaultJSONCodec.Unmarshal(v, &b.KeyAsString)
+		case "doc_count":
+			return true, DefaultJSONCodec.Unmarshal(v, &b.DocCount)
+		}
+		return false, nil
+	})
+	return err
+}
+
+// MetricAggResult represents the result of a single-value metric aggregation.
+//
+// The value is nil when the aggregation has no value, eg. the avg of no documents.
+type MetricAggResult struct {
+	Value         *float64 `json:"value"`
+	ValueAsString string   `json:"value_as_string"`
+}
+
+// decodeBucket decodes the fields of the bucket with field, and returns the other fields,
+// which are the sub-aggregations.
+func decodeBucket(data []byte, field func(k string, v json.RawMessage) (bool, error)) (Aggregations, error) {
+	var fields map[string]json.RawMessage
+	if err := DefaultJSONCodec.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+
+	var aggs Aggregations
+	for k, v := range fields {
+		ok, err := field(k, v)
+		if err != nil {
+			return nil, fmt.Errorf("cannot decode bucket field %q: %s", k, err)
+		}
+		if ok {
+			continue
+		}
+		if aggs == nil {
+			aggs = make(Aggregations)
+		}
+		aggs[k] = v
+	}
+	return aggs, nil
+}
+
+// Aggs returns the aggregations of the response, or nil when the response has none.
+func (r *SearchResponse) Aggs() (Aggregations, error) {
+	if len(r.Aggregations) == 0 {
+		return nil, nil
+	}
+	var aggs Aggregations
+	if err := DefaultJSONCodec.Unmarshal(r.Aggregations, &aggs); err != nil {
+		return nil, fmt.Errorf("cannot decode aggregations: %s", err)
+	}
+	return aggs, nil
+}
+
+// GetTermsAgg decodes the terms aggregation with the name from the body of the search response,
+// and closes it; see Aggregations.Terms.
+//
+// To decode several aggregations of a response, use ParseSearchResponse and SearchResponse.Aggs.
+func GetTermsAgg(res *Response, name string) (*TermsAggResult, error) {
+	aggs, err := parseAggs(res)
+	if err != nil {
+		return nil, err
+	}
+	return aggs.Terms(name)
+}
+
+// GetDateHistogramAgg decodes the date_histogram aggregation with the name from the body
+// of the search response, and closes it; see Aggregations.DateHistogram.
+func GetDateHistogramAgg(res *Response, name string) (*DateHistogramAggResult, error) {
+	aggs, err := parseAggs(res)
+	if err != nil {
+		return nil, err
+	}
+	return aggs.DateHistogram(name)
+}
+
+// GetMetricAgg decodes the single-value metric aggregation with the name from the body
+// of the search response, and closes it; see Aggregations.Metric.
+func GetMetricAgg(res *Response, name string) (*MetricAggResult, error) {
+	aggs, err := parseAggs(res)
+	if err != nil {
+		return nil, err
+	}
+	return aggs.Metric(name)
+}
+
+// parseAggs decodes the aggregations from the body of the search response, and closes it.
+func parseAggs(res *Response) (Aggregations, error) {
+	sr, err := ParseSearchResponse(res)
+	if err != nil {
+		return nil, err
+	}
+	return sr.Aggs()
+}
@@ -0,0 +1,130 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+//go:build !integration
+// +build !integration
+
+package opensearchapi
+
+import (
+	"io/ioutil"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestAggregations(t *testing.T) {
+	body := `{"took":3,"hits":{"total":{"value":12,"relation":"eq"},"hits":[]},"aggregations":{
+		"by_status":{"doc_count_error_upper_bound":0,"sum_other_doc_count":2,"buckets":[
+			{"key":"error","doc_count":7,"avg_latency":{"value":120.5}},
+			{"key":"ok","doc_count":3,"avg_latency":{"value":null}}]},
+		"by_code":{"buckets":[{"key":500,"doc_count":4},{"key":true,"key_as_string":"true","doc_count":1}]},
+		"per_day":{"buckets":[
+			{"key_as_string":"2023-01-01","key":1672531200000,"doc_count":5,"by_status":{"buckets":[{"key":"ok","doc_count":5}]}},
+			{"key_as_string":"2023-01-02","key":1672617600000,"doc_count":0}]},
+		"max_latency":{"value":980.0,"value_as_string":"980ms"}}}`
+
+	newResponse := func() *Response {
+		return &Response{StatusCode: 200, Body: ioutil.NopCloser(strings.NewReader(body))}
+	}
+
+	t.Run("Terms", func(t *testing.T) {
+		terms, err := GetTermsAgg(newResponse(), "by_status")
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if terms.SumOtherDocCount != 2 || len(terms.Buckets) != 2 {
+			t.Fatalf("Unexpected result: %+v", terms)
+		}
+		if b := terms.Buckets[0]; b.Key != "error" || b.DocCount != 7 {
+			t.Errorf("Unexpected bucket: %+v", b)
+		}
+
+		avg, err := terms.Buckets[0].Aggregations.Metric("avg_latency")
+		if err != nil || avg.Value == nil || *avg.Value != 120.5 {
+			t.Errorf("Unexpected sub-aggregation: %+v, %v", avg, err)
+		}
+		if avg, err := terms.Buckets[1].Aggregations.Metric("avg_latency"); err != nil || avg.Value != nil {
+			t.Errorf("Expected no value, got: %+v, %v", avg, err)
+		}
+
+		codes, err := GetTermsAgg(newResponse(), "by_code")
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if codes.Buckets[0].Key != "500" || codes.Buckets[1].Key != "true" || codes.Buckets[1].KeyAsString != "true" {
+			t.Errorf("Unexpected buckets: %+v", codes.Buckets)
+		}
+	})
+
+	t.Run("DateHistogram", func(t *testing.T) {
+		h, err := GetDateHistogramAgg(newResponse(), "per_day")
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if len(h.Buckets) != 2 {
+			t.Fatalf("Unexpected buckets: %+v", h.Buckets)
+		}
+		b := h.Buckets[0]
+		if !b.Time().Equal(time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)) || b.KeyAsString != "2023-01-01" || b.DocCount != 5 {
+			t.Errorf("Unexpected bucket: %+v", b)
+		}
+		if sub, err := b.Aggregations.Terms("by_status"); err != nil || len(sub.Buckets) != 1 {
+			t.Errorf("Unexpected sub-aggregation: %+v, %v", sub, err)
+		}
+		if h.Buckets[1].Aggregations != nil {
+			t.Errorf("Expected no sub-aggregations, got: %v", h.Buckets[1].Aggregations)
+		}
+	})
+
+	t.Run("Metric", func(t *testing.T) {
+		m, err := GetMetricAgg(newResponse(), "max_latency")
+		if err != nil || m.Value == nil || *m.Value != 980 || m.ValueAsString != "980ms" {
+			t.Errorf("Unexpected result: %+v, %v", m, err)
+		}
+	})
+
+	t.Run("Missing", func(t *testing.T) {
+		if _, err := GetTermsAgg(newResponse(), "by_host"); err == nil {
+			t.Errorf("Expected error")
+		}
+		noAggs := &Response{StatusCode: 200, Body: ioutil.NopCloser(strings.NewReader(`{"hits":{"total":0,"hits":[]}}`))}
+		if _, err := GetTermsAgg(noAggs, "by_host"); err == nil {
+			t.Errorf("Expected error")
+		}
+	})
+
+	t.Run("Search response", func(t *testing.T) {
+		sr, err := ParseSearchResponse(newResponse())
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		aggs, err := sr.Aggs()
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if terms, err := aggs.Terms("by_status"); err != nil || len(terms.Buckets) != 2 {
+			t.Errorf("Unexpected result: %+v, %v", terms, err)
+		}
+		if m, err := aggs.Metric("max_latency"); err != nil || m.Value == nil || *m.Value != 980 {
+			t.Errorf("Unexpected result: %+v, %v", m, err)
+		}
+		if aggs, err := (&SearchResponse{}).Aggs(); err != nil || aggs != nil {
+			t.Errorf("Expected no aggregations, got: %v, %v", aggs, err)
+		}
+	})
+
+	t.Run("Error response", func(t *testing.T) {
+		res := &Response{StatusCode: 400, Body: ioutil.NopCloser(strings.NewReader(
+			`{"error":{"type":"search_phase_execution_exception","reason":"all shards failed"},"status":400}`))}
+		if _, err := GetMetricAgg(res, "max_latency"); err == nil || !strings.Contains(err.Error(), "search_phase_execution_exception") {
+			t.Errorf("Expected the API error, got: %v", err)
+		}
+	})
+}
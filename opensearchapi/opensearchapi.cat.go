@@ -0,0 +1,41 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchapi
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// parseCatResponse decodes the JSON body of a Cat API response into v and closes it.
+//
+// The Cat APIs return a text table by default; the request must set the format to "json".
+// Columns removed with the filter_path or h parameters are left empty.
+func parseCatResponse(res *Response, v interface{}) error {
+	if res == nil || res.Body == nil {
+		return errors.New("cannot parse cat response: empty response")
+	}
+	defer res.Close()
+
+	if err := res.Err(); err != nil {
+		return err
+	}
+
+	if ct := res.Header.Get(headerContentType); ct != "" && !strings.Contains(ct, "json") {
+		return fmt.Errorf("cannot parse cat response: unexpected content type %q, use WithFormat(\"json\")", ct)
+	}
+
+	if err := decodeBody(res, v); err != nil {
+		return fmt.Errorf("cannot parse cat response: %s", err)
+	}
+
+	return nil
+}
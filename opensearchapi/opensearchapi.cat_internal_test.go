@@ -0,0 +1,93 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+//go:build !integration
+// +build !integration
+
+package opensearchapi
+
+import (
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func newCatTestResponse(status int, contentType, body string) *Response {
+	return &Response{
+		StatusCode: status,
+		Header:     http.Header{"Content-Type": []string{contentType}},
+		Body:       ioutil.NopCloser(strings.NewReader(body)),
+	}
+}
+
+func TestParseCat(t *testing.T) {
+	t.Run("Indices", func(t *testing.T) {
+		res := newCatTestResponse(200, "application/json; charset=UTF-8",
+			`[{"health":"green","status":"open","index":"test","uuid":"abc","pri":"1","rep":"0","docs.count":"42","docs.deleted":"0","store.size":"5kb","pri.store.size":"5kb"}]`)
+
+		items, err := ParseCatIndices(res)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if len(items) != 1 {
+			t.Fatalf("Unexpected number of items: %d", len(items))
+		}
+		if items[0].Index != "test" || items[0].Health != "green" || items[0].DocsCount != "42" || items[0].StoreSize != "5kb" {
+			t.Errorf("Unexpected item: %+v", items[0])
+		}
+	})
+
+	t.Run("Nodes with filtered columns", func(t *testing.T) {
+		res := newCatTestResponse(200, "application/json",
+			`[{"name":"node-1","node.role":"dimr","cluster_manager":"*"}]`)
+
+		items, err := ParseCatNodes(res)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if items[0].Name != "node-1" || items[0].ClusterManager != "*" || items[0].NodeRole != "dimr" {
+			t.Errorf("Unexpected item: %+v", items[0])
+		}
+		if items[0].IP != "" || items[0].HeapPercent != "" {
+			t.Errorf("Expected filtered columns to be empty, got: %+v", items[0])
+		}
+	})
+
+	t.Run("Shards", func(t *testing.T) {
+		res := newCatTestResponse(200, "application/json",
+			`[{"index":"test","shard":"0","prirep":"r","state":"UNASSIGNED","unassigned.reason":"INDEX_CREATED"}]`)
+
+		items, err := ParseCatShards(res)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if items[0].PriRep != "r" || items[0].State != "UNASSIGNED" || items[0].UnassignedReason != "INDEX_CREATED" {
+			t.Errorf("Unexpected item: %+v", items[0])
+		}
+	})
+
+	t.Run("Text format", func(t *testing.T) {
+		res := newCatTestResponse(200, "text/plain; charset=UTF-8", "green open test abc 1 0 42 0 5kb 5kb\n")
+
+		if _, err := ParseCatIndices(res); err == nil || !strings.Contains(err.Error(), "WithFormat") {
+			t.Errorf("Expected content type error, got: %v", err)
+		}
+	})
+
+	t.Run("Error response", func(t *testing.T) {
+		res := newCatTestResponse(404, "application/json",
+			`{"error":{"type":"index_not_found_exception","reason":"no such index [foo]"},"status":404}`)
+
+		_, err := ParseCatIndices(res)
+		if err == nil || !strings.Contains(err.Error(), "index_not_found_exception") {
+			t.Errorf("Expected API error, got: %v", err)
+		}
+	})
+}
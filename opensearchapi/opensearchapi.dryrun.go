@@ -0,0 +1,59 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchapi
+
+import (
+	"context"
+	"errors"
+	"net/http"
+)
+
+// dryRunTransport captures the requests instead of performing them.
+type dryRunTransport struct {
+	req *http.Request
+}
+
+// Perform captures the request and returns an empty "404 Not Found" response,
+// so that the APIs making a check before the request, eg. RoleCreateRequest with CreateOnly,
+// go on with the request itself.
+func (t *dryRunTransport) Perform(req *http.Request) (*http.Response, error) {
+	t.req = req
+	return &http.Response{
+		Status:     "404 Not Found",
+		StatusCode: http.StatusNotFound,
+		Header:     make(http.Header),
+		Body:       http.NoBody,
+		Request:    req,
+	}, nil
+}
+
+// BuildRequest returns the HTTP request built by r, with its path, query parameters,
+// headers and body, without performing it, eg. to test the requests without a cluster.
+//
+// The request is returned as built by the API, before the client sets the node URL,
+// the authentication and the global headers. The body is not consumed.
+// Errors of the request validation are returned as is.
+//
+// When the API makes several requests, eg. the existence check of RoleCreateRequest with CreateOnly,
+// the last one is returned, the previous ones being answered with "404 Not Found".
+func BuildRequest(ctx context.Context, r Request) (*http.Request, error) {
+	var t dryRunTransport
+	res, err := r.Do(ctx, &t)
+	if res != nil && res.Body != nil {
+		res.Body.Close()
+	}
+	if t.req == nil {
+		if err == nil {
+			err = errors.New("dry run: no request has been built")
+		}
+		return nil, err
+	}
+	return t.req, nil
+}
@@ -0,0 +1,86 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+//go:build !integration
+// +build !integration
+
+package opensearchapi
+
+import (
+	"context"
+	"io/ioutil"
+	"strings"
+	"testing"
+)
+
+func TestBuildRequest(t *testing.T) {
+	t.Run("Role create", func(t *testing.T) {
+		req, err := BuildRequest(context.Background(), RoleCreateRequest{
+			Role:   "readers",
+			Body:   strings.NewReader(`{"cluster_permissions":["cluster_monitor"]}`),
+			Pretty: true,
+			Header: map[string][]string{"X-Opaque-Id": {"abc"}},
+		})
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+
+		if req.Method != "PUT" || req.URL.String() != "/_plugins/_security/api/roles/readers?pretty=true" {
+			t.Errorf("Unexpected request: %s %s", req.Method, req.URL)
+		}
+		if req.Header.Get("Content-Type") != "application/json" || req.Header.Get("X-Opaque-Id") != "abc" {
+			t.Errorf("Unexpected headers: %v", req.Header)
+		}
+		body, _ := ioutil.ReadAll(req.Body)
+		if string(body) != `{"cluster_permissions":["cluster_monitor"]}` {
+			t.Errorf("Unexpected body: %s", body)
+		}
+	})
+
+	t.Run("Role create only", func(t *testing.T) {
+		req, err := BuildRequest(context.Background(), RoleCreateRequest{
+			Role:       "readers",
+			Body:       strings.NewReader(`{"cluster_permissions":["cluster_monitor"]}`),
+			CreateOnly: true,
+		})
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+
+		if req.Method != "PUT" || req.URL.String() != "/_plugins/_security/api/roles/readers" {
+			t.Errorf("Unexpected request: %s %s", req.Method, req.URL)
+		}
+		body, _ := ioutil.ReadAll(req.Body)
+		if string(body) != `{"cluster_permissions":["cluster_monitor"]}` {
+			t.Errorf("Unexpected body: %s", body)
+		}
+	})
+
+	t.Run("Generic request", func(t *testing.T) {
+		req, err := BuildRequest(context.Background(), GenericRequest{
+			Method: "POST",
+			Path:   "/_plugins/_sql",
+			Body:   strings.NewReader(`SELECT * FROM logs`),
+			Header: map[string][]string{"Content-Type": {"text/plain"}},
+		})
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+
+		if ct := req.Header.Values("Content-Type"); len(ct) != 1 || ct[0] != "text/plain" {
+			t.Errorf("Unexpected Content-Type: %v", ct)
+		}
+	})
+
+	t.Run("Invalid request", func(t *testing.T) {
+		if _, err := BuildRequest(context.Background(), RoleCreateRequest{Role: ".."}); err == nil {
+			t.Errorf("Expected error")
+		}
+	})
+}
@@ -22,7 +22,50 @@
 
 package opensearchapi
 
-import "fmt"
+import (
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// Sentinel errors matching, with errors.Is, the errors returned by Response.Err for the corresponding status codes.
+var (
+	ErrUnauthorized = errors.New("unauthorized")
+	ErrForbidden    = errors.New("forbidden")
+	ErrNotFound     = errors.New("not found")
+	ErrConflict     = errors.New("conflict")
+)
+
+// statusSentinel returns the sentinel error of the status code, or nil.
+func statusSentinel(status int) error {
+	switch status {
+	case http.StatusUnauthorized:
+		return ErrUnauthorized
+	case http.StatusForbidden:
+		return ErrForbidden
+	case http.StatusNotFound:
+		return ErrNotFound
+	case http.StatusConflict:
+		return ErrConflict
+	default:
+		return nil
+	}
+}
+
+// statusError represents an error response without an API error in the body.
+type statusError struct {
+	status int
+	msg    string
+}
+
+func (e *statusError) Error() string {
+	return e.msg
+}
+
+// Is returns true when target is the sentinel error of the status code, eg. ErrNotFound.
+func (e *statusError) Is(target error) bool {
+	return target != nil && target == statusSentinel(e.status)
+}
 
 // Error represents the API error response.
 type Error struct {
@@ -51,3 +94,16 @@ type RootCause struct {
 func (e *Error) Error() string {
 	return fmt.Sprintf("status: %d, type: %s, reason: %s, root_cause: %s", e.Status, e.Err.Type, e.Err.Reason, e.Err.RootCause)
 }
+
+// Is returns true when target is the sentinel error of the status code, eg. ErrNotFound.
+func (e *Error) Is(target error) bool {
+	return target != nil && target == statusSentinel(e.Status)
+}
+
+// IsVersionConflict returns true when err is an API error caused by a version conflict,
+// eg. when indexing a document with an external version lower than the stored one,
+// or when the if_seq_no and if_primary_term of a request don't match the stored document.
+func IsVersionConflict(err error) bool {
+	var e *Error
+	return errors.As(err, &e) && (e.Status == http.StatusConflict || e.Err.Type == "version_conflict_engine_exception")
+}
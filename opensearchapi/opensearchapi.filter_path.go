@@ -0,0 +1,106 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchapi
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"path"
+	"strings"
+)
+
+// ErrFilterPathNoMatch is returned by CheckFilterPath when a filter path matches nothing in the response.
+var ErrFilterPathNoMatch = errors.New("filter_path matched nothing")
+
+// CheckFilterPath returns an error wrapping ErrFilterPathNoMatch when one of the filter paths,
+// as passed to WithFilterPath, matches nothing in the response body.
+//
+// The server returns an empty body for the filter paths which match nothing, so a typo
+// in a path is otherwise indistinguishable from an empty result. The exclusions, the paths
+// starting with "-", are not checked. The body is restored, so the response can still be read.
+func CheckFilterPath(res *Response, filterPath ...string) error {
+	if res == nil || res.Body == nil {
+		return errors.New("cannot check filter_path: empty response")
+	}
+
+	b, err := ioutil.ReadAll(res.Body)
+	res.Body.Close()
+	res.Body = ioutil.NopCloser(bytes.NewReader(b))
+	if err != nil {
+		return fmt.Errorf("cannot check filter_path: %s", err)
+	}
+
+	var v interface{}
+	if len(bytes.TrimSpace(b)) > 0 {
+		if err := res.jsonCodec().Unmarshal(b, &v); err != nil {
+			return fmt.Errorf("cannot check filter_path: %s", err)
+		}
+	}
+
+	var unmatched []string
+	for _, p := range filterPath {
+		for _, p := range strings.Split(p, ",") {
+			if p == "" || strings.HasPrefix(p, "-") {
+				continue
+			}
+			if !matchFilterPath(v, strings.Split(p, ".")) {
+				unmatched = append(unmatched, p)
+			}
+		}
+	}
+
+	if len(unmatched) > 0 {
+		return fmt.Errorf("%w: %s", ErrFilterPathNoMatch, strings.Join(unmatched, ","))
+	}
+	return nil
+}
+
+// matchFilterPath returns true when the path segments match a value in v;
+// the arrays are traversed, as with the filter_path of the server.
+func matchFilterPath(v interface{}, segs []string) bool {
+	if len(segs) == 0 {
+		return true
+	}
+
+	if vv, ok := v.([]interface{}); ok {
+		for _, e := range vv {
+			if matchFilterPath(e, segs) {
+				return true
+			}
+		}
+		return false
+	}
+
+	m, ok := v.(map[string]interface{})
+	if !ok {
+		return false
+	}
+
+	if segs[0] == "**" {
+		if matchFilterPath(v, segs[1:]) {
+			return true
+		}
+		for _, e := range m {
+			if matchFilterPath(e, segs) {
+				return true
+			}
+		}
+		return false
+	}
+
+	for k, e := range m {
+		if ok, _ := path.Match(segs[0], k); ok && matchFilterPath(e, segs[1:]) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,81 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchapi
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+)
+
+// GenericRequest configures a request to an endpoint without a typed API, eg. of a plugin.
+//
+// The request is performed by the transport like the typed ones, with the same
+// authentication, headers, signing and retries. The body is sent as JSON,
+// unless it's a ContentTyper; the JSONBody and ReplayableBody bodies are supported.
+//
+type GenericRequest struct {
+	Method string
+	Path   string // The path, eg. "/_plugins/_ml/models/_search", which can include a query string
+	Params map[string]string
+	Body   io.Reader
+
+	Header http.Header
+}
+
+// Do executes the request and returns response or error.
+//
+func (r GenericRequest) Do(ctx context.Context, transport Transport) (*Response, error) {
+	if r.Method == "" || r.Path == "" {
+		return nil, errors.New("cannot create request: method and path are required")
+	}
+
+	req, err := newRequest(transport, r.Method, r.Path, r.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(r.Params) > 0 {
+		q := req.URL.Query()
+		for k, v := range r.Params {
+			q.Set(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	if r.Body != nil && r.Header.Get(headerContentType) == "" {
+		req.Header[headerContentType] = bodyContentType(r.Body)
+	}
+
+	for k, vv := range r.Header {
+		for _, v := range vv {
+			req.Header.Add(k, v)
+		}
+	}
+
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	res, err := transport.Perform(req)
+	if err != nil {
+		return nil, err
+	}
+
+	response := Response{
+		StatusCode: res.StatusCode,
+		Body:       res.Body,
+		Header:     res.Header,
+		codec:      JSONCodecOf(transport),
+	}
+
+	return &response, response.Err()
+}
@@ -27,6 +27,7 @@
 package opensearchapi
 
 import (
+	"fmt"
 	"net/http"
 	"strconv"
 	"time"
@@ -64,3 +65,21 @@ func formatDuration(d time.Duration) string {
 	}
 	return strconv.FormatInt(int64(d)/int64(time.Millisecond), 10) + "ms"
 }
+
+// WaitForActiveShardsAll waits for all the shard copies to be active, see ValidateWaitForActiveShards.
+const WaitForActiveShardsAll = "all"
+
+// WaitForActiveShardsN returns the wait for active shards value waiting for n active shard copies.
+func WaitForActiveShardsN(n int) string { return strconv.Itoa(n) }
+
+// ValidateWaitForActiveShards returns an error when the wait for active shards value
+// is neither WaitForActiveShardsAll nor a non-negative number of shard copies.
+func ValidateWaitForActiveShards(v string) error {
+	if v == WaitForActiveShardsAll {
+		return nil
+	}
+	if n, err := strconv.Atoi(v); err != nil || n < 0 {
+		return fmt.Errorf("invalid wait for active shards %q: must be %q or a non-negative number", v, WaitForActiveShardsAll)
+	}
+	return nil
+}
@@ -0,0 +1,109 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+)
+
+// JSONEncoder encodes values to JSON.
+type JSONEncoder interface {
+	Marshal(v interface{}) ([]byte, error)
+}
+
+// JSONDecoder decodes JSON into values.
+type JSONDecoder interface {
+	Unmarshal(data []byte, v interface{}) error
+}
+
+// JSONCodec encodes the typed request bodies, eg. WithTemplateBody, and decodes
+// the responses in the Parse helpers, eg. ParseSearchResponse, and the opensearchutil helpers.
+//
+// The interface is implemented by the configurations of the libraries compatible
+// with encoding/json, eg. jsoniter.ConfigCompatibleWithStandardLibrary.
+type JSONCodec interface {
+	JSONEncoder
+	JSONDecoder
+}
+
+// JSONCodecProvider is implemented by the transports which configure the JSON codec, eg. opensearch.Client.
+type JSONCodecProvider interface {
+	JSONCodec() JSONCodec
+}
+
+// DefaultJSONCodec is the codec used when the transport doesn't provide one, and by the decoders
+// which don't know the transport, eg. Aggregations; it uses encoding/json.
+var DefaultJSONCodec JSONCodec = stdJSONCodec{}
+
+// JSONCodecOf returns the JSON codec provided by the transport, or DefaultJSONCodec.
+func JSONCodecOf(t Transport) JSONCodec {
+	if p, ok := t.(JSONCodecProvider); ok {
+		if c := p.JSONCodec(); c != nil {
+			return c
+		}
+	}
+	return DefaultJSONCodec
+}
+
+// jsonCodec returns the JSON codec of the transport which returned the response, or DefaultJSONCodec.
+func (r *Response) jsonCodec() JSONCodec {
+	if r.codec != nil {
+		return r.codec
+	}
+	return DefaultJSONCodec
+}
+
+// decodeBody decodes the JSON body of the response into v with the JSON codec of the response.
+func decodeBody(res *Response, v interface{}) error {
+	b, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return err
+	}
+	return res.jsonCodec().Unmarshal(b, v)
+}
+
+// JSONBody returns a request body which is encoded to JSON with the JSON codec of the transport
+// when the request is created, eg. for the bodies kept as maps or custom types. The encoded
+// body can be read again, so the request can be retried. See the WithJSONBody options.
+func JSONBody(v interface{}) io.Reader {
+	return &jsonBody{v: v}
+}
+
+// jsonBody is encoded by newRequest; it's encoded with DefaultJSONCodec when read directly.
+type jsonBody struct {
+	v   interface{}
+	r   *bytes.Reader
+	err error
+}
+
+func (b *jsonBody) Read(p []byte) (int, error) {
+	if b.r == nil && b.err == nil {
+		var data []byte
+		data, b.err = DefaultJSONCodec.Marshal(b.v)
+		b.r = bytes.NewReader(data)
+	}
+	if b.err != nil {
+		return 0, b.err
+	}
+	return b.r.Read(p)
+}
+
+type stdJSONCodec struct{}
+
+func (stdJSONCodec) Marshal(v interface{}) ([]byte, error) {
+	return json.Marshal(v)
+}
+
+func (stdJSONCodec) Unmarshal(data []byte, v interface{}) error {
+	return json.Unmarshal(data, v)
+}
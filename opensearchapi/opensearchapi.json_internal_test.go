@@ -0,0 +1,144 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+//go:build !integration
+// +build !integration
+
+package opensearchapi
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+type countingJSONCodec struct {
+	marshalled   int
+	unmarshalled int
+}
+
+func (c *countingJSONCodec) Marshal(v interface{}) ([]byte, error) {
+	c.marshalled++
+	return json.Marshal(v)
+}
+
+func (c *countingJSONCodec) Unmarshal(data []byte, v interface{}) error {
+	c.unmarshalled++
+	return json.Unmarshal(data, v)
+}
+
+type codecTransport struct {
+	mockTransport
+	codec JSONCodec
+}
+
+func (t *codecTransport) JSONCodec() JSONCodec { return t.codec }
+
+func TestJSONCodec(t *testing.T) {
+	t.Run("Default", func(t *testing.T) {
+		if c := JSONCodecOf(&mockTransport{}); c != DefaultJSONCodec {
+			t.Errorf("Unexpected codec: %T", c)
+		}
+		if c := JSONCodecOf(&codecTransport{}); c != DefaultJSONCodec {
+			t.Errorf("Unexpected codec for the transport without codec: %T", c)
+		}
+	})
+
+	t.Run("Transport", func(t *testing.T) {
+		codec := &countingJSONCodec{}
+		tp := &codecTransport{codec: codec}
+		tp.RoundTripFunc = func(req *http.Request) (*http.Response, error) {
+			body, _ := ioutil.ReadAll(req.Body)
+			if string(body) != `{"index_patterns":["logs-*"]}` {
+				t.Errorf("Unexpected body: %s", body)
+			}
+			return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody}, nil
+		}
+
+		req := IndicesPutIndexTemplateRequest{Name: "logs", TemplateBody: &IndexTemplateBody{IndexPatterns: []string{"logs-*"}}}
+		if _, err := req.Do(context.Background(), tp); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if codec.marshalled != 1 {
+			t.Errorf("Expected the body to be encoded with the codec of the transport")
+		}
+	})
+
+	t.Run("Response", func(t *testing.T) {
+		codec := &countingJSONCodec{}
+		tp := &codecTransport{codec: codec}
+		tp.RoundTripFunc = func(req *http.Request) (*http.Response, error) {
+			body := `{"cluster_name":"test","version":{"number":"2.9.0"}}`
+			return &http.Response{StatusCode: http.StatusOK, Body: ioutil.NopCloser(strings.NewReader(body))}, nil
+		}
+
+		res, err := InfoRequest{}.Do(context.Background(), tp)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		info, err := ParseInfoResponse(res)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if info.ClusterName != "test" {
+			t.Errorf("Unexpected response: %+v", info)
+		}
+		if codec.unmarshalled != 1 {
+			t.Errorf("Expected the body to be decoded with the codec of the transport")
+		}
+	})
+}
+
+func TestJSONBody(t *testing.T) {
+	t.Run("Option", func(t *testing.T) {
+		var req *http.Request
+		codec := &countingJSONCodec{}
+		tp := &codecTransport{codec: codec}
+		tp.RoundTripFunc = func(r *http.Request) (*http.Response, error) {
+			req = r
+			return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody}, nil
+		}
+
+		index := newIndexFunc(tp)
+		if _, err := index("test", nil, index.WithJSONBody(map[string]string{"title": "foo"})); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if codec.marshalled != 1 {
+			t.Errorf("Expected the body to be encoded with the codec of the transport")
+		}
+		if ct := req.Header.Get("Content-Type"); ct != "application/json" {
+			t.Errorf("Unexpected Content-Type: %q", ct)
+		}
+		if req.GetBody == nil {
+			t.Fatalf("Expected GetBody to be set")
+		}
+		for i := 0; i < 2; i++ {
+			body, _ := req.GetBody()
+			if b, _ := ioutil.ReadAll(body); string(b) != `{"title":"foo"}` {
+				t.Errorf("Unexpected body: %s", b)
+			}
+		}
+	})
+
+	t.Run("Encoding error", func(t *testing.T) {
+		index := newIndexFunc(&mockTransport{})
+		if _, err := index("test", nil, index.WithJSONBody(func() {})); err == nil {
+			t.Errorf("Expected encoding error")
+		}
+	})
+
+	t.Run("Read", func(t *testing.T) {
+		if b, _ := ioutil.ReadAll(JSONBody([]int{1, 2})); string(b) != `[1,2]` {
+			t.Errorf("Unexpected body: %s", b)
+		}
+	})
+}
@@ -0,0 +1,105 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchapi
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// bulkActionSourceLines maps the bulk actions to the number of source lines following the action line.
+var bulkActionSourceLines = map[string]int{
+	"index":  1,
+	"create": 1,
+	"update": 1,
+	"delete": 0,
+}
+
+// ValidateBulkBody parses the newline-delimited JSON of a bulk request body and returns an error
+// with the line number of the first malformed line.
+//
+// It checks that each line is valid JSON, that each action line is followed by the correct number
+// of source lines (0 for delete, 1 for index, create and update), and that the body ends with a newline.
+func ValidateBulkBody(body io.Reader) error {
+	var (
+		br       = bufio.NewReader(body)
+		lineNum  int
+		expected int    // Number of source lines expected after the current action
+		action   string // The current action
+		last     []byte
+	)
+
+	for {
+		line, err := br.ReadBytes('\n')
+		if err != nil && err != io.EOF {
+			return fmt.Errorf("invalid bulk body: %s", err)
+		}
+		if len(line) == 0 && err == io.EOF {
+			break
+		}
+		last = line
+		lineNum++
+
+		trimmed := bytes.TrimSpace(line)
+		if len(trimmed) == 0 {
+			if err == io.EOF {
+				break
+			}
+			continue
+		}
+
+		if !json.Valid(trimmed) {
+			return fmt.Errorf("invalid bulk body: line %d: invalid JSON", lineNum)
+		}
+
+		if expected > 0 {
+			if trimmed[0] != '{' {
+				return fmt.Errorf("invalid bulk body: line %d: source for %q action must be a JSON object", lineNum, action)
+			}
+			expected--
+		} else {
+			var meta map[string]json.RawMessage
+			if err := json.Unmarshal(trimmed, &meta); err != nil {
+				return fmt.Errorf("invalid bulk body: line %d: action must be a JSON object", lineNum)
+			}
+			if len(meta) != 1 {
+				return fmt.Errorf("invalid bulk body: line %d: action must have exactly one key, got %d", lineNum, len(meta))
+			}
+			for k := range meta {
+				action = k
+			}
+			n, ok := bulkActionSourceLines[action]
+			if !ok {
+				return fmt.Errorf("invalid bulk body: line %d: unknown action %q", lineNum, action)
+			}
+			expected = n
+		}
+
+		if err == io.EOF {
+			break
+		}
+	}
+
+	if lineNum == 0 {
+		return errors.New("invalid bulk body: body is empty")
+	}
+	if expected > 0 {
+		return fmt.Errorf("invalid bulk body: line %d: missing source for %q action", lineNum, action)
+	}
+	if len(last) == 0 || last[len(last)-1] != '\n' {
+		return fmt.Errorf("invalid bulk body: line %d: body must end with a newline", lineNum)
+	}
+
+	return nil
+}
@@ -27,7 +27,10 @@
 package opensearchapi
 
 import (
+	"bytes"
 	"context"
+	"encoding/base64"
+	"fmt"
 	"io"
 	"net/http"
 )
@@ -42,12 +45,94 @@ var (
 
 // Request defines the API request.
 //
+// The query string of the requests is encoded with the parameters sorted by key,
+// so identical requests have byte-identical URLs, eg. for caching proxies.
+//
 type Request interface {
 	Do(ctx context.Context, transport Transport) (*Response, error)
 }
 
+// ContentTyper is implemented by the request bodies which have a specific content type,
+// eg. the NDJSON bodies of the Bulk and Multi Search APIs.
+//
+type ContentTyper interface {
+	ContentType() string
+}
+
+// bodyContentType returns the content type of the body, which defaults to JSON.
+//
+func bodyContentType(body io.Reader) []string {
+	if b, ok := body.(ContentTyper); ok && b.ContentType() != "" {
+		return []string{b.ContentType()}
+	}
+	return headerContentTypeJSON
+}
+
 // newRequest creates an HTTP request.
 //
-func newRequest(method, path string, body io.Reader) (*http.Request, error) {
+// The JSONBody bodies are encoded with the JSON codec of the transport.
+//
+func newRequest(transport Transport, method, path string, body io.Reader) (*http.Request, error) {
+	switch b := body.(type) {
+	case *jsonBody:
+		data, err := JSONCodecOf(transport).Marshal(b.v)
+		if err != nil {
+			return nil, fmt.Errorf("cannot encode body: %s", err)
+		}
+		body = bytes.NewReader(data)
+	case *replayableBody:
+		rc, err := b.fn()
+		if err != nil {
+			return nil, fmt.Errorf("cannot get body: %s", err)
+		}
+		req, err := http.NewRequest(method, path, rc)
+		if err != nil {
+			rc.Close()
+			return nil, err
+		}
+		req.GetBody = b.fn
+		return req, nil
+	}
 	return http.NewRequest(method, path, body)
 }
+
+// ReplayableBody returns a request body opened by fn, which is called again
+// to resend the body when the request is retried or redirected.
+//
+// It's meant for the streamed bodies, eg. files, which are otherwise buffered
+// by the transport to be retried. The body is closed by the transport.
+//
+func ReplayableBody(fn func() (io.ReadCloser, error)) io.Reader {
+	return &replayableBody{fn: fn}
+}
+
+// replayableBody is opened by newRequest; it's opened on the first read when read directly.
+//
+type replayableBody struct {
+	fn  func() (io.ReadCloser, error)
+	rc  io.ReadCloser
+	err error
+}
+
+func (b *replayableBody) Read(p []byte) (int, error) {
+	if b.rc == nil && b.err == nil {
+		b.rc, b.err = b.fn()
+	}
+	if b.err != nil {
+		return 0, b.err
+	}
+	return b.rc.Read(p)
+}
+
+func (b *replayableBody) Close() error {
+	if b.rc == nil {
+		return nil
+	}
+	return b.rc.Close()
+}
+
+// basicAuth returns the value of the Authorization header for the credentials.
+//
+func basicAuth(username, password string) string {
+	return "Basic " + base64.StdEncoding.EncodeToString([]byte(username+":"+password))
+}
@@ -29,6 +29,7 @@ package opensearchapi
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
@@ -36,13 +37,44 @@ import (
 	"reflect"
 	"strconv"
 	"strings"
+
+	"github.com/alphastrikelabs/opensearch-go/v2/internal/redact"
 )
 
 // Response represents the API response.
+//
+// The body is owned by the caller, who must close it, with Close or Body.Close, once consumed;
+// the APIs never close it, so it can be streamed. The helpers which decode a response,
+// eg. ParseBulkResponse or StreamObjectEntries, close it with Close, so calling Close again is safe.
 type Response struct {
 	StatusCode int
 	Header     http.Header
 	Body       io.ReadCloser
+
+	codec JSONCodec // The JSON codec of the transport which returned the response, see JSONCodecOf
+}
+
+// errBodyClosed is returned by the reads of the body after Close.
+var errBodyClosed = errors.New("read on closed response body")
+
+// closedBody replaces the body of a response once closed.
+type closedBody struct{}
+
+func (closedBody) Read([]byte) (int, error) { return 0, errBodyClosed }
+func (closedBody) Close() error             { return nil }
+
+// Close closes the body of the response, and replaces it with a closed body,
+// so the following calls to Close or Body.Close return nil. It's not safe for concurrent use.
+func (r *Response) Close() error {
+	if r == nil || r.Body == nil {
+		return nil
+	}
+	if _, ok := r.Body.(closedBody); ok {
+		return nil
+	}
+	err := r.Body.Close()
+	r.Body = closedBody{}
+	return err
 }
 
 // String returns the response as a string.
@@ -83,6 +115,34 @@ func (r *Response) String() string {
 	return out.String()
 }
 
+// PrettyBody returns the JSON body of the response indented with two spaces, whatever the formatting
+// of the server, eg. for the output of command-line tools. The bodies of other content types,
+// eg. the text output of the cat APIs, are returned unchanged.
+//
+// The body is read and closed, and replaced with the original bytes, so it can be read again.
+func (r *Response) PrettyBody() ([]byte, error) {
+	if r == nil || r.Body == nil {
+		return nil, nil
+	}
+
+	b, err := ioutil.ReadAll(r.Body)
+	r.Body.Close() // errcheck exclude
+	r.Body = ioutil.NopCloser(bytes.NewReader(b))
+	if err != nil {
+		return nil, fmt.Errorf("cannot read response body: %s", err)
+	}
+
+	if ct := r.Header.Get("Content-Type"); ct != "" && !strings.Contains(ct, "json") {
+		return b, nil
+	}
+
+	var buf bytes.Buffer
+	if err := json.Indent(&buf, bytes.TrimSpace(b), "", "  "); err != nil {
+		return nil, fmt.Errorf("cannot indent response body: %s", err)
+	}
+	return buf.Bytes(), nil
+}
+
 // Status returns the response status as a string.
 func (r *Response) Status() string {
 	var b strings.Builder
@@ -110,21 +170,77 @@ func (r *Response) HasWarnings() bool {
 }
 
 // Err returns an error when the response status indicates failures.
+//
+// The error matches, with errors.Is, the sentinel error of the status code, eg. ErrNotFound;
+// it is a *Error when the body contains an API error.
 func (r *Response) Err() error {
 	if r.IsError() {
 		if r.Body == nil {
-			return fmt.Errorf(r.Status())
+			return &statusError{status: r.StatusCode, msg: r.Status()}
 		}
 		body, err := io.ReadAll(r.Body)
 		if err != nil {
-			return fmt.Errorf(r.Status())
+			return &statusError{status: r.StatusCode, msg: r.Status()}
 		}
 		var e *Error
 		err = json.Unmarshal(body, &e)
 		if err == nil && !reflect.ValueOf(e.Err).IsZero() {
+			if e.Status == 0 {
+				e.Status = r.StatusCode
+			}
 			return e
 		}
-		return fmt.Errorf("status: %d, error: %s", r.StatusCode, string(body))
+		// Redact the secrets which the body may include, eg. the request body echoed by a proxy
+		return &statusError{
+			status: r.StatusCode,
+			msg:    fmt.Sprintf("status: %d, error: %s", r.StatusCode, redact.Body(body, nil)),
+		}
+	}
+	return nil
+}
+
+// StreamObjectEntries decodes the entries of the JSON object in the response body one at a time,
+// calling fn with the name and the raw value of every entry, and closes the body.
+//
+// It is meant for the large responses keyed by name, eg. the Role Get API listing all the roles,
+// whose memory usage is bounded by the largest entry instead of the whole body.
+// The error of the response is returned when its status indicates a failure,
+// and the error of fn, as is, stops the decoding.
+func StreamObjectEntries(res *Response, fn func(name string, raw json.RawMessage) error) error {
+	if res == nil || res.Body == nil {
+		return errors.New("cannot stream object entries: empty response")
+	}
+	defer res.Close()
+
+	if err := res.Err(); err != nil {
+		return err
+	}
+
+	dec := json.NewDecoder(res.Body)
+	if t, err := dec.Token(); err != nil {
+		return fmt.Errorf("cannot stream object entries: %s", err)
+	} else if d, ok := t.(json.Delim); !ok || d != '{' {
+		return fmt.Errorf("cannot stream object entries: expected an object, got %v", t)
+	}
+
+	for dec.More() {
+		t, err := dec.Token()
+		if err != nil {
+			return fmt.Errorf("cannot stream object entries: %s", err)
+		}
+		name, _ := t.(string)
+
+		var raw json.RawMessage
+		if err := dec.Decode(&raw); err != nil {
+			return fmt.Errorf("cannot stream object entries: entry %q: %s", name, err)
+		}
+		if err := fn(name, raw); err != nil {
+			return err
+		}
+	}
+
+	if _, err := dec.Token(); err != nil {
+		return fmt.Errorf("cannot stream object entries: %s", err)
 	}
 	return nil
 }
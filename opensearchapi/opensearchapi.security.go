@@ -0,0 +1,108 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchapi
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// ErrAlreadyExists is returned by the create-only requests when the resource already exists.
+var ErrAlreadyExists = errors.New("already exists")
+
+// escapeRoleName validates the role name and returns it escaped for use in the URL path.
+func escapeRoleName(name string) (string, error) {
+	return escapeName("role", name)
+}
+
+// escapeName validates the name of a security resource, eg. a role or a cluster,
+// and returns it escaped for use in the URL path.
+func escapeName(kind, name string) (string, error) {
+	switch strings.TrimSpace(name) {
+	case "":
+		return "", fmt.Errorf("invalid %s name %q: name is empty", kind, name)
+	case ".", "..":
+		return "", fmt.Errorf("invalid %s name %q: name is a relative path", kind, name)
+	}
+	return url.PathEscape(name), nil
+}
+
+// noCacheHeader returns a copy of the header which bypasses the response cache of the client.
+func noCacheHeader(h http.Header) http.Header {
+	if h == nil {
+		h = make(http.Header)
+	} else {
+		h = h.Clone()
+	}
+	h.Set("Cache-Control", "no-cache")
+	return h
+}
+
+// SecurityStatus represents the outcome of a request creating or replacing a security resource, eg. a role.
+type SecurityStatus int
+
+const (
+	// SecurityStatusUnknown is the status of the responses without a known status.
+	SecurityStatusUnknown SecurityStatus = iota
+	// SecurityStatusCreated means the resource didn't exist and was created.
+	SecurityStatusCreated
+	// SecurityStatusUpdated means the resource existed and was replaced.
+	SecurityStatusUpdated
+)
+
+// String returns the name of the status.
+func (s SecurityStatus) String() string {
+	switch s {
+	case SecurityStatusCreated:
+		return "created"
+	case SecurityStatusUpdated:
+		return "updated"
+	default:
+		return "unknown"
+	}
+}
+
+// UnmarshalJSON decodes the status of the security plugin, "CREATED" or "OK".
+func (s *SecurityStatus) UnmarshalJSON(b []byte) error {
+	var v string
+	if err := DefaultJSONCodec.Unmarshal(b, &v); err != nil {
+		return err
+	}
+
+	switch strings.ToUpper(v) {
+	case "CREATED":
+		*s = SecurityStatusCreated
+	case "OK":
+		*s = SecurityStatusUpdated
+	default:
+		*s = SecurityStatusUnknown
+	}
+	return nil
+}
+
+// parseSecurityResponse decodes the body of a security plugin response into v and closes it.
+func parseSecurityResponse(res *Response, kind string, v interface{}) error {
+	if res == nil || res.Body == nil {
+		return fmt.Errorf("cannot parse %s response: empty response", kind)
+	}
+	defer res.Close()
+
+	if err := res.Err(); err != nil {
+		return err
+	}
+
+	if err := decodeBody(res, v); err != nil {
+		return fmt.Errorf("cannot parse %s response: %s", kind, err)
+	}
+	return nil
+}
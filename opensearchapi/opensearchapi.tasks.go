@@ -0,0 +1,180 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+)
+
+// TaskInfo represents a task in the Tasks API responses.
+//
+// The status depends on the action, eg. the progress of a reindex; it's only set for the tasks
+// which report one. The description is only set with the detailed parameter.
+type TaskInfo struct {
+	Node               string            `json:"node"`
+	ID                 int64             `json:"id"`
+	Type               string            `json:"type"`
+	Action             string            `json:"action"`
+	Description        string            `json:"description"`
+	Status             json.RawMessage   `json:"status"`
+	StartTimeInMillis  int64             `json:"start_time_in_millis"`
+	RunningTimeInNanos int64             `json:"running_time_in_nanos"`
+	Cancellable        bool              `json:"cancellable"`
+	Cancelled          bool              `json:"cancelled"`
+	ParentTaskID       string            `json:"parent_task_id"`
+	Headers            map[string]string `json:"headers"`
+}
+
+// TaskID returns the identifier of the task, eg. "oTUltX4IQMOUUVeiohTt8A:12345", as expected by the Tasks APIs.
+func (t *TaskInfo) TaskID() string {
+	return t.Node + ":" + strconv.FormatInt(t.ID, 10)
+}
+
+// TasksListResp represents the Tasks List and Tasks Cancel API responses.
+type TasksListResp struct {
+	Tasks        []*TaskInfo       `json:"-"` // The tasks of all the nodes, sorted by node and id
+	NodeFailures []json.RawMessage `json:"node_failures"`
+	TaskFailures []json.RawMessage `json:"task_failures"`
+}
+
+// TaskNode represents a task with its child tasks, see TasksListResp.Tree.
+type TaskNode struct {
+	*TaskInfo
+	Children []*TaskNode
+}
+
+// Tree returns the tasks arranged by parent task. The tasks without parent, or which parent is not
+// in the response, eg. because it was filtered out with the actions parameter, are returned at the root.
+func (r *TasksListResp) Tree() []*TaskNode {
+	nodes := make(map[string]*TaskNode, len(r.Tasks))
+	for _, t := range r.Tasks {
+		nodes[t.TaskID()] = &TaskNode{TaskInfo: t}
+	}
+
+	var roots []*TaskNode
+	for _, t := range r.Tasks {
+		n := nodes[t.TaskID()]
+		if parent, ok := nodes[t.ParentTaskID]; ok && parent != n {
+			parent.Children = append(parent.Children, n)
+		} else {
+			roots = append(roots, n)
+		}
+	}
+	return roots
+}
+
+// taskWithChildren represents a task in the responses grouped by parents.
+type taskWithChildren struct {
+	TaskInfo
+	Children []*taskWithChildren `json:"children"`
+}
+
+// ParseTasksListResponse decodes the body of a Tasks List or Tasks Cancel API response and closes it.
+//
+// The tasks are returned as a list whatever the group_by parameter of the request: "nodes" (default),
+// "parents" or "none"; use Tree to arrange them by parent task.
+func ParseTasksListResponse(res *Response) (*TasksListResp, error) {
+	if res == nil || res.Body == nil {
+		return nil, errors.New("cannot parse tasks response: empty response")
+	}
+	defer res.Close()
+
+	if err := res.Err(); err != nil {
+		return nil, err
+	}
+
+	var body struct {
+		TasksListResp
+		Nodes map[string]struct {
+			Tasks map[string]*TaskInfo `json:"tasks"`
+		} `json:"nodes"`
+		Tasks json.RawMessage `json:"tasks"`
+	}
+	if err := decodeBody(res, &body); err != nil {
+		return nil, fmt.Errorf("cannot parse tasks response: %s", err)
+	}
+
+	tr := body.TasksListResp
+	for _, node := range body.Nodes {
+		for _, t := range node.Tasks {
+			tr.Tasks = append(tr.Tasks, t)
+		}
+	}
+
+	if tasks := bytes.TrimSpace(body.Tasks); len(tasks) > 0 && !bytes.Equal(tasks, []byte("null")) {
+		var grouped []*taskWithChildren
+		if tasks[0] == '[' {
+			if err := res.jsonCodec().Unmarshal(tasks, &grouped); err != nil {
+				return nil, fmt.Errorf("cannot parse tasks response: %s", err)
+			}
+		} else {
+			var byID map[string]*taskWithChildren
+			if err := res.jsonCodec().Unmarshal(tasks, &byID); err != nil {
+				return nil, fmt.Errorf("cannot parse tasks response: %s", err)
+			}
+			for _, t := range byID {
+				grouped = append(grouped, t)
+			}
+		}
+
+		var add func(tasks []*taskWithChildren)
+		add = func(tasks []*taskWithChildren) {
+			for _, t := range tasks {
+				task := t.TaskInfo
+				tr.Tasks = append(tr.Tasks, &task)
+				add(t.Children)
+			}
+		}
+		add(grouped)
+	}
+
+	sort.Slice(tr.Tasks, func(i, j int) bool {
+		if tr.Tasks[i].Node != tr.Tasks[j].Node {
+			return tr.Tasks[i].Node < tr.Tasks[j].Node
+		}
+		return tr.Tasks[i].ID < tr.Tasks[j].ID
+	})
+
+	return &tr, nil
+}
+
+// TasksGetResp represents the Tasks Get API response.
+//
+// The response and the error are only set once the task is completed, when the task stores its result,
+// eg. the reindex tasks started with wait_for_completion=false.
+type TasksGetResp struct {
+	Completed bool            `json:"completed"`
+	Task      TaskInfo        `json:"task"`
+	Response  json.RawMessage `json:"response"`
+	Error     json.RawMessage `json:"error"`
+}
+
+// ParseTasksGetResponse decodes the body of a Tasks Get API response and closes it.
+func ParseTasksGetResponse(res *Response) (*TasksGetResp, error) {
+	if res == nil || res.Body == nil {
+		return nil, errors.New("cannot parse task response: empty response")
+	}
+	defer res.Close()
+
+	if err := res.Err(); err != nil {
+		return nil, err
+	}
+
+	var tr TasksGetResp
+	if err := decodeBody(res, &tr); err != nil {
+		return nil, fmt.Errorf("cannot parse task response: %s", err)
+	}
+	return &tr, nil
+}
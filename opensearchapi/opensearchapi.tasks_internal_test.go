@@ -0,0 +1,89 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+//go:build !integration
+// +build !integration
+
+package opensearchapi
+
+import (
+	"io/ioutil"
+	"strings"
+	"testing"
+)
+
+func TestParseTasksListResponse(t *testing.T) {
+	for name, body := range map[string]string{
+		"Nodes": `{"nodes":{
+			"n1":{"name":"node-1","tasks":{
+				"n1:7":{"node":"n1","id":7,"action":"indices:data/write/reindex","cancellable":true,"status":{"total":100}},
+				"n1:9":{"node":"n1","id":9,"action":"indices:data/write/bulk","parent_task_id":"n1:7"}}},
+			"n2":{"name":"node-2","tasks":{
+				"n2:3":{"node":"n2","id":3,"action":"indices:data/write/bulk[s]","parent_task_id":"n1:9"},
+				"n2:4":{"node":"n2","id":4,"action":"cluster:monitor/tasks/lists","parent_task_id":"n1:1"}}}},
+			"node_failures":[{"type":"failed_node_exception","reason":"Failed node [n3]"}]}`,
+		"Parents": `{"tasks":{
+			"n1:7":{"node":"n1","id":7,"action":"indices:data/write/reindex","cancellable":true,"status":{"total":100},"children":[
+				{"node":"n1","id":9,"action":"indices:data/write/bulk","parent_task_id":"n1:7","children":[
+					{"node":"n2","id":3,"action":"indices:data/write/bulk[s]","parent_task_id":"n1:9"}]}]},
+			"n2:4":{"node":"n2","id":4,"action":"cluster:monitor/tasks/lists","parent_task_id":"n1:1"}},
+			"node_failures":[{"type":"failed_node_exception","reason":"Failed node [n3]"}]}`,
+		"None": `{"tasks":[
+			{"node":"n2","id":4,"action":"cluster:monitor/tasks/lists","parent_task_id":"n1:1"},
+			{"node":"n1","id":9,"action":"indices:data/write/bulk","parent_task_id":"n1:7"},
+			{"node":"n2","id":3,"action":"indices:data/write/bulk[s]","parent_task_id":"n1:9"},
+			{"node":"n1","id":7,"action":"indices:data/write/reindex","cancellable":true,"status":{"total":100}}],
+			"node_failures":[{"type":"failed_node_exception","reason":"Failed node [n3]"}]}`,
+	} {
+		t.Run(name, func(t *testing.T) {
+			tr, err := ParseTasksListResponse(&Response{StatusCode: 200, Body: ioutil.NopCloser(strings.NewReader(body))})
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+
+			var ids []string
+			for _, task := range tr.Tasks {
+				ids = append(ids, task.TaskID())
+			}
+			if strings.Join(ids, ",") != "n1:7,n1:9,n2:3,n2:4" {
+				t.Errorf("Unexpected tasks: %v", ids)
+			}
+			if string(tr.Tasks[0].Status) != `{"total":100}` || !tr.Tasks[0].Cancellable {
+				t.Errorf("Unexpected task: %+v", tr.Tasks[0])
+			}
+			if len(tr.NodeFailures) != 1 {
+				t.Errorf("Unexpected node failures: %s", tr.NodeFailures)
+			}
+
+			roots := tr.Tree()
+			if len(roots) != 2 || roots[0].TaskID() != "n1:7" || roots[1].TaskID() != "n2:4" {
+				t.Fatalf("Unexpected roots: %v", roots)
+			}
+			if c := roots[0].Children; len(c) != 1 || c[0].TaskID() != "n1:9" || len(c[0].Children) != 1 || c[0].Children[0].TaskID() != "n2:3" {
+				t.Errorf("Unexpected children: %v", c)
+			}
+		})
+	}
+}
+
+func TestParseTasksGetResponse(t *testing.T) {
+	body := `{"completed":true,"task":{"node":"n1","id":7,"action":"indices:data/write/reindex"},"response":{"total":100}}`
+	tr, err := ParseTasksGetResponse(&Response{StatusCode: 200, Body: ioutil.NopCloser(strings.NewReader(body))})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if !tr.Completed || tr.Task.TaskID() != "n1:7" || string(tr.Response) != `{"total":100}` || tr.Error != nil {
+		t.Errorf("Unexpected response: %+v", tr)
+	}
+
+	body = `{"error":{"type":"resource_not_found_exception","reason":"task [n1:8] isn't running and hasn't stored its results"},"status":404}`
+	if _, err := ParseTasksGetResponse(&Response{StatusCode: 404, Body: ioutil.NopCloser(strings.NewReader(body))}); err == nil {
+		t.Errorf("Expected error")
+	}
+}
@@ -0,0 +1,66 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+//go:build !integration
+// +build !integration
+
+package opensearchapi
+
+import (
+	"errors"
+	"io/ioutil"
+	"strings"
+	"testing"
+)
+
+func TestCheckFilterPath(t *testing.T) {
+	body := `{"hits":{"total":{"value":1},"hits":[{"_id":"1","_source":{"role":"admin"}}]},"took":3}`
+
+	tests := []struct {
+		name      string
+		body      string
+		paths     []string
+		unmatched string
+	}{
+		{"Match", body, []string{"hits.total.value", "took"}, ""},
+		{"Array", body, []string{"hits.hits._id"}, ""},
+		{"Wildcard", body, []string{"hits.*.value", "to*"}, ""},
+		{"Double wildcard", body, []string{"**.role"}, ""},
+		{"Comma separated", body, []string{"took,hits.hits._source.role"}, ""},
+		{"Exclusion", body, []string{"-rolex", "took"}, ""},
+		{"No match", body, []string{"took", "rolex"}, "rolex"},
+		{"Partial path", body, []string{"hits.hits._source.rolex"}, "hits.hits._source.rolex"},
+		{"Empty body", `{}`, []string{"rolex"}, "rolex"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := &Response{StatusCode: 200, Body: ioutil.NopCloser(strings.NewReader(tt.body))}
+
+			err := CheckFilterPath(res, tt.paths...)
+			if tt.unmatched == "" {
+				if err != nil {
+					t.Errorf("Unexpected error: %s", err)
+				}
+			} else {
+				if !errors.Is(err, ErrFilterPathNoMatch) {
+					t.Fatalf("Expected ErrFilterPathNoMatch, got: %v", err)
+				}
+				if !strings.HasSuffix(err.Error(), ": "+tt.unmatched) {
+					t.Errorf("Unexpected error: %s", err)
+				}
+			}
+
+			b, _ := ioutil.ReadAll(res.Body)
+			if string(b) != tt.body {
+				t.Errorf("Expected the body to be restored, got: %s", b)
+			}
+		})
+	}
+}
@@ -0,0 +1,134 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+//go:build !integration
+// +build !integration
+
+package opensearchapi
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+type mockTransport struct {
+	RoundTripFunc func(*http.Request) (*http.Response, error)
+}
+
+func (t *mockTransport) Perform(req *http.Request) (*http.Response, error) {
+	if t.RoundTripFunc == nil {
+		return &http.Response{StatusCode: 200, Body: ioutil.NopCloser(strings.NewReader(`{}`))}, nil
+	}
+	return t.RoundTripFunc(req)
+}
+
+func TestValidateBulkBody(t *testing.T) {
+	tests := []struct {
+		name    string
+		body    string
+		wantErr string
+	}{
+		{
+			name: "Valid",
+			body: `{"index":{"_index":"test","_id":"1"}}` + "\n" +
+				`{"title":"foo"}` + "\n" +
+				`{"delete":{"_index":"test","_id":"2"}}` + "\n" +
+				`{"update":{"_index":"test","_id":"3"}}` + "\n" +
+				`{"doc":{"title":"bar"}}` + "\n",
+		},
+		{
+			name:    "Empty",
+			body:    "",
+			wantErr: "body is empty",
+		},
+		{
+			name:    "Source not an object",
+			body:    `{"index":{"_index":"test"}}` + "\n" + `["foo"]` + "\n",
+			wantErr: `line 2: source for "index" action must be a JSON object`,
+		},
+		{
+			name:    "Missing source at the end",
+			body:    `{"delete":{"_id":"2"}}` + "\n" + `{"create":{"_id":"1"}}` + "\n",
+			wantErr: `line 2: missing source for "create" action`,
+		},
+		{
+			name:    "Invalid JSON",
+			body:    `{"index":{}}` + "\n" + `{"title":` + "\n",
+			wantErr: "line 2: invalid JSON",
+		},
+		{
+			name:    "Unknown action",
+			body:    `{"upsert":{}}` + "\n",
+			wantErr: `line 1: unknown action "upsert"`,
+		},
+		{
+			name:    "Multiple keys",
+			body:    `{"index":{},"delete":{}}` + "\n",
+			wantErr: "line 1: action must have exactly one key, got 2",
+		},
+		{
+			name:    "Missing trailing newline",
+			body:    `{"delete":{"_id":"1"}}`,
+			wantErr: "line 1: body must end with a newline",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateBulkBody(strings.NewReader(tt.body))
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("Unexpected error: %s", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Unexpected error: got=%v, want=%q", err, tt.wantErr)
+			}
+		})
+	}
+
+	t.Run("WithValidateBody", func(t *testing.T) {
+		var called bool
+		tp := &mockTransport{RoundTripFunc: func(req *http.Request) (*http.Response, error) {
+			called = true
+			b, _ := ioutil.ReadAll(req.Body)
+			if !strings.HasPrefix(string(b), `{"delete"`) {
+				t.Errorf("Unexpected body: %s", b)
+			}
+			return &http.Response{StatusCode: 200, Body: ioutil.NopCloser(strings.NewReader(`{}`))}, nil
+		}}
+		bulk := newBulkFunc(tp)
+
+		if _, err := bulk(strings.NewReader(`{"index":{}}`+"\n"), bulk.WithValidateBody()); err == nil {
+			t.Errorf("Expected validation error")
+		}
+		if called {
+			t.Errorf("Unexpected request for invalid body")
+		}
+
+		if _, err := bulk(strings.NewReader(`{"delete":{"_id":"1"}}`+"\n"), bulk.WithValidateBody(), bulk.WithContext(context.Background())); err != nil {
+			t.Errorf("Unexpected error: %s", err)
+		}
+		if !called {
+			t.Errorf("Expected request for valid body")
+		}
+
+		called = false
+		if _, err := bulk(strings.NewReader(`{"delete":{"_id":"1"}}`+"\n"), bulk.WithValidateBody(), bulk.WithChunked()); err == nil {
+			t.Errorf("Expected error for a chunked body")
+		}
+		if called {
+			t.Errorf("Unexpected request for a chunked body")
+		}
+	})
+}
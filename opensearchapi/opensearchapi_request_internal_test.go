@@ -30,8 +30,13 @@ package opensearchapi
 
 import (
 	"bytes"
+	"context"
+	"errors"
 	"io"
+	"io/ioutil"
 	"net/http"
+	"reflect"
+	"sort"
 	"strings"
 	"testing"
 )
@@ -44,7 +49,7 @@ func TestAPIRequest(t *testing.T) {
 	)
 
 	t.Run("newRequest", func(t *testing.T) {
-		req, err = newRequest("GET", "/foo", nil)
+		req, err = newRequest(nil, "GET", "/foo", nil)
 		if err != nil {
 			t.Fatalf("Unexpected error: %s", err)
 		}
@@ -57,7 +62,7 @@ func TestAPIRequest(t *testing.T) {
 		}
 
 		body = `{"foo":"bar"}`
-		req, err = newRequest("GET", "/foo", strings.NewReader(body))
+		req, err = newRequest(nil, "GET", "/foo", strings.NewReader(body))
 		if err != nil {
 			t.Fatalf("Unexpected error: %s", err)
 		}
@@ -69,14 +74,14 @@ func TestAPIRequest(t *testing.T) {
 			t.Errorf("Unexpected length of req.Body, got=%d, want=%d", req.ContentLength, len(body))
 		}
 
-		req, err = newRequest("GET", "/foo", bytes.NewBuffer([]byte(body)))
+		req, err = newRequest(nil, "GET", "/foo", bytes.NewBuffer([]byte(body)))
 		if err != nil {
 			t.Fatalf("Unexpected error: %s", err)
 		}
 		if _, ok := req.Body.(io.ReadCloser); !ok {
 			t.Errorf("Unexpected type for req.Body: %T", req.Body)
 		}
-		req, err = newRequest("GET", "/foo", bytes.NewReader([]byte(body)))
+		req, err = newRequest(nil, "GET", "/foo", bytes.NewReader([]byte(body)))
 		if err != nil {
 			t.Fatalf("Unexpected error: %s", err)
 		}
@@ -84,4 +89,251 @@ func TestAPIRequest(t *testing.T) {
 			t.Errorf("Unexpected type for req.Body: %T", req.Body)
 		}
 	})
+
+	t.Run("Replayable body", func(t *testing.T) {
+		var opened int
+		body = `{"foo":"bar"}`
+		rb := ReplayableBody(func() (io.ReadCloser, error) {
+			opened++
+			return ioutil.NopCloser(strings.NewReader(body)), nil
+		})
+
+		req, err = newRequest(nil, "PUT", "/foo", rb)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if req.GetBody == nil {
+			t.Fatalf("Expected GetBody to be set")
+		}
+
+		for _, rc := range []func() (io.ReadCloser, error){
+			func() (io.ReadCloser, error) { return req.Body, nil },
+			req.GetBody,
+		} {
+			r, _ := rc()
+			if b, _ := ioutil.ReadAll(r); string(b) != body {
+				t.Errorf("Unexpected body: %s", b)
+			}
+		}
+		if opened != 2 {
+			t.Errorf("Unexpected number of opened bodies: %d", opened)
+		}
+
+		_, err = newRequest(nil, "PUT", "/foo", ReplayableBody(func() (io.ReadCloser, error) {
+			return nil, errors.New("MOCK ERROR")
+		}))
+		if err == nil {
+			t.Errorf("Expected error")
+		}
+	})
+}
+
+func TestAPIRequestIndicesOptions(t *testing.T) {
+	var req *http.Request
+	tp := &mockTransport{RoundTripFunc: func(r *http.Request) (*http.Response, error) {
+		req = r
+		return &http.Response{StatusCode: 200}, nil
+	}}
+
+	stats := newIndicesStatsFunc(tp)
+	if _, err := stats(
+		stats.WithIndex("logs-*"),
+		stats.WithAllowNoIndices(false),
+		stats.WithIgnoreUnavailable(false),
+		stats.WithExpandWildcards("open,hidden"),
+	); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	for k, v := range map[string]string{
+		"allow_no_indices":   "false",
+		"ignore_unavailable": "false",
+		"expand_wildcards":   "open,hidden",
+	} {
+		if got := req.URL.Query().Get(k); got != v {
+			t.Errorf("Unexpected value for %s: want=%q, got=%q", k, v, got)
+		}
+	}
+
+	recovery := newIndicesRecoveryFunc(tp)
+	if _, err := recovery(recovery.WithIgnoreUnavailable(true)); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if req.URL.RawQuery != "ignore_unavailable=true" {
+		t.Errorf("Unexpected query: %s", req.URL.RawQuery)
+	}
+}
+
+func TestAPIRequestQueryOrder(t *testing.T) {
+	requests := map[string]func() Request{
+		"Search": func() Request {
+			return SearchRequest{
+				Index:          []string{"test"},
+				Size:           IntPtr(10),
+				From:           IntPtr(5),
+				Routing:        []string{"foo"},
+				Preference:     "_local",
+				TrackTotalHits: true,
+				Pretty:         true,
+				FilterPath:     []string{"hits.hits._id"},
+			}
+		},
+		"Role get": func() Request {
+			return RoleGetRequest{
+				Role:        "readers",
+				Pretty:      true,
+				Human:       true,
+				ErrorTrace:  true,
+				FilterPath:  []string{"readers"},
+				QueryParams: map[string]string{"zzz": "1", "aaa": "2", "mmm": "3"},
+			}
+		},
+	}
+
+	for name, newReq := range requests {
+		t.Run(name, func(t *testing.T) {
+			var rawQuery string
+			for i := 0; i < 50; i++ {
+				req, err := BuildRequest(context.Background(), newReq())
+				if err != nil {
+					t.Fatalf("Unexpected error: %s", err)
+				}
+
+				if i == 0 {
+					rawQuery = req.URL.RawQuery
+				} else if req.URL.RawQuery != rawQuery {
+					t.Fatalf("Expected identical query strings, got %q and %q", rawQuery, req.URL.RawQuery)
+				}
+			}
+
+			var keys []string
+			for _, kv := range strings.Split(rawQuery, "&") {
+				keys = append(keys, strings.SplitN(kv, "=", 2)[0])
+			}
+			if !sort.StringsAreSorted(keys) {
+				t.Errorf("Expected the query string to be sorted: %q", rawQuery)
+			}
+		})
+	}
+}
+
+func TestAPIRequestDebug(t *testing.T) {
+	var req *http.Request
+	tp := &mockTransport{RoundTripFunc: func(r *http.Request) (*http.Response, error) {
+		req = r
+		return &http.Response{StatusCode: 200, Body: io.NopCloser(strings.NewReader(`{}`))}, nil
+	}}
+	api := New(tp)
+
+	if _, err := api.Cat.Indices(api.Cat.Indices.WithDebug()); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	q := req.URL.Query()
+	if q.Get("pretty") != "true" || q.Get("human") != "true" || q.Get("error_trace") != "true" {
+		t.Errorf("Unexpected query string: %s", req.URL.RawQuery)
+	}
+}
+
+func TestAPIRequestBasicAuth(t *testing.T) {
+	var req *http.Request
+	tp := &mockTransport{RoundTripFunc: func(r *http.Request) (*http.Response, error) {
+		req = r
+		return &http.Response{StatusCode: 200, Body: io.NopCloser(strings.NewReader(`{}`))}, nil
+	}}
+	api := New(tp)
+
+	if _, err := api.Role.GetRole(api.Role.GetRole.WithBasicAuth("alice", "s3cr3t")); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	username, password, ok := req.BasicAuth()
+	if !ok || username != "alice" || password != "s3cr3t" {
+		t.Errorf("Unexpected credentials: %q, %q", username, password)
+	}
+}
+
+func TestAPIRequestContentType(t *testing.T) {
+	var req *http.Request
+	tp := &mockTransport{RoundTripFunc: func(r *http.Request) (*http.Response, error) {
+		req = r
+		return &http.Response{StatusCode: 200, Body: io.NopCloser(strings.NewReader(`{}`))}, nil
+	}}
+	api := New(tp)
+
+	tests := []struct {
+		name string
+		opts []func(*RoleCreateRequest)
+		want []string
+	}{
+		{"Default", nil, []string{"application/json"}},
+		{"Option", []func(*RoleCreateRequest){api.Role.CreateRole.WithContentType("application/yaml")}, []string{"application/yaml"}},
+		{"Header", []func(*RoleCreateRequest){api.Role.CreateRole.WithHeader(map[string]string{"Content-Type": "application/yaml"})}, []string{"application/yaml"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := append([]func(*RoleCreateRequest){api.Role.CreateRole.WithBody(strings.NewReader(`{}`))}, tt.opts...)
+			if _, err := api.Role.CreateRole("test", opts...); err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if ct := req.Header.Values("Content-Type"); !reflect.DeepEqual(ct, tt.want) {
+				t.Errorf("Unexpected Content-Type: want=%v, got=%v", tt.want, ct)
+			}
+		})
+	}
+}
+
+func TestAPIRequestHTTPHeader(t *testing.T) {
+	var req *http.Request
+	tp := &mockTransport{RoundTripFunc: func(r *http.Request) (*http.Response, error) {
+		req = r
+		return &http.Response{StatusCode: 200, Body: io.NopCloser(strings.NewReader(`{}`))}, nil
+	}}
+	api := New(tp)
+
+	h := http.Header{}
+	h.Add("Baggage", "a=1")
+	h.Add("Baggage", "b=2")
+
+	_, err := api.Search(
+		api.Search.WithHTTPHeader(h),
+		api.Search.WithHeader(map[string]string{"X-Foo": "bar"}),
+	)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	if v := req.Header.Values("Baggage"); !reflect.DeepEqual(v, []string{"a=1", "b=2"}) {
+		t.Errorf("Unexpected Baggage header: %v", v)
+	}
+	if v := req.Header.Get("X-Foo"); v != "bar" {
+		t.Errorf("Unexpected X-Foo header: %q", v)
+	}
+	if len(h) != 1 {
+		t.Errorf("Expected the header passed to the option not to be modified, got: %v", h)
+	}
+}
+
+func TestAPIRequestAccept(t *testing.T) {
+	var req *http.Request
+	tp := &mockTransport{RoundTripFunc: func(r *http.Request) (*http.Response, error) {
+		req = r
+		return &http.Response{StatusCode: 200, Body: io.NopCloser(strings.NewReader(`{}`))}, nil
+	}}
+	api := New(tp)
+
+	if _, err := api.Role.GetRole(api.Role.GetRole.WithAccept("application/yaml")); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if v := req.Header.Values("Accept"); !reflect.DeepEqual(v, []string{"application/yaml"}) {
+		t.Errorf("Unexpected Accept header: %v", v)
+	}
+
+	if _, err := api.Cat.Indices(); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if v := req.Header.Get("Accept"); v != "" {
+		t.Errorf("Unexpected Accept header: %q", v)
+	}
 }
@@ -30,6 +30,7 @@
 package opensearchapi
 
 import (
+	"encoding/json"
 	"errors"
 	"io"
 	"io/ioutil"
@@ -178,6 +179,61 @@ func TestAPIResponse(t *testing.T) {
 		}
 	})
 
+	t.Run("Error with redacted body", func(t *testing.T) {
+		res = &Response{
+			StatusCode: 502,
+			Body:       ioutil.NopCloser(strings.NewReader(`{"request":{"current_password":"foo","password":"bar"}}`)),
+		}
+
+		err := res.Err()
+		if err == nil {
+			t.Fatalf("Expected error for response: %s", res.Status())
+		}
+		if err.Error() != `status: 502, error: {"request":{"current_password":"***","password":"***"}}` {
+			t.Errorf("Unexpected error: %s", err)
+		}
+	})
+
+	t.Run("Error sentinels", func(t *testing.T) {
+		tests := []struct {
+			status int
+			body   string
+			want   error
+		}{
+			{401, "", ErrUnauthorized},
+			{403, `{"status":"FORBIDDEN","message":"no permissions"}`, ErrForbidden},
+			{404, `{"error":{"type":"index_not_found_exception","reason":"no such index [test]"},"status":404}`, ErrNotFound},
+			{409, `{"error":{"type":"version_conflict_engine_exception","reason":"version conflict"}}`, ErrConflict},
+		}
+
+		for _, tt := range tests {
+			res := &Response{StatusCode: tt.status}
+			if tt.body != "" {
+				res.Body = io.NopCloser(strings.NewReader(tt.body))
+			}
+
+			err := res.Err()
+			if !errors.Is(err, tt.want) {
+				t.Errorf("Expected %q for status %d, got: %v", tt.want, tt.status, err)
+			}
+			for _, other := range []error{ErrUnauthorized, ErrForbidden, ErrNotFound, ErrConflict} {
+				if other != tt.want && errors.Is(err, other) {
+					t.Errorf("Unexpected match of %q for status %d", other, tt.status)
+				}
+			}
+		}
+
+		res = &Response{StatusCode: 404, Body: io.NopCloser(strings.NewReader(`{"error":{"type":"index_not_found_exception","reason":"no such index [test]"},"status":404}`))}
+		var errTest *Error
+		if err := res.Err(); !errors.As(err, &errTest) || errTest.Err.Reason != "no such index [test]" {
+			t.Errorf("Expected the API error to be kept, got: %v", err)
+		}
+		res = &Response{StatusCode: 500, Body: io.NopCloser(strings.NewReader(`{}`))}
+		if err := res.Err(); errors.Is(err, ErrNotFound) || err.Error() != "status: 500, error: {}" {
+			t.Errorf("Unexpected error: %v", err)
+		}
+	})
+
 	t.Run("Warnings", func(t *testing.T) {
 		hdr := http.Header{}
 		hdr.Add("Warning", "Foo 1")
@@ -193,3 +249,154 @@ func TestAPIResponse(t *testing.T) {
 		}
 	})
 }
+
+func TestStreamObjectEntries(t *testing.T) {
+	newResponse := func(status int, body string) *Response {
+		return &Response{StatusCode: status, Body: ioutil.NopCloser(strings.NewReader(body))}
+	}
+
+	t.Run("Entries", func(t *testing.T) {
+		res := newResponse(200, `{"admin":{"reserved":true},"reader":{"cluster_permissions":["cluster_monitor"]},"empty":{}}`)
+
+		var names, values []string
+		err := StreamObjectEntries(res, func(name string, raw json.RawMessage) error {
+			names = append(names, name)
+			values = append(values, string(raw))
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if strings.Join(names, ",") != "admin,reader,empty" {
+			t.Errorf("Unexpected names: %v", names)
+		}
+		if values[1] != `{"cluster_permissions":["cluster_monitor"]}` || values[2] != `{}` {
+			t.Errorf("Unexpected values: %v", values)
+		}
+	})
+
+	t.Run("Callback error", func(t *testing.T) {
+		stop := errors.New("stop")
+		var calls int
+		err := StreamObjectEntries(newResponse(200, `{"a":1,"b":2}`), func(string, json.RawMessage) error {
+			calls++
+			return stop
+		})
+		if err != stop || calls != 1 {
+			t.Errorf("Unexpected result: %d calls, %v", calls, err)
+		}
+	})
+
+	t.Run("Invalid body", func(t *testing.T) {
+		for _, body := range []string{`[{"a":1}]`, `{"a":1`, `{"a":}`, ``} {
+			if err := StreamObjectEntries(newResponse(200, body), func(string, json.RawMessage) error { return nil }); err == nil {
+				t.Errorf("Expected error for body %q", body)
+			}
+		}
+	})
+
+	t.Run("Error response", func(t *testing.T) {
+		res := newResponse(403, `{"error":{"type":"security_exception","reason":"no permissions"},"status":403}`)
+		if err := StreamObjectEntries(res, func(string, json.RawMessage) error { return nil }); err == nil {
+			t.Errorf("Expected error for the error response")
+		}
+	})
+}
+
+// closeOnceBody panics when closed twice.
+type closeOnceBody struct {
+	io.Reader
+	closed bool
+}
+
+func (b *closeOnceBody) Close() error {
+	if b.closed {
+		panic("body closed twice")
+	}
+	b.closed = true
+	return nil
+}
+
+func TestResponseClose(t *testing.T) {
+	t.Run("Idempotent", func(t *testing.T) {
+		body := &closeOnceBody{Reader: strings.NewReader(`{}`)}
+		res := &Response{StatusCode: 200, Body: body}
+
+		if err := res.Close(); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if err := res.Close(); err != nil {
+			t.Errorf("Unexpected error: %s", err)
+		}
+		if err := res.Body.Close(); err != nil {
+			t.Errorf("Unexpected error: %s", err)
+		}
+		if !body.closed {
+			t.Errorf("Expected the body to be closed")
+		}
+		if _, err := res.Body.Read(make([]byte, 1)); err == nil {
+			t.Errorf("Expected error reading a closed body")
+		}
+	})
+
+	t.Run("After a helper", func(t *testing.T) {
+		res := &Response{StatusCode: 200, Body: &closeOnceBody{Reader: strings.NewReader(`{"errors":false,"items":[]}`)}}
+		if _, err := ParseBulkResponse(res); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		res.Close()
+		res.Body.Close()
+	})
+
+	t.Run("Nil", func(t *testing.T) {
+		var res *Response
+		if err := res.Close(); err != nil {
+			t.Errorf("Unexpected error: %s", err)
+		}
+		if err := (&Response{}).Close(); err != nil {
+			t.Errorf("Unexpected error: %s", err)
+		}
+	})
+}
+
+func TestResponsePrettyBody(t *testing.T) {
+	want := "{\n  \"acknowledged\": true,\n  \"shards\": [\n    1,\n    2\n  ]\n}"
+
+	for name, body := range map[string]string{
+		"Compact": `{"acknowledged":true,"shards":[1,2]}`,
+		"Server":  "{\n    \"acknowledged\" : true,\n    \"shards\" : [ 1, 2 ]\n}\n",
+	} {
+		t.Run(name, func(t *testing.T) {
+			res := &Response{
+				StatusCode: 200,
+				Header:     http.Header{"Content-Type": []string{"application/json; charset=UTF-8"}},
+				Body:       ioutil.NopCloser(strings.NewReader(body)),
+			}
+			b, err := res.PrettyBody()
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if string(b) != want {
+				t.Errorf("Unexpected body:\n%s", b)
+			}
+			if rest, _ := ioutil.ReadAll(res.Body); string(rest) != body {
+				t.Errorf("Expected the original body to be readable again, got: %q", rest)
+			}
+		})
+	}
+
+	t.Run("Text", func(t *testing.T) {
+		body := "green open logs\n"
+		res := &Response{StatusCode: 200, Header: http.Header{"Content-Type": []string{"text/plain; charset=UTF-8"}}, Body: ioutil.NopCloser(strings.NewReader(body))}
+		if b, err := res.PrettyBody(); err != nil || string(b) != body {
+			t.Errorf("Unexpected result: %q, %v", b, err)
+		}
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		res := &Response{StatusCode: 200, Body: ioutil.NopCloser(strings.NewReader(`{"acknowledged":`))}
+		if _, err := res.PrettyBody(); err == nil {
+			t.Errorf("Expected error")
+		}
+	})
+}
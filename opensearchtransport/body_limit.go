@@ -0,0 +1,52 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchtransport
+
+import (
+	"errors"
+	"io"
+	"net/http"
+)
+
+// ErrResponseTooLarge is returned when reading a response body larger than the MaxResponseBodySize.
+var ErrResponseTooLarge = errors.New("response body exceeds the maximum size")
+
+// setResBodyLimit limits the size of the response body, when configured.
+func (c *Client) setResBodyLimit(res *http.Response) {
+	if c.maxResponseBodySize <= 0 || res == nil || res.Body == nil || res.Body == http.NoBody {
+		return
+	}
+	res.Body = &limitedBody{ReadCloser: res.Body, n: c.maxResponseBodySize}
+}
+
+// limitedBody reads up to n bytes of the body, and fails with ErrResponseTooLarge
+// when the body has more bytes, instead of silently truncating it like io.LimitReader.
+type limitedBody struct {
+	io.ReadCloser
+	n int64
+}
+
+func (b *limitedBody) Read(p []byte) (int, error) {
+	if b.n <= 0 {
+		var buf [1]byte
+		n, err := b.ReadCloser.Read(buf[:])
+		if n > 0 {
+			return 0, ErrResponseTooLarge
+		}
+		return 0, err
+	}
+
+	if int64(len(p)) > b.n {
+		p = p[:b.n]
+	}
+	n, err := b.ReadCloser.Read(p)
+	b.n -= int64(n)
+	return n, err
+}
@@ -0,0 +1,125 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+//go:build !integration
+// +build !integration
+
+package opensearchtransport
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestMaxResponseBodySize(t *testing.T) {
+	newTransport := func(body string, max int64) *Client {
+		u, _ := url.Parse("http://foo.bar")
+		tp, _ := New(Config{
+			URLs:                []*url.URL{u},
+			MaxResponseBodySize: max,
+			Transport: &mockTransp{
+				RoundTripFunc: func(req *http.Request) (*http.Response, error) {
+					return &http.Response{StatusCode: 200, Body: ioutil.NopCloser(strings.NewReader(body))}, nil
+				},
+			},
+		})
+		return tp
+	}
+
+	tests := []struct {
+		name string
+		body string
+		max  int64
+		err  error
+	}{
+		{"Unlimited", "0123456789", 0, nil},
+		{"Below the limit", "0123456789", 11, nil},
+		{"At the limit", "0123456789", 10, nil},
+		{"Above the limit", "0123456789", 9, ErrResponseTooLarge},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, _ := http.NewRequest("GET", "/", nil)
+			res, err := newTransport(tt.body, tt.max).Perform(req)
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			defer res.Body.Close()
+
+			b, err := ioutil.ReadAll(res.Body)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("Unexpected error: want=%v, got=%v", tt.err, err)
+			}
+			if tt.err == nil && string(b) != tt.body {
+				t.Errorf("Unexpected body: %q", b)
+			}
+			if tt.err != nil && int64(len(b)) > tt.max {
+				t.Errorf("Expected at most %d bytes to be read, got: %d", tt.max, len(b))
+			}
+		})
+	}
+}
+
+// endlessBody is a response body which never ends, counting the bytes read.
+type endlessBody struct{ n int64 }
+
+func (b *endlessBody) Read(p []byte) (int, error) {
+	for i := range p {
+		p[i] = 'x'
+	}
+	atomic.AddInt64(&b.n, int64(len(p)))
+	return len(p), nil
+}
+
+func (b *endlessBody) Close() error { return nil }
+
+func TestMaxResponseBodySizeRetries(t *testing.T) {
+	var bodies []*endlessBody
+	u, _ := url.Parse("http://foo.bar")
+	tp, _ := New(Config{
+		URLs:                []*url.URL{u},
+		MaxResponseBodySize: 100,
+		MaxRetries:          1,
+		RetryOnRejection:    func(Rejection, int) (time.Duration, bool) { return 0, true },
+		Logger:              &TextLogger{Output: ioutil.Discard, EnableResponseBody: true},
+		Transport: &mockTransp{
+			RoundTripFunc: func(req *http.Request) (*http.Response, error) {
+				body := &endlessBody{}
+				bodies = append(bodies, body)
+				return &http.Response{StatusCode: http.StatusTooManyRequests, Body: body}, nil
+			},
+		},
+	})
+
+	req, _ := http.NewRequest("GET", "/", nil)
+	res, err := tp.Perform(req)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	defer res.Body.Close()
+
+	if _, err := ioutil.ReadAll(res.Body); !errors.Is(err, ErrResponseTooLarge) {
+		t.Errorf("Expected ErrResponseTooLarge, got: %v", err)
+	}
+	if len(bodies) != 2 {
+		t.Fatalf("Expected the request to be retried, got: %d requests", len(bodies))
+	}
+	for i, b := range bodies {
+		if n := atomic.LoadInt64(&b.n); n > 100+512 {
+			t.Errorf("Expected the body %d to be read up to the limit, got: %d bytes", i, n)
+		}
+	}
+}
@@ -0,0 +1,143 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchtransport
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const defaultCircuitBreakerCooldown = 30 * time.Second
+
+// ErrCircuitOpen is returned by Perform, without sending the request, while the circuit breaker is open.
+var ErrCircuitOpen = errors.New("circuit breaker is open")
+
+// CircuitState represents the state of the circuit breaker.
+type CircuitState int
+
+const (
+	// CircuitClosed means the requests are sent; it's the state when the circuit breaker is disabled.
+	CircuitClosed CircuitState = iota
+	// CircuitOpen means the requests fail with ErrCircuitOpen until the cooldown has elapsed.
+	CircuitOpen
+	// CircuitHalfOpen means a single request is sent to test the recovery of the cluster,
+	// closing the circuit when it succeeds, and opening it again when it fails.
+	CircuitHalfOpen
+)
+
+// String returns the name of the state.
+func (s CircuitState) String() string {
+	switch s {
+	case CircuitOpen:
+		return "open"
+	case CircuitHalfOpen:
+		return "half-open"
+	default:
+		return "closed"
+	}
+}
+
+// circuitBreaker counts the consecutive failures of the requests,
+// and opens the circuit when they reach the threshold.
+type circuitBreaker struct {
+	sync.Mutex
+
+	threshold int
+	window    time.Duration
+	cooldown  time.Duration
+
+	state    CircuitState
+	failures int
+	since    time.Time // The first failure when closed, the opening when open.
+	probing  bool
+}
+
+// allow returns ErrCircuitOpen when the request must not be sent.
+func (b *circuitBreaker) allow() error {
+	b.Lock()
+	defer b.Unlock()
+
+	switch b.state {
+	case CircuitOpen:
+		if time.Since(b.since) < b.cooldown {
+			return ErrCircuitOpen
+		}
+		b.state = CircuitHalfOpen
+		b.probing = true
+	case CircuitHalfOpen:
+		if b.probing {
+			return ErrCircuitOpen
+		}
+		b.probing = true
+	}
+	return nil
+}
+
+// record updates the state with the outcome of an allowed request.
+//
+// Only the network errors and the 5xx responses are failures;
+// the requests canceled by the caller are not counted.
+func (b *circuitBreaker) record(res *http.Response, err error) {
+	b.Lock()
+	defer b.Unlock()
+
+	if b.state == CircuitHalfOpen {
+		b.probing = false
+	}
+
+	switch {
+	case err != nil && errors.Is(err, context.Canceled):
+		return
+	case err != nil || (res != nil && res.StatusCode >= 500):
+		b.onFailure(time.Now())
+	default:
+		if b.state != CircuitOpen {
+			b.state = CircuitClosed
+			b.failures = 0
+		}
+	}
+}
+
+func (b *circuitBreaker) onFailure(now time.Time) {
+	switch b.state {
+	case CircuitOpen:
+		return
+	case CircuitHalfOpen:
+		b.state = CircuitOpen
+		b.since = now
+		return
+	}
+
+	if b.failures == 0 || (b.window > 0 && now.Sub(b.since) > b.window) {
+		b.failures = 0
+		b.since = now
+	}
+	b.failures++
+
+	if b.failures >= b.threshold {
+		b.state = CircuitOpen
+		b.failures = 0
+		b.since = now
+	}
+}
+
+// CircuitState returns the state of the circuit breaker, CircuitClosed when it's disabled.
+func (c *Client) CircuitState() CircuitState {
+	if c.breaker == nil {
+		return CircuitClosed
+	}
+
+	c.breaker.Lock()
+	defer c.breaker.Unlock()
+	return c.breaker.state
+}
@@ -0,0 +1,138 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+//go:build !integration
+// +build !integration
+
+package opensearchtransport
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	newTransport := func(status *int, err *error) *Client {
+		u, _ := url.Parse("http://foo.bar")
+		tp, _ := New(Config{
+			URLs:                    []*url.URL{u},
+			DisableRetry:            true,
+			EnableMetrics:           true,
+			CircuitBreakerThreshold: 2,
+			CircuitBreakerCooldown:  10 * time.Millisecond,
+			Transport: &mockTransp{
+				RoundTripFunc: func(req *http.Request) (*http.Response, error) {
+					if *err != nil {
+						return nil, *err
+					}
+					return &http.Response{StatusCode: *status, Body: http.NoBody}, nil
+				},
+			},
+		})
+		return tp
+	}
+
+	perform := func(tp *Client) error {
+		req, _ := http.NewRequest("GET", "/", nil)
+		_, err := tp.Perform(req)
+		return err
+	}
+
+	t.Run("Open and recover", func(t *testing.T) {
+		var (
+			status = 503
+			err    error
+		)
+		tp := newTransport(&status, &err)
+
+		perform(tp)
+		if tp.CircuitState() != CircuitClosed {
+			t.Fatalf("Unexpected state: %s", tp.CircuitState())
+		}
+		perform(tp)
+		if tp.CircuitState() != CircuitOpen {
+			t.Fatalf("Unexpected state: %s", tp.CircuitState())
+		}
+
+		if e := perform(tp); !errors.Is(e, ErrCircuitOpen) {
+			t.Errorf("Expected ErrCircuitOpen, got: %v", e)
+		}
+		if m, _ := tp.Metrics(); m.CircuitState != "open" {
+			t.Errorf("Unexpected circuit state in metrics: %q", m.CircuitState)
+		}
+
+		time.Sleep(20 * time.Millisecond)
+		status = 200
+		if e := perform(tp); e != nil {
+			t.Errorf("Unexpected error: %s", e)
+		}
+		if tp.CircuitState() != CircuitClosed {
+			t.Errorf("Unexpected state: %s", tp.CircuitState())
+		}
+	})
+
+	t.Run("Failed probe", func(t *testing.T) {
+		var (
+			status int
+			err    = fmt.Errorf("Mock network error")
+		)
+		tp := newTransport(&status, &err)
+
+		perform(tp)
+		perform(tp)
+		time.Sleep(20 * time.Millisecond)
+
+		if e := perform(tp); errors.Is(e, ErrCircuitOpen) {
+			t.Fatalf("Expected the probe to be sent")
+		}
+		if tp.CircuitState() != CircuitOpen {
+			t.Errorf("Unexpected state: %s", tp.CircuitState())
+		}
+	})
+
+	t.Run("Client errors and cancellations", func(t *testing.T) {
+		var (
+			status = 404
+			err    error
+		)
+		tp := newTransport(&status, &err)
+
+		perform(tp)
+		perform(tp)
+
+		status, err = 0, context.Canceled
+		perform(tp)
+		perform(tp)
+
+		if tp.CircuitState() != CircuitClosed {
+			t.Errorf("Unexpected state: %s", tp.CircuitState())
+		}
+	})
+
+	t.Run("Window", func(t *testing.T) {
+		b := &circuitBreaker{threshold: 2, window: time.Minute, cooldown: time.Minute}
+		now := time.Now()
+
+		b.onFailure(now.Add(-2 * time.Minute))
+		b.onFailure(now)
+		if b.state != CircuitClosed {
+			t.Errorf("Expected the failures outside the window to be ignored")
+		}
+
+		b.onFailure(now.Add(time.Second))
+		if b.state != CircuitOpen {
+			t.Errorf("Unexpected state: %s", b.state)
+		}
+	})
+}
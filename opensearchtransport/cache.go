@@ -0,0 +1,189 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchtransport
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+var defaultResponseCachePaths = []string{"/_plugins/_security/api/"}
+
+// responseCache caches the responses of GET requests by path, query and Accept header.
+type responseCache struct {
+	sync.Mutex
+
+	ttl     time.Duration
+	paths   []string
+	entries map[string]cacheEntry
+
+	correlationIDHeader string // Not cached, as the ID is set for every request
+}
+
+type cacheEntry struct {
+	path       string
+	status     string
+	statusCode int
+	header     http.Header
+	body       []byte
+	expires    time.Time
+}
+
+func newResponseCache(ttl time.Duration, paths []string, correlationIDHeader string) *responseCache {
+	if len(paths) == 0 {
+		paths = defaultResponseCachePaths
+	}
+	return &responseCache{ttl: ttl, paths: paths, entries: make(map[string]cacheEntry), correlationIDHeader: correlationIDHeader}
+}
+
+// cacheable returns true when the path matches the cached paths.
+func (c *responseCache) cacheable(path string) bool {
+	for _, p := range c.paths {
+		if strings.HasPrefix(path, p) {
+			return true
+		}
+	}
+	return false
+}
+
+// get returns a copy of the cached response for the request.
+//
+// Requests with the "Cache-Control: no-cache" header, or with their own credentials, always miss the cache.
+func (c *responseCache) get(req *http.Request, shared bool) (*http.Response, bool) {
+	if req.Method != http.MethodGet || !c.cacheable(req.URL.Path) || req.Header.Get("Cache-Control") == "no-cache" || !shared {
+		return nil, false
+	}
+
+	key := cacheKey(req.URL, req.Header.Get("Accept"))
+
+	c.Lock()
+	defer c.Unlock()
+
+	e, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	if time.Now().After(e.expires) {
+		delete(c.entries, key)
+		return nil, false
+	}
+
+	return &http.Response{
+		Status:        e.status,
+		StatusCode:    e.statusCode,
+		Header:        e.header.Clone(),
+		Body:          ioutil.NopCloser(bytes.NewReader(e.body)),
+		ContentLength: int64(len(e.body)),
+		Request:       req,
+	}, true
+}
+
+// update stores the successful responses of GET requests made with the credentials of the client,
+// and invalidates the entries for the resource modified by PUT, POST, PATCH and DELETE requests.
+//
+// The URL must be the request URL before it has been resolved against the connection URL,
+// and accept is the Accept header of the request, as used for the lookup.
+func (c *responseCache) update(method string, u *url.URL, accept string, shared bool, res *http.Response) error {
+	if res == nil || !c.cacheable(u.Path) || res.StatusCode > 299 {
+		return nil
+	}
+
+	switch method {
+	case http.MethodGet:
+		// Stored below
+	case http.MethodPut, http.MethodPost, http.MethodPatch, http.MethodDelete:
+		c.invalidate(u.Path)
+		return nil
+	default:
+		return nil
+	}
+
+	if !shared {
+		return nil
+	}
+
+	body, err := ioutil.ReadAll(res.Body)
+	res.Body.Close()
+	if err != nil {
+		// Keep the error, eg. ErrResponseTooLarge, for the reader of the body
+		res.Body = ioutil.NopCloser(io.MultiReader(bytes.NewReader(body), errorReader{err: err}))
+		return err
+	}
+	res.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	header := res.Header.Clone()
+	if c.correlationIDHeader != "" {
+		header.Del(c.correlationIDHeader)
+	}
+
+	c.Lock()
+	c.entries[cacheKey(u, accept)] = cacheEntry{
+		path:       u.Path,
+		status:     res.Status,
+		statusCode: res.StatusCode,
+		header:     header,
+		body:       body,
+		expires:    time.Now().Add(c.ttl),
+	}
+	c.Unlock()
+
+	return nil
+}
+
+// cacheKey returns the key of the entry for the URL and the Accept header,
+// as the format of the response, eg. JSON or YAML, depends on the header.
+func cacheKey(u *url.URL, accept string) string {
+	return u.RequestURI() + "\n" + accept
+}
+
+// invalidate removes the entries for the path, its parents and its children,
+// eg. a change to "/roles/foo" invalidates "/roles" and "/roles/foo".
+func (c *responseCache) invalidate(path string) {
+	path = strings.TrimSuffix(path, "/")
+
+	c.Lock()
+	defer c.Unlock()
+
+	for key, e := range c.entries {
+		p := strings.TrimSuffix(e.path, "/")
+		if p == path || strings.HasPrefix(path, p+"/") || strings.HasPrefix(p, path+"/") {
+			delete(c.entries, key)
+		}
+	}
+}
+
+// sharedCredentials returns true when the request has no credentials of its own, including the ones
+// set from its context, so it is performed with the credentials of the client and its response can be shared.
+//
+// The Authorization header of the global headers is the one of the client.
+func (c *Client) sharedCredentials(req *http.Request) bool {
+	auth, ok := req.Header["Authorization"]
+	if !ok {
+		return true
+	}
+	for k, global := range c.header {
+		if http.CanonicalHeaderKey(k) != "Authorization" || len(global) != len(auth) {
+			continue
+		}
+		for i := range auth {
+			if auth[i] != global[i] {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
@@ -0,0 +1,323 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+//go:build !integration
+// +build !integration
+
+package opensearchtransport
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestResponseCache(t *testing.T) {
+	newClient := func(t *testing.T, ttl time.Duration, calls *[]string) *Client {
+		u, _ := url.Parse("http://localhost:9200/prefix")
+		c, err := New(Config{
+			URLs:             []*url.URL{u},
+			ResponseCacheTTL: ttl,
+			Transport: &mockTransp{RoundTripFunc: func(req *http.Request) (*http.Response, error) {
+				*calls = append(*calls, req.Method+" "+req.URL.RequestURI())
+				if strings.HasSuffix(req.URL.Path, "/missing") {
+					return &http.Response{StatusCode: http.StatusNotFound, Body: ioutil.NopCloser(strings.NewReader(`{}`))}, nil
+				}
+				return &http.Response{StatusCode: http.StatusOK, Header: http.Header{"X-Foo": {"bar"}}, Body: ioutil.NopCloser(strings.NewReader(`{"foo":"bar"}`))}, nil
+			}},
+		})
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		return c
+	}
+
+	perform := func(t *testing.T, c *Client, method, path string, header http.Header) string {
+		req, _ := http.NewRequest(method, path, nil)
+		for k, v := range header {
+			req.Header[k] = v
+		}
+		res, err := c.Perform(req)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		defer res.Body.Close()
+		body, _ := ioutil.ReadAll(res.Body)
+		return string(body)
+	}
+
+	t.Run("Hit", func(t *testing.T) {
+		var calls []string
+		c := newClient(t, time.Minute, &calls)
+
+		for i := 0; i < 3; i++ {
+			if body := perform(t, c, "GET", "/_plugins/_security/api/roles/foo", nil); body != `{"foo":"bar"}` {
+				t.Errorf("Unexpected body: %s", body)
+			}
+		}
+		if len(calls) != 1 {
+			t.Errorf("Expected 1 request, got: %v", calls)
+		}
+		if calls[0] != "GET /prefix/_plugins/_security/api/roles/foo" {
+			t.Errorf("Unexpected request: %s", calls[0])
+		}
+
+		perform(t, c, "GET", "/_plugins/_security/api/roles/foo?pretty=true", nil)
+		if len(calls) != 2 {
+			t.Errorf("Expected the query to be part of the key, got: %v", calls)
+		}
+	})
+
+	t.Run("Not cached", func(t *testing.T) {
+		var calls []string
+		c := newClient(t, time.Minute, &calls)
+
+		perform(t, c, "GET", "/_cluster/health", nil)
+		perform(t, c, "GET", "/_cluster/health", nil)
+		perform(t, c, "GET", "/_plugins/_security/api/roles/missing", nil)
+		perform(t, c, "GET", "/_plugins/_security/api/roles/missing", nil)
+		perform(t, c, "GET", "/_plugins/_security/api/roles/foo", nil)
+		perform(t, c, "GET", "/_plugins/_security/api/roles/foo", http.Header{"Cache-Control": {"no-cache"}})
+
+		if len(calls) != 6 {
+			t.Errorf("Expected 6 requests, got: %v", calls)
+		}
+	})
+
+	t.Run("Accept", func(t *testing.T) {
+		var calls []string
+		c := newClient(t, time.Minute, &calls)
+		yaml := http.Header{"Accept": {"application/yaml"}}
+
+		perform(t, c, "GET", "/_plugins/_security/api/roles/foo", nil)
+		perform(t, c, "GET", "/_plugins/_security/api/roles/foo", yaml)
+		perform(t, c, "GET", "/_plugins/_security/api/roles/foo", yaml)
+		perform(t, c, "GET", "/_plugins/_security/api/roles/foo", nil)
+
+		if len(calls) != 2 {
+			t.Errorf("Expected 1 request for each Accept header, got: %v", calls)
+		}
+
+		perform(t, c, "PUT", "/_plugins/_security/api/roles/foo", nil)
+		perform(t, c, "GET", "/_plugins/_security/api/roles/foo", yaml)
+		if len(calls) != 4 {
+			t.Errorf("Expected the entries to be invalidated, got: %v", calls)
+		}
+	})
+
+	t.Run("Read-only methods", func(t *testing.T) {
+		var calls []string
+		c := newClient(t, time.Minute, &calls)
+
+		perform(t, c, "GET", "/_plugins/_security/api/roles/foo", nil)
+		perform(t, c, "HEAD", "/_plugins/_security/api/roles/foo", nil)
+		perform(t, c, "OPTIONS", "/_plugins/_security/api/roles/foo", nil)
+		perform(t, c, "GET", "/_plugins/_security/api/roles/foo", nil)
+
+		if len(calls) != 3 {
+			t.Errorf("Expected the entry to be kept, got: %v", calls)
+		}
+
+		perform(t, c, "PATCH", "/_plugins/_security/api/roles/foo", nil)
+		perform(t, c, "GET", "/_plugins/_security/api/roles/foo", nil)
+		if len(calls) != 5 {
+			t.Errorf("Expected the entry to be invalidated, got: %v", calls)
+		}
+	})
+
+	t.Run("Expired", func(t *testing.T) {
+		var calls []string
+		c := newClient(t, time.Millisecond, &calls)
+
+		perform(t, c, "GET", "/_plugins/_security/api/roles/foo", nil)
+		time.Sleep(5 * time.Millisecond)
+		perform(t, c, "GET", "/_plugins/_security/api/roles/foo", nil)
+
+		if len(calls) != 2 {
+			t.Errorf("Expected 2 requests, got: %v", calls)
+		}
+	})
+
+	t.Run("Request credentials", func(t *testing.T) {
+		var calls []string
+		c := newClient(t, time.Minute, &calls)
+		auth := http.Header{"Authorization": {"Basic dGVzdDp0ZXN0"}}
+
+		perform(t, c, "GET", "/_plugins/_security/api/roles/foo", nil)
+		perform(t, c, "GET", "/_plugins/_security/api/roles/foo", auth)
+		perform(t, c, "GET", "/_plugins/_security/api/roles/bar", auth)
+		perform(t, c, "GET", "/_plugins/_security/api/roles/bar", nil)
+		perform(t, c, "GET", "/_plugins/_security/api/roles/foo", nil)
+
+		if len(calls) != 4 {
+			t.Errorf("Expected 4 requests, got: %v", calls)
+		}
+
+		perform(t, c, "DELETE", "/_plugins/_security/api/roles/foo", auth)
+		perform(t, c, "GET", "/_plugins/_security/api/roles/foo", nil)
+		if len(calls) != 6 {
+			t.Errorf("Expected the entry to be invalidated, got: %v", calls)
+		}
+	})
+
+	t.Run("Context credentials", func(t *testing.T) {
+		u, _ := url.Parse("http://localhost:9200")
+		c, _ := New(Config{
+			URLs:             []*url.URL{u},
+			Username:         "alice",
+			Password:         "secret",
+			PropagateHeaders: []string{"Authorization"},
+			ResponseCacheTTL: time.Minute,
+			Transport: &mockTransp{RoundTripFunc: func(req *http.Request) (*http.Response, error) {
+				user, _, _ := req.BasicAuth()
+				return &http.Response{StatusCode: http.StatusOK, Body: ioutil.NopCloser(strings.NewReader(`{"user":"` + user + `"}`))}, nil
+			}},
+		})
+
+		performCtx := func(ctx context.Context) string {
+			req, _ := http.NewRequest("GET", "/_plugins/_security/api/account", nil)
+			res, err := c.Perform(req.WithContext(ctx))
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			defer res.Body.Close()
+			body, _ := ioutil.ReadAll(res.Body)
+			return string(body)
+		}
+
+		basic := func(user string) http.Header {
+			req, _ := http.NewRequest("GET", "/", nil)
+			req.SetBasicAuth(user, "secret")
+			return http.Header{"Authorization": req.Header["Authorization"]}
+		}
+
+		alice, bob, carol := context.Background(),
+			ContextWithHeader(context.Background(), basic("bob")),
+			ContextWithPropagatedHeader(context.Background(), basic("carol"))
+
+		for _, tt := range []struct {
+			ctx  context.Context
+			want string
+		}{
+			{alice, `{"user":"alice"}`},
+			{bob, `{"user":"bob"}`},
+			{carol, `{"user":"carol"}`},
+			{bob, `{"user":"bob"}`},
+			{alice, `{"user":"alice"}`},
+		} {
+			if body := performCtx(tt.ctx); body != tt.want {
+				t.Errorf("Unexpected body: %s, want: %s", body, tt.want)
+			}
+		}
+
+		noCache := ContextWithHeader(context.Background(), http.Header{"Cache-Control": {"no-cache"}})
+		var calls int
+		c.transport = &mockTransp{RoundTripFunc: func(req *http.Request) (*http.Response, error) {
+			calls++
+			return &http.Response{StatusCode: http.StatusOK, Body: ioutil.NopCloser(strings.NewReader(`{"user":"alice"}`))}, nil
+		}}
+		performCtx(noCache)
+		if calls != 1 {
+			t.Errorf("Expected the Cache-Control header of the context to bypass the cache, got: %d requests", calls)
+		}
+	})
+
+	t.Run("Cached response", func(t *testing.T) {
+		var calls int
+		u, _ := url.Parse("http://localhost:9200")
+		c, _ := New(Config{
+			URLs:                []*url.URL{u},
+			ResponseCacheTTL:    time.Minute,
+			EnableCorrelationID: true,
+			CorrelationIDFunc:   func() string { return fmt.Sprintf("id-%d", calls) },
+			MaxResponseBodySize: 8,
+			Transport: &mockTransp{RoundTripFunc: func(req *http.Request) (*http.Response, error) {
+				calls++
+				body := `{"a":1}`
+				if strings.HasSuffix(req.URL.Path, "/large") {
+					body = `{"foo":"bar"}`
+				}
+				return &http.Response{StatusCode: http.StatusOK, Header: http.Header{}, Body: ioutil.NopCloser(strings.NewReader(body))}, nil
+			}},
+		})
+
+		for i := 0; i < 2; i++ {
+			req, _ := http.NewRequest("GET", "/_plugins/_security/api/roles/foo", nil)
+			res, err := c.Perform(req)
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			res.Body.Close()
+			if id := res.Header.Get("X-Opaque-Id"); id == "" {
+				t.Errorf("Expected a correlation ID on response %d", i)
+			}
+		}
+		if calls != 1 {
+			t.Fatalf("Expected the response to be cached, got: %d requests", calls)
+		}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		req, _ := http.NewRequest("GET", "/_plugins/_security/api/roles/foo", nil)
+		res, _ := c.Perform(req.WithContext(ctx))
+		cancel()
+		if _, err := ioutil.ReadAll(res.Body); err != context.Canceled {
+			t.Errorf("Expected the cached body to be bound to the context, got: %v", err)
+		}
+
+		for i := 0; i < 2; i++ {
+			req, _ := http.NewRequest("GET", "/_plugins/_security/api/roles/large", nil)
+			res, err := c.Perform(req)
+			if err != nil || res == nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if _, err := ioutil.ReadAll(res.Body); !errors.Is(err, ErrResponseTooLarge) {
+				t.Errorf("Expected ErrResponseTooLarge, got: %v", err)
+			}
+			res.Body.Close()
+		}
+		if calls != 3 {
+			t.Errorf("Expected the oversized response not to be cached, got: %d requests", calls)
+		}
+	})
+
+	t.Run("Invalidate", func(t *testing.T) {
+		var calls []string
+		c := newClient(t, time.Minute, &calls)
+
+		perform(t, c, "GET", "/_plugins/_security/api/roles", nil)
+		perform(t, c, "GET", "/_plugins/_security/api/roles/foo", nil)
+		perform(t, c, "GET", "/_plugins/_security/api/roles/bar", nil)
+		perform(t, c, "PUT", "/_plugins/_security/api/roles/foo", nil)
+		perform(t, c, "GET", "/_plugins/_security/api/roles", nil)
+		perform(t, c, "GET", "/_plugins/_security/api/roles/foo", nil)
+		perform(t, c, "GET", "/_plugins/_security/api/roles/bar", nil)
+
+		if len(calls) != 6 {
+			t.Errorf("Expected 6 requests, got: %v", calls)
+		}
+	})
+
+	t.Run("Disabled", func(t *testing.T) {
+		var calls []string
+		c := newClient(t, 0, &calls)
+
+		perform(t, c, "GET", "/_plugins/_security/api/roles/foo", nil)
+		perform(t, c, "GET", "/_plugins/_security/api/roles/foo", nil)
+
+		if len(calls) != 2 {
+			t.Errorf("Expected 2 requests, got: %v", calls)
+		}
+	})
+}
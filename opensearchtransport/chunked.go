@@ -0,0 +1,54 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchtransport
+
+import (
+	"compress/gzip"
+	"io"
+	"net/http"
+)
+
+// SetChunked sets the request to be sent with the chunked transfer encoding.
+//
+// The body of a chunked request without GetBody, eg. an io.PipeReader, is streamed: it's not
+// buffered by the transport, so its length doesn't have to be known and it doesn't have to fit
+// in memory, but the request is not retried once sent, as the body can't be read again.
+// The bodies with GetBody, eg. set with opensearchapi.ReplayableBody, are still retried.
+func SetChunked(req *http.Request) *http.Request {
+	req.TransferEncoding = []string{"chunked"}
+	req.ContentLength = -1
+	return req
+}
+
+// isChunked returns true when the request is sent with the chunked transfer encoding.
+func isChunked(req *http.Request) bool {
+	for _, te := range req.TransferEncoding {
+		if te == "chunked" {
+			return true
+		}
+	}
+	return false
+}
+
+// gzipStream returns a reader of the body compressed while it's read.
+func gzipStream(body io.ReadCloser) io.ReadCloser {
+	pr, pw := io.Pipe()
+	go func() {
+		defer body.Close()
+
+		zw := gzip.NewWriter(pw)
+		_, err := io.Copy(zw, body)
+		if err == nil {
+			err = zw.Close()
+		}
+		pw.CloseWithError(err)
+	}()
+	return pr
+}
@@ -0,0 +1,221 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+//go:build !integration
+// +build !integration
+
+package opensearchtransport
+
+import (
+	"compress/gzip"
+	"context"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestChunkedRequest(t *testing.T) {
+	t.Run("Streamed", func(t *testing.T) {
+		var (
+			calls int
+			body  string
+		)
+		u, _ := url.Parse("http://foo.bar")
+		tp, _ := New(Config{
+			URLs: []*url.URL{u},
+			Transport: &mockTransp{
+				RoundTripFunc: func(req *http.Request) (*http.Response, error) {
+					calls++
+					if req.GetBody != nil {
+						t.Errorf("Expected the body not to be buffered")
+					}
+					b, _ := ioutil.ReadAll(req.Body)
+					body = string(b)
+					return &http.Response{StatusCode: http.StatusBadGateway, Body: http.NoBody}, nil
+				},
+			},
+		})
+
+		pr, pw := io.Pipe()
+		go func() {
+			pw.Write([]byte("foo\n"))
+			pw.Write([]byte("bar\n"))
+			pw.Close()
+		}()
+
+		req, _ := http.NewRequest("POST", "/_bulk", pr)
+		res, err := tp.Perform(SetChunked(req))
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if res.StatusCode != http.StatusBadGateway || calls != 1 {
+			t.Errorf("Expected the streamed request not to be retried, got: status=%d, calls=%d", res.StatusCode, calls)
+		}
+		if body != "foo\nbar\n" {
+			t.Errorf("Unexpected body: %q", body)
+		}
+	})
+
+	t.Run("Replayable", func(t *testing.T) {
+		var calls int
+		u, _ := url.Parse("http://foo.bar")
+		tp, _ := New(Config{
+			URLs: []*url.URL{u},
+			Transport: &mockTransp{
+				RoundTripFunc: func(req *http.Request) (*http.Response, error) {
+					calls++
+					return &http.Response{StatusCode: http.StatusBadGateway, Body: http.NoBody}, nil
+				},
+			},
+		})
+
+		req, _ := http.NewRequest("POST", "/_bulk", strings.NewReader("foo\n"))
+		tp.Perform(SetChunked(req))
+		if calls != 4 {
+			t.Errorf("Expected the request with GetBody to be retried, got: calls=%d", calls)
+		}
+	})
+
+	t.Run("Compressed", func(t *testing.T) {
+		var (
+			encoding string
+			body     string
+		)
+		u, _ := url.Parse("http://foo.bar")
+		tp, _ := New(Config{
+			URLs:                []*url.URL{u},
+			CompressRequestBody: true,
+			Transport: &mockTransp{
+				RoundTripFunc: func(req *http.Request) (*http.Response, error) {
+					encoding = req.Header.Get("Content-Encoding")
+					zr, err := gzip.NewReader(req.Body)
+					if err != nil {
+						t.Fatalf("Unexpected error: %s", err)
+					}
+					b, _ := ioutil.ReadAll(zr)
+					body = string(b)
+					return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody}, nil
+				},
+			},
+		})
+
+		req, _ := http.NewRequest("POST", "/_bulk", ioutil.NopCloser(strings.NewReader("foo\n")))
+		if _, err := tp.Perform(SetChunked(req)); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if encoding != "gzip" || body != "foo\n" {
+			t.Errorf("Unexpected body: encoding=%q, body=%q", encoding, body)
+		}
+	})
+
+	t.Run("Transfer encoding", func(t *testing.T) {
+		var te []string
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			te = r.TransferEncoding
+			io.Copy(ioutil.Discard, r.Body)
+		}))
+		defer srv.Close()
+
+		u, _ := url.Parse(srv.URL)
+		tp, _ := New(Config{URLs: []*url.URL{u}})
+
+		req, _ := http.NewRequest("POST", "/_bulk", strings.NewReader("foo\n"))
+		res, err := tp.Perform(SetChunked(req))
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		res.Body.Close()
+
+		if len(te) != 1 || te[0] != "chunked" {
+			t.Errorf("Unexpected transfer encoding: %v", te)
+		}
+	})
+}
+
+func TestStreamedRequestBodyClosed(t *testing.T) {
+	u, _ := url.Parse("http://foo.bar")
+	okTransport := &mockTransp{RoundTripFunc: func(req *http.Request) (*http.Response, error) {
+		return &http.Response{StatusCode: http.StatusInternalServerError, Body: http.NoBody}, nil
+	}}
+
+	for _, tt := range []struct {
+		name    string
+		client  func() *Client
+		context func() context.Context
+	}{
+		{
+			"Rate limiter",
+			func() *Client {
+				tp, _ := New(Config{URLs: []*url.URL{u}, RateLimit: 1, Transport: okTransport})
+				return tp
+			},
+			func() context.Context {
+				ctx, cancel := context.WithCancel(context.Background())
+				cancel()
+				return ctx
+			},
+		},
+		{
+			"Circuit open",
+			func() *Client {
+				tp, _ := New(Config{URLs: []*url.URL{u}, CircuitBreakerThreshold: 1, DisableRetry: true, Transport: okTransport})
+				req, _ := http.NewRequest("GET", "/", nil)
+				tp.Perform(req)
+				return tp
+			},
+			context.Background,
+		},
+		{
+			"No connection",
+			func() *Client {
+				tp, _ := New(Config{Transport: okTransport})
+				return tp
+			},
+			context.Background,
+		},
+		{
+			"Signing failure",
+			func() *Client {
+				tp, _ := New(Config{URLs: []*url.URL{u}, Signer: &mockSigner{ReturnError: true}, Transport: okTransport})
+				return tp
+			},
+			context.Background,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			tp := tt.client()
+
+			pr, pw := io.Pipe()
+			written := make(chan error, 1)
+			go func() {
+				_, err := pw.Write([]byte("foo\n"))
+				written <- err
+			}()
+
+			req, _ := http.NewRequestWithContext(tt.context(), "POST", "/_bulk", pr)
+			if _, err := tp.Perform(SetChunked(req)); err == nil {
+				t.Fatalf("Expected error")
+			}
+
+			select {
+			case err := <-written:
+				if err != io.ErrClosedPipe {
+					t.Errorf("Expected io.ErrClosedPipe, got: %v", err)
+				}
+			case <-time.After(time.Second):
+				t.Fatalf("Expected the request body to be closed")
+			}
+		})
+	}
+}
@@ -0,0 +1,157 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchtransport
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+const (
+	masterTimeoutParam         = "master_timeout"
+	clusterManagerTimeoutParam = "cluster_manager_timeout"
+)
+
+// clusterVersion caches the version of the cluster, detected with a request to the root path.
+type clusterVersion struct {
+	sync.Mutex
+	detect sync.Mutex // Serializes the detections, without holding the lock during the request
+
+	known          bool
+	clusterManager bool // The cluster accepts the cluster_manager_timeout parameter
+}
+
+// reset forgets the detected version, eg. after a connection failure, to detect it again.
+func (v *clusterVersion) reset() {
+	v.Lock()
+	v.known = false
+	v.Unlock()
+}
+
+// setReqClusterManagerTimeout sends the cluster manager timeout of the request in the parameter
+// accepted by the cluster, when the version detection is enabled: master_timeout for Elasticsearch
+// and OpenSearch 1.x, cluster_manager_timeout for OpenSearch 2.0 and later. The request is sent
+// unchanged when the version cannot be detected.
+func (c *Client) setReqClusterManagerTimeout(req *http.Request) {
+	if c.clusterVersion == nil {
+		return
+	}
+
+	q := req.URL.Query()
+	if q.Get(masterTimeoutParam) == "" && q.Get(clusterManagerTimeoutParam) == "" {
+		return
+	}
+
+	supported, err := c.detectClusterManager(req)
+	if err != nil {
+		if debugLogger != nil {
+			debugLogger.Logf("Error detecting cluster version: %s\n", err)
+		}
+		return
+	}
+
+	from, to := clusterManagerTimeoutParam, masterTimeoutParam
+	if supported {
+		from, to = masterTimeoutParam, clusterManagerTimeoutParam
+	}
+	if v := q.Get(from); v != "" {
+		if q.Get(to) == "" {
+			q.Set(to, v)
+		}
+		q.Del(from)
+		req.URL.RawQuery = q.Encode()
+	}
+}
+
+// detectClusterManager returns whether the cluster accepts the cluster_manager_timeout parameter,
+// fetching its version on the first call, or after a reset.
+func (c *Client) detectClusterManager(req *http.Request) (bool, error) {
+	c.clusterVersion.detect.Lock()
+	defer c.clusterVersion.detect.Unlock()
+
+	c.clusterVersion.Lock()
+	known, supported := c.clusterVersion.known, c.clusterVersion.clusterManager
+	c.clusterVersion.Unlock()
+	if known {
+		return supported, nil
+	}
+
+	res, err := c.performVersionRequest(req)
+	if err != nil {
+		return false, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return false, fmt.Errorf("unexpected status: %d", res.StatusCode)
+	}
+
+	var info struct {
+		Version struct {
+			Number       string `json:"number"`
+			Distribution string `json:"distribution"`
+		} `json:"version"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&info); err != nil {
+		return false, err
+	}
+
+	major, err := strconv.Atoi(strings.SplitN(info.Version.Number, ".", 2)[0])
+	if err != nil {
+		return false, fmt.Errorf("invalid version %q", info.Version.Number)
+	}
+
+	supported = info.Version.Distribution == "opensearch" && major >= 2
+
+	c.clusterVersion.Lock()
+	c.clusterVersion.known, c.clusterVersion.clusterManager = true, supported
+	c.clusterVersion.Unlock()
+	return supported, nil
+}
+
+// performVersionRequest sends the request for the version to a connection of the pool, with the
+// credentials of req. The request is sent directly: it's part of req, which has already passed
+// the rate limiter and the circuit breaker, and holds the probe of the breaker when half-open.
+func (c *Client) performVersionRequest(req *http.Request) (*http.Response, error) {
+	c.Lock()
+	conn, err := c.pool.Next()
+	c.Unlock()
+	if err != nil {
+		return nil, fmt.Errorf("cannot get connection: %s", err)
+	}
+
+	infoReq, err := http.NewRequest(http.MethodGet, "/", nil)
+	if err != nil {
+		return nil, err
+	}
+	infoReq = infoReq.WithContext(req.Context())
+
+	if auth, ok := req.Header["Authorization"]; ok {
+		infoReq.Header["Authorization"] = auth
+	}
+	c.setReqUserAgent(infoReq)
+	c.setReqGlobalHeader(infoReq)
+	c.setReqURL(conn.URL, infoReq)
+	c.setReqAuth(conn.URL, infoReq)
+	if err := c.signRequest(infoReq); err != nil {
+		return nil, fmt.Errorf("failed to sign request: %s", err)
+	}
+
+	res, err := c.transport.RoundTrip(infoReq)
+	if err != nil {
+		return nil, err
+	}
+	c.setResBodyLimit(res)
+	return res, nil
+}
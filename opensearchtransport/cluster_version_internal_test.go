@@ -0,0 +1,175 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+//go:build !integration
+// +build !integration
+
+package opensearchtransport
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestClusterManagerTimeout(t *testing.T) {
+	newTransport := func(info string, numInfo *int, query *string, fail *bool, detect bool) *Client {
+		u, _ := url.Parse("http://foo.bar")
+		tp, _ := New(Config{
+			URLs:                   []*url.URL{u},
+			DisableRetry:           true,
+			EnableVersionDetection: detect,
+			Transport: &mockTransp{
+				RoundTripFunc: func(req *http.Request) (*http.Response, error) {
+					if req.URL.Path == "/" {
+						*numInfo++
+						return &http.Response{StatusCode: http.StatusOK, Body: ioutil.NopCloser(strings.NewReader(info))}, nil
+					}
+					if *fail {
+						return nil, fmt.Errorf("Mock network error")
+					}
+					*query = req.URL.RawQuery
+					return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody}, nil
+				},
+			},
+		})
+		return tp
+	}
+
+	perform := func(tp *Client, query string) {
+		req, _ := http.NewRequest("PUT", "/test?"+query, nil)
+		tp.Perform(req)
+	}
+
+	tests := []struct {
+		name  string
+		info  string
+		query string
+		want  string
+	}{
+		{"OpenSearch 2", `{"version":{"distribution":"opensearch","number":"2.5.0"}}`, "master_timeout=30s", "cluster_manager_timeout=30s"},
+		{"OpenSearch 2 both", `{"version":{"distribution":"opensearch","number":"2.5.0"}}`, "cluster_manager_timeout=10s&master_timeout=30s", "cluster_manager_timeout=10s"},
+		{"OpenSearch 1", `{"version":{"distribution":"opensearch","number":"1.3.7"}}`, "cluster_manager_timeout=30s", "master_timeout=30s"},
+		{"Elasticsearch", `{"version":{"number":"7.10.2"}}`, "cluster_manager_timeout=30s&pretty=true", "master_timeout=30s&pretty=true"},
+		{"Invalid version", `{"version":{"number":"unknown"}}`, "cluster_manager_timeout=30s", "cluster_manager_timeout=30s"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				numInfo int
+				query   string
+				fail    bool
+			)
+			tp := newTransport(tt.info, &numInfo, &query, &fail, true)
+
+			perform(tp, tt.query)
+			if query != tt.want {
+				t.Errorf("Unexpected query: %s", query)
+			}
+		})
+	}
+
+	t.Run("Cache and reconnect", func(t *testing.T) {
+		var (
+			numInfo int
+			query   string
+			fail    bool
+		)
+		tp := newTransport(`{"version":{"distribution":"opensearch","number":"2.5.0"}}`, &numInfo, &query, &fail, true)
+
+		perform(tp, "pretty=true")
+		if numInfo != 0 {
+			t.Errorf("Expected no detection for requests without a timeout")
+		}
+
+		perform(tp, "master_timeout=30s")
+		perform(tp, "master_timeout=30s")
+		if numInfo != 1 {
+			t.Errorf("Expected the version to be detected once, got: %d", numInfo)
+		}
+
+		fail = true
+		perform(tp, "master_timeout=30s")
+		fail = false
+		perform(tp, "master_timeout=30s")
+		if numInfo != 2 {
+			t.Errorf("Expected the version to be detected again after a failure, got: %d", numInfo)
+		}
+		if query != "cluster_manager_timeout=30s" {
+			t.Errorf("Unexpected query: %s", query)
+		}
+	})
+
+	t.Run("Rate limiter and circuit breaker", func(t *testing.T) {
+		var (
+			numInfo int
+			query   string
+			fail    = true
+		)
+		u, _ := url.Parse("http://foo.bar")
+		tp, _ := New(Config{
+			URLs:                    []*url.URL{u},
+			DisableRetry:            true,
+			EnableVersionDetection:  true,
+			RateLimit:               0.001,
+			RateLimitBurst:          2,
+			CircuitBreakerThreshold: 1,
+			CircuitBreakerCooldown:  time.Millisecond,
+			Transport: &mockTransp{
+				RoundTripFunc: func(req *http.Request) (*http.Response, error) {
+					if req.URL.Path == "/" {
+						numInfo++
+						return &http.Response{StatusCode: http.StatusOK, Body: ioutil.NopCloser(strings.NewReader(`{"version":{"distribution":"opensearch","number":"2.5.0"}}`))}, nil
+					}
+					if fail {
+						return nil, fmt.Errorf("Mock network error")
+					}
+					query = req.URL.RawQuery
+					return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody}, nil
+				},
+			},
+		})
+
+		// Open the circuit breaker, and wait for the half-open state
+		perform(tp, "pretty=true")
+		time.Sleep(5 * time.Millisecond)
+		fail = false
+
+		// The detection uses neither the last token of the rate limiter, nor the probe of the breaker
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		req, _ := http.NewRequestWithContext(ctx, "PUT", "/test?master_timeout=30s", nil)
+		if _, err := tp.Perform(req); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if numInfo != 1 || query != "cluster_manager_timeout=30s" {
+			t.Errorf("Unexpected detection: %d, %s", numInfo, query)
+		}
+	})
+
+	t.Run("Disabled", func(t *testing.T) {
+		var (
+			numInfo int
+			query   string
+			fail    bool
+		)
+		tp := newTransport(`{"version":{"number":"7.10.2"}}`, &numInfo, &query, &fail, false)
+
+		perform(tp, "cluster_manager_timeout=30s")
+		if numInfo != 0 || query != "cluster_manager_timeout=30s" {
+			t.Errorf("Unexpected detection: %d, %s", numInfo, query)
+		}
+	})
+}
@@ -0,0 +1,60 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchtransport
+
+import (
+	"crypto/rand"
+	"fmt"
+	"net/http"
+)
+
+const defaultCorrelationIDHeader = "X-Opaque-Id"
+
+// NewUUID returns a random (version 4) UUID, the default generator of the correlation IDs.
+func NewUUID() string {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		panic(fmt.Sprintf("cannot generate UUID: %s", err))
+	}
+	b[6] = (b[6] & 0x0f) | 0x40 // Version 4
+	b[8] = (b[8] & 0x3f) | 0x80 // Variant RFC 4122
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16])
+}
+
+// setReqCorrelationID sets a generated ID in the correlation header,
+// unless it's already set on the request, and returns the ID of the request.
+func (c *Client) setReqCorrelationID(req *http.Request) string {
+	if c.correlationIDFunc == nil {
+		return ""
+	}
+
+	if id := req.Header.Get(c.correlationIDHeader); id != "" {
+		return id
+	}
+
+	id := c.correlationIDFunc()
+	req.Header.Set(c.correlationIDHeader, id)
+	return id
+}
+
+// setResCorrelationID sets the ID of the request in the correlation header of the response,
+// unless the server has already returned it.
+func (c *Client) setResCorrelationID(res *http.Response, id string) {
+	if res == nil || id == "" {
+		return
+	}
+
+	if res.Header == nil {
+		res.Header = make(http.Header)
+	}
+	if res.Header.Get(c.correlationIDHeader) == "" {
+		res.Header.Set(c.correlationIDHeader, id)
+	}
+}
@@ -0,0 +1,131 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+//go:build !integration
+// +build !integration
+
+package opensearchtransport
+
+import (
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestCorrelationID(t *testing.T) {
+	newClient := func(t *testing.T, cfg Config, reqID *string) *Client {
+		cfg.URLs = []*url.URL{{Scheme: "http", Host: "foo"}}
+		cfg.Transport = &mockTransp{RoundTripFunc: func(req *http.Request) (*http.Response, error) {
+			*reqID = req.Header.Get("X-Opaque-Id") + req.Header.Get("X-Request-Id")
+			return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody}, nil
+		}}
+		c, err := New(cfg)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		return c
+	}
+
+	t.Run("Default", func(t *testing.T) {
+		var reqID string
+		c := newClient(t, Config{EnableCorrelationID: true}, &reqID)
+
+		req, _ := http.NewRequest("GET", "/", nil)
+		res, err := c.Perform(req)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+
+		if !regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`).MatchString(reqID) {
+			t.Errorf("Unexpected ID: %q", reqID)
+		}
+		if id := res.Header.Get("X-Opaque-Id"); id != reqID {
+			t.Errorf("Expected the response to carry the ID %q, got: %q", reqID, id)
+		}
+	})
+
+	t.Run("Custom header and generator", func(t *testing.T) {
+		var reqID string
+		c := newClient(t, Config{
+			EnableCorrelationID: true,
+			CorrelationIDHeader: "X-Request-Id",
+			CorrelationIDFunc:   func() string { return "abc" },
+		}, &reqID)
+
+		req, _ := http.NewRequest("GET", "/", nil)
+		res, err := c.Perform(req)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+
+		if reqID != "abc" || res.Header.Get("X-Request-Id") != "abc" {
+			t.Errorf("Unexpected ID: request=%q, response=%q", reqID, res.Header.Get("X-Request-Id"))
+		}
+	})
+
+	t.Run("Set by the caller", func(t *testing.T) {
+		var reqID string
+		c := newClient(t, Config{EnableCorrelationID: true}, &reqID)
+
+		req, _ := http.NewRequest("GET", "/", nil)
+		req.Header.Set("X-Opaque-Id", "foo")
+		res, err := c.Perform(req)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+
+		if reqID != "foo" || res.Header.Get("X-Opaque-Id") != "foo" {
+			t.Errorf("Unexpected ID: request=%q, response=%q", reqID, res.Header.Get("X-Opaque-Id"))
+		}
+	})
+
+	t.Run("Cached responses", func(t *testing.T) {
+		var (
+			reqID string
+			n     int
+		)
+		c := newClient(t, Config{
+			EnableCorrelationID: true,
+			CorrelationIDFunc:   func() string { n++; return strconv.Itoa(n) },
+			ResponseCacheTTL:    time.Minute,
+		}, &reqID)
+
+		for _, want := range []string{"1", "2"} {
+			req, _ := http.NewRequest("GET", "/_plugins/_security/api/roles", nil)
+			res, err := c.Perform(req)
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+
+			if id := res.Header.Get("X-Opaque-Id"); id != want {
+				t.Errorf("Expected the response to carry the ID %q, got: %q", want, id)
+			}
+		}
+		if reqID != "1" {
+			t.Errorf("Expected the second response from the cache, got request ID: %q", reqID)
+		}
+	})
+
+	t.Run("Disabled", func(t *testing.T) {
+		var reqID string
+		c := newClient(t, Config{}, &reqID)
+
+		req, _ := http.NewRequest("GET", "/", nil)
+		if _, err := c.Perform(req); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+
+		if reqID != "" {
+			t.Errorf("Unexpected ID: %q", reqID)
+		}
+	})
+}
@@ -70,6 +70,10 @@ func (c *Client) DiscoverNodes() error {
 		return fmt.Errorf("discovery: get nodes: %s", err)
 	}
 
+	if c.clusterVersion != nil {
+		c.clusterVersion.reset()
+	}
+
 	for _, node := range nodes {
 		var (
 			isClusterManagerOnlyNode bool
@@ -33,7 +33,7 @@ and is not intended for direct use: to configure the client, use the opensearch.
 The default HTTP transport of the client is http.Transport; use the Transport option to customize it;
 
 The package will automatically retry requests on network-related errors, and on specific
-response status codes (by default 502, 503, 504). Use the RetryOnStatus option to customize the list.
+response status codes (by default 502, 503, 504, 429). Use the RetryOnStatus option to customize the list.
 The transport will not retry a timeout network error, unless enabled by setting EnableRetryOnTimeout to true.
 
 Use the MaxRetries option to configure the number of retries, and set DisableRetry to true
@@ -42,6 +42,10 @@ to disable the retry behaviour altogether.
 By default, the retry will be performed without any delay; to configure a backoff interval,
 implement the RetryBackoff option function; see an example in the package unit tests for information.
 
+The 429 Too Many Requests responses are retried with an exponential backoff when a thread pool
+of the server rejected the request, and not retried when a circuit breaker tripped;
+implement the RetryOnRejection option function to customize the behaviour.
+
 When multiple addresses are passed in configuration, the package will use them in a round-robin fashion,
 and will keep track of live and dead nodes. The status of dead nodes is checked periodically.
 
@@ -49,6 +53,11 @@ To customize the node selection behaviour, provide a Selector implementation in
 To replace the connection pool entirely, provide a custom ConnectionPool implementation via
 the ConnectionPoolFunc option.
 
+For the proxies and gateways which block PUT or DELETE requests, list the methods in the MethodOverride
+option to send them as POST, with the original method in the X-HTTP-Method-Override header; the server,
+or the gateway, must support the header. A single request can opt in by setting the header to its method,
+eg. with the WithMethodOverride option of the security APIs.
+
 The package defines the Logger interface for logging information about request and response.
 It comes with several bundled loggers for logging in text and JSON.
 
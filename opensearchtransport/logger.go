@@ -67,6 +67,7 @@ type TextLogger struct {
 	Output             io.Writer
 	EnableRequestBody  bool
 	EnableResponseBody bool
+	RedactedKeys       []string // Keys of the JSON values redacted in the bodies. Default: DefaultRedactedKeys().
 }
 
 // ColorLogger prints the log message in a terminal-optimized plain text.
@@ -75,6 +76,7 @@ type ColorLogger struct {
 	Output             io.Writer
 	EnableRequestBody  bool
 	EnableResponseBody bool
+	RedactedKeys       []string // Keys of the JSON values redacted in the bodies. Default: DefaultRedactedKeys().
 }
 
 // CurlLogger prints the log message as a runnable curl command.
@@ -83,6 +85,7 @@ type CurlLogger struct {
 	Output             io.Writer
 	EnableRequestBody  bool
 	EnableResponseBody bool
+	RedactedKeys       []string // Keys of the JSON values redacted in the bodies. Default: DefaultRedactedKeys().
 }
 
 // JSONLogger prints the log message as JSON.
@@ -91,6 +94,7 @@ type JSONLogger struct {
 	Output             io.Writer
 	EnableRequestBody  bool
 	EnableResponseBody bool
+	RedactedKeys       []string // Keys of the JSON values redacted in the bodies. Default: DefaultRedactedKeys().
 }
 
 // debuggingLogger prints debug messages as plain text.
@@ -117,13 +121,13 @@ func (l *TextLogger) LogRoundTrip(req *http.Request, res *http.Response, err err
 		} else {
 			buf.ReadFrom(req.Body)
 		}
-		logBodyAsText(l.Output, &buf, ">")
+		logBodyAsText(l.Output, bytes.NewReader(RedactBody(buf.Bytes(), l.RedactedKeys)), ">")
 	}
 	if l.ResponseBodyEnabled() && res != nil && res.Body != nil && res.Body != http.NoBody {
 		defer res.Body.Close()
 		var buf bytes.Buffer
 		buf.ReadFrom(res.Body)
-		logBodyAsText(l.Output, &buf, "<")
+		logBodyAsText(l.Output, bytes.NewReader(RedactBody(buf.Bytes(), l.RedactedKeys)), "<")
 	}
 	if err != nil {
 		fmt.Fprintf(l.Output, "! ERROR: %v\n", err)
@@ -183,7 +187,7 @@ func (l *ColorLogger) LogRoundTrip(req *http.Request, res *http.Response, err er
 			buf.ReadFrom(req.Body)
 		}
 		fmt.Fprint(l.Output, "\x1b[2m")
-		logBodyAsText(l.Output, &buf, "       »")
+		logBodyAsText(l.Output, bytes.NewReader(RedactBody(buf.Bytes(), l.RedactedKeys)), "       »")
 		fmt.Fprint(l.Output, "\x1b[0m")
 	}
 
@@ -192,7 +196,7 @@ func (l *ColorLogger) LogRoundTrip(req *http.Request, res *http.Response, err er
 		var buf bytes.Buffer
 		buf.ReadFrom(res.Body)
 		fmt.Fprint(l.Output, "\x1b[2m")
-		logBodyAsText(l.Output, &buf, "       «")
+		logBodyAsText(l.Output, bytes.NewReader(RedactBody(buf.Bytes(), l.RedactedKeys)), "       «")
 		fmt.Fprint(l.Output, "\x1b[0m")
 	}
 
@@ -271,7 +275,7 @@ func (l *CurlLogger) LogRoundTrip(req *http.Request, res *http.Response, err err
 
 		b.Grow(buf.Len())
 		b.WriteString(" -d \\\n'")
-		json.Indent(&b, buf.Bytes(), "", " ")
+		json.Indent(&b, RedactBody(buf.Bytes(), l.RedactedKeys), "", " ")
 		b.WriteString("'")
 	}
 
@@ -287,7 +291,7 @@ func (l *CurlLogger) LogRoundTrip(req *http.Request, res *http.Response, err err
 
 		b.Grow(buf.Len())
 		b.WriteString("# ")
-		json.Indent(&b, buf.Bytes(), "# ", " ")
+		json.Indent(&b, RedactBody(buf.Bytes(), l.RedactedKeys), "# ", " ")
 	}
 
 	b.WriteString("\n")
@@ -377,7 +381,7 @@ func (l *JSONLogger) LogRoundTrip(req *http.Request, res *http.Response, err err
 
 		b.Grow(buf.Len() + 8)
 		b.WriteString(`,"body":`)
-		appendQuote(buf.String())
+		appendQuote(string(RedactBody(buf.Bytes(), l.RedactedKeys)))
 	}
 	b.WriteRune('}') // Close "http.request"
 	// ---- Response
@@ -391,7 +395,7 @@ func (l *JSONLogger) LogRoundTrip(req *http.Request, res *http.Response, err err
 
 		b.Grow(buf.Len() + 8)
 		b.WriteString(`,"body":`)
-		appendQuote(buf.String())
+		appendQuote(string(RedactBody(buf.Bytes(), l.RedactedKeys)))
 	}
 	b.WriteRune('}') // Close "http.response"
 	b.WriteRune('}') // Close "http"
@@ -370,6 +370,47 @@ func TestTransportLogger(t *testing.T) {
 		}
 	})
 
+	t.Run("Redacted", func(t *testing.T) {
+		loggers := map[string]func(io.Writer) Logger{
+			"Text":  func(w io.Writer) Logger { return &TextLogger{Output: w, EnableRequestBody: true, EnableResponseBody: true} },
+			"Color": func(w io.Writer) Logger { return &ColorLogger{Output: w, EnableRequestBody: true, EnableResponseBody: true} },
+			"Curl":  func(w io.Writer) Logger { return &CurlLogger{Output: w, EnableRequestBody: true, EnableResponseBody: true} },
+			"JSON":  func(w io.Writer) Logger { return &JSONLogger{Output: w, EnableRequestBody: true, EnableResponseBody: true} },
+		}
+
+		for name, newLogger := range loggers {
+			t.Run(name, func(t *testing.T) {
+				var dst strings.Builder
+
+				tp, _ := New(Config{
+					URLs:      []*url.URL{{Scheme: "http", Host: "foo"}},
+					Username:  "admin",
+					Password:  "s3cr3t",
+					Transport: newRoundTripper(),
+					Logger:    newLogger(&dst),
+				})
+
+				req, _ := http.NewRequest("PUT", "/_plugins/_security/api/internalusers/foo", nil)
+				req.Body = ioutil.NopCloser(strings.NewReader(`{"password" : "p4ss\"w0rd","backend_roles":["admin"]}`))
+
+				if _, err := tp.Perform(req); err != nil {
+					t.Fatalf("Unexpected error: %s", err)
+				}
+
+				output := dst.String()
+				if strings.Contains(output, "p4ss") || strings.Contains(output, "w0rd") {
+					t.Errorf("Expected the password to be redacted: %s", output)
+				}
+				if strings.Contains(output, "Authorization") || strings.Contains(output, "YWRtaW46czNjcjN0") {
+					t.Errorf("Expected the Authorization header to be omitted: %s", output)
+				}
+				if !strings.Contains(output, "backend_roles") {
+					t.Errorf("Expected the other fields to be logged: %s", output)
+				}
+			})
+		}
+	})
+
 	t.Run("Custom", func(t *testing.T) {
 		var dst strings.Builder
 
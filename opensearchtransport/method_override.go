@@ -0,0 +1,40 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchtransport
+
+import (
+	"net/http"
+	"strings"
+)
+
+// MethodOverrideHeader is the header carrying the method of the requests tunneled through POST.
+const MethodOverrideHeader = "X-HTTP-Method-Override"
+
+// setReqMethodOverride sends the request as POST, with its method in the MethodOverrideHeader,
+// when its method is in the MethodOverride configuration, or when the request sets the header
+// to its method, eg. with the WithMethodOverride option of the API.
+func (c *Client) setReqMethodOverride(req *http.Request) *http.Request {
+	if req.Method == http.MethodPost || req.Method == http.MethodGet || req.Method == http.MethodHead {
+		return req
+	}
+
+	override := strings.EqualFold(req.Header.Get(MethodOverrideHeader), req.Method)
+	for _, m := range c.methodOverride {
+		if strings.EqualFold(m, req.Method) {
+			override = true
+		}
+	}
+
+	if override {
+		req.Header.Set(MethodOverrideHeader, strings.ToUpper(req.Method))
+		req.Method = http.MethodPost
+	}
+	return req
+}
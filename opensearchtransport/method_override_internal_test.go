@@ -0,0 +1,76 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+//go:build !integration
+// +build !integration
+
+package opensearchtransport
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+)
+
+func TestMethodOverride(t *testing.T) {
+	newTransport := func(methods []string, req **http.Request) *Client {
+		u, _ := url.Parse("http://foo.bar")
+		tp, _ := New(Config{
+			URLs:           []*url.URL{u},
+			MethodOverride: methods,
+			Transport: &mockTransp{
+				RoundTripFunc: func(r *http.Request) (*http.Response, error) {
+					*req = r
+					return &http.Response{StatusCode: 200, Body: http.NoBody}, nil
+				},
+			},
+		})
+		return tp
+	}
+
+	tests := []struct {
+		name       string
+		methods    []string
+		method     string
+		header     string
+		wantMethod string
+		wantHeader string
+	}{
+		{"Disabled", nil, "PUT", "", "PUT", ""},
+		{"Client", []string{"put"}, "PUT", "", "POST", "PUT"},
+		{"Client other method", []string{"PUT"}, "DELETE", "", "DELETE", ""},
+		{"Request", nil, "PUT", "PUT", "POST", "PUT"},
+		{"Client DELETE", []string{"PUT", "DELETE"}, "DELETE", "", "POST", "DELETE"},
+		{"Request DELETE", nil, "DELETE", "DELETE", "POST", "DELETE"},
+		{"Request mismatch", nil, "DELETE", "PUT", "DELETE", "PUT"},
+		{"GET", []string{"GET"}, "GET", "", "GET", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var req *http.Request
+			tp := newTransport(tt.methods, &req)
+
+			r, _ := http.NewRequest(tt.method, "/_plugins/_security/api/roles/test", nil)
+			if tt.header != "" {
+				r.Header.Set(MethodOverrideHeader, tt.header)
+			}
+			if _, err := tp.Perform(r); err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+
+			if req.Method != tt.wantMethod {
+				t.Errorf("Unexpected method: want=%s, got=%s", tt.wantMethod, req.Method)
+			}
+			if v := req.Header.Get(MethodOverrideHeader); v != tt.wantHeader {
+				t.Errorf("Unexpected %s header: want=%q, got=%q", MethodOverrideHeader, tt.wantHeader, v)
+			}
+		})
+	}
+}
@@ -55,6 +55,8 @@ type Metrics struct {
 	Responses map[int]int `json:"responses"`
 
 	Connections []fmt.Stringer `json:"connections"`
+
+	CircuitState string `json:"circuit_state,omitempty"`
 }
 
 // ConnectionMetric represents metric information for a connection.
@@ -93,18 +95,31 @@ func (c *Client) Metrics() (Metrics, error) {
 	c.metrics.RLock()
 	defer c.metrics.RUnlock()
 
-	if lockable, ok := c.pool.(sync.Locker); ok {
+	// The pool is replaced by the node discovery
+	c.Lock()
+	pool := c.pool
+	c.Unlock()
+
+	if lockable, ok := pool.(sync.Locker); ok {
 		lockable.Lock()
 		defer lockable.Unlock()
 	}
 
+	// The responses are copied, as they keep being updated by the requests
 	m := Metrics{
 		Requests:  c.metrics.requests,
 		Failures:  c.metrics.failures,
-		Responses: c.metrics.responses,
+		Responses: make(map[int]int, len(c.metrics.responses)),
+	}
+	for status, n := range c.metrics.responses {
+		m.Responses[status] = n
 	}
 
-	if pool, ok := c.pool.(connectionable); ok {
+	if c.breaker != nil {
+		m.CircuitState = c.CircuitState().String()
+	}
+
+	if pool, ok := pool.(connectionable); ok {
 		for _, c := range pool.connections() {
 			c.Lock()
 
@@ -179,6 +194,11 @@ func (m Metrics) String() string {
 	}
 	b.WriteString("]")
 
+	if m.CircuitState != "" {
+		b.WriteString(" CircuitState:")
+		b.WriteString(m.CircuitState)
+	}
+
 	b.WriteString("}")
 	return b.String()
 }
@@ -29,6 +29,8 @@ package opensearchtransport
 import (
 	"bytes"
 	"compress/gzip"
+	"context"
+	"crypto/tls"
 	"crypto/x509"
 	"errors"
 	"fmt"
@@ -45,6 +47,8 @@ import (
 	"sync"
 	"time"
 
+	"golang.org/x/time/rate"
+
 	"github.com/alphastrikelabs/opensearch-go/v2/signer"
 
 	"github.com/alphastrikelabs/opensearch-go/v2/internal/version"
@@ -64,7 +68,7 @@ var (
 	reGoVersion         = regexp.MustCompile(`go(\d+\.\d+\..+)`)
 
 	defaultMaxRetries    = 3
-	defaultRetryOnStatus = [...]int{502, 503, 504}
+	defaultRetryOnStatus = [...]int{502, 503, 504, 429}
 )
 
 func init() {
@@ -85,9 +89,47 @@ type Config struct {
 	Username string
 	Password string
 
-	Header http.Header
+	Header http.Header // Global headers, added to the requests which don't set them
 	CACert []byte
 
+	// ClientCert and ClientKey are the PEM-encoded certificate and key used for mutual TLS,
+	// InsecureSkipVerify disables the verification of the server certificate. The transport
+	// must be an *http.Transport; it is cloned, so the original transport is not modified.
+	ClientCert         []byte
+	ClientKey          []byte
+	InsecureSkipVerify bool
+
+	// MaxIdleConnsPerHost, IdleConnTimeout, ForceHTTP2, DialTimeout and TLSHandshakeTimeout configure
+	// the connections of the transport, which must be an *http.Transport; it is cloned, so the original
+	// transport is not modified. The dial and TLS handshake timeouts are independent of the request context.
+	// The dial timeout is applied to the DialContext of the transport, when set, eg. a proxy dialer.
+	MaxIdleConnsPerHost int
+	IdleConnTimeout     time.Duration
+	ForceHTTP2          bool
+	DialTimeout         time.Duration
+	TLSHandshakeTimeout time.Duration
+
+	// MethodOverride lists the methods, eg. PUT, of the requests to send as POST, with the method
+	// in the MethodOverrideHeader, for the proxies and gateways which block them. A request can
+	// opt in by setting the header to its method, eg. with the WithMethodOverride option of the API.
+	MethodOverride []string
+
+	// PropagateHeaders lists the headers to copy from the request context
+	// to the outgoing requests, see ContextWithPropagatedHeader and TraceHeaders.
+	PropagateHeaders []string
+	// InjectHeaders sets headers from the request context, eg. the trace headers of the span
+	// of the context, with the propagator of a tracing library; see TraceHeaders. They take
+	// precedence over the PropagateHeaders, but not over the headers set on the request.
+	InjectHeaders func(ctx context.Context, header http.Header)
+
+	// EnableCorrelationID sets a generated ID in the CorrelationIDHeader of the requests
+	// which don't have it, eg. to trace them in the audit log. The ID is set on the response as well.
+	EnableCorrelationID bool
+	// CorrelationIDHeader sets the name of the correlation header. Default: "X-Opaque-Id".
+	CorrelationIDHeader string
+	// CorrelationIDFunc generates the correlation IDs. Default: NewUUID.
+	CorrelationIDFunc func() string
+
 	Signer signer.Signer
 
 	RetryOnStatus        []int
@@ -96,11 +138,65 @@ type Config struct {
 	MaxRetries           int
 	RetryBackoff         func(attempt int) time.Duration
 
+	// RetryOnRejection decides whether to retry a 429 Too Many Requests response,
+	// when 429 is in RetryOnStatus, and returns the delay before the retry, which
+	// replaces RetryBackoff; attempt starts at 1. Default: DefaultRetryOnRejection.
+	RetryOnRejection func(rejection Rejection, attempt int) (backoff time.Duration, retry bool)
+
+	// RetryOnlyUnprocessed restricts retries of non-idempotent requests, eg. POST,
+	// after a network error to the errors where the request has definitely not
+	// been processed by the server, see RetryErrorNotProcessed.
+	RetryOnlyUnprocessed bool
+
+	// CircuitBreakerThreshold enables the circuit breaker, which opens after the number of consecutive
+	// failed requests, ie. with a network error or a 5xx response, within CircuitBreakerWindow, if set.
+	// While open, the requests fail with ErrCircuitOpen; after CircuitBreakerCooldown, default 30s,
+	// a single request is sent to test the recovery of the cluster. See Client.CircuitState.
+	CircuitBreakerThreshold int
+	CircuitBreakerWindow    time.Duration
+	CircuitBreakerCooldown  time.Duration
+
+	// RateLimit limits the requests of the client to the number per second, with bursts
+	// of up to RateLimitBurst requests, default 1; Perform blocks until the request is allowed
+	// or its context is done. The retries are not limited.
+	RateLimit      float64
+	RateLimitBurst int
+
+	// RequestTimeout sets a timeout for the requests which context has no deadline,
+	// including the retries. The timeout set by the caller is never shortened.
+	RequestTimeout time.Duration
+
+	// ResponseCacheTTL enables caching of the successful responses of GET requests
+	// to ResponseCachePaths for the duration. The cached entries are invalidated
+	// by the successful requests with other methods to the same resource.
+	// The cache is bypassed by requests with the "Cache-Control: no-cache" header,
+	// and by requests with their own Authorization header, eg. set with WithBasicAuth,
+	// ContextWithHeader or ContextWithPropagatedHeader.
+	ResponseCacheTTL time.Duration
+	// ResponseCachePaths sets the path prefixes of the cached requests.
+	// Default: "/_plugins/_security/api/".
+	ResponseCachePaths []string
+
 	CompressRequestBody bool
 
+	// MaxResponseBodySize limits the size of the response bodies, in bytes: reading a larger body
+	// fails with ErrResponseTooLarge once the limit is reached, bounding the memory used to decode it.
+	MaxResponseBodySize int64
+
+	// EnableVersionDetection detects the version of the cluster, with a request to the root path
+	// before the first request with a cluster manager timeout, to send the timeout in the parameter
+	// accepted by the cluster: master_timeout for Elasticsearch and OpenSearch 1.x, cluster_manager_timeout
+	// for OpenSearch 2.0 and later. The version is detected again after a connection failure or a discovery.
+	EnableVersionDetection bool
+
 	EnableMetrics     bool
 	EnableDebugLogger bool
 
+	// SlowRequestThreshold enables logging of requests which take longer than the threshold.
+	// The requests are logged to SlowRequestLogger, or to os.Stderr when it's not set.
+	SlowRequestThreshold time.Duration
+	SlowRequestLogger    DebuggingLogger
+
 	DiscoverNodesInterval time.Duration
 
 	Transport http.RoundTripper
@@ -119,17 +215,38 @@ type Client struct {
 	password string
 	header   http.Header
 
+	propagateHeaders []string
+	injectHeaders    func(context.Context, http.Header)
+	methodOverride   []string
+
+	correlationIDHeader string
+	correlationIDFunc   func() string
+
 	signer signer.Signer
 
 	retryOnStatus         []int
 	disableRetry          bool
 	enableRetryOnTimeout  bool
+	retryOnlyUnprocessed  bool
 	maxRetries            int
 	retryBackoff          func(attempt int) time.Duration
+	retryOnRejection      func(rejection Rejection, attempt int) (time.Duration, bool)
 	discoverNodesInterval time.Duration
 	discoverNodesTimer    *time.Timer
 
+	requestTimeout time.Duration
+
+	responseCache *responseCache
+	breaker       *circuitBreaker
+	limiter       *rate.Limiter
+
 	compressRequestBody bool
+	maxResponseBodySize int64
+
+	clusterVersion *clusterVersion
+
+	slowRequestThreshold time.Duration
+	slowRequestLogger    DebuggingLogger
 
 	metrics *metrics
 
@@ -164,6 +281,57 @@ func New(cfg Config) (*Client, error) {
 		cfg.Transport = httpTransport
 	}
 
+	if cfg.ClientCert != nil || cfg.ClientKey != nil || cfg.InsecureSkipVerify {
+		httpTransport, ok := cfg.Transport.(*http.Transport)
+		if !ok {
+			return nil, fmt.Errorf("unable to configure TLS for transport of type %T", cfg.Transport)
+		}
+
+		httpTransport = httpTransport.Clone()
+		if httpTransport.TLSClientConfig == nil {
+			httpTransport.TLSClientConfig = &tls.Config{}
+		}
+		if cfg.ClientCert != nil || cfg.ClientKey != nil {
+			cert, err := tls.X509KeyPair(cfg.ClientCert, cfg.ClientKey)
+			if err != nil {
+				return nil, fmt.Errorf("unable to load client certificate: %s", err)
+			}
+			httpTransport.TLSClientConfig.Certificates = []tls.Certificate{cert}
+		}
+		if cfg.InsecureSkipVerify {
+			httpTransport.TLSClientConfig.InsecureSkipVerify = true
+		}
+
+		cfg.Transport = httpTransport
+	}
+
+	if cfg.MaxIdleConnsPerHost != 0 || cfg.IdleConnTimeout != 0 || cfg.ForceHTTP2 ||
+		cfg.DialTimeout != 0 || cfg.TLSHandshakeTimeout != 0 {
+		httpTransport, ok := cfg.Transport.(*http.Transport)
+		if !ok {
+			return nil, fmt.Errorf("unable to configure connections for transport of type %T", cfg.Transport)
+		}
+
+		httpTransport = httpTransport.Clone()
+		if cfg.MaxIdleConnsPerHost != 0 {
+			httpTransport.MaxIdleConnsPerHost = cfg.MaxIdleConnsPerHost
+		}
+		if cfg.IdleConnTimeout != 0 {
+			httpTransport.IdleConnTimeout = cfg.IdleConnTimeout
+		}
+		if cfg.ForceHTTP2 {
+			httpTransport.ForceAttemptHTTP2 = true
+		}
+		if cfg.DialTimeout != 0 {
+			httpTransport.DialContext = dialWithTimeout(httpTransport.DialContext, cfg.DialTimeout)
+		}
+		if cfg.TLSHandshakeTimeout != 0 {
+			httpTransport.TLSHandshakeTimeout = cfg.TLSHandshakeTimeout
+		}
+
+		cfg.Transport = httpTransport
+	}
+
 	if len(cfg.RetryOnStatus) == 0 {
 		cfg.RetryOnStatus = defaultRetryOnStatus[:]
 	}
@@ -172,6 +340,10 @@ func New(cfg Config) (*Client, error) {
 		cfg.MaxRetries = defaultMaxRetries
 	}
 
+	if cfg.RetryOnRejection == nil {
+		cfg.RetryOnRejection = DefaultRetryOnRejection
+	}
+
 	var conns []*Connection
 	for _, u := range cfg.URLs {
 		conns = append(conns, &Connection{URL: u})
@@ -183,16 +355,28 @@ func New(cfg Config) (*Client, error) {
 		password: cfg.Password,
 		header:   cfg.Header,
 
+		propagateHeaders: cfg.PropagateHeaders,
+		injectHeaders:    cfg.InjectHeaders,
+		methodOverride:   cfg.MethodOverride,
+
 		signer: cfg.Signer,
 
 		retryOnStatus:         cfg.RetryOnStatus,
 		disableRetry:          cfg.DisableRetry,
 		enableRetryOnTimeout:  cfg.EnableRetryOnTimeout,
+		retryOnlyUnprocessed:  cfg.RetryOnlyUnprocessed,
 		maxRetries:            cfg.MaxRetries,
 		retryBackoff:          cfg.RetryBackoff,
+		retryOnRejection:      cfg.RetryOnRejection,
 		discoverNodesInterval: cfg.DiscoverNodesInterval,
 
+		requestTimeout: cfg.RequestTimeout,
+
 		compressRequestBody: cfg.CompressRequestBody,
+		maxResponseBodySize: cfg.MaxResponseBodySize,
+
+		slowRequestThreshold: cfg.SlowRequestThreshold,
+		slowRequestLogger:    cfg.SlowRequestLogger,
 
 		transport: cfg.Transport,
 		logger:    cfg.Logger,
@@ -210,6 +394,48 @@ func New(cfg Config) (*Client, error) {
 		debugLogger = &debuggingLogger{Output: os.Stdout}
 	}
 
+	if cfg.EnableVersionDetection {
+		client.clusterVersion = &clusterVersion{}
+	}
+
+	if cfg.RateLimit > 0 {
+		burst := cfg.RateLimitBurst
+		if burst <= 0 {
+			burst = 1
+		}
+		client.limiter = rate.NewLimiter(rate.Limit(cfg.RateLimit), burst)
+	}
+
+	if cfg.CircuitBreakerThreshold > 0 {
+		client.breaker = &circuitBreaker{
+			threshold: cfg.CircuitBreakerThreshold,
+			window:    cfg.CircuitBreakerWindow,
+			cooldown:  cfg.CircuitBreakerCooldown,
+		}
+		if client.breaker.cooldown <= 0 {
+			client.breaker.cooldown = defaultCircuitBreakerCooldown
+		}
+	}
+
+	if cfg.EnableCorrelationID {
+		client.correlationIDHeader = cfg.CorrelationIDHeader
+		if client.correlationIDHeader == "" {
+			client.correlationIDHeader = defaultCorrelationIDHeader
+		}
+		client.correlationIDFunc = cfg.CorrelationIDFunc
+		if client.correlationIDFunc == nil {
+			client.correlationIDFunc = NewUUID
+		}
+	}
+
+	if cfg.ResponseCacheTTL > 0 {
+		client.responseCache = newResponseCache(cfg.ResponseCacheTTL, cfg.ResponseCachePaths, client.correlationIDHeader)
+	}
+
+	if client.slowRequestThreshold > 0 && client.slowRequestLogger == nil {
+		client.slowRequestLogger = &debuggingLogger{Output: os.Stderr}
+	}
+
 	if cfg.EnableMetrics {
 		client.metrics = &metrics{responses: make(map[int]int)}
 		// TODO(karmi): Type assertion to interface
@@ -230,12 +456,68 @@ func New(cfg Config) (*Client, error) {
 	return &client, nil
 }
 
+// dialWithTimeout returns the dial function with the timeout, or a net.Dialer with the timeout
+// and the default keep-alive when dial is nil.
+func dialWithTimeout(dial func(ctx context.Context, network, addr string) (net.Conn, error), timeout time.Duration) func(ctx context.Context, network, addr string) (net.Conn, error) {
+	if dial == nil {
+		return (&net.Dialer{Timeout: timeout, KeepAlive: 30 * time.Second}).DialContext
+	}
+	return func(ctx context.Context, network, addr string) (net.Conn, error) {
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+		return dial(ctx, network, addr)
+	}
+}
+
 // Perform executes the request and returns a response or error.
-func (c *Client) Perform(req *http.Request) (*http.Response, error) {
+func (c *Client) Perform(req *http.Request) (res *http.Response, err error) {
+	// Set the default timeout, when configured
+	req, release := c.setReqTimeout(req)
+	defer func() { release(res) }()
+
+	// Update request
+	c.setReqUserAgent(req)
+	c.setReqContextHeader(req)
+	c.setReqGlobalHeader(req)
+	c.setReqPropagatedHeader(req)
+	correlationID := c.setReqCorrelationID(req)
+
+	// Return the cached response, when enabled; the headers from the context must be set
+	// before the lookup, as they can carry the credentials or the Cache-Control of the request
 	var (
-		res *http.Response
-		err error
+		cacheURL    url.URL
+		cacheAccept string
+		cacheShared bool
 	)
+	if c.responseCache != nil {
+		cacheShared = c.sharedCredentials(req)
+		if cached, ok := c.responseCache.get(req, cacheShared); ok {
+			closeReqBody(req)
+			c.setResCorrelationID(cached, correlationID)
+			c.setResBodyLimit(cached)
+			setResContext(req.Context(), cached)
+			return cached, nil
+		}
+		cacheURL = *req.URL
+		cacheAccept = req.Header.Get("Accept")
+	}
+
+	// Wait for the rate limiter, when enabled
+	if c.limiter != nil {
+		if err := c.limiter.Wait(req.Context()); err != nil {
+			closeReqBody(req)
+			return nil, err
+		}
+	}
+
+	// Fail fast while the circuit breaker is open, when enabled
+	if c.breaker != nil {
+		if err := c.breaker.allow(); err != nil {
+			closeReqBody(req)
+			return nil, err
+		}
+		defer func() { c.breaker.record(res, err) }()
+	}
 
 	// Compatibility Header
 	if compatibilityHeader {
@@ -252,20 +534,28 @@ func (c *Client) Perform(req *http.Request) (*http.Response, error) {
 		c.metrics.Unlock()
 	}
 
-	// Update request
-	c.setReqUserAgent(req)
-	c.setReqGlobalHeader(req)
+	c.setReqClusterManagerTimeout(req)
+
+	streamed := isChunked(req) && req.GetBody == nil
 
 	if req.Body != nil && req.Body != http.NoBody {
-		if c.compressRequestBody {
+		if c.compressRequestBody && streamed {
+			req.Body = gzipStream(req.Body)
+			req.Header.Set("Content-Encoding", "gzip")
+			req.ContentLength = -1
+
+		} else if c.compressRequestBody {
 			var buf bytes.Buffer
 			zw := gzip.NewWriter(&buf)
 			if _, err := io.Copy(zw, req.Body); err != nil {
+				closeReqBody(req)
 				return nil, fmt.Errorf("failed to compress request body: %s", err)
 			}
 			if err := zw.Close(); err != nil {
+				closeReqBody(req)
 				return nil, fmt.Errorf("failed to compress request body (during close): %s", err)
 			}
+			closeReqBody(req)
 
 			req.GetBody = func() (io.ReadCloser, error) {
 				r := buf
@@ -276,10 +566,11 @@ func (c *Client) Perform(req *http.Request) (*http.Response, error) {
 			req.Header.Set("Content-Encoding", "gzip")
 			req.ContentLength = int64(buf.Len())
 
-		} else if req.GetBody == nil {
+		} else if req.GetBody == nil && !streamed {
 			if !c.disableRetry || (c.logger != nil && c.logger.RequestBodyEnabled()) {
 				var buf bytes.Buffer
 				buf.ReadFrom(req.Body)
+				closeReqBody(req)
 
 				req.GetBody = func() (io.ReadCloser, error) {
 					r := buf
@@ -290,11 +581,18 @@ func (c *Client) Perform(req *http.Request) (*http.Response, error) {
 		}
 	}
 
+	ctx := req.Context()
+	trackProgress := c.retryOnlyUnprocessed && !c.disableRetry && !isIdempotent(req.Method)
+
+	// Tunnel the method through POST, when configured
+	c.setReqMethodOverride(req)
+
 	for i := 0; i <= c.maxRetries; i++ {
 		var (
 			conn            *Connection
 			shouldRetry     bool
 			shouldCloseBody bool
+			progress        requestProgress
 		)
 
 		// Get connection from the pool
@@ -305,6 +603,7 @@ func (c *Client) Perform(req *http.Request) (*http.Response, error) {
 			if c.logger != nil {
 				c.logRoundTrip(req, nil, err, time.Time{}, time.Duration(0))
 			}
+			closeReqBody(req)
 			return nil, fmt.Errorf("cannot get connection: %s", err)
 		}
 
@@ -313,6 +612,7 @@ func (c *Client) Perform(req *http.Request) (*http.Response, error) {
 		c.setReqAuth(conn.URL, req)
 
 		if err = c.signRequest(req); err != nil {
+			closeReqBody(req)
 			return nil, fmt.Errorf("failed to sign request: %s", err)
 		}
 
@@ -324,14 +624,27 @@ func (c *Client) Perform(req *http.Request) (*http.Response, error) {
 			req.Body = body
 		}
 
+		// Record whether the request reaches the server, when needed
+		if trackProgress {
+			req = withRequestProgress(ctx, req, &progress)
+		}
+
 		// Set up time measures and execute the request
 		start := time.Now().UTC()
 		res, err = c.transport.RoundTrip(req)
 		dur := time.Since(start)
 
+		// Limit the size of the response body, before it's read by the loggers or the retries
+		c.setResBodyLimit(res)
+
+		// Log slow requests, when enabled
+		if c.slowRequestThreshold > 0 && dur >= c.slowRequestThreshold {
+			c.logSlowRequest(req, res, dur)
+		}
+
 		// Log request and response
 		if c.logger != nil {
-			if c.logger.RequestBodyEnabled() && req.Body != nil && req.Body != http.NoBody {
+			if c.logger.RequestBodyEnabled() && req.Body != nil && req.Body != http.NoBody && req.GetBody != nil {
 				req.Body, _ = req.GetBody()
 			}
 			c.logRoundTrip(req, res, err, start, dur)
@@ -350,6 +663,11 @@ func (c *Client) Perform(req *http.Request) (*http.Response, error) {
 			c.pool.OnFailure(conn)
 			c.Unlock()
 
+			// Detect the version again on reconnect, when enabled
+			if c.clusterVersion != nil {
+				c.clusterVersion.reset()
+			}
+
 			// Retry on EOF errors
 			if err == io.EOF {
 				shouldRetry = true
@@ -361,6 +679,11 @@ func (c *Client) Perform(req *http.Request) (*http.Response, error) {
 					shouldRetry = true
 				}
 			}
+
+			// Retry non-idempotent requests only when not processed by the server, when configured
+			if trackProgress && progress.classify(err) != RetryErrorNotProcessed {
+				shouldRetry = false
+			}
 		} else {
 			// Report the connection as succesfull
 			c.Lock()
@@ -384,6 +707,17 @@ func (c *Client) Perform(req *http.Request) (*http.Response, error) {
 			}
 		}
 
+		// Back off or stop retrying on rejections, depending on their cause
+		var rejectionBackoff time.Duration
+		if shouldRetry && res != nil && res.StatusCode == http.StatusTooManyRequests {
+			rejectionBackoff, shouldRetry = c.retryOnRejection(ClassifyRejection(res), i+1)
+		}
+
+		// Don't retry the streamed bodies, which can't be sent again
+		if streamed && req.Body != nil && req.Body != http.NoBody {
+			shouldRetry = false
+		}
+
 		// Break if retry should not be performed
 		if !shouldRetry {
 			break
@@ -398,17 +732,40 @@ func (c *Client) Perform(req *http.Request) (*http.Response, error) {
 		}
 
 		// Delay the retry if a backoff function is configured
-		if c.retryBackoff != nil {
+		if rejectionBackoff > 0 {
+			if i < c.maxRetries {
+				select {
+				case <-time.After(rejectionBackoff):
+				case <-ctx.Done():
+					// The body of the rejected response is already closed
+					return nil, ctx.Err()
+				}
+			}
+		} else if c.retryBackoff != nil {
 			time.Sleep(c.retryBackoff(i + 1))
 		}
 	}
 
+	c.setResCorrelationID(res, correlationID)
+
+	// Update the response cache, when enabled; a failure to read the body is returned
+	// to the reader of the body, and the response is not cached
+	if c.responseCache != nil && err == nil {
+		if cacheErr := c.responseCache.update(req.Method, &cacheURL, cacheAccept, cacheShared, res); cacheErr != nil && debugLogger != nil {
+			debugLogger.Logf("Error caching the response: %s\n", cacheErr)
+		}
+	}
+
+	setResContext(req.Context(), res)
+
 	// TODO(karmi): Wrap error
 	return res, err
 }
 
 // URLs returns a list of transport URLs.
 func (c *Client) URLs() []*url.URL {
+	c.Lock()
+	defer c.Unlock()
 	return c.pool.URLs()
 }
 
@@ -444,6 +801,14 @@ func (c *Client) setReqAuth(u *url.URL, req *http.Request) *http.Request {
 	return req
 }
 
+// closeReqBody closes the body of a request which is not sent, as the transport does for the
+// requests it sends, so the writer of a streamed body, eg. an io.PipeWriter, doesn't block forever.
+func closeReqBody(req *http.Request) {
+	if req.Body != nil && req.Body != http.NoBody {
+		req.Body.Close()
+	}
+}
+
 func (c *Client) signRequest(req *http.Request) error {
 	if c.signer != nil {
 		return c.signer.SignRequest(req)
@@ -456,10 +821,12 @@ func (c *Client) setReqUserAgent(req *http.Request) *http.Request {
 	return req
 }
 
+// setReqGlobalHeader adds the global headers to the request,
+// except the headers already set on the request, which take precedence.
 func (c *Client) setReqGlobalHeader(req *http.Request) *http.Request {
 	if len(c.header) > 0 {
 		for k, v := range c.header {
-			if req.Header.Get(k) != k {
+			if len(req.Header.Values(k)) == 0 {
 				for _, vv := range v {
 					req.Header.Add(k, vv)
 				}
@@ -490,6 +857,16 @@ func (c *Client) logRoundTrip(
 	c.logger.LogRoundTrip(req, &dupRes, err, start, dur) // errcheck exclude
 }
 
+func (c *Client) logSlowRequest(req *http.Request, res *http.Response, dur time.Duration) {
+	c.slowRequestLogger.Logf("Slow request: %s %s [status:%d request:%s threshold:%s]\n", // errcheck exclude
+		req.Method,
+		req.URL.Path,
+		resStatusCode(res),
+		dur.Truncate(time.Millisecond),
+		c.slowRequestThreshold,
+	)
+}
+
 func initUserAgent() string {
 	var b strings.Builder
 
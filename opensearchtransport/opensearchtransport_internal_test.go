@@ -31,10 +31,13 @@ package opensearchtransport
 import (
 	"bytes"
 	"compress/gzip"
+	"context"
+	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"math/rand"
+	"net"
 	"net/http"
 	"net/url"
 	"reflect"
@@ -118,7 +121,7 @@ func TestTransportConfig(t *testing.T) {
 	t.Run("Defaults", func(t *testing.T) {
 		tp, _ := New(Config{})
 
-		if !reflect.DeepEqual(tp.retryOnStatus, []int{502, 503, 504}) {
+		if !reflect.DeepEqual(tp.retryOnStatus, []int{502, 503, 504, 429}) {
 			t.Errorf("Unexpected retryOnStatus: %v", tp.retryOnStatus)
 		}
 
@@ -168,6 +171,114 @@ func TestTransportConfig(t *testing.T) {
 			t.Errorf("Unexpected compressRequestBody: %v", tp.compressRequestBody)
 		}
 	})
+
+	t.Run("Connections", func(t *testing.T) {
+		tp, err := New(Config{
+			MaxIdleConnsPerHost: 64,
+			IdleConnTimeout:     30 * time.Second,
+			ForceHTTP2:          true,
+			DialTimeout:         2 * time.Second,
+			TLSHandshakeTimeout: 3 * time.Second,
+			Transport:           &http.Transport{},
+		})
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+
+		httpTransport, ok := tp.transport.(*http.Transport)
+		if !ok {
+			t.Fatalf("Unexpected transport: %T", tp.transport)
+		}
+		if httpTransport.MaxIdleConnsPerHost != 64 {
+			t.Errorf("Unexpected MaxIdleConnsPerHost: %d", httpTransport.MaxIdleConnsPerHost)
+		}
+		if httpTransport.IdleConnTimeout != 30*time.Second {
+			t.Errorf("Unexpected IdleConnTimeout: %s", httpTransport.IdleConnTimeout)
+		}
+		if !httpTransport.ForceAttemptHTTP2 {
+			t.Errorf("Unexpected ForceAttemptHTTP2: %v", httpTransport.ForceAttemptHTTP2)
+		}
+		if httpTransport.DialContext == nil {
+			t.Errorf("Expected DialContext to be set")
+		}
+		if httpTransport.TLSHandshakeTimeout != 3*time.Second {
+			t.Errorf("Unexpected TLSHandshakeTimeout: %s", httpTransport.TLSHandshakeTimeout)
+		}
+
+		if _, err := New(Config{MaxIdleConnsPerHost: 64, Transport: &mockTransp{}}); err == nil {
+			t.Errorf("Expected error for a transport which is not http.Transport")
+		}
+	})
+
+	t.Run("Custom dialer", func(t *testing.T) {
+		var (
+			called   bool
+			deadline time.Time
+		)
+		tp, err := New(Config{
+			DialTimeout: 2 * time.Second,
+			Transport: &http.Transport{DialContext: func(ctx context.Context, network, addr string) (net.Conn, error) {
+				called = true
+				deadline, _ = ctx.Deadline()
+				return nil, errors.New("mock dial error")
+			}},
+		})
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+
+		tp.transport.(*http.Transport).DialContext(context.Background(), "tcp", "localhost:9200")
+		if !called {
+			t.Fatalf("Expected the custom dialer to be kept")
+		}
+		if d := time.Until(deadline); d <= 0 || d > 2*time.Second {
+			t.Errorf("Expected the dial timeout to be applied, got deadline in: %s", d)
+		}
+	})
+
+	t.Run("Default transport unmodified", func(t *testing.T) {
+		if _, err := New(Config{MaxIdleConnsPerHost: 64}); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if http.DefaultTransport.(*http.Transport).MaxIdleConnsPerHost == 64 {
+			t.Errorf("Expected http.DefaultTransport to be left unmodified")
+		}
+	})
+
+	t.Run("Client certificate", func(t *testing.T) {
+		cert, _ := ioutil.ReadFile("testdata/cert.pem")
+		key, _ := ioutil.ReadFile("testdata/key.pem")
+
+		tp, err := New(Config{ClientCert: cert, ClientKey: key, InsecureSkipVerify: true})
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+
+		tlsConfig := tp.transport.(*http.Transport).TLSClientConfig
+		if len(tlsConfig.Certificates) != 1 {
+			t.Errorf("Unexpected certificates: %d", len(tlsConfig.Certificates))
+		}
+		if !tlsConfig.InsecureSkipVerify {
+			t.Errorf("Expected InsecureSkipVerify to be set")
+		}
+		if tlsConfig := http.DefaultTransport.(*http.Transport).TLSClientConfig; tlsConfig != nil && tlsConfig.InsecureSkipVerify {
+			t.Errorf("Expected http.DefaultTransport to be left unmodified")
+		}
+	})
+
+	t.Run("Invalid client certificate", func(t *testing.T) {
+		cert, _ := ioutil.ReadFile("testdata/cert.pem")
+
+		_, err := New(Config{ClientCert: cert})
+		if err == nil || !strings.Contains(err.Error(), "unable to load client certificate") {
+			t.Errorf("Expected client certificate error, got: %v", err)
+		}
+
+		_, err = New(Config{ClientCert: cert, ClientKey: cert, Transport: &mockTransp{}})
+		if err == nil {
+			t.Errorf("Expected error for custom transport")
+		}
+	})
 }
 
 func TestTransportConnectionPool(t *testing.T) {
@@ -391,8 +502,8 @@ func TestTransportPerform(t *testing.T) {
 			req.Header.Set("X-Foo", "baz")
 			tp.setReqGlobalHeader(req)
 
-			if req.Header.Get("X-Foo") != "baz" {
-				t.Errorf("Unexpected global HTTP request header value: %s", req.Header.Get("X-Foo"))
+			if v := req.Header.Values("X-Foo"); len(v) != 1 || v[0] != "baz" {
+				t.Errorf("Unexpected global HTTP request header value: %s", v)
 			}
 		}
 	})
@@ -1034,3 +1145,230 @@ func TestRequestSigning(t *testing.T) {
 		}
 	})
 }
+
+type mockDebuggingLogger struct {
+	messages []string
+}
+
+func (l *mockDebuggingLogger) Log(a ...interface{}) error {
+	l.messages = append(l.messages, fmt.Sprint(a...))
+	return nil
+}
+
+func (l *mockDebuggingLogger) Logf(format string, a ...interface{}) error {
+	l.messages = append(l.messages, fmt.Sprintf(format, a...))
+	return nil
+}
+
+func TestRequestTimeout(t *testing.T) {
+	newTransport := func(fn func(*http.Request) (*http.Response, error)) *Client {
+		u, _ := url.Parse("http://example.com")
+		tp, _ := New(Config{
+			URLs:           []*url.URL{u},
+			RequestTimeout: 50 * time.Millisecond,
+			DisableRetry:   true,
+			Transport:      &mockTransp{RoundTripFunc: fn},
+		})
+		return tp
+	}
+
+	t.Run("Without deadline", func(t *testing.T) {
+		var reqCtx context.Context
+		tp := newTransport(func(req *http.Request) (*http.Response, error) {
+			reqCtx = req.Context()
+			return &http.Response{StatusCode: 200, Body: ioutil.NopCloser(strings.NewReader(`{}`))}, nil
+		})
+
+		req, _ := http.NewRequest("GET", "/", nil)
+		res, err := tp.Perform(req)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+
+		deadline, ok := reqCtx.Deadline()
+		if !ok || time.Until(deadline) > 50*time.Millisecond {
+			t.Errorf("Unexpected deadline: %v", deadline)
+		}
+
+		if reqCtx.Err() != nil {
+			t.Errorf("Expected the context to be alive until the body is closed, got: %s", reqCtx.Err())
+		}
+		res.Body.Close()
+		if reqCtx.Err() != context.Canceled {
+			t.Errorf("Expected the context to be cancelled, got: %v", reqCtx.Err())
+		}
+	})
+
+	t.Run("With deadline", func(t *testing.T) {
+		var reqCtx context.Context
+		tp := newTransport(func(req *http.Request) (*http.Response, error) {
+			reqCtx = req.Context()
+			return &http.Response{StatusCode: 200, Body: ioutil.NopCloser(strings.NewReader(`{}`))}, nil
+		})
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Hour)
+		defer cancel()
+
+		req, _ := http.NewRequest("GET", "/", nil)
+		if _, err := tp.Perform(req.WithContext(ctx)); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+
+		want, _ := ctx.Deadline()
+		if got, _ := reqCtx.Deadline(); !got.Equal(want) {
+			t.Errorf("Unexpected deadline: want=%v, got=%v", want, got)
+		}
+	})
+
+	t.Run("Hung request", func(t *testing.T) {
+		tp := newTransport(func(req *http.Request) (*http.Response, error) {
+			<-req.Context().Done()
+			return nil, req.Context().Err()
+		})
+
+		req, _ := http.NewRequest("GET", "/", nil)
+		start := time.Now()
+		if _, err := tp.Perform(req); err != context.DeadlineExceeded {
+			t.Errorf("Expected deadline exceeded error, got: %v", err)
+		}
+		if d := time.Since(start); d > time.Second {
+			t.Errorf("Unexpected duration: %s", d)
+		}
+	})
+}
+
+func TestRateLimit(t *testing.T) {
+	newTransport := func() *Client {
+		u, _ := url.Parse("http://foo.bar")
+		tp, _ := New(Config{
+			URLs:           []*url.URL{u},
+			RateLimit:      20,
+			RateLimitBurst: 2,
+			Transport: &mockTransp{
+				RoundTripFunc: func(req *http.Request) (*http.Response, error) {
+					return &http.Response{StatusCode: 200, Body: http.NoBody}, nil
+				},
+			},
+		})
+		return tp
+	}
+
+	t.Run("Burst and wait", func(t *testing.T) {
+		tp := newTransport()
+
+		start := time.Now()
+		for i := 0; i < 4; i++ {
+			req, _ := http.NewRequest("GET", "/", nil)
+			if _, err := tp.Perform(req); err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+		}
+		if d := time.Since(start); d < 80*time.Millisecond {
+			t.Errorf("Expected the requests beyond the burst to wait, took %s", d)
+		}
+	})
+
+	t.Run("Context canceled", func(t *testing.T) {
+		tp := newTransport()
+
+		for i := 0; i < 2; i++ {
+			req, _ := http.NewRequest("GET", "/", nil)
+			tp.Perform(req)
+		}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		req, _ := http.NewRequestWithContext(ctx, "GET", "/", nil)
+		if _, err := tp.Perform(req); err == nil {
+			t.Errorf("Expected error for canceled context")
+		}
+	})
+}
+
+func TestSlowRequestLogger(t *testing.T) {
+	newTransport := func(logger DebuggingLogger, delay time.Duration) *Client {
+		u, _ := url.Parse("http://example.com")
+		tp, _ := New(Config{
+			URLs:                 []*url.URL{u},
+			SlowRequestThreshold: 20 * time.Millisecond,
+			SlowRequestLogger:    logger,
+			Transport: &mockTransp{
+				RoundTripFunc: func(req *http.Request) (*http.Response, error) {
+					time.Sleep(delay)
+					return &http.Response{StatusCode: 200, Body: ioutil.NopCloser(strings.NewReader(`{}`))}, nil
+				},
+			},
+		})
+		return tp
+	}
+
+	t.Run("Slow request", func(t *testing.T) {
+		logger := &mockDebuggingLogger{}
+		tp := newTransport(logger, 30*time.Millisecond)
+
+		req, _ := http.NewRequest("GET", "/_search", nil)
+		if _, err := tp.Perform(req); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+
+		if len(logger.messages) != 1 {
+			t.Fatalf("Expected 1 message, got: %d", len(logger.messages))
+		}
+		msg := logger.messages[0]
+		if !strings.Contains(msg, "GET /_search") || !strings.Contains(msg, "status:200") {
+			t.Errorf("Unexpected message: %s", msg)
+		}
+	})
+
+	t.Run("Fast request", func(t *testing.T) {
+		logger := &mockDebuggingLogger{}
+		tp := newTransport(logger, 0)
+
+		req, _ := http.NewRequest("GET", "/_search", nil)
+		if _, err := tp.Perform(req); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+
+		if len(logger.messages) != 0 {
+			t.Errorf("Unexpected messages: %v", logger.messages)
+		}
+	})
+
+	t.Run("Default logger", func(t *testing.T) {
+		tp := newTransport(nil, 0)
+		if tp.slowRequestLogger == nil {
+			t.Errorf("Expected the default logger to be set")
+		}
+	})
+}
+
+func TestResponseBodyContext(t *testing.T) {
+	u, _ := url.Parse("http://example.com")
+	tp, _ := New(Config{
+		URLs: []*url.URL{u},
+		Transport: &mockTransp{RoundTripFunc: func(req *http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 200, Body: ioutil.NopCloser(strings.NewReader(`{"foo":"bar"}`))}, nil
+		}},
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	req, _ := http.NewRequest("GET", "/", nil)
+	res, err := tp.Perform(req.WithContext(ctx))
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	defer res.Body.Close()
+
+	buf := make([]byte, 4)
+	if _, err := res.Body.Read(buf); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	cancel()
+
+	if _, err := res.Body.Read(buf); err != context.Canceled {
+		t.Errorf("Expected context error, got: %v", err)
+	}
+}
@@ -0,0 +1,137 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchtransport
+
+import (
+	"context"
+	"net/http"
+)
+
+// TraceHeaders lists the W3C Trace Context and B3 propagation headers,
+// for use with Config.PropagateHeaders.
+//
+// The headers are copied as they were received, eg. by a server, so the outgoing requests
+// carry the span ID of the incoming request instead of the span in the context. To propagate
+// the current span, use Config.InjectHeaders with the propagator of the tracing library, eg.
+// with OpenTelemetry:
+//
+//	InjectHeaders: func(ctx context.Context, h http.Header) {
+//		otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(h))
+//	}
+var TraceHeaders = []string{
+	"traceparent",
+	"tracestate",
+	"b3",
+	"X-B3-TraceId",
+	"X-B3-SpanId",
+	"X-B3-ParentSpanId",
+	"X-B3-Sampled",
+	"X-B3-Flags",
+}
+
+type propagatedHeaderKey struct{}
+
+// ContextWithPropagatedHeader returns a copy of ctx carrying the header h,
+// eg. the headers of an incoming request stored by a tracing middleware.
+//
+// The headers listed in Config.PropagateHeaders are copied from h
+// to the outgoing requests performed with the context.
+func ContextWithPropagatedHeader(ctx context.Context, h http.Header) context.Context {
+	return context.WithValue(ctx, propagatedHeaderKey{}, h)
+}
+
+// PropagatedHeaderFromContext returns the header stored in ctx by ContextWithPropagatedHeader.
+func PropagatedHeaderFromContext(ctx context.Context) (http.Header, bool) {
+	h, ok := ctx.Value(propagatedHeaderKey{}).(http.Header)
+	return h, ok
+}
+
+// setReqPropagatedHeader sets the headers of Config.InjectHeaders, then copies the configured headers
+// from the request context, unless they're already set on the request.
+func (c *Client) setReqPropagatedHeader(req *http.Request) *http.Request {
+	if c.injectHeaders != nil {
+		h := make(http.Header)
+		c.injectHeaders(req.Context(), h)
+		for k, vv := range h {
+			if _, ok := req.Header[http.CanonicalHeaderKey(k)]; ok {
+				continue
+			}
+			for _, v := range vv {
+				req.Header.Add(k, v)
+			}
+		}
+	}
+
+	if len(c.propagateHeaders) > 0 {
+		if h, ok := PropagatedHeaderFromContext(req.Context()); ok {
+			for _, name := range c.propagateHeaders {
+				if _, ok := req.Header[http.CanonicalHeaderKey(name)]; ok {
+					continue
+				}
+				for _, v := range h.Values(name) {
+					req.Header.Add(name, v)
+				}
+			}
+		}
+	}
+
+	return req
+}
+
+type headerKey struct{}
+
+// ContextWithHeader returns a copy of ctx carrying the header h, which is added to all the
+// requests performed with the context, eg. a request ID set once by a middleware.
+//
+// The headers set on the request, eg. with the WithHeader option of the APIs, take precedence
+// over the headers of the context, which take precedence over the global headers of Config.Header.
+// The header is merged with the header already carried by ctx, if any, replacing its values.
+func ContextWithHeader(ctx context.Context, h http.Header) context.Context {
+	merged := make(http.Header)
+	if parent, ok := HeaderFromContext(ctx); ok {
+		for k, vv := range parent {
+			merged[k] = append([]string(nil), vv...)
+		}
+	}
+	for k, vv := range h {
+		merged[http.CanonicalHeaderKey(k)] = append([]string(nil), vv...)
+	}
+	return context.WithValue(ctx, headerKey{}, merged)
+}
+
+// ContextWithOpaqueID returns a copy of ctx carrying the X-Opaque-Id header,
+// which identifies the requests performed with the context in the tasks and the slow logs.
+func ContextWithOpaqueID(ctx context.Context, id string) context.Context {
+	return ContextWithHeader(ctx, http.Header{"X-Opaque-Id": []string{id}})
+}
+
+// HeaderFromContext returns the header stored in ctx by ContextWithHeader or ContextWithOpaqueID.
+func HeaderFromContext(ctx context.Context) (http.Header, bool) {
+	h, ok := ctx.Value(headerKey{}).(http.Header)
+	return h, ok
+}
+
+// setReqContextHeader adds the headers of the request context, except the headers already set on the request.
+func (c *Client) setReqContextHeader(req *http.Request) *http.Request {
+	h, ok := HeaderFromContext(req.Context())
+	if !ok {
+		return req
+	}
+
+	for k, vv := range h {
+		if len(req.Header.Values(k)) > 0 {
+			continue
+		}
+		for _, v := range vv {
+			req.Header.Add(k, v)
+		}
+	}
+	return req
+}
@@ -0,0 +1,196 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+//go:build !integration
+// +build !integration
+
+package opensearchtransport
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+)
+
+func TestHeaderPropagation(t *testing.T) {
+	newTransport := func(propagate []string, gotHeader *http.Header) *Client {
+		u, _ := url.Parse("http://example.com")
+		tp, _ := New(Config{
+			URLs:             []*url.URL{u},
+			PropagateHeaders: propagate,
+			Transport: &mockTransp{
+				RoundTripFunc: func(req *http.Request) (*http.Response, error) {
+					*gotHeader = req.Header
+					return &http.Response{StatusCode: 200}, nil
+				},
+			},
+		})
+		return tp
+	}
+
+	incoming := http.Header{}
+	incoming.Set("traceparent", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	incoming.Set("X-B3-TraceId", "80f198ee56343ba864fe8b2a57d3eff7")
+	incoming.Set("Cookie", "secret")
+
+	t.Run("Propagate", func(t *testing.T) {
+		var got http.Header
+		tp := newTransport(TraceHeaders, &got)
+
+		ctx := ContextWithPropagatedHeader(context.Background(), incoming)
+		req, _ := http.NewRequestWithContext(ctx, "GET", "/", nil)
+		if _, err := tp.Perform(req); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+
+		if got.Get("traceparent") != incoming.Get("traceparent") {
+			t.Errorf("Expected traceparent to be propagated, got: %q", got.Get("traceparent"))
+		}
+		if got.Get("X-B3-TraceId") != incoming.Get("X-B3-TraceId") {
+			t.Errorf("Expected X-B3-TraceId to be propagated, got: %q", got.Get("X-B3-TraceId"))
+		}
+		if got.Get("Cookie") != "" {
+			t.Errorf("Unexpected header Cookie: %q", got.Get("Cookie"))
+		}
+	})
+
+	t.Run("Request header wins", func(t *testing.T) {
+		var got http.Header
+		tp := newTransport(TraceHeaders, &got)
+
+		ctx := ContextWithPropagatedHeader(context.Background(), incoming)
+		req, _ := http.NewRequestWithContext(ctx, "GET", "/", nil)
+		req.Header.Set("traceparent", "explicit")
+		if _, err := tp.Perform(req); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+
+		if v := got.Values("traceparent"); len(v) != 1 || v[0] != "explicit" {
+			t.Errorf("Unexpected traceparent: %v", v)
+		}
+	})
+
+	t.Run("Inject", func(t *testing.T) {
+		type spanKey struct{}
+
+		var got http.Header
+		u, _ := url.Parse("http://example.com")
+		tp, _ := New(Config{
+			URLs:             []*url.URL{u},
+			PropagateHeaders: TraceHeaders,
+			InjectHeaders: func(ctx context.Context, h http.Header) {
+				if span, ok := ctx.Value(spanKey{}).(string); ok {
+					h.Set("traceparent", "00-4bf92f3577b34da6a3ce929d0e0e4736-"+span+"-01")
+				}
+			},
+			Transport: &mockTransp{
+				RoundTripFunc: func(req *http.Request) (*http.Response, error) {
+					got = req.Header
+					return &http.Response{StatusCode: 200}, nil
+				},
+			},
+		})
+
+		ctx := ContextWithPropagatedHeader(context.Background(), incoming)
+		ctx = context.WithValue(ctx, spanKey{}, "b7ad6b7169203331")
+		req, _ := http.NewRequestWithContext(ctx, "GET", "/", nil)
+		if _, err := tp.Perform(req); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+
+		if v := got.Values("traceparent"); len(v) != 1 || v[0] != "00-4bf92f3577b34da6a3ce929d0e0e4736-b7ad6b7169203331-01" {
+			t.Errorf("Expected the injected traceparent, got: %v", v)
+		}
+		if got.Get("X-B3-TraceId") != incoming.Get("X-B3-TraceId") {
+			t.Errorf("Expected X-B3-TraceId to be propagated, got: %q", got.Get("X-B3-TraceId"))
+		}
+	})
+
+	t.Run("Disabled", func(t *testing.T) {
+		var got http.Header
+		tp := newTransport(nil, &got)
+
+		ctx := ContextWithPropagatedHeader(context.Background(), incoming)
+		req, _ := http.NewRequestWithContext(ctx, "GET", "/", nil)
+		if _, err := tp.Perform(req); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+
+		if got.Get("traceparent") != "" {
+			t.Errorf("Unexpected traceparent: %q", got.Get("traceparent"))
+		}
+	})
+}
+
+func TestContextHeader(t *testing.T) {
+	var got http.Header
+	u, _ := url.Parse("http://example.com")
+	tp, _ := New(Config{
+		URLs:   []*url.URL{u},
+		Header: http.Header{"X-Opaque-Id": []string{"global"}, "X-Team": []string{"search"}},
+		Transport: &mockTransp{
+			RoundTripFunc: func(req *http.Request) (*http.Response, error) {
+				got = req.Header
+				return &http.Response{StatusCode: 200}, nil
+			},
+		},
+	})
+
+	ctx := ContextWithOpaqueID(context.Background(), "req-1")
+	ctx = ContextWithHeader(ctx, http.Header{"x-tenant": []string{"a", "b"}})
+
+	if h, ok := HeaderFromContext(ctx); !ok || h.Get("X-Opaque-Id") != "req-1" || len(h.Values("X-Tenant")) != 2 {
+		t.Fatalf("Unexpected context header: %v", h)
+	}
+
+	t.Run("Context", func(t *testing.T) {
+		req, _ := http.NewRequestWithContext(ctx, "GET", "/", nil)
+		if _, err := tp.Perform(req); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+
+		if v := got.Values("X-Opaque-Id"); len(v) != 1 || v[0] != "req-1" {
+			t.Errorf("Expected the context to override the global header, got: %v", v)
+		}
+		if v := got.Values("X-Tenant"); len(v) != 2 {
+			t.Errorf("Unexpected X-Tenant: %v", v)
+		}
+		if v := got.Get("X-Team"); v != "search" {
+			t.Errorf("Unexpected X-Team: %q", v)
+		}
+	})
+
+	t.Run("Request header wins", func(t *testing.T) {
+		req, _ := http.NewRequestWithContext(ctx, "GET", "/", nil)
+		req.Header.Set("X-Opaque-Id", "explicit")
+		if _, err := tp.Perform(req); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+
+		if v := got.Values("X-Opaque-Id"); len(v) != 1 || v[0] != "explicit" {
+			t.Errorf("Unexpected X-Opaque-Id: %v", v)
+		}
+	})
+
+	t.Run("Replace", func(t *testing.T) {
+		inner := ContextWithOpaqueID(ctx, "req-2")
+		req, _ := http.NewRequestWithContext(inner, "GET", "/", nil)
+		if _, err := tp.Perform(req); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+
+		if v := got.Values("X-Opaque-Id"); len(v) != 1 || v[0] != "req-2" {
+			t.Errorf("Unexpected X-Opaque-Id: %v", v)
+		}
+		if h, _ := HeaderFromContext(ctx); h.Get("X-Opaque-Id") != "req-1" {
+			t.Errorf("Expected the parent context not to be modified")
+		}
+	})
+}
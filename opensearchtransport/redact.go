@@ -0,0 +1,31 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchtransport
+
+import (
+	"github.com/alphastrikelabs/opensearch-go/v2/internal/redact"
+)
+
+// DefaultRedactedKeys returns the keys of the JSON values redacted by RedactBody when no keys are passed.
+//
+// They are used by the loggers which don't set RedactedKeys, and by the errors of the API responses.
+// The returned slice is a copy: to redact other keys, set RedactedKeys, eg. to append(DefaultRedactedKeys(), "token").
+func DefaultRedactedKeys() []string {
+	return redact.DefaultKeys()
+}
+
+// RedactBody returns a copy of the JSON body where the string values of the keys
+// are replaced with "***", eg. {"password":"***"}. The formatting of the body is preserved,
+// and bodies which are not JSON, eg. NDJSON, are redacted as well.
+//
+// DefaultRedactedKeys are used when keys is empty.
+func RedactBody(body []byte, keys []string) []byte {
+	return redact.Body(body, keys)
+}
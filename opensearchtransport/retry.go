@@ -0,0 +1,180 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchtransport
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptrace"
+	"sync/atomic"
+	"time"
+)
+
+// RetryErrorClass classifies the errors returned by the transport for the purpose of retrying.
+type RetryErrorClass int
+
+const (
+	// RetryErrorUnknown means the request might have been processed by the server.
+	RetryErrorUnknown RetryErrorClass = iota
+	// RetryErrorNotProcessed means the request has definitely not been processed by the server,
+	// because the connection could not be established or the request was not written completely.
+	RetryErrorNotProcessed
+)
+
+// String returns the name of the class.
+func (c RetryErrorClass) String() string {
+	switch c {
+	case RetryErrorNotProcessed:
+		return "not processed"
+	default:
+		return "unknown"
+	}
+}
+
+// ClassifyRetryError returns RetryErrorNotProcessed for errors raised while dialing the server,
+// and RetryErrorUnknown for any other error.
+//
+// It's used for transports which don't report the progress of the request
+// through net/http/httptrace, ie. other than *http.Transport.
+func ClassifyRetryError(err error) RetryErrorClass {
+	if err == nil {
+		return RetryErrorUnknown
+	}
+
+	var dnsErr *net.DNSError
+	if errors.As(err, &dnsErr) {
+		return RetryErrorNotProcessed
+	}
+
+	var opErr *net.OpError
+	if errors.As(err, &opErr) && opErr.Op == "dial" {
+		return RetryErrorNotProcessed
+	}
+
+	return RetryErrorUnknown
+}
+
+// Rejection classifies the 429 Too Many Requests responses for the purpose of retrying.
+type Rejection int
+
+const (
+	// RejectionUnknown means the cause of the rejection could not be determined.
+	RejectionUnknown Rejection = iota
+	// RejectionExecution means a thread pool of the server rejected the request because its queue is full,
+	// eg. rejected_execution_exception; the request usually succeeds when retried after a delay.
+	RejectionExecution
+	// RejectionCircuitBreaker means a circuit breaker of the server tripped, eg. for lack of memory;
+	// the request is likely to trip it again until the load of the server decreases.
+	RejectionCircuitBreaker
+)
+
+// String returns the name of the rejection.
+func (r Rejection) String() string {
+	switch r {
+	case RejectionExecution:
+		return "execution"
+	case RejectionCircuitBreaker:
+		return "circuit breaker"
+	default:
+		return "unknown"
+	}
+}
+
+// ClassifyRejection returns the Rejection of a 429 Too Many Requests response
+// from the error type in its body; the body is restored, so it can still be read.
+func ClassifyRejection(res *http.Response) Rejection {
+	if res == nil || res.Body == nil || res.StatusCode != http.StatusTooManyRequests {
+		return RejectionUnknown
+	}
+
+	body, err := ioutil.ReadAll(res.Body)
+	res.Body.Close()
+	if err != nil {
+		// Keep the error, eg. ErrResponseTooLarge, for the reader of the body
+		res.Body = ioutil.NopCloser(io.MultiReader(bytes.NewReader(body), errorReader{err: err}))
+		return RejectionUnknown
+	}
+	res.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	switch {
+	case bytes.Contains(body, []byte("circuit_breaking_exception")):
+		return RejectionCircuitBreaker
+	case bytes.Contains(body, []byte("rejected_execution_exception")):
+		return RejectionExecution
+	default:
+		return RejectionUnknown
+	}
+}
+
+// DefaultRetryOnRejection is the default of the RetryOnRejection option.
+//
+// It doesn't retry the requests rejected by a circuit breaker, and retries the other
+// rejections with an exponential backoff starting at 100ms, up to 10s.
+func DefaultRetryOnRejection(rejection Rejection, attempt int) (time.Duration, bool) {
+	if rejection == RejectionCircuitBreaker {
+		return 0, false
+	}
+
+	backoff := 100 * time.Millisecond
+	for i := 1; i < attempt && backoff < 10*time.Second; i++ {
+		backoff *= 2
+	}
+	if backoff > 10*time.Second {
+		backoff = 10 * time.Second
+	}
+	return backoff, true
+}
+
+// isIdempotent returns true for the HTTP methods which are safe to repeat.
+func isIdempotent(method string) bool {
+	switch method {
+	case "", http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+// requestProgress records how far the transport got with a request.
+type requestProgress struct {
+	started int32
+	written int32
+}
+
+// withRequestProgress returns a copy of req which records its progress into p.
+func withRequestProgress(ctx context.Context, req *http.Request, p *requestProgress) *http.Request {
+	trace := &httptrace.ClientTrace{
+		GetConn: func(string) {
+			atomic.StoreInt32(&p.started, 1)
+		},
+		WroteRequest: func(info httptrace.WroteRequestInfo) {
+			if info.Err == nil {
+				atomic.StoreInt32(&p.written, 1)
+			}
+		},
+	}
+	return req.WithContext(httptrace.WithClientTrace(ctx, trace))
+}
+
+// classify returns the class of err, taking into account whether the request has been written.
+func (p *requestProgress) classify(err error) RetryErrorClass {
+	if atomic.LoadInt32(&p.started) == 0 {
+		return ClassifyRetryError(err)
+	}
+	if atomic.LoadInt32(&p.written) == 0 {
+		return RetryErrorNotProcessed
+	}
+	return RetryErrorUnknown
+}
@@ -0,0 +1,291 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+//go:build !integration
+// +build !integration
+
+package opensearchtransport
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync/atomic"
+	"syscall"
+	"testing"
+	"time"
+)
+
+func TestClassifyRetryError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want RetryErrorClass
+	}{
+		{"Nil", nil, RetryErrorUnknown},
+		{"Dial", &net.OpError{Op: "dial", Err: syscall.ECONNREFUSED}, RetryErrorNotProcessed},
+		{"DNS", &net.DNSError{Err: "no such host", Name: "foo"}, RetryErrorNotProcessed},
+		{"Read", &net.OpError{Op: "read", Err: syscall.ECONNRESET}, RetryErrorUnknown},
+		{"EOF", io.EOF, RetryErrorUnknown},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ClassifyRetryError(tt.err); got != tt.want {
+				t.Errorf("Unexpected class: want=%s, got=%s", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestRetryOnlyUnprocessed(t *testing.T) {
+	// resetServer reads the request and closes the connection without responding,
+	// simulating a reset after the request has reached the server.
+	newResetServer := func(t *testing.T, numReqs *int32) *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(numReqs, 1)
+			ioutil.ReadAll(r.Body)
+			conn, _, err := w.(http.Hijacker).Hijack()
+			if err != nil {
+				t.Fatalf("Cannot hijack connection: %s", err)
+			}
+			conn.Close()
+		}))
+	}
+
+	newClient := func(t *testing.T, u string, tp http.RoundTripper, retryOnlyUnprocessed bool) *Client {
+		uu, _ := url.Parse(u)
+		c, err := New(Config{
+			URLs:                 []*url.URL{uu},
+			Transport:            tp,
+			MaxRetries:           2,
+			RetryOnlyUnprocessed: retryOnlyUnprocessed,
+		})
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		return c
+	}
+
+	t.Run("Reset after write, POST", func(t *testing.T) {
+		var numReqs int32
+		srv := newResetServer(t, &numReqs)
+		defer srv.Close()
+
+		c := newClient(t, srv.URL, &http.Transport{DisableKeepAlives: true}, true)
+		req, _ := http.NewRequest("POST", "/_bulk", strings.NewReader(`{"index":{}}`+"\n"+`{}`+"\n"))
+
+		if _, err := c.Perform(req); err == nil {
+			t.Fatalf("Expected error")
+		}
+		if n := atomic.LoadInt32(&numReqs); n != 1 {
+			t.Errorf("Expected no retries, got %d requests", n)
+		}
+	})
+
+	t.Run("Reset after write, POST, without the option", func(t *testing.T) {
+		var numReqs int32
+		srv := newResetServer(t, &numReqs)
+		defer srv.Close()
+
+		c := newClient(t, srv.URL, &http.Transport{DisableKeepAlives: true}, false)
+		req, _ := http.NewRequest("POST", "/_bulk", strings.NewReader(`{"index":{}}`+"\n"+`{}`+"\n"))
+
+		if _, err := c.Perform(req); err == nil {
+			t.Fatalf("Expected error")
+		}
+		if n := atomic.LoadInt32(&numReqs); n != 3 {
+			t.Errorf("Expected 2 retries, got %d requests", n)
+		}
+	})
+
+	t.Run("Reset after write, GET", func(t *testing.T) {
+		var numReqs int32
+		srv := newResetServer(t, &numReqs)
+		defer srv.Close()
+
+		c := newClient(t, srv.URL, &http.Transport{DisableKeepAlives: true}, true)
+		req, _ := http.NewRequest("GET", "/", nil)
+
+		if _, err := c.Perform(req); err == nil {
+			t.Fatalf("Expected error")
+		}
+		if n := atomic.LoadInt32(&numReqs); n != 3 {
+			t.Errorf("Expected 2 retries, got %d requests", n)
+		}
+	})
+
+	t.Run("Reset before write, POST", func(t *testing.T) {
+		var numDials int32
+		tp := &http.Transport{
+			DialContext: func(ctx context.Context, network, addr string) (net.Conn, error) {
+				atomic.AddInt32(&numDials, 1)
+				return nil, &net.OpError{Op: "dial", Net: network, Err: syscall.ECONNRESET}
+			},
+		}
+
+		c := newClient(t, "http://localhost:9200", tp, true)
+		req, _ := http.NewRequest("POST", "/_bulk", strings.NewReader(`{"index":{}}`+"\n"+`{}`+"\n"))
+
+		if _, err := c.Perform(req); err == nil {
+			t.Fatalf("Expected error")
+		}
+		if n := atomic.LoadInt32(&numDials); n != 3 {
+			t.Errorf("Expected 2 retries, got %d dials", n)
+		}
+	})
+
+	t.Run("Custom transport", func(t *testing.T) {
+		var numReqs int32
+		tp := &mockTransp{RoundTripFunc: func(req *http.Request) (*http.Response, error) {
+			if atomic.AddInt32(&numReqs, 1) == 1 {
+				return nil, &net.OpError{Op: "dial", Err: errors.New("connection refused")}
+			}
+			return nil, &net.OpError{Op: "read", Err: syscall.ECONNRESET}
+		}}
+
+		c := newClient(t, "http://localhost:9200", tp, true)
+		req, _ := http.NewRequest("POST", "/_bulk", strings.NewReader(`{}`))
+
+		if _, err := c.Perform(req); err == nil {
+			t.Fatalf("Expected error")
+		}
+		if n := atomic.LoadInt32(&numReqs); n != 2 {
+			t.Errorf("Expected 1 retry, got %d requests", n)
+		}
+	})
+}
+
+func TestClassifyRejection(t *testing.T) {
+	tests := []struct {
+		name   string
+		status int
+		body   string
+		want   Rejection
+	}{
+		{"Execution", 429, `{"error":{"type":"rejected_execution_exception"},"status":429}`, RejectionExecution},
+		{"Legacy execution", 429, `{"error":{"type":"es_rejected_execution_exception"},"status":429}`, RejectionExecution},
+		{"Circuit breaker", 429, `{"error":{"type":"circuit_breaking_exception"},"status":429}`, RejectionCircuitBreaker},
+		{"Unknown", 429, `Too Many Requests`, RejectionUnknown},
+		{"Other status", 503, `{"error":{"type":"circuit_breaking_exception"}}`, RejectionUnknown},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := &http.Response{StatusCode: tt.status, Body: ioutil.NopCloser(strings.NewReader(tt.body))}
+			if got := ClassifyRejection(res); got != tt.want {
+				t.Errorf("Unexpected rejection: want=%s, got=%s", tt.want, got)
+			}
+			if b, _ := ioutil.ReadAll(res.Body); string(b) != tt.body {
+				t.Errorf("Expected the body to be restored, got: %s", b)
+			}
+		})
+	}
+}
+
+func TestDefaultRetryOnRejection(t *testing.T) {
+	if _, retry := DefaultRetryOnRejection(RejectionCircuitBreaker, 1); retry {
+		t.Errorf("Expected circuit breaker trips not to be retried")
+	}
+
+	for attempt, want := range map[int]time.Duration{1: 100 * time.Millisecond, 3: 400 * time.Millisecond, 20: 10 * time.Second} {
+		backoff, retry := DefaultRetryOnRejection(RejectionExecution, attempt)
+		if !retry || backoff != want {
+			t.Errorf("Unexpected backoff for attempt %d: want=%s, got=%s, retry=%v", attempt, want, backoff, retry)
+		}
+	}
+}
+
+func TestRetryOnRejection(t *testing.T) {
+	newTransport := func(numReqs *int, body string, fn func(Rejection, int) (time.Duration, bool)) *Client {
+		u, _ := url.Parse("http://foo.bar")
+		tp, _ := New(Config{
+			URLs:             []*url.URL{u},
+			RetryOnRejection: fn,
+			Transport: &mockTransp{
+				RoundTripFunc: func(req *http.Request) (*http.Response, error) {
+					*numReqs++
+					return &http.Response{StatusCode: http.StatusTooManyRequests, Body: ioutil.NopCloser(strings.NewReader(body))}, nil
+				},
+			},
+		})
+		return tp
+	}
+
+	t.Run("Execution rejection", func(t *testing.T) {
+		var (
+			numReqs  int
+			attempts []int
+		)
+		tp := newTransport(&numReqs, `{"error":{"type":"rejected_execution_exception"}}`, func(r Rejection, attempt int) (time.Duration, bool) {
+			if r != RejectionExecution {
+				t.Errorf("Unexpected rejection: %s", r)
+			}
+			attempts = append(attempts, attempt)
+			return time.Millisecond, true
+		})
+
+		req, _ := http.NewRequest("POST", "/_bulk", strings.NewReader(`{}`))
+		res, err := tp.Perform(req)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if res.StatusCode != http.StatusTooManyRequests {
+			t.Errorf("Unexpected status: %d", res.StatusCode)
+		}
+		if numReqs != defaultMaxRetries+1 {
+			t.Errorf("Unexpected number of requests: %d", numReqs)
+		}
+		if len(attempts) != defaultMaxRetries+1 || attempts[0] != 1 {
+			t.Errorf("Unexpected attempts: %v", attempts)
+		}
+	})
+
+	t.Run("Circuit breaker", func(t *testing.T) {
+		var numReqs int
+		body := `{"error":{"type":"circuit_breaking_exception"}}`
+		tp := newTransport(&numReqs, body, nil)
+
+		req, _ := http.NewRequest("GET", "/_search", nil)
+		res, err := tp.Perform(req)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if numReqs != 1 {
+			t.Errorf("Expected the request not to be retried, got %d requests", numReqs)
+		}
+		if b, _ := ioutil.ReadAll(res.Body); string(b) != body {
+			t.Errorf("Unexpected body: %s", b)
+		}
+	})
+
+	t.Run("Context canceled during the backoff", func(t *testing.T) {
+		var numReqs int
+		tp := newTransport(&numReqs, `{"error":{"type":"rejected_execution_exception"}}`, func(Rejection, int) (time.Duration, bool) {
+			return time.Hour, true
+		})
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		req, _ := http.NewRequestWithContext(ctx, "GET", "/_search", nil)
+		res, err := tp.Perform(req)
+		if err != context.DeadlineExceeded || res != nil {
+			t.Errorf("Expected context.DeadlineExceeded, got: %v, %v", res, err)
+		}
+		if numReqs != 1 {
+			t.Errorf("Expected the request not to be retried, got %d requests", numReqs)
+		}
+	})
+}
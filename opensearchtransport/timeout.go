@@ -0,0 +1,80 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchtransport
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"sync"
+)
+
+// setReqTimeout sets the configured request timeout on the request context,
+// unless the context already has a deadline.
+//
+// It returns the request and a function to release the context, which must be called
+// with the response once the request has been performed.
+func (c *Client) setReqTimeout(req *http.Request) (*http.Request, func(*http.Response)) {
+	if c.requestTimeout <= 0 {
+		return req, func(*http.Response) {}
+	}
+
+	if _, ok := req.Context().Deadline(); ok {
+		return req, func(*http.Response) {}
+	}
+
+	ctx, cancel := context.WithTimeout(req.Context(), c.requestTimeout)
+	return req.WithContext(ctx), func(res *http.Response) {
+		// The context must stay alive until the response body is consumed
+		if res != nil && res.Body != nil && res.Body != http.NoBody {
+			res.Body = &cancelReadCloser{ReadCloser: res.Body, cancel: cancel}
+			return
+		}
+		cancel()
+	}
+}
+
+// cancelReadCloser cancels the request context when the response body is closed.
+type cancelReadCloser struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+	once   sync.Once
+}
+
+// Close closes the body and cancels the context.
+func (r *cancelReadCloser) Close() error {
+	err := r.ReadCloser.Close()
+	r.once.Do(r.cancel)
+	return err
+}
+
+// setResContext makes the reads of the response body fail with the context error
+// once the request context is done, including with custom transports which don't
+// interrupt the reads themselves.
+func setResContext(ctx context.Context, res *http.Response) {
+	if ctx.Done() == nil || res == nil || res.Body == nil || res.Body == http.NoBody {
+		return
+	}
+	res.Body = &contextReadCloser{ReadCloser: res.Body, ctx: ctx}
+}
+
+// contextReadCloser returns the context error on reads once the context is done.
+type contextReadCloser struct {
+	io.ReadCloser
+	ctx context.Context
+}
+
+// Read reads from the body, unless the context is done.
+func (r *contextReadCloser) Read(p []byte) (int, error) {
+	if err := r.ctx.Err(); err != nil {
+		return 0, err
+	}
+	return r.ReadCloser.Read(p)
+}
@@ -0,0 +1,39 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchutil
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/alphastrikelabs/opensearch-go/v2/opensearchapi"
+)
+
+// GetAuditConfig returns the current audit logging configuration of the cluster.
+//
+// The configuration can be compared field by field with a desired one,
+// and updated as a whole with the Audit Config Update API.
+func GetAuditConfig(ctx context.Context, client opensearchapi.Transport) (*opensearchapi.AuditConfig, error) {
+	res, err := opensearchapi.AuditConfigGetRequest{}.Do(ctx, client)
+	if err != nil {
+		return nil, fmt.Errorf("get audit config: %w", err)
+	}
+	defer res.Body.Close()
+
+	if err := res.Err(); err != nil {
+		return nil, fmt.Errorf("get audit config: %w", err)
+	}
+
+	var ar opensearchapi.AuditConfigGetResponse
+	if err := decodeBody(client, res.Body, &ar); err != nil {
+		return nil, fmt.Errorf("get audit config: error parsing response body: %s", err)
+	}
+	return &ar.Config, nil
+}
@@ -0,0 +1,56 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+//go:build !integration
+// +build !integration
+
+package opensearchutil
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/alphastrikelabs/opensearch-go/v2"
+	"github.com/alphastrikelabs/opensearch-go/v2/opensearchapi"
+)
+
+func TestGetAuditConfig(t *testing.T) {
+	newClient := func(status int, body string) *opensearch.Client {
+		client, _ := opensearch.NewClient(opensearch.Config{Transport: &mockTransport{
+			RoundTripFunc: func(req *http.Request) (*http.Response, error) {
+				return &http.Response{StatusCode: status, Body: ioutil.NopCloser(strings.NewReader(body))}, nil
+			},
+		}})
+		return client
+	}
+
+	t.Run("Config", func(t *testing.T) {
+		client := newClient(200, `{"config":{"enabled":true,"audit":{"enable_rest":true,"disabled_rest_categories":["AUTHENTICATED"]}}}`)
+
+		config, err := GetAuditConfig(context.Background(), client)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if !config.IsCategoryEnabled("FAILED_LOGIN") || config.IsCategoryEnabled("AUTHENTICATED") {
+			t.Errorf("Unexpected config: %+v", config)
+		}
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		client := newClient(403, `{"status":"FORBIDDEN","message":"no permissions"}`)
+
+		if _, err := GetAuditConfig(context.Background(), client); !errors.Is(err, opensearchapi.ErrForbidden) {
+			t.Errorf("Expected ErrForbidden, got: %v", err)
+		}
+	})
+}
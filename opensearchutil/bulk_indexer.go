@@ -44,7 +44,8 @@ import (
 
 // BulkIndexer represents a parallel, asynchronous, efficient indexer for OpenSearch.
 type BulkIndexer interface {
-	// Add adds an item to the indexer. It returns an error when the item cannot be added.
+	// Add adds an item to the indexer. It returns an error when the item cannot be added,
+	// eg. when the version and the version type are an invalid combination.
 	// Use the OnSuccess and OnFailure callbacks to get the operation result for the item.
 	//
 	// You must call the Close() method after you're done adding items.
@@ -64,6 +65,7 @@ type BulkIndexer interface {
 type BulkIndexerConfig struct {
 	NumWorkers    int           // The number of workers. Defaults to runtime.NumCPU().
 	FlushBytes    int           // The flush threshold in bytes. Defaults to 5MB.
+	FlushItems    int           // The flush threshold as number of items. Defaults to disabled.
 	FlushInterval time.Duration // The flush threshold as duration. Defaults to 30sec.
 
 	Client      *opensearch.Client      // The OpenSearch client.
@@ -182,6 +184,12 @@ type BulkIndexerResponseItem struct {
 	} `json:"error,omitempty"`
 }
 
+// IsVersionConflict returns true when the item failed because of a version conflict,
+// eg. when indexing a document with an external version lower than the stored one.
+func (i BulkIndexerResponseItem) IsVersionConflict() bool {
+	return i.Status == http.StatusConflict || i.Error.Type == "version_conflict_engine_exception"
+}
+
 // BulkResponseJSONDecoder defines the interface for custom JSON decoders.
 type BulkResponseJSONDecoder interface {
 	UnmarshalFromReader(io.Reader, *BulkIndexerResponse) error
@@ -251,6 +259,16 @@ func NewBulkIndexer(cfg BulkIndexerConfig) (BulkIndexer, error) {
 //
 // Adding an item after a call to Close() will panic.
 func (bi *bulkIndexer) Add(ctx context.Context, item BulkIndexerItem) error {
+	if item.Version != nil || item.VersionType != nil {
+		var versionType string
+		if item.VersionType != nil {
+			versionType = *item.VersionType
+		}
+		if err := opensearchapi.ValidateVersionType(versionType, item.Version != nil, item.DocumentID != ""); err != nil {
+			return err
+		}
+	}
+
 	atomic.AddUint64(&bi.stats.numAdded, 1)
 
 	select {
@@ -404,7 +422,8 @@ func (w *worker) run() {
 			}
 
 			w.items = append(w.items, item)
-			if w.buf.Len() >= w.bi.config.FlushBytes {
+			if w.buf.Len() >= w.bi.config.FlushBytes ||
+				(w.bi.config.FlushItems > 0 && len(w.items) >= w.bi.config.FlushItems) {
 				if err := w.flush(ctx); err != nil {
 					w.mu.Unlock()
 					if w.bi.config.OnError != nil {
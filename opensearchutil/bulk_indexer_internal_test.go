@@ -48,6 +48,7 @@ import (
 	"time"
 
 	"github.com/alphastrikelabs/opensearch-go/v2"
+	"github.com/alphastrikelabs/opensearch-go/v2/opensearchapi"
 	"github.com/alphastrikelabs/opensearch-go/v2/opensearchtransport"
 )
 
@@ -517,6 +518,119 @@ func TestBulkIndexer(t *testing.T) {
 		bi.Close(context.Background())
 	})
 
+	t.Run("Flush on item count", func(t *testing.T) {
+		var countReqs uint64
+
+		client, _ := opensearch.NewClient(opensearch.Config{Transport: &mockTransport{
+			RoundTripFunc: func(request *http.Request) (*http.Response, error) {
+				atomic.AddUint64(&countReqs, 1)
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Status:     "200 OK",
+					Body:       ioutil.NopCloser(strings.NewReader(`{"items":[{"index": {}},{"index": {}}]}`))}, nil
+			},
+		}})
+
+		cfg := BulkIndexerConfig{
+			NumWorkers:    1,
+			FlushItems:    2,
+			FlushInterval: time.Hour, // Disable auto-flushing
+			Client:        client,
+		}
+		if os.Getenv("DEBUG") != "" {
+			cfg.DebugLogger = log.New(os.Stdout, "", 0)
+		}
+
+		bi, _ := NewBulkIndexer(cfg)
+
+		for i := 0; i < 4; i++ {
+			bi.Add(context.Background(),
+				BulkIndexerItem{Action: "index", Body: strings.NewReader(`{"title":"foo"}`)})
+		}
+
+		if err := bi.Close(context.Background()); err != nil {
+			t.Errorf("Unexpected error: %s", err)
+		}
+
+		stats := bi.Stats()
+
+		if stats.NumRequests != 2 {
+			t.Errorf("Unexpected NumRequests: want=%d, got=%d", 2, stats.NumRequests)
+		}
+
+		if stats.NumFlushed != 4 {
+			t.Errorf("Unexpected NumFlushed: want=%d, got=%d", 4, stats.NumFlushed)
+		}
+
+		if n := atomic.LoadUint64(&countReqs); n != 2 {
+			t.Errorf("Unexpected number of requests: want=%d, got=%d", 2, n)
+		}
+	})
+
+	t.Run("External versioning", func(t *testing.T) {
+		var (
+			countConflicts uint64
+			metaLines      []string
+		)
+
+		client, _ := opensearch.NewClient(opensearch.Config{Transport: &mockTransport{
+			RoundTripFunc: func(request *http.Request) (*http.Response, error) {
+				body, _ := ioutil.ReadAll(request.Body)
+				metaLines = append(metaLines, strings.Split(string(body), "\n")[0])
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Status:     "200 OK",
+					Body: ioutil.NopCloser(strings.NewReader(`{"errors":true,"items":[{"index":{"_id":"1","status":409,` +
+						`"error":{"type":"version_conflict_engine_exception","reason":"[1]: version conflict, current version [5] is higher or equal to the one provided [3]"}}}]}`))}, nil
+			},
+		}})
+
+		bi, _ := NewBulkIndexer(BulkIndexerConfig{NumWorkers: 1, Client: client})
+
+		external := opensearchapi.VersionTypeExternal
+		version := int64(3)
+
+		for _, item := range []BulkIndexerItem{
+			{Action: "index", DocumentID: "1", VersionType: &external},
+			{Action: "index", Version: &version, VersionType: &external},
+			{Action: "index", DocumentID: "1", Version: &version},
+		} {
+			if err := bi.Add(context.Background(), item); err == nil {
+				t.Errorf("Expected error for invalid item: %+v", item)
+			}
+		}
+
+		err := bi.Add(context.Background(), BulkIndexerItem{
+			Action:      "index",
+			DocumentID:  "1",
+			Version:     &version,
+			VersionType: &external,
+			Body:        strings.NewReader(`{"title":"foo"}`),
+			OnFailure: func(ctx context.Context, item BulkIndexerItem, res BulkIndexerResponseItem, err error) {
+				if res.IsVersionConflict() {
+					atomic.AddUint64(&countConflicts, 1)
+				}
+			},
+		})
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+
+		if err := bi.Close(context.Background()); err != nil {
+			t.Errorf("Unexpected error: %s", err)
+		}
+
+		if len(metaLines) != 1 || metaLines[0] != `{"index":{"_id":"1","version":3,"version_type":"external"}}` {
+			t.Errorf("Unexpected meta: %q", metaLines)
+		}
+		if n := atomic.LoadUint64(&countConflicts); n != 1 {
+			t.Errorf("Unexpected number of conflicts: want=%d, got=%d", 1, n)
+		}
+		if stats := bi.Stats(); stats.NumAdded != 1 || stats.NumFailed != 1 {
+			t.Errorf("Unexpected stats: %+v", stats)
+		}
+	})
+
 	t.Run("TooManyRequests", func(t *testing.T) {
 		var (
 			wg sync.WaitGroup
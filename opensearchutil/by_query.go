@@ -0,0 +1,155 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchutil
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/alphastrikelabs/opensearch-go/v2/opensearchapi"
+)
+
+// ByQueryResponse represents the response of the Delete By Query and Update By Query APIs,
+// which is also the result of their asynchronous tasks.
+type ByQueryResponse struct {
+	Took             int64 `json:"took"`
+	TimedOut         bool  `json:"timed_out"`
+	Total            int64 `json:"total"`
+	Updated          int64 `json:"updated"`
+	Deleted          int64 `json:"deleted"`
+	Batches          int64 `json:"batches"`
+	VersionConflicts int64 `json:"version_conflicts"`
+	Noops            int64 `json:"noops"`
+	Retries          struct {
+		Bulk   int64 `json:"bulk"`
+		Search int64 `json:"search"`
+	} `json:"retries"`
+	ThrottledMillis   int64            `json:"throttled_millis"`
+	RequestsPerSecond float64          `json:"requests_per_second"`
+	Failures          []ByQueryFailure `json:"failures"`
+}
+
+// ByQueryFailure represents a failure of a Delete By Query or Update By Query operation:
+// either the failure of a document, with its ID, status and cause, eg. a version conflict,
+// or the failure of a search on a shard, with the shard, node and reason.
+type ByQueryFailure struct {
+	Index  string             `json:"index"`
+	ID     string             `json:"id"`
+	Status int                `json:"status"`
+	Cause  *opensearchapi.Err `json:"cause"`
+
+	Shard  *int               `json:"shard"`
+	Node   string             `json:"node"`
+	Reason *opensearchapi.Err `json:"reason"`
+}
+
+// String returns the document or shard, and the reason of the failure.
+func (f ByQueryFailure) String() string {
+	cause := f.Cause
+	if cause == nil {
+		cause = f.Reason
+	}
+	var reason string
+	if cause != nil {
+		reason = cause.Type + ": " + cause.Reason
+	}
+
+	if f.Shard != nil {
+		return fmt.Sprintf("[%s][%d] %s", f.Index, *f.Shard, reason)
+	}
+	return fmt.Sprintf("[%s][%s] %s", f.Index, f.ID, reason)
+}
+
+// DeleteByQuery performs the request synchronously and returns the response.
+//
+// When the operation stops on failures, eg. on a version conflict without conflicts set to "proceed",
+// the documents deleted until then are not restored: the response is returned with an error,
+// and lists the failures. With conflicts set to "proceed", the conflicts are only counted.
+func DeleteByQuery(ctx context.Context, client opensearchapi.Transport, req opensearchapi.DeleteByQueryRequest) (*ByQueryResponse, error) {
+	req.WaitForCompletion = nil
+	return doByQuery(ctx, client, req, "delete by query")
+}
+
+// UpdateByQuery performs the request synchronously and returns the response.
+//
+// When the operation stops on failures, eg. on a version conflict without conflicts set to "proceed",
+// the documents updated until then are not restored: the response is returned with an error,
+// and lists the failures. With conflicts set to "proceed", the conflicts are only counted.
+func UpdateByQuery(ctx context.Context, client opensearchapi.Transport, req opensearchapi.UpdateByQueryRequest) (*ByQueryResponse, error) {
+	req.WaitForCompletion = nil
+	return doByQuery(ctx, client, req, "update by query")
+}
+
+// StartDeleteByQuery submits the request asynchronously and returns the ID of the task.
+//
+// The result of the completed task, returned by the Tasks Get API, is a ByQueryResponse.
+func StartDeleteByQuery(ctx context.Context, client opensearchapi.Transport, req opensearchapi.DeleteByQueryRequest) (string, error) {
+	req.WaitForCompletion = opensearchapi.BoolPtr(false)
+	return startTask(ctx, client, req, "delete by query")
+}
+
+// StartUpdateByQuery submits the request asynchronously and returns the ID of the task.
+//
+// The result of the completed task, returned by the Tasks Get API, is a ByQueryResponse.
+func StartUpdateByQuery(ctx context.Context, client opensearchapi.Transport, req opensearchapi.UpdateByQueryRequest) (string, error) {
+	req.WaitForCompletion = opensearchapi.BoolPtr(false)
+	return startTask(ctx, client, req, "update by query")
+}
+
+// doByQuery performs the request and decodes the response, including the 409 responses
+// returned when the operation stops on version conflicts, which list the failures.
+//
+// The request is performed directly, as the APIs consume the body of the error responses.
+func doByQuery(ctx context.Context, client opensearchapi.Transport, req opensearchapi.Request, op string) (*ByQueryResponse, error) {
+	httpReq, err := opensearchapi.BuildRequest(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	res, err := client.Perform(httpReq)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK && res.StatusCode != http.StatusConflict {
+		r := opensearchapi.Response{StatusCode: res.StatusCode, Header: res.Header, Body: res.Body}
+		return nil, fmt.Errorf("%s: %w", op, r.Err())
+	}
+
+	var r ByQueryResponse
+	if err := decodeBody(client, res.Body, &r); err != nil {
+		return nil, fmt.Errorf("%s: error parsing response body: %s", op, err)
+	}
+
+	if len(r.Failures) > 0 {
+		return &r, fmt.Errorf("%s: %d failure(s), first: %s", op, len(r.Failures), r.Failures[0])
+	}
+	return &r, nil
+}
+
+// startTask performs the asynchronous request and returns the ID of the task.
+func startTask(ctx context.Context, client opensearchapi.Transport, req opensearchapi.Request, op string) (string, error) {
+	res, err := req.Do(ctx, client)
+	defer res.Close()
+	if err != nil {
+		return "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	var task struct {
+		Task string `json:"task"`
+	}
+	if err := decodeBody(client, res.Body, &task); err != nil {
+		return "", fmt.Errorf("%s: error parsing response body: %s", op, err)
+	}
+
+	return task.Task, nil
+}
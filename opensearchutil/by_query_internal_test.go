@@ -0,0 +1,128 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+//go:build !integration
+// +build !integration
+
+package opensearchutil
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/alphastrikelabs/opensearch-go/v2"
+	"github.com/alphastrikelabs/opensearch-go/v2/opensearchapi"
+)
+
+func TestDeleteByQuery(t *testing.T) {
+	var (
+		status int
+		body   string
+		query  string
+	)
+	client, _ := opensearch.NewClient(opensearch.Config{DisableRetry: true, Transport: &mockTransport{
+		RoundTripFunc: func(req *http.Request) (*http.Response, error) {
+			if req.Method != http.MethodPost || req.URL.Path != "/logs/_delete_by_query" {
+				t.Errorf("Unexpected request: %s %s", req.Method, req.URL.Path)
+			}
+			query = req.URL.RawQuery
+			return &http.Response{StatusCode: status, Body: ioutil.NopCloser(strings.NewReader(body))}, nil
+		},
+	}})
+
+	req := opensearchapi.DeleteByQueryRequest{
+		Index:             []string{"logs"},
+		Body:              strings.NewReader(`{"query":{"range":{"@timestamp":{"lt":"now-30d"}}}}`),
+		Conflicts:         "proceed",
+		Slices:            "auto",
+		ScrollSize:        opensearchapi.IntPtr(5000),
+		RequestsPerSecond: opensearchapi.IntPtr(500),
+	}
+
+	t.Run("Proceed", func(t *testing.T) {
+		status = http.StatusOK
+		body = `{"took":147,"timed_out":false,"total":120,"deleted":118,"batches":1,"version_conflicts":2,"noops":0,
+			"retries":{"bulk":1,"search":0},"throttled_millis":0,"requests_per_second":500.0,"failures":[]}`
+
+		r, err := DeleteByQuery(context.Background(), client, req)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if r.Deleted != 118 || r.VersionConflicts != 2 || r.Retries.Bulk != 1 || r.RequestsPerSecond != 500 {
+			t.Errorf("Unexpected response: %+v", r)
+		}
+		if query != "conflicts=proceed&requests_per_second=500&scroll_size=5000&slices=auto" {
+			t.Errorf("Unexpected query: %s", query)
+		}
+	})
+
+	t.Run("Fractional throttle", func(t *testing.T) {
+		status = http.StatusOK
+		body = `{"took":147,"total":0,"requests_per_second":0.5,"failures":[]}`
+
+		rps := 0.5
+		r := req
+		r.Body = nil
+		r.RequestsPerSecondFloat = &rps
+
+		if _, err := DeleteByQuery(context.Background(), client, r); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if query != "conflicts=proceed&requests_per_second=0.5&scroll_size=5000&slices=auto" {
+			t.Errorf("Unexpected query: %s", query)
+		}
+	})
+
+	t.Run("Conflict", func(t *testing.T) {
+		status = http.StatusConflict
+		body = `{"took":12,"timed_out":false,"total":120,"deleted":40,"batches":1,"version_conflicts":1,"failures":[
+			{"index":"logs","id":"42","status":409,"cause":{"type":"version_conflict_engine_exception","reason":"[42]: version conflict"}}]}`
+
+		r, err := DeleteByQuery(context.Background(), client, opensearchapi.DeleteByQueryRequest{Index: []string{"logs"}})
+		if err == nil || !strings.Contains(err.Error(), "[logs][42] version_conflict_engine_exception") {
+			t.Errorf("Unexpected error: %v", err)
+		}
+		if r == nil || r.Deleted != 40 || len(r.Failures) != 1 || r.Failures[0].Status != http.StatusConflict {
+			t.Errorf("Unexpected response: %+v", r)
+		}
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		status = http.StatusNotFound
+		body = `{"error":{"type":"index_not_found_exception","reason":"no such index [logs]"},"status":404}`
+
+		if r, err := DeleteByQuery(context.Background(), client, opensearchapi.DeleteByQueryRequest{Index: []string{"logs"}}); r != nil || !errors.Is(err, opensearchapi.ErrNotFound) {
+			t.Errorf("Unexpected result: %+v, %v", r, err)
+		}
+	})
+}
+
+func TestStartUpdateByQuery(t *testing.T) {
+	client, _ := opensearch.NewClient(opensearch.Config{Transport: &mockTransport{
+		RoundTripFunc: func(req *http.Request) (*http.Response, error) {
+			if req.URL.Path != "/logs/_update_by_query" || req.URL.Query().Get("wait_for_completion") != "false" || req.URL.Query().Get("requests_per_second") != "2.5" {
+				t.Errorf("Unexpected request: %s %s", req.Method, req.URL)
+			}
+			return &http.Response{StatusCode: http.StatusOK, Body: ioutil.NopCloser(strings.NewReader(`{"task":"n1:42"}`))}, nil
+		},
+	}})
+
+	rps := 2.5
+	taskID, err := StartUpdateByQuery(context.Background(), client, opensearchapi.UpdateByQueryRequest{Index: []string{"logs"}, RequestsPerSecondFloat: &rps})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if taskID != "n1:42" {
+		t.Errorf("Unexpected task ID: %s", taskID)
+	}
+}
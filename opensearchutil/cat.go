@@ -0,0 +1,58 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchutil
+
+import (
+	"context"
+
+	"github.com/alphastrikelabs/opensearch-go/v2/opensearchapi"
+)
+
+// catFormat is the format of the Cat API responses decoded by the typed helpers.
+const catFormat = "json"
+
+// CatIndices performs the Cat Indices request in the JSON format and decodes the rows.
+//
+// The format of the request is always set to JSON; the other parameters are kept,
+// eg. the columns selected with H.
+func CatIndices(ctx context.Context, client opensearchapi.Transport, req opensearchapi.CatIndicesRequest) ([]opensearchapi.CatIndicesItem, error) {
+	req.Format = catFormat
+	res, err := req.Do(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	return opensearchapi.ParseCatIndices(res)
+}
+
+// CatNodes performs the Cat Nodes request in the JSON format and decodes the rows.
+//
+// The format of the request is always set to JSON; the other parameters are kept,
+// eg. the columns selected with H.
+func CatNodes(ctx context.Context, client opensearchapi.Transport, req opensearchapi.CatNodesRequest) ([]opensearchapi.CatNodesItem, error) {
+	req.Format = catFormat
+	res, err := req.Do(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	return opensearchapi.ParseCatNodes(res)
+}
+
+// CatShards performs the Cat Shards request in the JSON format and decodes the rows.
+//
+// The format of the request is always set to JSON; the other parameters are kept,
+// eg. the columns selected with H.
+func CatShards(ctx context.Context, client opensearchapi.Transport, req opensearchapi.CatShardsRequest) ([]opensearchapi.CatShardsItem, error) {
+	req.Format = catFormat
+	res, err := req.Do(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	return opensearchapi.ParseCatShards(res)
+}
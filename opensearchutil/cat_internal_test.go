@@ -0,0 +1,94 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+//go:build !integration
+// +build !integration
+
+package opensearchutil
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/alphastrikelabs/opensearch-go/v2"
+	"github.com/alphastrikelabs/opensearch-go/v2/opensearchapi"
+)
+
+func TestCat(t *testing.T) {
+	var query string
+	client, _ := opensearch.NewClient(opensearch.Config{Transport: &mockTransport{
+		RoundTripFunc: func(req *http.Request) (*http.Response, error) {
+			query = req.URL.RawQuery
+			if req.URL.Query().Get("format") != "json" {
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Header:     http.Header{"Content-Type": []string{"text/plain; charset=UTF-8"}},
+					Body:       ioutil.NopCloser(strings.NewReader("green open test\n")),
+				}, nil
+			}
+
+			var body string
+			switch req.URL.Path {
+			case "/_cat/indices/test":
+				body = `[{"health":"green","status":"open","index":"test","docs.count":"42"}]`
+			case "/_cat/nodes":
+				body = `[{"name":"node-1","cluster_manager":"*"}]`
+			case "/_cat/shards":
+				body = `[{"index":"test","shard":"0","prirep":"p","state":"STARTED"}]`
+			default:
+				t.Errorf("Unexpected request: %s %s", req.Method, req.URL.Path)
+			}
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     http.Header{"Content-Type": []string{"application/json; charset=UTF-8"}},
+				Body:       ioutil.NopCloser(strings.NewReader(body)),
+			}, nil
+		},
+	}})
+
+	t.Run("Indices", func(t *testing.T) {
+		items, err := CatIndices(context.Background(), client, opensearchapi.CatIndicesRequest{
+			Index:  []string{"test"},
+			Format: "txt",
+			H:      []string{"health", "status", "index", "docs.count"},
+		})
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if len(items) != 1 || items[0].Index != "test" || items[0].DocsCount != "42" {
+			t.Errorf("Unexpected items: %+v", items)
+		}
+		if !strings.Contains(query, "h=health%2Cstatus%2Cindex%2Cdocs.count") {
+			t.Errorf("Expected the columns to be kept, got query: %s", query)
+		}
+	})
+
+	t.Run("Nodes", func(t *testing.T) {
+		items, err := CatNodes(context.Background(), client, opensearchapi.CatNodesRequest{})
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if len(items) != 1 || items[0].Name != "node-1" || items[0].ClusterManager != "*" {
+			t.Errorf("Unexpected items: %+v", items)
+		}
+	})
+
+	t.Run("Shards", func(t *testing.T) {
+		items, err := CatShards(context.Background(), client, opensearchapi.CatShardsRequest{})
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if len(items) != 1 || items[0].PriRep != "p" || items[0].State != "STARTED" {
+			t.Errorf("Unexpected items: %+v", items)
+		}
+	})
+}
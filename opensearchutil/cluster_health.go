@@ -0,0 +1,104 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchutil
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"time"
+
+	"github.com/alphastrikelabs/opensearch-go/v2/opensearchapi"
+)
+
+// clusterStatusRanks orders the cluster health statuses.
+var clusterStatusRanks = map[string]int{"red": 1, "yellow": 2, "green": 3}
+
+// clusterHealthPollInterval is the delay between the requests, eg. when the cluster is not available yet.
+var clusterHealthPollInterval = time.Second
+
+// ClusterStatusTimeoutError is returned by WaitForClusterStatus when the cluster
+// has not reached the status within the timeout.
+type ClusterStatusTimeoutError struct {
+	Status     string // The requested status
+	LastStatus string // The last status returned by the cluster, empty when the cluster has not returned any
+}
+
+// Error returns the requested and the last status.
+func (e *ClusterStatusTimeoutError) Error() string {
+	last := e.LastStatus
+	if last == "" {
+		last = "unknown"
+	}
+	return fmt.Sprintf("cluster status %q not reached within the timeout, last status: %s", e.Status, last)
+}
+
+// WaitForClusterStatus waits until the cluster health status is at least status,
+// eg. "yellow" is reached by the "yellow" and "green" clusters.
+//
+// A *ClusterStatusTimeoutError is returned when the status is not reached within the timeout,
+// and the errors of the transport, eg. when the cluster cannot be reached, are returned as is.
+// The error responses, eg. while the cluster is forming, are retried until the timeout.
+func WaitForClusterStatus(ctx context.Context, client opensearchapi.Transport, status string, timeout time.Duration) error {
+	want, ok := clusterStatusRanks[status]
+	if !ok {
+		return fmt.Errorf("wait for cluster status: invalid status %q", status)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	deadline, _ := ctx.Deadline()
+
+	var last string
+	for {
+		remaining := time.Until(deadline).Truncate(time.Millisecond)
+		if remaining <= 0 {
+			return &ClusterStatusTimeoutError{Status: status, LastStatus: last}
+		}
+
+		// The request is performed directly, as the API consumes the body of the 408 responses,
+		// returned when the status is not reached within the timeout
+		req, err := opensearchapi.BuildRequest(ctx, opensearchapi.ClusterHealthRequest{WaitForStatus: status, Timeout: remaining})
+		if err != nil {
+			return fmt.Errorf("wait for cluster status: %w", err)
+		}
+		res, err := client.Perform(req)
+		if err != nil {
+			if ctx.Err() == context.DeadlineExceeded {
+				return &ClusterStatusTimeoutError{Status: status, LastStatus: last}
+			}
+			return fmt.Errorf("wait for cluster status: %w", err)
+		}
+
+		var health struct {
+			Status string `json:"status"`
+		}
+		err = decodeBody(client, res.Body, &health)
+		io.Copy(ioutil.Discard, res.Body)
+		res.Body.Close()
+
+		if err == nil && health.Status != "" {
+			last = health.Status
+			if clusterStatusRanks[last] >= want {
+				return nil
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			if ctx.Err() == context.DeadlineExceeded {
+				return &ClusterStatusTimeoutError{Status: status, LastStatus: last}
+			}
+			return fmt.Errorf("wait for cluster status: %w", ctx.Err())
+		case <-time.After(clusterHealthPollInterval):
+		}
+	}
+}
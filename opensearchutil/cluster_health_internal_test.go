@@ -0,0 +1,97 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+//go:build !integration
+// +build !integration
+
+package opensearchutil
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alphastrikelabs/opensearch-go/v2"
+)
+
+func TestWaitForClusterStatus(t *testing.T) {
+	clusterHealthPollInterval = 10 * time.Millisecond
+	defer func() { clusterHealthPollInterval = time.Second }()
+
+	newClient := func(fn func(n int, req *http.Request) (*http.Response, error)) *opensearch.Client {
+		var n int
+		client, _ := opensearch.NewClient(opensearch.Config{
+			DisableRetry: true,
+			Transport: &mockTransport{RoundTripFunc: func(req *http.Request) (*http.Response, error) {
+				n++
+				return fn(n, req)
+			}},
+		})
+		return client
+	}
+	health := func(code int, status string) *http.Response {
+		return &http.Response{StatusCode: code, Body: ioutil.NopCloser(strings.NewReader(fmt.Sprintf(`{"status":%q}`, status)))}
+	}
+
+	t.Run("Reached after unavailable", func(t *testing.T) {
+		client := newClient(func(n int, req *http.Request) (*http.Response, error) {
+			if q := req.URL.Query(); q.Get("wait_for_status") != "yellow" || q.Get("timeout") == "" {
+				t.Errorf("Unexpected query: %s", req.URL.RawQuery)
+			}
+			if n == 1 {
+				return &http.Response{StatusCode: http.StatusServiceUnavailable, Body: ioutil.NopCloser(strings.NewReader(`{}`))}, nil
+			}
+			return health(http.StatusOK, "green"), nil
+		})
+
+		if err := WaitForClusterStatus(context.Background(), client, "yellow", time.Second); err != nil {
+			t.Errorf("Unexpected error: %s", err)
+		}
+	})
+
+	t.Run("Timeout", func(t *testing.T) {
+		client := newClient(func(n int, req *http.Request) (*http.Response, error) {
+			return health(http.StatusRequestTimeout, "yellow"), nil
+		})
+
+		err := WaitForClusterStatus(context.Background(), client, "green", 50*time.Millisecond)
+
+		var timeoutErr *ClusterStatusTimeoutError
+		if !errors.As(err, &timeoutErr) {
+			t.Fatalf("Expected ClusterStatusTimeoutError, got: %v", err)
+		}
+		if timeoutErr.LastStatus != "yellow" {
+			t.Errorf("Unexpected last status: %q", timeoutErr.LastStatus)
+		}
+	})
+
+	t.Run("Transport error", func(t *testing.T) {
+		client := newClient(func(n int, req *http.Request) (*http.Response, error) {
+			return nil, errors.New("connection refused")
+		})
+
+		err := WaitForClusterStatus(context.Background(), client, "green", time.Second)
+
+		var timeoutErr *ClusterStatusTimeoutError
+		if err == nil || errors.As(err, &timeoutErr) {
+			t.Errorf("Expected transport error, got: %v", err)
+		}
+	})
+
+	t.Run("Invalid status", func(t *testing.T) {
+		if err := WaitForClusterStatus(context.Background(), nil, "blue", time.Second); err == nil {
+			t.Errorf("Expected error")
+		}
+	})
+}
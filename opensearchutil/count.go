@@ -0,0 +1,61 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchutil
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/alphastrikelabs/opensearch-go/v2/opensearchapi"
+)
+
+// Count returns the number of documents in the indices matching index which match the query body,
+// or all the documents when body is nil.
+//
+// The options of the Count API can be passed, eg. to terminate early:
+//
+//	n, err := opensearchutil.Count(ctx, client, "logs-*", opensearchutil.NewJSONReader(query),
+//		client.Count.WithTerminateAfter(1000),
+//		client.Count.WithPreference("_local"),
+//	)
+func Count(
+	ctx context.Context,
+	client opensearchapi.Transport,
+	index string,
+	body io.Reader,
+	o ...func(*opensearchapi.CountRequest),
+) (int64, error) {
+	req := opensearchapi.CountRequest{Body: body}
+	for _, f := range o {
+		f(&req)
+	}
+	if index != "" {
+		req.Index = []string{index}
+	}
+
+	res, err := req.Do(ctx, client)
+	if err != nil {
+		return 0, fmt.Errorf("count: %w", err)
+	}
+	defer res.Body.Close()
+
+	var cr struct {
+		Count *int64 `json:"count"`
+	}
+	if err := decodeBody(client, res.Body, &cr); err != nil {
+		return 0, fmt.Errorf("count: error parsing response body: %s", err)
+	}
+	if cr.Count == nil {
+		return 0, fmt.Errorf("count: missing count in response body")
+	}
+
+	return *cr.Count, nil
+}
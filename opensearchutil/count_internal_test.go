@@ -0,0 +1,84 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+//go:build !integration
+// +build !integration
+
+package opensearchutil
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/alphastrikelabs/opensearch-go/v2"
+)
+
+func TestCount(t *testing.T) {
+	t.Run("Query", func(t *testing.T) {
+		client, _ := opensearch.NewClient(opensearch.Config{Transport: &mockTransport{
+			RoundTripFunc: func(req *http.Request) (*http.Response, error) {
+				if req.Method != "POST" || req.URL.Path != "/logs-*/_count" {
+					t.Errorf("Unexpected request: %s %s", req.Method, req.URL.Path)
+				}
+				if req.URL.RawQuery != "preference=_local&terminate_after=100" {
+					t.Errorf("Unexpected query: %s", req.URL.RawQuery)
+				}
+				body, _ := ioutil.ReadAll(req.Body)
+				if string(body) != `{"query":{"term":{"level":"error"}}}` {
+					t.Errorf("Unexpected body: %s", body)
+				}
+				resBody := `{"count":42,"terminated_early":false,"_shards":{"total":1,"successful":1,"skipped":0,"failed":0}}`
+				return &http.Response{StatusCode: http.StatusOK, Body: ioutil.NopCloser(strings.NewReader(resBody))}, nil
+			},
+		}})
+
+		n, err := Count(context.Background(), client, "logs-*", strings.NewReader(`{"query":{"term":{"level":"error"}}}`),
+			client.Count.WithTerminateAfter(100),
+			client.Count.WithPreference("_local"),
+		)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if n != 42 {
+			t.Errorf("Unexpected count: %d", n)
+		}
+	})
+
+	t.Run("All documents", func(t *testing.T) {
+		client, _ := opensearch.NewClient(opensearch.Config{Transport: &mockTransport{
+			RoundTripFunc: func(req *http.Request) (*http.Response, error) {
+				if req.URL.Path != "/_count" || req.Body != nil {
+					t.Errorf("Unexpected request: %s %s", req.Method, req.URL.Path)
+				}
+				return &http.Response{StatusCode: http.StatusOK, Body: ioutil.NopCloser(strings.NewReader(`{"count":0}`))}, nil
+			},
+		}})
+
+		n, err := Count(context.Background(), client, "", nil)
+		if err != nil || n != 0 {
+			t.Errorf("Unexpected result: %d, %v", n, err)
+		}
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		client, _ := opensearch.NewClient(opensearch.Config{Transport: &mockTransport{
+			RoundTripFunc: func(req *http.Request) (*http.Response, error) {
+				resBody := `{"error":{"type":"index_not_found_exception","reason":"no such index [logs]"},"status":404}`
+				return &http.Response{StatusCode: http.StatusNotFound, Body: ioutil.NopCloser(strings.NewReader(resBody))}, nil
+			},
+		}})
+
+		if _, err := Count(context.Background(), client, "logs", nil); err == nil {
+			t.Errorf("Expected error for the missing index")
+		}
+	})
+}
@@ -0,0 +1,59 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchutil
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/alphastrikelabs/opensearch-go/v2/opensearchapi"
+)
+
+// IndexExists returns true when the index exists, and false when it doesn't.
+//
+// Responses other than 200 and 404 are returned as an error.
+func IndexExists(ctx context.Context, client opensearchapi.Transport, index string) (bool, error) {
+	if index == "" {
+		return false, errors.New("index exists: index is required")
+	}
+	return exists(ctx, client, opensearchapi.IndicesExistsRequest{Index: []string{index}})
+}
+
+// DocumentExists returns true when the document exists in the index, and false when it doesn't.
+//
+// Responses other than 200 and 404 are returned as an error.
+func DocumentExists(ctx context.Context, client opensearchapi.Transport, index, id string) (bool, error) {
+	if index == "" || id == "" {
+		return false, errors.New("document exists: index and document ID are required")
+	}
+	return exists(ctx, client, opensearchapi.ExistsRequest{Index: index, DocumentID: id})
+}
+
+// exists performs the request and translates the response status, closing the response body.
+func exists(ctx context.Context, client opensearchapi.Transport, req opensearchapi.Request) (bool, error) {
+	res, err := req.Do(ctx, client)
+	if res == nil {
+		return false, err
+	}
+	defer res.Body.Close()
+
+	switch res.StatusCode {
+	case http.StatusOK:
+		return true, nil
+	case http.StatusNotFound:
+		return false, nil
+	default:
+		if err == nil {
+			err = res.Err()
+		}
+		return false, err
+	}
+}
@@ -0,0 +1,83 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+//go:build !integration
+// +build !integration
+
+package opensearchutil
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/alphastrikelabs/opensearch-go/v2"
+)
+
+func TestExists(t *testing.T) {
+	var closed int
+	client, _ := opensearch.NewClient(opensearch.Config{Transport: &mockTransport{
+		RoundTripFunc: func(req *http.Request) (*http.Response, error) {
+			if req.Method != "HEAD" {
+				t.Errorf("Unexpected method: %s", req.Method)
+			}
+
+			status := http.StatusInternalServerError
+			switch req.URL.Path {
+			case "/found", "/found/_doc/1":
+				status = http.StatusOK
+			case "/missing", "/found/_doc/2":
+				status = http.StatusNotFound
+			}
+			return &http.Response{StatusCode: status, Body: &closeCounter{Reader: strings.NewReader(""), n: &closed}}, nil
+		},
+	}})
+
+	tests := []struct {
+		name    string
+		fn      func() (bool, error)
+		want    bool
+		wantErr bool
+	}{
+		{"Index found", func() (bool, error) { return IndexExists(context.Background(), client, "found") }, true, false},
+		{"Index missing", func() (bool, error) { return IndexExists(context.Background(), client, "missing") }, false, false},
+		{"Index error", func() (bool, error) { return IndexExists(context.Background(), client, "broken") }, false, true},
+		{"Index empty", func() (bool, error) { return IndexExists(context.Background(), client, "") }, false, true},
+		{"Document found", func() (bool, error) { return DocumentExists(context.Background(), client, "found", "1") }, true, false},
+		{"Document missing", func() (bool, error) { return DocumentExists(context.Background(), client, "found", "2") }, false, false},
+		{"Document error", func() (bool, error) { return DocumentExists(context.Background(), client, "found", "3") }, false, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ok, err := tt.fn()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Unexpected error: %v", err)
+			}
+			if ok != tt.want {
+				t.Errorf("want=%t, got=%t", tt.want, ok)
+			}
+		})
+	}
+
+	if closed != 6 {
+		t.Errorf("Expected the response bodies to be closed, got %d closed", closed)
+	}
+}
+
+type closeCounter struct {
+	*strings.Reader
+	n *int
+}
+
+func (c *closeCounter) Close() error {
+	*c.n++
+	return nil
+}
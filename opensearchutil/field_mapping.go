@@ -0,0 +1,95 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchutil
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/alphastrikelabs/opensearch-go/v2/opensearchapi"
+)
+
+// FieldMapping represents the mapping of a field in an index.
+type FieldMapping struct {
+	FullName string                 // The full path of the field, eg. "user.name"
+	Type     string                 // The type of the field, eg. "keyword"; empty for the object fields
+	Mapping  map[string]interface{} // The mapping parameters of the field, including the type
+}
+
+// fieldMappingResponse represents the response of the Indices Get Field Mapping API.
+type fieldMappingResponse map[string]struct {
+	Mappings map[string]struct {
+		FullName string                            `json:"full_name"`
+		Mapping  map[string]map[string]interface{} `json:"mapping"`
+	} `json:"mappings"`
+}
+
+// GetFieldMapping returns the mapping of field in the indices matching index, keyed by the index name.
+//
+// The field is a full path, eg. "user.name", or the name of a multi-field, eg. "title.raw";
+// the wildcards are not supported. The indices where the field is not mapped are omitted.
+func GetFieldMapping(ctx context.Context, client opensearchapi.Transport, index, field string) (map[string]FieldMapping, error) {
+	if field == "" || strings.ContainsAny(field, "*,") {
+		return nil, fmt.Errorf("get field mapping: invalid field %q", field)
+	}
+
+	req := opensearchapi.IndicesGetFieldMappingRequest{Index: []string{index}, Fields: []string{field}}
+	res, err := req.Do(ctx, client)
+	if err != nil {
+		return nil, fmt.Errorf("get field mapping: %w", err)
+	}
+	defer res.Body.Close()
+
+	var fmr fieldMappingResponse
+	if err := decodeBody(client, res.Body, &fmr); err != nil {
+		return nil, fmt.Errorf("get field mapping: error parsing response body: %s", err)
+	}
+
+	mappings := make(map[string]FieldMapping, len(fmr))
+	for idx, im := range fmr {
+		m, ok := im.Mappings[field]
+		if !ok {
+			continue
+		}
+		fm := FieldMapping{FullName: m.FullName}
+		// The mapping is keyed by the last segment of the field name
+		for _, params := range m.Mapping {
+			fm.Mapping = params
+			if t, ok := params["type"].(string); ok {
+				fm.Type = t
+			}
+		}
+		mappings[idx] = fm
+	}
+
+	return mappings, nil
+}
+
+// FieldType returns the type of field in the indices matching index, eg. "text" or "keyword".
+//
+// An empty string is returned when the field is not mapped, and an error
+// when the field is mapped with different types in the indices.
+func FieldType(ctx context.Context, client opensearchapi.Transport, index, field string) (string, error) {
+	mappings, err := GetFieldMapping(ctx, client, index, field)
+	if err != nil {
+		return "", err
+	}
+
+	var fieldType, fieldIndex string
+	for idx, fm := range mappings {
+		if fieldType != "" && fm.Type != fieldType {
+			return "", fmt.Errorf("field type: field %q is mapped as %q in %q and as %q in %q", field, fieldType, fieldIndex, fm.Type, idx)
+		}
+		fieldType, fieldIndex = fm.Type, idx
+	}
+
+	return fieldType, nil
+}
@@ -0,0 +1,94 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+//go:build !integration
+// +build !integration
+
+package opensearchutil
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/alphastrikelabs/opensearch-go/v2"
+)
+
+func TestGetFieldMapping(t *testing.T) {
+	newClient := func(t *testing.T, resBody string) *opensearch.Client {
+		client, _ := opensearch.NewClient(opensearch.Config{Transport: &mockTransport{
+			RoundTripFunc: func(req *http.Request) (*http.Response, error) {
+				if req.Method != "GET" || req.URL.Path != "/logs-*/_mapping/field/user.name" {
+					t.Errorf("Unexpected request: %s %s", req.Method, req.URL.Path)
+				}
+				return &http.Response{StatusCode: http.StatusOK, Body: ioutil.NopCloser(strings.NewReader(resBody))}, nil
+			},
+		}})
+		return client
+	}
+
+	t.Run("Mappings", func(t *testing.T) {
+		client := newClient(t, `{
+			"logs-1":{"mappings":{"user.name":{"full_name":"user.name","mapping":{"name":{"type":"keyword","ignore_above":256}}}}},
+			"logs-2":{"mappings":{}}}`)
+
+		mappings, err := GetFieldMapping(context.Background(), client, "logs-*", "user.name")
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if len(mappings) != 1 {
+			t.Fatalf("Unexpected mappings: %+v", mappings)
+		}
+		fm := mappings["logs-1"]
+		if fm.FullName != "user.name" || fm.Type != "keyword" || fm.Mapping["ignore_above"] != float64(256) {
+			t.Errorf("Unexpected mapping: %+v", fm)
+		}
+	})
+
+	t.Run("Type", func(t *testing.T) {
+		client := newClient(t, `{
+			"logs-1":{"mappings":{"user.name":{"full_name":"user.name","mapping":{"name":{"type":"text"}}}}},
+			"logs-2":{"mappings":{"user.name":{"full_name":"user.name","mapping":{"name":{"type":"text"}}}}}}`)
+
+		ft, err := FieldType(context.Background(), client, "logs-*", "user.name")
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if ft != "text" {
+			t.Errorf("Unexpected type: %q", ft)
+		}
+	})
+
+	t.Run("Not mapped", func(t *testing.T) {
+		client := newClient(t, `{"logs-1":{"mappings":{}}}`)
+
+		ft, err := FieldType(context.Background(), client, "logs-*", "user.name")
+		if err != nil || ft != "" {
+			t.Errorf("Unexpected result: %q, %v", ft, err)
+		}
+	})
+
+	t.Run("Conflicting types", func(t *testing.T) {
+		client := newClient(t, `{
+			"logs-1":{"mappings":{"user.name":{"full_name":"user.name","mapping":{"name":{"type":"text"}}}}},
+			"logs-2":{"mappings":{"user.name":{"full_name":"user.name","mapping":{"name":{"type":"keyword"}}}}}}`)
+
+		if _, err := FieldType(context.Background(), client, "logs-*", "user.name"); err == nil {
+			t.Errorf("Expected error for the conflicting types")
+		}
+	})
+
+	t.Run("Invalid field", func(t *testing.T) {
+		if _, err := GetFieldMapping(context.Background(), newClient(t, `{}`), "logs-*", "user.*"); err == nil {
+			t.Errorf("Expected error for the wildcard field")
+		}
+	})
+}
@@ -0,0 +1,128 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchutil
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// MatchIndexPattern returns whether the index matches the pattern, with the rules of the security plugin:
+//
+//   - a pattern starting and ending with a slash, eg. "/logs-[0-9]+/", is a regular expression,
+//     which must match the whole index name;
+//   - a pattern with "*" or "?" is a wildcard pattern, where "*" matches any sequence of characters,
+//     including an empty one, and "?" any single character;
+//   - any other pattern must be equal to the index name.
+//
+// The matching is case-sensitive. The regular expressions use the Go syntax, which is close to
+// the Java one for the common constructs, but without lookarounds and backreferences;
+// an invalid regular expression matches no index.
+//
+// The pattern is parsed on each call; use an IndexPatternMatcher to match several indices.
+func MatchIndexPattern(pattern, index string) bool {
+	if isRegexpPattern(pattern) {
+		re, err := compileRegexpPattern(pattern)
+		return err == nil && re.MatchString(index)
+	}
+	if !isWildcardPattern(pattern) {
+		return pattern == index
+	}
+	return matchWildcard(pattern, index)
+}
+
+// IndexPatternMatcher matches the indices against a list of patterns, with the rules of MatchIndexPattern.
+// An index matches when it matches any of the patterns.
+//
+// It's safe for concurrent use.
+type IndexPatternMatcher struct {
+	exact     map[string]bool
+	wildcards []string
+	regexps   []*regexp.Regexp
+}
+
+// NewIndexPatternMatcher compiles the patterns, and returns an error when a regular expression is invalid.
+func NewIndexPatternMatcher(patterns ...string) (*IndexPatternMatcher, error) {
+	m := IndexPatternMatcher{exact: make(map[string]bool)}
+	for _, pattern := range patterns {
+		switch {
+		case isRegexpPattern(pattern):
+			re, err := compileRegexpPattern(pattern)
+			if err != nil {
+				return nil, fmt.Errorf("invalid index pattern %q: %s", pattern, err)
+			}
+			m.regexps = append(m.regexps, re)
+		case isWildcardPattern(pattern):
+			m.wildcards = append(m.wildcards, pattern)
+		default:
+			m.exact[pattern] = true
+		}
+	}
+	return &m, nil
+}
+
+// Match returns whether the index matches any of the patterns.
+func (m *IndexPatternMatcher) Match(index string) bool {
+	if m.exact[index] {
+		return true
+	}
+	for _, pattern := range m.wildcards {
+		if matchWildcard(pattern, index) {
+			return true
+		}
+	}
+	for _, re := range m.regexps {
+		if re.MatchString(index) {
+			return true
+		}
+	}
+	return false
+}
+
+func isRegexpPattern(pattern string) bool {
+	return len(pattern) > 1 && strings.HasPrefix(pattern, "/") && strings.HasSuffix(pattern, "/")
+}
+
+func isWildcardPattern(pattern string) bool {
+	return strings.ContainsAny(pattern, "*?")
+}
+
+// compileRegexpPattern compiles the regular expression between the slashes, anchored to match the whole value.
+func compileRegexpPattern(pattern string) (*regexp.Regexp, error) {
+	return regexp.Compile(`^(?:` + pattern[1:len(pattern)-1] + `)$`)
+}
+
+// matchWildcard matches the value against the wildcard pattern, backtracking to the last "*".
+// The characters are compared as runes, so "?" matches a single non-ASCII character.
+func matchWildcard(patternStr, valueStr string) bool {
+	pattern, value := []rune(patternStr), []rune(valueStr)
+	var p, v, star, mark = 0, 0, -1, 0
+	for v < len(value) {
+		switch {
+		case p < len(pattern) && (pattern[p] == '?' || pattern[p] == value[v]):
+			p++
+			v++
+		case p < len(pattern) && pattern[p] == '*':
+			star, mark = p, v
+			p++
+		case star >= 0:
+			p = star + 1
+			mark++
+			v = mark
+		default:
+			return false
+		}
+	}
+	for p < len(pattern) && pattern[p] == '*' {
+		p++
+	}
+	return p == len(pattern)
+}
@@ -0,0 +1,93 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+//go:build !integration
+// +build !integration
+
+package opensearchutil
+
+import (
+	"testing"
+)
+
+var indexPatternTests = []struct {
+	pattern, index string
+	want           bool
+}{
+	{"logs", "logs", true},
+	{"logs", "Logs", false},
+	{"logs", "logs-1", false},
+	{"logs-*", "logs-", true},
+	{"logs-*", "LOGS-1", false},
+	{"logs-*-a", "logs-x-y-a", true},
+	{"logs-*-a", "logs-x-y-b", false},
+	{"logs-?", "logs-1", true},
+	{"logs-?", "logs-12", false},
+	{"logs-?", "logs-é", true},
+	{"*", "", true},
+	{"*", "logs", true},
+	{"/logs-\\d+/", "logs-12", true},
+	{"/logs-\\d+/", "xlogs-12", false},
+	{"/logs-\\d+/", "logs-12x", false},
+	{"/logs|metrics/", "metrics", true},
+	{"/logs|metrics/", "logs-metrics", false},
+	{"/Logs/", "logs", false},
+	{"/", "/", true},
+	{"/logs", "/logs", true},
+	{"/[/", "[", false},
+}
+
+func TestMatchIndexPattern(t *testing.T) {
+	for _, tt := range indexPatternTests {
+		if got := MatchIndexPattern(tt.pattern, tt.index); got != tt.want {
+			t.Errorf("MatchIndexPattern(%q, %q): got %v, want %v", tt.pattern, tt.index, got, tt.want)
+		}
+	}
+}
+
+func TestIndexPatternMatcher(t *testing.T) {
+	t.Run("Single", func(t *testing.T) {
+		for _, tt := range indexPatternTests {
+			m, err := NewIndexPatternMatcher(tt.pattern)
+			if err != nil {
+				if tt.pattern != "/[/" {
+					t.Errorf("Unexpected error: %s", err)
+				}
+				continue
+			}
+			if got := m.Match(tt.index); got != tt.want {
+				t.Errorf("Match(%q, %q): got %v, want %v", tt.pattern, tt.index, got, tt.want)
+			}
+		}
+	})
+
+	t.Run("Multiple", func(t *testing.T) {
+		m, err := NewIndexPatternMatcher("audit", "logs-*", "/metrics-[0-9]+/")
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		for index, want := range map[string]bool{
+			"audit":      true,
+			"logs-1":     true,
+			"metrics-42": true,
+			"metrics-x":  false,
+			"audit-1":    false,
+		} {
+			if got := m.Match(index); got != want {
+				t.Errorf("Match(%q): got %v, want %v", index, got, want)
+			}
+		}
+	})
+
+	t.Run("InvalidRegexp", func(t *testing.T) {
+		if _, err := NewIndexPatternMatcher("logs", "/[/"); err == nil {
+			t.Errorf("Expected error")
+		}
+	})
+}
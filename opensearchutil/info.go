@@ -0,0 +1,53 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchutil
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/alphastrikelabs/opensearch-go/v2/opensearchapi"
+)
+
+// Ping returns whether the cluster responds with 200 to a HEAD request on the root path.
+//
+// An error is returned only when the request could not be performed.
+func Ping(ctx context.Context, client opensearchapi.Transport) (bool, error) {
+	res, err := opensearchapi.PingRequest{}.Do(ctx, client)
+	if res == nil {
+		return false, fmt.Errorf("ping: %w", err)
+	}
+	if res.Body != nil {
+		res.Body.Close()
+	}
+
+	return res.StatusCode == http.StatusOK, nil
+}
+
+// Info returns the name and the version of the cluster.
+//
+// The distribution in the version is opensearchapi.DistributionOpenSearch or opensearchapi.DistributionElasticsearch,
+// to branch on the features available in the cluster.
+func Info(ctx context.Context, client opensearchapi.Transport) (*opensearchapi.InfoResp, error) {
+	res, err := opensearchapi.InfoRequest{}.Do(ctx, client)
+	if err != nil {
+		if res != nil && res.Body != nil {
+			res.Body.Close()
+		}
+		return nil, fmt.Errorf("info: %w", err)
+	}
+
+	info, err := opensearchapi.ParseInfoResponse(res)
+	if err != nil {
+		return nil, fmt.Errorf("info: %w", err)
+	}
+	return info, nil
+}
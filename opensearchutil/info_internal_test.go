@@ -0,0 +1,91 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+//go:build !integration
+// +build !integration
+
+package opensearchutil
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/alphastrikelabs/opensearch-go/v2"
+	"github.com/alphastrikelabs/opensearch-go/v2/opensearchapi"
+)
+
+func TestPing(t *testing.T) {
+	var (
+		status int
+		err    error
+	)
+	client, _ := opensearch.NewClient(opensearch.Config{DisableRetry: true, Transport: &mockTransport{
+		RoundTripFunc: func(req *http.Request) (*http.Response, error) {
+			if req.Method != http.MethodHead || req.URL.Path != "/" {
+				t.Errorf("Unexpected request: %s %s", req.Method, req.URL.Path)
+			}
+			if err != nil {
+				return nil, err
+			}
+			return &http.Response{StatusCode: status, Body: http.NoBody}, nil
+		},
+	}})
+
+	for _, tt := range []struct {
+		status int
+		want   bool
+	}{{http.StatusOK, true}, {http.StatusServiceUnavailable, false}, {http.StatusUnauthorized, false}} {
+		status = tt.status
+		ok, e := Ping(context.Background(), client)
+		if e != nil {
+			t.Errorf("Unexpected error: %s", e)
+		}
+		if ok != tt.want {
+			t.Errorf("Unexpected result for status %d: %v", tt.status, ok)
+		}
+	}
+
+	err = errors.New("Mock network error")
+	if ok, e := Ping(context.Background(), client); ok || e == nil {
+		t.Errorf("Expected error, got: %v, %v", ok, e)
+	}
+}
+
+func TestInfo(t *testing.T) {
+	var body string
+	client, _ := opensearch.NewClient(opensearch.Config{Transport: &mockTransport{
+		RoundTripFunc: func(req *http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: http.StatusOK, Body: ioutil.NopCloser(strings.NewReader(body))}, nil
+		},
+	}})
+
+	for _, tt := range []struct {
+		body         string
+		distribution string
+	}{
+		{`{"cluster_name":"logs","version":{"distribution":"opensearch","number":"2.5.0"}}`, opensearchapi.DistributionOpenSearch},
+		{`{"cluster_name":"logs","version":{"number":"7.10.2","build_flavor":"oss"}}`, opensearchapi.DistributionElasticsearch},
+	} {
+		body = tt.body
+		info, err := Info(context.Background(), client)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if info.ClusterName != "logs" || info.Version.Number == "" {
+			t.Errorf("Unexpected info: %+v", info)
+		}
+		if info.Version.Distribution != tt.distribution {
+			t.Errorf("Unexpected distribution: %q", info.Version.Distribution)
+		}
+	}
+}
@@ -0,0 +1,149 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchutil
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"runtime"
+	"strings"
+	"sync"
+
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/alphastrikelabs/opensearch-go/v2/opensearchapi"
+)
+
+// PasswordHashCost is the bcrypt cost of the hashes computed by HashPassword,
+// the cost used by the hash tool of the security plugin.
+const PasswordHashCost = 12
+
+// HashPassword returns the bcrypt hash of the password, in the format of the hash tool of the security plugin,
+// to be set as the Hash of an internal user instead of the plain text password.
+//
+// Passwords longer than 72 bytes are rejected, as bcrypt ignores the bytes beyond.
+func HashPassword(plaintext string) (string, error) {
+	if plaintext == "" {
+		return "", errors.New("cannot hash password: empty password")
+	}
+	if len(plaintext) > 72 {
+		return "", errors.New("cannot hash password: password longer than 72 bytes")
+	}
+
+	h, err := bcrypt.GenerateFromPassword([]byte(plaintext), PasswordHashCost)
+	if err != nil {
+		return "", fmt.Errorf("cannot hash password: %s", err)
+	}
+	if err := checkPasswordHash(string(h)); err != nil {
+		return "", fmt.Errorf("cannot hash password: %s", err)
+	}
+
+	// The hash tool of the security plugin uses the "2y" version, identical to "2a".
+	return "$2y$" + string(h[4:]), nil
+}
+
+// InternalUser represents an internal user of the security plugin, as created by InternalUsersBulkUpsert.
+type InternalUser struct {
+	Name         string
+	Password     string // The plain text password, or its bcrypt hash with PreHashedPasswords
+	BackendRoles []string
+	Attributes   map[string]string
+}
+
+// InternalUsersBulkUpsertConfig represents the configuration of InternalUsersBulkUpsert.
+type InternalUsersBulkUpsertConfig struct {
+	NumWorkers         int  // The number of concurrent requests (default: number of CPUs)
+	PreHashedPasswords bool // The passwords are bcrypt hashes, sent in the "hash" field
+}
+
+// InternalUsersBulkUpsert creates or replaces the internal users, and returns the failures by user name.
+//
+// The failures of single users don't stop the operation. When the context is cancelled,
+// the remaining users are not created, and the context error is returned.
+func InternalUsersBulkUpsert(ctx context.Context, client opensearchapi.Transport, users []InternalUser, cfg InternalUsersBulkUpsertConfig) (map[string]error, error) {
+	if cfg.NumWorkers <= 0 {
+		cfg.NumWorkers = runtime.NumCPU()
+	}
+
+	var (
+		mu   sync.Mutex
+		wg   sync.WaitGroup
+		errs = make(map[string]error)
+		ch   = make(chan InternalUser)
+	)
+
+	for i := 0; i < cfg.NumWorkers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for user := range ch {
+				if err := upsertInternalUser(ctx, client, user, cfg.PreHashedPasswords); err != nil {
+					mu.Lock()
+					errs[user.Name] = err
+					mu.Unlock()
+				}
+			}
+		}()
+	}
+
+	var ctxErr error
+	for _, user := range users {
+		if ctx.Err() == nil {
+			select {
+			case ch <- user:
+				continue
+			case <-ctx.Done():
+			}
+		}
+		ctxErr = fmt.Errorf("internal users bulk upsert: %w", ctx.Err())
+		break
+	}
+	close(ch)
+	wg.Wait()
+
+	return errs, ctxErr
+}
+
+// upsertInternalUser creates or replaces the user, sending its password as a hash when preHashed is true.
+func upsertInternalUser(ctx context.Context, client opensearchapi.Transport, user InternalUser, preHashed bool) error {
+	body := opensearchapi.InternalUserBody{
+		BackendRoles: user.BackendRoles,
+		Attributes:   user.Attributes,
+	}
+	if preHashed {
+		if err := checkPasswordHash(user.Password); err != nil {
+			return err
+		}
+		body.Hash = user.Password
+	} else {
+		body.Password = user.Password
+	}
+
+	req := opensearchapi.InternalUserCreateRequest{Username: user.Name, UserBody: &body}
+	return doRequest(ctx, client, req)
+}
+
+// checkPasswordHash returns an error when s is not a bcrypt hash, eg. "$2y$12$...",
+// with a cost accepted by the security plugin.
+func checkPasswordHash(s string) error {
+	if len(s) != 60 || !(strings.HasPrefix(s, "$2a$") || strings.HasPrefix(s, "$2b$") || strings.HasPrefix(s, "$2y$")) {
+		return errors.New("password is not a bcrypt hash")
+	}
+
+	cost, err := bcrypt.Cost([]byte(s))
+	if err != nil {
+		return fmt.Errorf("password is not a bcrypt hash: %s", err)
+	}
+	if cost < bcrypt.MinCost || cost > bcrypt.MaxCost {
+		return fmt.Errorf("invalid bcrypt cost %d: must be between %d and %d", cost, bcrypt.MinCost, bcrypt.MaxCost)
+	}
+	return nil
+}
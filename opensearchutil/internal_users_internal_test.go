@@ -0,0 +1,134 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+//go:build !integration
+// +build !integration
+
+package opensearchutil
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/alphastrikelabs/opensearch-go/v2"
+)
+
+func TestHashPassword(t *testing.T) {
+	hash, err := HashPassword("s3cr3t!")
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	if !strings.HasPrefix(hash, "$2y$12$") {
+		t.Errorf("Unexpected hash format: %s", hash)
+	}
+	if err := bcrypt.CompareHashAndPassword([]byte(hash), []byte("s3cr3t!")); err != nil {
+		t.Errorf("Expected the hash to match the password: %s", err)
+	}
+	if err := checkPasswordHash(hash); err != nil {
+		t.Errorf("Unexpected error: %s", err)
+	}
+
+	for _, p := range []string{"", strings.Repeat("a", 73)} {
+		if _, err := HashPassword(p); err == nil {
+			t.Errorf("Expected error for password of length %d", len(p))
+		}
+	}
+
+	if err := checkPasswordHash("$2y$03$" + strings.Repeat("a", 53)); err == nil {
+		t.Errorf("Expected error for cost below the minimum")
+	}
+}
+
+func TestInternalUsersBulkUpsert(t *testing.T) {
+	var (
+		mu     sync.Mutex
+		bodies = make(map[string]string)
+	)
+	client, _ := opensearch.NewClient(opensearch.Config{Transport: &mockTransport{
+		RoundTripFunc: func(req *http.Request) (*http.Response, error) {
+			b, _ := ioutil.ReadAll(req.Body)
+			mu.Lock()
+			bodies[req.Method+" "+req.URL.Path] = string(b)
+			mu.Unlock()
+
+			if strings.HasSuffix(req.URL.Path, "/weak") {
+				return &http.Response{StatusCode: http.StatusBadRequest, Body: ioutil.NopCloser(strings.NewReader(
+					`{"status":"error","reason":"Weak password"}`))}, nil
+			}
+			return &http.Response{StatusCode: http.StatusCreated, Body: ioutil.NopCloser(strings.NewReader(`{}`))}, nil
+		},
+	}})
+
+	hash, _ := HashPassword("s3cr3t!")
+
+	t.Run("Upsert", func(t *testing.T) {
+		bodies = make(map[string]string)
+		users := []InternalUser{
+			{Name: "svc-ingest", Password: "s3cr3t!", BackendRoles: []string{"ingest"}, Attributes: map[string]string{"team": "logs"}},
+			{Name: "weak", Password: "123"},
+		}
+
+		errs, err := InternalUsersBulkUpsert(context.Background(), client, users, InternalUsersBulkUpsertConfig{NumWorkers: 2})
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if len(errs) != 1 || errs["weak"] == nil {
+			t.Errorf("Unexpected errors: %v", errs)
+		}
+
+		body := bodies["PUT /_plugins/_security/api/internalusers/svc-ingest"]
+		want := `{"password":"s3cr3t!","backend_roles":["ingest"],"attributes":{"team":"logs"}}`
+		if body != want {
+			t.Errorf("Unexpected body: %s", body)
+		}
+	})
+
+	t.Run("Pre-hashed passwords", func(t *testing.T) {
+		bodies = make(map[string]string)
+		users := []InternalUser{{Name: "svc-hashed", Password: hash}, {Name: "svc-plain", Password: "s3cr3t!"}}
+
+		errs, err := InternalUsersBulkUpsert(context.Background(), client, users, InternalUsersBulkUpsertConfig{PreHashedPasswords: true})
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if len(errs) != 1 || errs["svc-plain"] == nil {
+			t.Errorf("Unexpected errors: %v", errs)
+		}
+
+		body := bodies["PUT /_plugins/_security/api/internalusers/svc-hashed"]
+		if body != `{"hash":"`+hash+`"}` {
+			t.Errorf("Unexpected body: %s", body)
+		}
+		if _, ok := bodies["PUT /_plugins/_security/api/internalusers/svc-plain"]; ok {
+			t.Errorf("Expected the plain text password not to be sent")
+		}
+	})
+
+	t.Run("Cancelled", func(t *testing.T) {
+		bodies = make(map[string]string)
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		_, err := InternalUsersBulkUpsert(ctx, client, []InternalUser{{Name: "svc"}}, InternalUsersBulkUpsertConfig{})
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("Expected context error, got: %v", err)
+		}
+		if len(bodies) != 0 {
+			t.Errorf("Unexpected requests: %v", bodies)
+		}
+	})
+}
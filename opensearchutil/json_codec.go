@@ -0,0 +1,26 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchutil
+
+import (
+	"io"
+	"io/ioutil"
+
+	"github.com/alphastrikelabs/opensearch-go/v2/opensearchapi"
+)
+
+// decodeBody decodes the JSON body into v with the codec of the client, see opensearchapi.JSONCodecOf.
+func decodeBody(client opensearchapi.Transport, body io.Reader, v interface{}) error {
+	b, err := ioutil.ReadAll(body)
+	if err != nil {
+		return err
+	}
+	return opensearchapi.JSONCodecOf(client).Unmarshal(b, v)
+}
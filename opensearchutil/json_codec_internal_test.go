@@ -0,0 +1,81 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+//go:build !integration
+// +build !integration
+
+package opensearchutil
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/alphastrikelabs/opensearch-go/v2"
+	"github.com/alphastrikelabs/opensearch-go/v2/opensearchapi"
+)
+
+type countingJSONCodec struct {
+	marshalled   int
+	unmarshalled int
+}
+
+func (c *countingJSONCodec) Marshal(v interface{}) ([]byte, error) {
+	c.marshalled++
+	return json.Marshal(v)
+}
+
+func (c *countingJSONCodec) Unmarshal(data []byte, v interface{}) error {
+	c.unmarshalled++
+	return json.Unmarshal(data, v)
+}
+
+func TestJSONCodec(t *testing.T) {
+	codec := &countingJSONCodec{}
+	client, _ := opensearch.NewClient(opensearch.Config{
+		JSONCodec: codec,
+		Transport: &mockTransport{
+			RoundTripFunc: func(req *http.Request) (*http.Response, error) {
+				resBody := `{"count":3}`
+				if req.Method == "PUT" {
+					resBody = `{"status":"CREATED"}`
+				}
+				return &http.Response{StatusCode: http.StatusOK, Body: ioutil.NopCloser(strings.NewReader(resBody))}, nil
+			},
+		},
+	})
+
+	if client.JSONCodec() != codec {
+		t.Fatalf("Unexpected codec: %T", client.JSONCodec())
+	}
+
+	n, err := Count(context.Background(), client, "test", nil)
+	if err != nil || n != 3 {
+		t.Fatalf("Unexpected result: %d, %v", n, err)
+	}
+	if codec.unmarshalled != 1 {
+		t.Errorf("Expected the response to be decoded with the codec of the client")
+	}
+
+	roles := map[string]opensearchapi.RoleBody{"a": {}, "b": {}}
+	if err := RolesBulkUpsert(context.Background(), client, roles, false); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if codec.marshalled != 2 {
+		t.Errorf("Expected the roles to be encoded with the codec of the client, got %d", codec.marshalled)
+	}
+
+	defaultClient, _ := opensearch.NewClient(opensearch.Config{Transport: &mockTransport{}})
+	if defaultClient.JSONCodec() != opensearchapi.DefaultJSONCodec {
+		t.Errorf("Unexpected default codec: %T", defaultClient.JSONCodec())
+	}
+}
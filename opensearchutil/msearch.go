@@ -0,0 +1,160 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchutil
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/alphastrikelabs/opensearch-go/v2/opensearchapi"
+)
+
+// MsearchItem represents a search of a Multi Search request.
+type MsearchItem struct {
+	// Header is the header line of the search, eg. {"index": "test"};
+	// when it is empty, the search is executed on the default indices of the request.
+	Header map[string]interface{}
+
+	// Body is the search body, encoded to JSON unless it is []byte or json.RawMessage;
+	// when it is nil, all the documents are matched.
+	Body interface{}
+
+	// Preference is the preference of the search, set in the header; see opensearchapi.ValidatePreference.
+	// The Multi Search API has no preference parameter: it's set for each search.
+	Preference string
+}
+
+// MsearchResponseItem represents the response of a search of a Multi Search request.
+type MsearchResponseItem struct {
+	Status int             // The status of the search
+	Body   json.RawMessage // The search response, or the error response when the search failed
+
+	codec opensearchapi.JSONCodec
+}
+
+// IsError returns true when the search failed.
+func (i MsearchResponseItem) IsError() bool {
+	return i.Status > 299
+}
+
+// Err returns the error of the search as a *opensearchapi.Error, or nil when the search succeeded.
+func (i MsearchResponseItem) Err() error {
+	if !i.IsError() {
+		return nil
+	}
+
+	var e opensearchapi.Error
+	if err := json.Unmarshal(i.Body, &e); err != nil || e.Err.Type == "" {
+		return fmt.Errorf("status: %d, error: %s", i.Status, i.Body)
+	}
+	e.Status = i.Status
+	return &e
+}
+
+// Decode decodes the search response into v, or returns the error of the search.
+func (i MsearchResponseItem) Decode(v interface{}) error {
+	if err := i.Err(); err != nil {
+		return err
+	}
+	if i.codec == nil {
+		return json.Unmarshal(i.Body, v)
+	}
+	return i.codec.Unmarshal(i.Body, v)
+}
+
+// Msearch executes the searches in a single Multi Search request on index,
+// and returns their responses in the order of the items.
+//
+// The failure of a search doesn't fail the request: the responses have to be checked individually.
+//
+//	items := []opensearchutil.MsearchItem{
+//		{Header: map[string]interface{}{"index": "logs"}, Body: map[string]interface{}{"size": 0, "aggs": aggs}},
+//		{Header: map[string]interface{}{"index": "metrics"}, Body: map[string]interface{}{"size": 0, "aggs": aggs}},
+//	}
+//	responses, err := opensearchutil.Msearch(ctx, client, nil, items)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	for i, r := range responses {
+//		var sr SearchResponse
+//		if err := r.Decode(&sr); err != nil {
+//			log.Printf("search %d: %s", i, err)
+//		}
+//	}
+func Msearch(ctx context.Context, client opensearchapi.Transport, index []string, items []MsearchItem) ([]MsearchResponseItem, error) {
+	if len(items) == 0 {
+		return nil, nil
+	}
+
+	var body NDJSONBuilder
+	for i, item := range items {
+		var header interface{} = item.Header
+		if item.Preference != "" {
+			if err := opensearchapi.ValidatePreference(item.Preference); err != nil {
+				return nil, fmt.Errorf("msearch: search %d: %s", i, err)
+			}
+			h := make(map[string]interface{}, len(item.Header)+1)
+			for k, v := range item.Header {
+				h[k] = v
+			}
+			h["preference"] = item.Preference
+			header = h
+		} else if item.Header == nil {
+			header = struct{}{}
+		}
+		doc := item.Body
+		if doc == nil {
+			doc = struct{}{}
+		}
+		if err := body.Add(header, doc); err != nil {
+			return nil, fmt.Errorf("msearch: search %d: %s", i, err)
+		}
+	}
+
+	res, err := opensearchapi.MsearchRequest{Index: index, Body: &body}.Do(ctx, client)
+	if err != nil {
+		return nil, fmt.Errorf("msearch: %w", err)
+	}
+	defer res.Body.Close()
+
+	var mr struct {
+		Responses []json.RawMessage `json:"responses"`
+	}
+	if err := decodeBody(client, res.Body, &mr); err != nil {
+		return nil, fmt.Errorf("msearch: error parsing response body: %s", err)
+	}
+	if len(mr.Responses) != len(items) {
+		return nil, fmt.Errorf("msearch: unexpected number of responses: %d, expected %d", len(mr.Responses), len(items))
+	}
+
+	codec := opensearchapi.JSONCodecOf(client)
+	responses := make([]MsearchResponseItem, len(items))
+	for i, raw := range mr.Responses {
+		var r struct {
+			Status int             `json:"status"`
+			Error  json.RawMessage `json:"error"`
+		}
+		if err := codec.Unmarshal(raw, &r); err != nil {
+			return nil, fmt.Errorf("msearch: error parsing response %d: %s", i, err)
+		}
+		// The status is missing from the responses of the earlier versions
+		if r.Status == 0 {
+			r.Status = http.StatusOK
+			if len(r.Error) > 0 {
+				r.Status = http.StatusInternalServerError
+			}
+		}
+		responses[i] = MsearchResponseItem{Status: r.Status, Body: raw, codec: codec}
+	}
+
+	return responses, nil
+}
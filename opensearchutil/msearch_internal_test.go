@@ -0,0 +1,141 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+//go:build !integration
+// +build !integration
+
+package opensearchutil
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/alphastrikelabs/opensearch-go/v2"
+	"github.com/alphastrikelabs/opensearch-go/v2/opensearchapi"
+)
+
+func TestMsearch(t *testing.T) {
+	t.Run("Responses", func(t *testing.T) {
+		client, _ := opensearch.NewClient(opensearch.Config{Transport: &mockTransport{
+			RoundTripFunc: func(req *http.Request) (*http.Response, error) {
+				if req.Method != "POST" || req.URL.Path != "/logs/_msearch" {
+					t.Errorf("Unexpected request: %s %s", req.Method, req.URL.Path)
+				}
+				if ct := req.Header.Get("Content-Type"); ct != NDJSONContentType {
+					t.Errorf("Unexpected content type: %s", ct)
+				}
+				body, _ := ioutil.ReadAll(req.Body)
+				want := "{\"index\":\"metrics\"}\n{\"size\":0}\n{}\n{}\n{}\n{\"query\":{\"bad\":{}}}\n"
+				if string(body) != want {
+					t.Errorf("Unexpected body:\nwant: %q\ngot:  %q", want, body)
+				}
+				resBody := `{"took":3,"responses":[
+					{"took":1,"hits":{"total":{"value":5,"relation":"eq"},"hits":[]},"status":200},
+					{"took":1,"hits":{"total":{"value":2,"relation":"eq"},"hits":[]}},
+					{"error":{"root_cause":[],"type":"parsing_exception","reason":"unknown query [bad]"},"status":400}]}`
+				return &http.Response{StatusCode: http.StatusOK, Body: ioutil.NopCloser(strings.NewReader(resBody))}, nil
+			},
+		}})
+
+		responses, err := Msearch(context.Background(), client, []string{"logs"}, []MsearchItem{
+			{Header: map[string]interface{}{"index": "metrics"}, Body: map[string]interface{}{"size": 0}},
+			{},
+			{Body: []byte(`{"query": {"bad": {}}}`)},
+		})
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if len(responses) != 3 {
+			t.Fatalf("Unexpected number of responses: %d", len(responses))
+		}
+
+		for i, want := range []int64{5, 2} {
+			var sr struct {
+				Hits struct {
+					Total struct {
+						Value int64 `json:"value"`
+					} `json:"total"`
+				} `json:"hits"`
+			}
+			if err := responses[i].Decode(&sr); err != nil {
+				t.Fatalf("Unexpected error for response %d: %s", i, err)
+			}
+			if responses[i].Status != 200 || sr.Hits.Total.Value != want {
+				t.Errorf("Unexpected response %d: %d %+v", i, responses[i].Status, sr)
+			}
+		}
+
+		if !responses[2].IsError() || responses[2].Status != 400 {
+			t.Errorf("Expected response 2 to fail, got status %d", responses[2].Status)
+		}
+		var e *opensearchapi.Error
+		if err := responses[2].Decode(&struct{}{}); !errors.As(err, &e) || e.Err.Type != "parsing_exception" || e.Status != 400 {
+			t.Errorf("Unexpected error: %v", err)
+		}
+	})
+
+	t.Run("Preference", func(t *testing.T) {
+		var body string
+		client, _ := opensearch.NewClient(opensearch.Config{Transport: &mockTransport{
+			RoundTripFunc: func(req *http.Request) (*http.Response, error) {
+				b, _ := ioutil.ReadAll(req.Body)
+				body = string(b)
+				return &http.Response{StatusCode: http.StatusOK, Body: ioutil.NopCloser(strings.NewReader(`{"responses":[{"status":200},{"status":200}]}`))}, nil
+			},
+		}})
+
+		header := map[string]interface{}{"index": "logs"}
+		if _, err := Msearch(context.Background(), client, nil, []MsearchItem{
+			{Header: header, Preference: "session-42"},
+			{Preference: opensearchapi.PreferenceLocal},
+		}); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		want := "{\"index\":\"logs\",\"preference\":\"session-42\"}\n{}\n{\"preference\":\"_local\"}\n{}\n"
+		if body != want {
+			t.Errorf("Unexpected body:\nwant: %q\ngot:  %q", want, body)
+		}
+		if _, ok := header["preference"]; ok {
+			t.Errorf("Unexpected change of the header: %v", header)
+		}
+
+		if _, err := Msearch(context.Background(), client, nil, []MsearchItem{{Preference: "_locale"}}); err == nil {
+			t.Errorf("Expected error for an invalid preference")
+		}
+	})
+
+	t.Run("Request error", func(t *testing.T) {
+		client, _ := opensearch.NewClient(opensearch.Config{Transport: &mockTransport{
+			RoundTripFunc: func(req *http.Request) (*http.Response, error) {
+				resBody := `{"error":{"type":"action_request_validation_exception","reason":"no requests added"},"status":400}`
+				return &http.Response{StatusCode: http.StatusBadRequest, Body: ioutil.NopCloser(strings.NewReader(resBody))}, nil
+			},
+		}})
+
+		if _, err := Msearch(context.Background(), client, nil, []MsearchItem{{}}); err == nil {
+			t.Errorf("Expected error for the failed request")
+		}
+	})
+
+	t.Run("Missing responses", func(t *testing.T) {
+		client, _ := opensearch.NewClient(opensearch.Config{Transport: &mockTransport{
+			RoundTripFunc: func(req *http.Request) (*http.Response, error) {
+				return &http.Response{StatusCode: http.StatusOK, Body: ioutil.NopCloser(strings.NewReader(`{"responses":[{"status":200}]}`))}, nil
+			},
+		}})
+
+		if _, err := Msearch(context.Background(), client, nil, []MsearchItem{{}, {}}); err == nil {
+			t.Errorf("Expected error for the missing response")
+		}
+	})
+}
@@ -0,0 +1,193 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchutil
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// NDJSONContentType is the content type of the newline-delimited JSON bodies.
+const NDJSONContentType = "application/x-ndjson"
+
+// NDJSONBuilder builds the newline-delimited JSON body of the Bulk, Multi Search and Multi Search Template APIs,
+// where every line is terminated by a newline, including the last one.
+//
+// The builder is an io.Reader which is sent with the "application/x-ndjson" content type.
+//
+//	var b opensearchutil.NDJSONBuilder
+//	b.Add(map[string]interface{}{"index": map[string]interface{}{"_index": "test"}}, doc)
+//	b.Add(map[string]interface{}{"delete": map[string]interface{}{"_index": "test", "_id": "1"}}, nil)
+//	res, err := client.Bulk(&b)
+type NDJSONBuilder struct {
+	buf bytes.Buffer
+}
+
+// Add writes the action line, followed by the document line unless doc is nil, eg. for delete actions.
+//
+// The action and the document are encoded to JSON, except []byte and json.RawMessage values,
+// which must be valid JSON and are compacted to a single line.
+// Nothing is written when an error is returned.
+func (b *NDJSONBuilder) Add(action, doc interface{}) error {
+	a, err := ndjsonLine(action)
+	if err != nil {
+		return fmt.Errorf("cannot encode action: %s", err)
+	}
+
+	var d []byte
+	if doc != nil {
+		if d, err = ndjsonLine(doc); err != nil {
+			return fmt.Errorf("cannot encode document: %s", err)
+		}
+	}
+
+	b.buf.Write(a)
+	b.buf.WriteByte('\n')
+	if d != nil {
+		b.buf.Write(d)
+		b.buf.WriteByte('\n')
+	}
+	return nil
+}
+
+// BulkActionMeta represents the metadata of an action line of the Bulk API.
+//
+// The routing of the Bulk API is set per action, in the metadata; the routing
+// passed to the Bulk API with WithRouting is the default for the actions without one.
+type BulkActionMeta struct {
+	Index           string `json:"_index,omitempty"`
+	DocumentID      string `json:"_id,omitempty"`
+	Routing         string `json:"routing,omitempty"`
+	Pipeline        string `json:"pipeline,omitempty"`
+	IfSeqNum        *int64 `json:"if_seq_no,omitempty"`
+	IfPrimaryTerm   *int64 `json:"if_primary_term,omitempty"`
+	RequireAlias    *bool  `json:"require_alias,omitempty"`
+	RetryOnConflict *int   `json:"retry_on_conflict,omitempty"`
+}
+
+// AddBulkAction writes the action line of the Bulk API with the metadata,
+// eg. "index" or "delete", followed by the document line unless doc is nil; see Add.
+func (b *NDJSONBuilder) AddBulkAction(action string, meta BulkActionMeta, doc interface{}) error {
+	return b.Add(map[string]BulkActionMeta{action: meta}, doc)
+}
+
+// Read implements the io.Reader interface, consuming the body.
+func (b *NDJSONBuilder) Read(p []byte) (int, error) {
+	return b.buf.Read(p)
+}
+
+// Bytes returns the unread part of the body.
+func (b *NDJSONBuilder) Bytes() []byte {
+	return b.buf.Bytes()
+}
+
+// Len returns the number of bytes of the unread part of the body.
+func (b *NDJSONBuilder) Len() int {
+	return b.buf.Len()
+}
+
+// Reset empties the body, eg. to reuse the builder for the next batch.
+func (b *NDJSONBuilder) Reset() {
+	b.buf.Reset()
+}
+
+// ContentType returns the content type of the body.
+func (b *NDJSONBuilder) ContentType() string {
+	return NDJSONContentType
+}
+
+// NDJSONStream streams the newline-delimited JSON body of the Bulk API to the request while it's sent,
+// through an io.Pipe, so the body doesn't have to fit in memory. It's meant to be sent with the
+// WithChunked option of the Bulk API, which doesn't buffer the body; the request is not retried.
+//
+// The lines are written by another goroutine, and every Add blocks until the line is sent;
+// Close ends the body, and CloseWithError aborts the request.
+//
+//	s := opensearchutil.NewNDJSONStream()
+//	go func() {
+//		for _, doc := range docs {
+//			if err := s.AddBulkAction("index", opensearchutil.BulkActionMeta{Index: "test"}, doc); err != nil {
+//				s.CloseWithError(err)
+//				return
+//			}
+//		}
+//		s.Close()
+//	}()
+//	res, err := client.Bulk(s, client.Bulk.WithChunked())
+type NDJSONStream struct {
+	pr *io.PipeReader
+	pw *io.PipeWriter
+}
+
+// NewNDJSONStream returns a new NDJSONStream.
+func NewNDJSONStream() *NDJSONStream {
+	pr, pw := io.Pipe()
+	return &NDJSONStream{pr: pr, pw: pw}
+}
+
+// Add writes the action line, followed by the document line unless doc is nil; see NDJSONBuilder.Add.
+//
+// It returns an error when the request has stopped reading the body, eg. when it failed.
+func (s *NDJSONStream) Add(action, doc interface{}) error {
+	var b NDJSONBuilder
+	if err := b.Add(action, doc); err != nil {
+		return err
+	}
+
+	_, err := s.pw.Write(b.Bytes())
+	return err
+}
+
+// AddBulkAction writes the action line of the Bulk API with the metadata; see NDJSONBuilder.AddBulkAction.
+func (s *NDJSONStream) AddBulkAction(action string, meta BulkActionMeta, doc interface{}) error {
+	return s.Add(map[string]BulkActionMeta{action: meta}, doc)
+}
+
+// Close ends the body, once all the lines are written.
+func (s *NDJSONStream) Close() error {
+	return s.pw.Close()
+}
+
+// CloseWithError aborts the request, which fails with err.
+func (s *NDJSONStream) CloseWithError(err error) error {
+	return s.pw.CloseWithError(err)
+}
+
+// Read implements the io.Reader interface, blocking until a line is written.
+func (s *NDJSONStream) Read(p []byte) (int, error) {
+	return s.pr.Read(p)
+}
+
+// ContentType returns the content type of the body.
+func (s *NDJSONStream) ContentType() string {
+	return NDJSONContentType
+}
+
+// ndjsonLine encodes v as a single line of JSON.
+func ndjsonLine(v interface{}) ([]byte, error) {
+	var raw []byte
+	switch v := v.(type) {
+	case json.RawMessage:
+		raw = v
+	case []byte:
+		raw = v
+	default:
+		// The encoding escapes newlines within strings, so the output is a single line
+		return json.Marshal(v)
+	}
+
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, raw); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,157 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+//go:build !integration
+// +build !integration
+
+package opensearchutil
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/alphastrikelabs/opensearch-go/v2"
+)
+
+func TestNDJSONBuilder(t *testing.T) {
+	t.Run("Framing", func(t *testing.T) {
+		var b NDJSONBuilder
+
+		if err := b.Add(map[string]interface{}{"index": map[string]string{"_index": "test"}}, map[string]string{"title": "foo\nbar"}); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if err := b.Add(json.RawMessage("{\n  \"delete\": {\"_id\": \"1\"}\n}"), nil); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if err := b.Add([]byte(`{"create":{}}`), []byte(`{"title":`)); err == nil {
+			t.Errorf("Expected error for invalid JSON")
+		}
+
+		want := `{"index":{"_index":"test"}}` + "\n" + `{"title":"foo\nbar"}` + "\n" + `{"delete":{"_id":"1"}}` + "\n"
+		body, _ := ioutil.ReadAll(&b)
+		if string(body) != want {
+			t.Errorf("Unexpected body:\nwant: %q\ngot:  %q", want, body)
+		}
+	})
+
+	t.Run("Bulk action routing", func(t *testing.T) {
+		var b NDJSONBuilder
+		b.AddBulkAction("index", BulkActionMeta{Index: "test", DocumentID: "1", Routing: "user1"}, map[string]string{"title": "foo"})
+		b.AddBulkAction("delete", BulkActionMeta{Index: "test", DocumentID: "2", Routing: "user2"}, nil)
+
+		want := `{"index":{"_index":"test","_id":"1","routing":"user1"}}` + "\n" + `{"title":"foo"}` + "\n" +
+			`{"delete":{"_index":"test","_id":"2","routing":"user2"}}` + "\n"
+		if string(b.Bytes()) != want {
+			t.Errorf("Unexpected body:\nwant: %q\ngot:  %q", want, b.Bytes())
+		}
+	})
+
+	t.Run("Content type", func(t *testing.T) {
+		var req *http.Request
+		client, _ := opensearch.NewClient(opensearch.Config{Transport: &mockTransport{
+			RoundTripFunc: func(r *http.Request) (*http.Response, error) {
+				req = r
+				return &http.Response{StatusCode: http.StatusOK, Body: ioutil.NopCloser(strings.NewReader(`{}`))}, nil
+			},
+		}})
+
+		var b NDJSONBuilder
+		b.Add(map[string]interface{}{"index": map[string]string{"_index": "test"}}, map[string]string{"title": "foo"})
+
+		res, err := client.Bulk(&b)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		res.Body.Close()
+
+		if ct := req.Header.Get("Content-Type"); ct != NDJSONContentType {
+			t.Errorf("Unexpected Content-Type: %q", ct)
+		}
+
+		var tb NDJSONBuilder
+		tb.Add(map[string]string{"index": "test"}, map[string]string{"id": "my-template"})
+
+		res, err = client.MsearchTemplate(&tb)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		res.Body.Close()
+
+		if ct := req.Header.Get("Content-Type"); ct != NDJSONContentType {
+			t.Errorf("Unexpected Content-Type for MsearchTemplate: %q", ct)
+		}
+	})
+}
+
+func TestNDJSONStream(t *testing.T) {
+	var (
+		body        string
+		contentType string
+		streamed    bool
+	)
+	client, _ := opensearch.NewClient(opensearch.Config{Transport: &mockTransport{
+		RoundTripFunc: func(req *http.Request) (*http.Response, error) {
+			streamed = req.GetBody == nil
+			contentType = req.Header.Get("Content-Type")
+			b, err := ioutil.ReadAll(req.Body)
+			if err != nil {
+				return nil, err
+			}
+			body = string(b)
+			return &http.Response{StatusCode: http.StatusOK, Body: ioutil.NopCloser(strings.NewReader(`{"errors":false,"items":[]}`))}, nil
+		},
+	}})
+
+	t.Run("Stream", func(t *testing.T) {
+		s := NewNDJSONStream()
+		go func() {
+			for _, id := range []string{"1", "2"} {
+				if err := s.AddBulkAction("index", BulkActionMeta{Index: "test", DocumentID: id}, map[string]string{"id": id}); err != nil {
+					s.CloseWithError(err)
+					return
+				}
+			}
+			s.Close()
+		}()
+
+		res, err := client.Bulk(s, client.Bulk.WithChunked())
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		res.Body.Close()
+
+		want := `{"index":{"_index":"test","_id":"1"}}` + "\n" + `{"id":"1"}` + "\n" +
+			`{"index":{"_index":"test","_id":"2"}}` + "\n" + `{"id":"2"}` + "\n"
+		if body != want {
+			t.Errorf("Unexpected body: %q", body)
+		}
+		if !streamed {
+			t.Errorf("Expected the body to be streamed")
+		}
+		if contentType != NDJSONContentType {
+			t.Errorf("Unexpected content type: %q", contentType)
+		}
+	})
+
+	t.Run("Abort", func(t *testing.T) {
+		s := NewNDJSONStream()
+		go func() {
+			s.Add(map[string]interface{}{"delete": map[string]string{"_index": "test", "_id": "1"}}, nil)
+			s.CloseWithError(errors.New("source failed"))
+		}()
+
+		if _, err := client.Bulk(s, client.Bulk.WithChunked()); err == nil || !strings.Contains(err.Error(), "source failed") {
+			t.Errorf("Expected the error of the stream, got: %v", err)
+		}
+	})
+}
@@ -0,0 +1,227 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchutil
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/alphastrikelabs/opensearch-go/v2/opensearchapi"
+)
+
+// EffectivePermissions represents the union of the permissions of the roles a user is mapped to,
+// as returned by ResolveUserPermissions.
+type EffectivePermissions struct {
+	User         string
+	BackendRoles []string
+	Roles        []string // The roles the user is mapped to, sorted
+
+	ClusterPermissions []string                            // The union of the cluster permissions of the roles
+	IndexPermissions   []opensearchapi.RoleIndexPermission // The index permissions of the roles, with the user variables replaced
+
+	actionGroups map[string][]string // The allowed actions of the action groups, by name
+}
+
+// Allows returns whether the permissions allow the action on the index, eg. "indices:data/read/search"
+// on "logs-2023", or the cluster action, eg. "cluster:monitor/health", when index is empty.
+//
+// The allowed actions are expanded from the action groups, and matched with their wildcards.
+// The index patterns are matched like the security plugin does, see MatchIndexPattern.
+// The document and field level security of the index permissions is not taken into account.
+func (p *EffectivePermissions) Allows(action, index string) bool {
+	if index == "" {
+		return p.allowsAction(p.ClusterPermissions, action)
+	}
+
+	for _, perm := range p.IndexPermissions {
+		for _, pattern := range perm.IndexPatterns {
+			if MatchIndexPattern(pattern, index) && p.allowsAction(perm.AllowedActions, action) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// allowsAction returns whether one of the allowed actions, or of the actions of the action groups, matches the action.
+func (p *EffectivePermissions) allowsAction(allowed []string, action string) bool {
+	for _, a := range p.expandActions(allowed, make(map[string]bool)) {
+		if MatchIndexPattern(a, action) {
+			return true
+		}
+	}
+	return false
+}
+
+// expandActions replaces the action groups of the actions by their actions, recursively.
+func (p *EffectivePermissions) expandActions(actions []string, seen map[string]bool) []string {
+	var out []string
+	for _, a := range actions {
+		group, ok := p.actionGroups[a]
+		if !ok {
+			out = append(out, a)
+			continue
+		}
+		if seen[a] {
+			continue
+		}
+		seen[a] = true
+		out = append(out, p.expandActions(group, seen)...)
+	}
+	return out
+}
+
+// userInfo represents an internal user, with the roles assigned to it directly.
+type userInfo struct {
+	BackendRoles  []string          `json:"backend_roles"`
+	Attributes    map[string]string `json:"attributes"`
+	SecurityRoles []string          `json:"opendistro_security_roles"`
+}
+
+// ResolveUserPermissions returns the permissions of the internal user: the roles mapped to the user,
+// by name or by backend role, and the roles assigned to the user directly, are resolved and merged.
+//
+// The role mappings by host are not taken into account, as they depend on the origin of the requests.
+// The user, the role mappings, the roles and the action groups are read with separate requests,
+// so the caller needs the permissions to read them.
+func ResolveUserPermissions(ctx context.Context, client opensearchapi.Transport, user string) (*EffectivePermissions, error) {
+	var users map[string]userInfo
+	if err := getSecurityResource(ctx, client, opensearchapi.InternalUserGetRequest{Username: user}, &users); err != nil {
+		return nil, fmt.Errorf("resolve user permissions: get user: %w", err)
+	}
+	u, ok := users[user]
+	if !ok {
+		return nil, fmt.Errorf("resolve user permissions: user %q: %w", user, opensearchapi.ErrNotFound)
+	}
+
+	var mappings map[string]opensearchapi.RoleMappingBody
+	if err := getSecurityResource(ctx, client, opensearchapi.RoleMappingGetRequest{}, &mappings); err != nil {
+		return nil, fmt.Errorf("resolve user permissions: get role mappings: %w", err)
+	}
+
+	var roles map[string]opensearchapi.RoleBody
+	if err := getSecurityResource(ctx, client, opensearchapi.RoleGetRequest{}, &roles); err != nil {
+		return nil, fmt.Errorf("resolve user permissions: get roles: %w", err)
+	}
+
+	var groups map[string]ActionGroup
+	req := opensearchapi.GenericRequest{Method: http.MethodGet, Path: securityAPIPath + "actiongroups"}
+	if err := getSecurityResource(ctx, client, req, &groups); err != nil {
+		return nil, fmt.Errorf("resolve user permissions: get action groups: %w", err)
+	}
+
+	p := &EffectivePermissions{
+		User:         user,
+		BackendRoles: u.BackendRoles,
+		actionGroups: make(map[string][]string, len(groups)),
+	}
+	for name, g := range groups {
+		p.actionGroups[name] = g.AllowedActions
+	}
+
+	mapped := append([]string(nil), u.SecurityRoles...)
+	for role, m := range mappings {
+		if isMapped(m, user, u.BackendRoles) {
+			mapped = append(mapped, role)
+		}
+	}
+
+	var cluster []string
+	for _, role := range normalizeStrings(mapped) {
+		body, ok := roles[role]
+		if !ok {
+			continue
+		}
+		p.Roles = append(p.Roles, role)
+		cluster = append(cluster, body.ClusterPermissions...)
+		for _, perm := range body.IndexPermissions {
+			patterns := make([]string, len(perm.IndexPatterns))
+			for i, pattern := range perm.IndexPatterns {
+				patterns[i] = replaceUserVariables(pattern, user, u.Attributes)
+			}
+			perm.IndexPatterns = patterns
+			p.IndexPermissions = append(p.IndexPermissions, perm)
+		}
+	}
+	p.ClusterPermissions = normalizeStrings(cluster)
+
+	return p, nil
+}
+
+// isMapped returns whether the role mapping maps the user, by name or by backend roles.
+func isMapped(m opensearchapi.RoleMappingBody, user string, backendRoles []string) bool {
+	for _, pattern := range m.Users {
+		if MatchIndexPattern(pattern, user) {
+			return true
+		}
+	}
+	for _, pattern := range m.BackendRoles {
+		for _, r := range backendRoles {
+			if MatchIndexPattern(pattern, r) {
+				return true
+			}
+		}
+	}
+	if len(m.AndBackendRoles) == 0 {
+		return false
+	}
+	for _, pattern := range m.AndBackendRoles {
+		var found bool
+		for _, r := range backendRoles {
+			if MatchIndexPattern(pattern, r) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// reUserVariable matches the user variables of the index patterns, eg. "${user.name}" or "${attr.internal.team}".
+var reUserVariable = regexp.MustCompile(`\$\{(user\.name|user_name|attr\.internal\.[^}]+)\}`)
+
+// replaceUserVariables replaces the user name and the attributes of the user in the index pattern.
+// The variables of unknown attributes are kept as is.
+func replaceUserVariables(pattern, user string, attributes map[string]string) string {
+	if !strings.Contains(pattern, "${") {
+		return pattern
+	}
+	return reUserVariable.ReplaceAllStringFunc(pattern, func(v string) string {
+		name := v[2 : len(v)-1]
+		if name == "user.name" || name == "user_name" {
+			return user
+		}
+		if value, ok := attributes[strings.TrimPrefix(name, "attr.internal.")]; ok {
+			return value
+		}
+		return v
+	})
+}
+
+// getSecurityResource performs the request and decodes the response into v.
+// The response is closed, including on error.
+func getSecurityResource(ctx context.Context, client opensearchapi.Transport, req opensearchapi.Request, v interface{}) error {
+	res, err := req.Do(ctx, client)
+	defer res.Close()
+	if err != nil {
+		return err
+	}
+
+	if err := decodeBody(client, res.Body, v); err != nil {
+		return fmt.Errorf("cannot decode response: %s", err)
+	}
+	return nil
+}
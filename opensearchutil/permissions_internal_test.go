@@ -0,0 +1,98 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+//go:build !integration
+// +build !integration
+
+package opensearchutil
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/alphastrikelabs/opensearch-go/v2"
+	"github.com/alphastrikelabs/opensearch-go/v2/opensearchapi"
+)
+
+func TestResolveUserPermissions(t *testing.T) {
+	bodies := map[string]string{
+		"/_plugins/_security/api/internalusers/alice": `{"alice":{"hash":"","reserved":false,
+			"backend_roles":["dev","ops"],"attributes":{"team":"search"},"opendistro_security_roles":["direct"]}}`,
+		"/_plugins/_security/api/rolesmapping": `{
+			"by_user":{"users":["ali*"]},
+			"by_backend_role":{"backend_roles":["dev"]},
+			"by_and_backend_roles":{"and_backend_roles":["dev","admin"]},
+			"by_host":{"hosts":["*"]}}`,
+		"/_plugins/_security/api/roles": `{
+			"direct":{"cluster_permissions":["cluster:monitor/health"]},
+			"by_user":{"cluster_permissions":["cluster_monitor"],
+				"index_permissions":[{"index_patterns":["logs-${user.name}-*"],"allowed_actions":["read"]}]},
+			"by_backend_role":{"index_permissions":[
+				{"index_patterns":["/metrics-[0-9]+/"],"allowed_actions":["indices:data/write/*"]},
+				{"index_patterns":["team-${attr.internal.team}"],"allowed_actions":["crud"]}]},
+			"by_and_backend_roles":{"cluster_permissions":["*"]},
+			"by_host":{"cluster_permissions":["*"]}}`,
+		"/_plugins/_security/api/actiongroups": `{
+			"read":{"allowed_actions":["indices:data/read/*"]},
+			"crud":{"allowed_actions":["read","indices:data/write/*","crud"]},
+			"cluster_monitor":{"allowed_actions":["cluster:monitor/*"]}}`,
+	}
+	client, _ := opensearch.NewClient(opensearch.Config{Transport: &mockTransport{
+		RoundTripFunc: func(req *http.Request) (*http.Response, error) {
+			body, ok := bodies[req.URL.Path]
+			if !ok {
+				return &http.Response{StatusCode: http.StatusNotFound, Body: ioutil.NopCloser(strings.NewReader(`{}`))}, nil
+			}
+			return &http.Response{StatusCode: http.StatusOK, Body: ioutil.NopCloser(strings.NewReader(body))}, nil
+		},
+	}})
+
+	p, err := ResolveUserPermissions(context.Background(), client, "alice")
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	if want := []string{"by_backend_role", "by_user", "direct"}; !reflect.DeepEqual(p.Roles, want) {
+		t.Errorf("Unexpected roles: %v", p.Roles)
+	}
+	if want := []string{"cluster:monitor/health", "cluster_monitor"}; !reflect.DeepEqual(p.ClusterPermissions, want) {
+		t.Errorf("Unexpected cluster permissions: %v", p.ClusterPermissions)
+	}
+
+	for _, tt := range []struct {
+		action, index string
+		want          bool
+	}{
+		{"cluster:monitor/health", "", true},
+		{"cluster:monitor/stats", "", true},
+		{"cluster:admin/settings/update", "", false},
+		{"indices:data/read/search", "logs-alice-2023", true},
+		{"indices:data/read/search", "logs-bob-2023", false},
+		{"indices:data/read/search", "Logs-alice-2023", false},
+		{"indices:data/write/index", "logs-alice-2023", false},
+		{"indices:data/write/index", "metrics-42", true},
+		{"indices:data/write/index", "metrics-42a", false},
+		{"indices:data/read/get", "team-search", true},
+		{"indices:data/write/bulk", "team-search", true},
+		{"indices:admin/delete", "team-search", false},
+	} {
+		if got := p.Allows(tt.action, tt.index); got != tt.want {
+			t.Errorf("Allows(%q, %q): got %v, want %v", tt.action, tt.index, got, tt.want)
+		}
+	}
+
+	if _, err := ResolveUserPermissions(context.Background(), client, "bob"); !errors.Is(err, opensearchapi.ErrNotFound) {
+		t.Errorf("Expected not found error, got: %v", err)
+	}
+}
@@ -0,0 +1,291 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchutil
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/alphastrikelabs/opensearch-go/v2/opensearchapi"
+)
+
+// PITPaginator pages through the results of a search using a Point In Time and search_after.
+//
+// The body is the search request body; it must contain a "sort" clause, which should end
+// with a unique tiebreaker. The paginator adds the "pit" and "search_after" clauses.
+//
+// The Point In Time is created on the first call to Next and deleted on Close, when the hits are
+// exhausted, an error occurs, or the context of the first call to Next is cancelled.
+//
+//	p := opensearchutil.NewPITPaginator(client, []string{"test"}, time.Minute, map[string]interface{}{
+//		"size": 100,
+//		"sort": []interface{}{map[string]string{"timestamp": "asc"}, map[string]string{"id": "asc"}},
+//	})
+//	defer p.Close(ctx)
+//	for p.Next(ctx) {
+//		for _, hit := range p.Hits() {
+//			fmt.Println(string(hit))
+//		}
+//	}
+//	if err := p.Err(); err != nil {
+//		log.Fatal(err)
+//	}
+type PITPaginator struct {
+	client    opensearchapi.Transport
+	index     []string
+	keepAlive time.Duration
+	body      map[string]interface{}
+
+	mu          sync.Mutex
+	watcher     *contextWatcher
+	pitID       string
+	searchAfter []json.RawMessage
+	hits        []json.RawMessage
+	done        bool
+	err         error
+}
+
+// searchAfterHit is used to extract the sort values of a hit.
+type searchAfterHit struct {
+	Sort []json.RawMessage `json:"sort"`
+}
+
+type searchAfterResponse struct {
+	PitID string `json:"pit_id"`
+	Hits  struct {
+		Hits []json.RawMessage `json:"hits"`
+	} `json:"hits"`
+}
+
+// NewPITPaginator creates a new paginator for the search body on index,
+// keeping the Point In Time alive for keepAlive between pages.
+func NewPITPaginator(
+	client opensearchapi.Transport,
+	index []string,
+	keepAlive time.Duration,
+	body map[string]interface{},
+) *PITPaginator {
+	return &PITPaginator{client: client, index: index, keepAlive: keepAlive, body: body}
+}
+
+// Next fetches the next page of hits.
+// It returns false when the hits are exhausted, the context is cancelled, or an error occurs.
+func (p *PITPaginator) Next(ctx context.Context) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.hits = nil
+
+	if p.done {
+		return false
+	}
+
+	if p.watcher == nil {
+		p.watcher = watchContext(ctx, p.cancel)
+	}
+
+	if err := ctx.Err(); err != nil {
+		p.stop(err)
+		return false
+	}
+
+	if _, ok := p.body["sort"]; !ok {
+		p.stop(errors.New("pit paginator: search body must contain a sort clause"))
+		return false
+	}
+
+	if p.pitID == "" {
+		_, pit, err := opensearchapi.PointInTimeCreateRequest{Index: p.index, KeepAlive: p.keepAlive}.Do(ctx, p.client)
+		if err != nil {
+			p.stop(fmt.Errorf("pit paginator: create point in time: %w", err))
+			return false
+		}
+		p.pitID = pit.PitID
+	}
+
+	body := make(map[string]interface{}, len(p.body)+2)
+	for k, v := range p.body {
+		body[k] = v
+	}
+	body["pit"] = map[string]string{"id": p.pitID, "keep_alive": formatKeepAlive(p.keepAlive)}
+	if len(p.searchAfter) > 0 {
+		body["search_after"] = p.searchAfter
+	}
+
+	page, err := searchAfter(ctx, p.client, opensearchapi.SearchRequest{Body: NewJSONReader(body)})
+	if err != nil {
+		p.stop(fmt.Errorf("pit paginator: %w", err))
+		return false
+	}
+
+	// The Point In Time ID may change between requests.
+	if page.PitID != "" {
+		p.pitID = page.PitID
+	}
+
+	if len(page.Hits.Hits) == 0 {
+		p.stop(nil)
+		return false
+	}
+
+	sort, err := lastSortValues(page.Hits.Hits)
+	if err != nil {
+		p.stop(fmt.Errorf("pit paginator: %s", err))
+		return false
+	}
+
+	p.hits = page.Hits.Hits
+	p.searchAfter = sort
+	return true
+}
+
+// Hits returns the hits of the current page as raw JSON.
+func (p *PITPaginator) Hits() []json.RawMessage {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.hits
+}
+
+// PitID returns the current Point In Time ID.
+func (p *PITPaginator) PitID() string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.pitID
+}
+
+// SearchAfter returns the sort values of the last hit of the current page.
+func (p *PITPaginator) SearchAfter() []json.RawMessage {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.searchAfter
+}
+
+// Err returns the error which stopped the pagination, if any.
+func (p *PITPaginator) Err() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.err
+}
+
+// Close deletes the Point In Time, when created. It is safe to call Close multiple times.
+func (p *PITPaginator) Close(ctx context.Context) error {
+	p.mu.Lock()
+	pitID := p.detach()
+	p.mu.Unlock()
+
+	return deletePIT(ctx, p.client, pitID)
+}
+
+// detach ends the pagination, stops watching the context,
+// and returns the ID of the Point In Time to delete, if any.
+func (p *PITPaginator) detach() string {
+	p.done = true
+	p.hits = nil
+	if p.watcher != nil {
+		p.watcher.stop()
+	}
+
+	pitID := p.pitID
+	p.pitID = ""
+	return pitID
+}
+
+// deletePIT deletes the Point In Time with the ID, when not empty.
+func deletePIT(ctx context.Context, client opensearchapi.Transport, pitID string) error {
+	if pitID == "" {
+		return nil
+	}
+
+	res, _, err := opensearchapi.PointInTimeDeleteRequest{PitID: []string{pitID}}.Do(ctx, client)
+	if err != nil {
+		if res != nil && res.StatusCode == 404 {
+			return nil
+		}
+		return fmt.Errorf("pit paginator: delete point in time: %w", err)
+	}
+
+	return nil
+}
+
+// stop ends the pagination with err and deletes the Point In Time.
+func (p *PITPaginator) stop(err error) {
+	p.err = err
+	// The caller's context may already be cancelled, use a fresh one for the cleanup.
+	ctx, cancel := context.WithTimeout(context.Background(), cleanupTimeout)
+	defer cancel()
+
+	if cerr := deletePIT(ctx, p.client, p.detach()); cerr != nil && p.err == nil {
+		p.err = cerr
+	}
+}
+
+// cancel ends the pagination when the watched context is cancelled.
+//
+// The Point In Time is deleted without holding the lock, so that a slow node
+// doesn't block the other methods; the error of the cancellation is kept.
+func (p *PITPaginator) cancel(err error) {
+	p.mu.Lock()
+	if p.done {
+		p.mu.Unlock()
+		return
+	}
+	p.err = err
+	pitID := p.detach()
+	p.mu.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), cleanupTimeout)
+	defer cancel()
+
+	_ = deletePIT(ctx, p.client, pitID)
+}
+
+// searchAfter executes the search request and decodes the page of hits.
+func searchAfter(ctx context.Context, client opensearchapi.Transport, req opensearchapi.SearchRequest) (*searchAfterResponse, error) {
+	res, err := req.Do(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if err := res.Err(); err != nil {
+		return nil, err
+	}
+
+	var page searchAfterResponse
+	if err := decodeBody(client, res.Body, &page); err != nil {
+		return nil, fmt.Errorf("error parsing response body: %s", err)
+	}
+
+	return &page, nil
+}
+
+// lastSortValues returns the sort values of the last hit.
+func lastSortValues(hits []json.RawMessage) ([]json.RawMessage, error) {
+	var hit searchAfterHit
+	if err := json.Unmarshal(hits[len(hits)-1], &hit); err != nil {
+		return nil, fmt.Errorf("error parsing hit: %s", err)
+	}
+	if len(hit.Sort) == 0 {
+		return nil, errors.New("hit is missing sort values")
+	}
+	return hit.Sort, nil
+}
+
+// formatKeepAlive formats the duration in the time units format.
+func formatKeepAlive(d time.Duration) string {
+	if d < time.Millisecond {
+		return fmt.Sprintf("%dnanos", int64(d))
+	}
+	return fmt.Sprintf("%dms", int64(d)/int64(time.Millisecond))
+}
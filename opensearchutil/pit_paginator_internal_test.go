@@ -0,0 +1,207 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+//go:build !integration
+// +build !integration
+
+package opensearchutil
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alphastrikelabs/opensearch-go/v2"
+)
+
+func TestPITPaginator(t *testing.T) {
+	var sortBody = map[string]interface{}{
+		"size": 2,
+		"sort": []interface{}{map[string]string{"id": "asc"}},
+	}
+
+	t.Run("Paginate", func(t *testing.T) {
+		var (
+			numDeletes   int
+			searchBodies []map[string]interface{}
+			pages        = []string{
+				`{"pit_id":"pit-1","hits":{"hits":[{"_id":"1","sort":[1]},{"_id":"2","sort":[2]}]}}`,
+				`{"pit_id":"pit-2","hits":{"hits":[{"_id":"3","sort":[3]}]}}`,
+				`{"pit_id":"pit-2","hits":{"hits":[]}}`,
+			}
+		)
+
+		client, _ := opensearch.NewClient(opensearch.Config{Transport: &mockTransport{
+			RoundTripFunc: func(req *http.Request) (*http.Response, error) {
+				var resBody string
+				switch {
+				case req.URL.Path == "/test/_search/point_in_time":
+					resBody = `{"pit_id":"pit-1"}`
+				case req.Method == "DELETE":
+					numDeletes++
+					b, _ := ioutil.ReadAll(req.Body)
+					if !strings.Contains(string(b), "pit-2") {
+						t.Errorf("Expected the latest PIT ID to be deleted, got: %s", b)
+					}
+					resBody = `{"pits":[{"pit_id":"pit-2","successful":true}]}`
+				case req.URL.Path == "/_search":
+					var body map[string]interface{}
+					json.NewDecoder(req.Body).Decode(&body)
+					searchBodies = append(searchBodies, body)
+					resBody = pages[len(searchBodies)-1]
+				default:
+					t.Errorf("Unexpected request: %s %s", req.Method, req.URL.Path)
+				}
+				return &http.Response{StatusCode: http.StatusOK, Body: ioutil.NopCloser(strings.NewReader(resBody))}, nil
+			},
+		}})
+
+		p := NewPITPaginator(client, []string{"test"}, time.Minute, sortBody)
+
+		var numHits int
+		for p.Next(context.Background()) {
+			numHits += len(p.Hits())
+		}
+		if err := p.Err(); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+
+		if numHits != 3 {
+			t.Errorf("Unexpected number of hits: %d", numHits)
+		}
+		if len(searchBodies) != 3 {
+			t.Fatalf("Unexpected number of searches: %d", len(searchBodies))
+		}
+		if _, ok := searchBodies[0]["search_after"]; ok {
+			t.Errorf("Unexpected search_after in the first request")
+		}
+		if sa := searchBodies[1]["search_after"].([]interface{}); sa[0] != float64(2) {
+			t.Errorf("Unexpected search_after: %v", sa)
+		}
+		if pit := searchBodies[2]["pit"].(map[string]interface{}); pit["id"] != "pit-2" || pit["keep_alive"] != "60000ms" {
+			t.Errorf("Unexpected pit: %v", pit)
+		}
+		if numDeletes != 1 {
+			t.Errorf("Expected the PIT to be deleted once, got: %d", numDeletes)
+		}
+		if err := p.Close(context.Background()); err != nil || numDeletes != 1 {
+			t.Errorf("Expected Close() after exhaustion to be a no-op, err: %v, deletes: %d", err, numDeletes)
+		}
+	})
+
+	t.Run("Context cancelled mid-iteration", func(t *testing.T) {
+		deletes := make(chan string, 2)
+		client, _ := opensearch.NewClient(opensearch.Config{Transport: &mockTransport{
+			RoundTripFunc: func(req *http.Request) (*http.Response, error) {
+				resBody := `{"pit_id":"pit-1","hits":{"hits":[{"_id":"1","sort":[1]},{"_id":"2","sort":[2]}]}}`
+				switch {
+				case req.URL.Path == "/test/_search/point_in_time":
+					resBody = `{"pit_id":"pit-1"}`
+				case req.Method == "DELETE":
+					b, _ := ioutil.ReadAll(req.Body)
+					deletes <- string(b)
+					resBody = `{"pits":[{"pit_id":"pit-1","successful":true}]}`
+				}
+				return &http.Response{StatusCode: http.StatusOK, Body: ioutil.NopCloser(strings.NewReader(resBody))}, nil
+			},
+		}})
+
+		ctx, cancel := context.WithCancel(context.Background())
+		p := NewPITPaginator(client, []string{"test"}, time.Minute, sortBody)
+		if !p.Next(ctx) {
+			t.Fatalf("Expected a page, err: %v", p.Err())
+		}
+
+		// The pagination is abandoned without calling Next or Close again
+		cancel()
+
+		select {
+		case body := <-deletes:
+			if !strings.Contains(body, "pit-1") {
+				t.Errorf("Unexpected delete body: %s", body)
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("Expected the PIT to be deleted on cancellation")
+		}
+
+		if p.Err() != context.Canceled {
+			t.Errorf("Expected context.Canceled, got: %v", p.Err())
+		}
+		if err := p.Close(context.Background()); err != nil || len(deletes) != 0 {
+			t.Errorf("Expected Close() after cancellation to be a no-op, err: %v", err)
+		}
+	})
+
+	t.Run("Context cancelled with a hanging node", func(t *testing.T) {
+		defer func(d time.Duration) { cleanupTimeout = d }(cleanupTimeout)
+		cleanupTimeout = 50 * time.Millisecond
+
+		deletes := make(chan struct{})
+		deleted := make(chan error, 1)
+		client, _ := opensearch.NewClient(opensearch.Config{Transport: &mockTransport{
+			RoundTripFunc: func(req *http.Request) (*http.Response, error) {
+				resBody := `{"pit_id":"pit-1","hits":{"hits":[{"_id":"1","sort":[1]}]}}`
+				switch {
+				case req.URL.Path == "/test/_search/point_in_time":
+					resBody = `{"pit_id":"pit-1"}`
+				case req.Method == "DELETE":
+					close(deletes)
+					<-req.Context().Done()
+					deleted <- req.Context().Err()
+					return nil, req.Context().Err()
+				}
+				return &http.Response{StatusCode: http.StatusOK, Body: ioutil.NopCloser(strings.NewReader(resBody))}, nil
+			},
+		}})
+
+		ctx, cancel := context.WithCancel(context.Background())
+		p := NewPITPaginator(client, []string{"test"}, time.Minute, sortBody)
+		if !p.Next(ctx) {
+			t.Fatalf("Expected a page, err: %v", p.Err())
+		}
+		cancel()
+		<-deletes
+
+		// The methods don't wait for the delete request
+		if p.Err() != context.Canceled {
+			t.Errorf("Expected context.Canceled, got: %v", p.Err())
+		}
+		if p.Hits() != nil {
+			t.Errorf("Unexpected hits: %s", p.Hits())
+		}
+		if err := p.Close(context.Background()); err != nil {
+			t.Errorf("Unexpected error: %s", err)
+		}
+
+		select {
+		case err := <-deleted:
+			if err != context.DeadlineExceeded {
+				t.Errorf("Expected context.DeadlineExceeded, got: %v", err)
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("Expected the delete request to time out")
+		}
+	})
+
+	t.Run("Missing sort", func(t *testing.T) {
+		client, _ := opensearch.NewClient(opensearch.Config{Transport: &mockTransport{}})
+
+		p := NewPITPaginator(client, []string{"test"}, time.Minute, map[string]interface{}{})
+		if p.Next(context.Background()) {
+			t.Errorf("Unexpected page")
+		}
+		if p.Err() == nil {
+			t.Errorf("Expected error for missing sort")
+		}
+	})
+}
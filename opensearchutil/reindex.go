@@ -0,0 +1,332 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchutil
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/alphastrikelabs/opensearch-go/v2/opensearchapi"
+)
+
+// ReindexResponse represents the result of a reindex operation.
+type ReindexResponse struct {
+	Took             int64 `json:"took"`
+	TimedOut         bool  `json:"timed_out"`
+	Total            int64 `json:"total"`
+	Created          int64 `json:"created"`
+	Updated          int64 `json:"updated"`
+	Deleted          int64 `json:"deleted"`
+	Batches          int64 `json:"batches"`
+	VersionConflicts int64 `json:"version_conflicts"`
+	Noops            int64 `json:"noops"`
+	Retries          struct {
+		Bulk   int64 `json:"bulk"`
+		Search int64 `json:"search"`
+	} `json:"retries"`
+	ThrottledMillis int64             `json:"throttled_millis"`
+	Failures        []json.RawMessage `json:"failures"`
+}
+
+// taskResponse represents the response of the Tasks Get API.
+type taskResponse struct {
+	Completed bool            `json:"completed"`
+	Task      json.RawMessage `json:"task"`
+	Response  json.RawMessage `json:"response"`
+	Error     json.RawMessage `json:"error"`
+}
+
+// ReindexAndWait submits the reindex request body asynchronously and polls the task
+// every pollInterval until it completes, returning the final reindex statistics.
+//
+// The task is polled with a ReindexTaskPoller, so the polling waits for the throttling of the task
+// to expire, and the task is cancelled when the context is cancelled.
+//
+// When timeout is greater than zero and the task doesn't complete in time, an error is returned;
+// when cancelOnTimeout is true, the task is cancelled as well.
+func ReindexAndWait(
+	ctx context.Context,
+	client opensearchapi.Transport,
+	body io.Reader,
+	pollInterval time.Duration,
+	timeout time.Duration,
+	cancelOnTimeout bool,
+) (*ReindexResponse, error) {
+	if pollInterval <= 0 {
+		return nil, errInvalidPollInterval
+	}
+
+	taskID, err := startReindex(ctx, client, opensearchapi.ReindexRequest{Body: body})
+	if err != nil {
+		return nil, err
+	}
+
+	var deadline <-chan time.Time
+	if timeout > 0 {
+		timer := time.NewTimer(timeout)
+		defer timer.Stop()
+		deadline = timer.C
+	}
+
+	p := NewReindexTaskPoller(client, taskID, pollInterval)
+	for {
+		ok, timedOut := p.next(ctx, deadline)
+		if timedOut {
+			if cancelOnTimeout {
+				if err := cancelTask(ctx, client, taskID); err != nil {
+					return nil, fmt.Errorf("reindex: task %s did not complete within %s: %s", taskID, timeout, err)
+				}
+			}
+			return nil, fmt.Errorf("reindex: task %s did not complete within %s", taskID, timeout)
+		}
+		if !ok {
+			break
+		}
+	}
+
+	if err := p.Err(); err != nil {
+		return nil, err
+	}
+	return p.Response(), nil
+}
+
+// ReindexStatus represents the progress of a running reindex task.
+type ReindexStatus struct {
+	Total                int64 `json:"total"`
+	Created              int64 `json:"created"`
+	Updated              int64 `json:"updated"`
+	Deleted              int64 `json:"deleted"`
+	Batches              int64 `json:"batches"`
+	VersionConflicts     int64 `json:"version_conflicts"`
+	Noops                int64 `json:"noops"`
+	ThrottledMillis      int64 `json:"throttled_millis"`
+	ThrottledUntilMillis int64 `json:"throttled_until_millis"`
+}
+
+var errInvalidPollInterval = errors.New("reindex: poll interval must be greater than zero")
+
+// ReindexTaskPoller polls an asynchronous reindex task until it completes.
+//
+// Each call to Next waits for the poll interval, or until the throttling of the task
+// expires when that is later, and fetches the task status. When the context is cancelled,
+// the task is cancelled as well.
+//
+//	p, err := opensearchutil.StartReindex(ctx, client, opensearchapi.ReindexBody{
+//		Source: opensearchapi.ReindexSource{Index: []string{"src"}},
+//		Dest:   opensearchapi.ReindexDest{Index: "dst", OpType: "create"},
+//	}, time.Second)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	for p.Next(ctx) {
+//		s := p.Status()
+//		fmt.Printf("%d/%d\n", s.Created+s.Updated, s.Total)
+//	}
+//	if err := p.Err(); err != nil {
+//		log.Fatal(err)
+//	}
+//	fmt.Println(p.Response().Created)
+type ReindexTaskPoller struct {
+	client       opensearchapi.Transport
+	taskID       string
+	pollInterval time.Duration
+
+	polled   bool
+	status   ReindexStatus
+	response *ReindexResponse
+	done     bool
+	err      error
+}
+
+// NewReindexTaskPoller creates a new poller for the reindex task,
+// fetching its status every pollInterval. The first call to Next fails
+// when pollInterval is not greater than zero.
+func NewReindexTaskPoller(client opensearchapi.Transport, taskID string, pollInterval time.Duration) *ReindexTaskPoller {
+	return &ReindexTaskPoller{client: client, taskID: taskID, pollInterval: pollInterval}
+}
+
+// StartReindex submits the reindex body asynchronously and returns a poller for the task.
+func StartReindex(
+	ctx context.Context,
+	client opensearchapi.Transport,
+	body opensearchapi.ReindexBody,
+	pollInterval time.Duration,
+) (*ReindexTaskPoller, error) {
+	if pollInterval <= 0 {
+		return nil, errInvalidPollInterval
+	}
+
+	taskID, err := startReindex(ctx, client, opensearchapi.ReindexRequest{ReindexBody: &body})
+	if err != nil {
+		return nil, err
+	}
+	return NewReindexTaskPoller(client, taskID, pollInterval), nil
+}
+
+// Next fetches the status of the task, waiting for the poll interval after the first call.
+// It returns false after the status of the completed task has been returned,
+// when the context is cancelled, or when an error occurs.
+func (p *ReindexTaskPoller) Next(ctx context.Context) bool {
+	ok, _ := p.next(ctx, nil)
+	return ok
+}
+
+// next is Next, which stops waiting for the poll interval and returns timedOut
+// when deadline fires, without cancelling the task.
+func (p *ReindexTaskPoller) next(ctx context.Context, deadline <-chan time.Time) (ok, timedOut bool) {
+	if p.done {
+		return false, false
+	}
+
+	if p.pollInterval <= 0 {
+		p.stop(errInvalidPollInterval)
+		return false, false
+	}
+
+	if p.polled {
+		wait := p.pollInterval
+		if throttled := time.Duration(p.status.ThrottledUntilMillis) * time.Millisecond; throttled > wait {
+			wait = throttled
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			p.cancel(ctx.Err())
+			return false, false
+		case <-deadline:
+			timer.Stop()
+			return false, true
+		case <-timer.C:
+		}
+	}
+	p.polled = true
+
+	tr, err := getTask(ctx, p.client, p.taskID)
+	if err != nil {
+		if ctx.Err() != nil {
+			p.cancel(ctx.Err())
+			return false, false
+		}
+		p.stop(fmt.Errorf("reindex: %s", err))
+		return false, false
+	}
+
+	var task struct {
+		Status ReindexStatus `json:"status"`
+	}
+	if len(tr.Task) > 0 {
+		if err := opensearchapi.JSONCodecOf(p.client).Unmarshal(tr.Task, &task); err != nil {
+			p.stop(fmt.Errorf("reindex: error parsing task status: %s", err))
+			return false, false
+		}
+	}
+	p.status = task.Status
+
+	if tr.Completed {
+		p.done = true
+		if len(tr.Error) > 0 {
+			p.err = fmt.Errorf("reindex: task %s failed: %s", p.taskID, tr.Error)
+			return false, false
+		}
+		var rr ReindexResponse
+		if err := opensearchapi.JSONCodecOf(p.client).Unmarshal(tr.Response, &rr); err != nil {
+			p.err = fmt.Errorf("reindex: error parsing task response: %s", err)
+			return false, false
+		}
+		p.response = &rr
+	}
+
+	return true, false
+}
+
+// TaskID returns the ID of the reindex task.
+func (p *ReindexTaskPoller) TaskID() string {
+	return p.taskID
+}
+
+// Status returns the last fetched status of the task.
+func (p *ReindexTaskPoller) Status() ReindexStatus {
+	return p.status
+}
+
+// Response returns the result of the completed task, or nil when it is still running.
+func (p *ReindexTaskPoller) Response() *ReindexResponse {
+	return p.response
+}
+
+// Err returns the first error encountered by the poller.
+func (p *ReindexTaskPoller) Err() error {
+	return p.err
+}
+
+// cancel cancels the task after the context has been cancelled.
+func (p *ReindexTaskPoller) cancel(err error) {
+	p.stop(cancelTaskAfter(p.client, p.taskID, err))
+}
+
+func (p *ReindexTaskPoller) stop(err error) {
+	p.done = true
+	p.err = err
+}
+
+// startReindex submits the reindex request asynchronously and returns the ID of the task.
+func startReindex(ctx context.Context, client opensearchapi.Transport, req opensearchapi.ReindexRequest) (string, error) {
+	req.WaitForCompletion = opensearchapi.BoolPtr(false)
+	return startTask(ctx, client, req, "reindex")
+}
+
+// getTask returns the status of the task.
+func getTask(ctx context.Context, client opensearchapi.Transport, taskID string) (*taskResponse, error) {
+	res, err := opensearchapi.TasksGetRequest{TaskID: taskID}.Do(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if err := res.Err(); err != nil {
+		return nil, err
+	}
+
+	var tr taskResponse
+	if err := decodeBody(client, res.Body, &tr); err != nil {
+		return nil, fmt.Errorf("error parsing task response: %s", err)
+	}
+
+	return &tr, nil
+}
+
+// cancelTaskAfter cancels the task after the context has been cancelled with err,
+// using a new context as that one is done, and returns err with the cancel error, if any.
+func cancelTaskAfter(client opensearchapi.Transport, taskID string, err error) error {
+	ctx, cancel := context.WithTimeout(context.Background(), cleanupTimeout)
+	defer cancel()
+
+	if cerr := cancelTask(ctx, client, taskID); cerr != nil {
+		return fmt.Errorf("%w (cancelling task %s: %s)", err, taskID, cerr)
+	}
+	return err
+}
+
+// cancelTask cancels the task.
+func cancelTask(ctx context.Context, client opensearchapi.Transport, taskID string) error {
+	res, err := opensearchapi.TasksCancelRequest{TaskID: taskID}.Do(ctx, client)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	return res.Err()
+}
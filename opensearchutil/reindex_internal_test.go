@@ -0,0 +1,308 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+//go:build !integration
+// +build !integration
+
+package opensearchutil
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alphastrikelabs/opensearch-go/v2"
+	"github.com/alphastrikelabs/opensearch-go/v2/opensearchapi"
+)
+
+func TestReindexAndWait(t *testing.T) {
+	body := `{"source":{"index":"src"},"dest":{"index":"dst"}}`
+
+	t.Run("Completed", func(t *testing.T) {
+		var numPolls int
+
+		client, _ := opensearch.NewClient(opensearch.Config{Transport: &mockTransport{
+			RoundTripFunc: func(req *http.Request) (*http.Response, error) {
+				var resBody string
+				switch {
+				case req.URL.Path == "/_reindex":
+					if !strings.Contains(req.URL.RawQuery, "wait_for_completion=false") {
+						t.Errorf("Expected wait_for_completion=false in query: %s", req.URL.RawQuery)
+					}
+					resBody = `{"task":"node-1:42"}`
+				case req.URL.Path == "/_tasks/node-1:42":
+					numPolls++
+					if numPolls < 2 {
+						resBody = `{"completed":false,"task":{}}`
+					} else {
+						resBody = `{"completed":true,"task":{},"response":{"total":3,"created":2,"updated":1,"version_conflicts":0,"failures":[]}}`
+					}
+				default:
+					t.Errorf("Unexpected request: %s %s", req.Method, req.URL.Path)
+				}
+				return &http.Response{StatusCode: http.StatusOK, Body: ioutil.NopCloser(strings.NewReader(resBody))}, nil
+			},
+		}})
+
+		rr, err := ReindexAndWait(context.Background(), client, strings.NewReader(body), time.Millisecond, 0, false)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if rr.Created != 2 || rr.Updated != 1 || rr.Total != 3 {
+			t.Errorf("Unexpected response: %+v", rr)
+		}
+		if numPolls != 2 {
+			t.Errorf("Unexpected number of polls: %d", numPolls)
+		}
+	})
+
+	t.Run("Throttled", func(t *testing.T) {
+		var polls []time.Time
+
+		client, _ := opensearch.NewClient(opensearch.Config{Transport: &mockTransport{
+			RoundTripFunc: func(req *http.Request) (*http.Response, error) {
+				resBody := `{"task":"node-1:42"}`
+				if req.URL.Path == "/_tasks/node-1:42" {
+					polls = append(polls, time.Now())
+					resBody = `{"completed":false,"task":{"status":{"throttled_until_millis":50}}}`
+					if len(polls) > 1 {
+						resBody = `{"completed":true,"task":{},"response":{"total":1,"created":1}}`
+					}
+				}
+				return &http.Response{StatusCode: http.StatusOK, Body: ioutil.NopCloser(strings.NewReader(resBody))}, nil
+			},
+		}})
+
+		if _, err := ReindexAndWait(context.Background(), client, strings.NewReader(body), time.Millisecond, 0, false); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if len(polls) != 2 {
+			t.Fatalf("Unexpected number of polls: %d", len(polls))
+		}
+		if d := polls[1].Sub(polls[0]); d < 50*time.Millisecond {
+			t.Errorf("Expected the poll to wait for the throttling, waited: %s", d)
+		}
+	})
+
+	t.Run("Timeout", func(t *testing.T) {
+		var cancelled bool
+
+		client, _ := opensearch.NewClient(opensearch.Config{Transport: &mockTransport{
+			RoundTripFunc: func(req *http.Request) (*http.Response, error) {
+				resBody := `{"completed":false,"task":{}}`
+				switch req.URL.Path {
+				case "/_reindex":
+					resBody = `{"task":"node-1:42"}`
+				case "/_tasks/node-1:42/_cancel":
+					cancelled = true
+					resBody = `{"nodes":{}}`
+				}
+				return &http.Response{StatusCode: http.StatusOK, Body: ioutil.NopCloser(strings.NewReader(resBody))}, nil
+			},
+		}})
+
+		_, err := ReindexAndWait(context.Background(), client, strings.NewReader(body), time.Millisecond, 10*time.Millisecond, true)
+		if err == nil {
+			t.Fatalf("Expected timeout error")
+		}
+		if !cancelled {
+			t.Errorf("Expected the task to be cancelled")
+		}
+	})
+
+	t.Run("Task error", func(t *testing.T) {
+		client, _ := opensearch.NewClient(opensearch.Config{Transport: &mockTransport{
+			RoundTripFunc: func(req *http.Request) (*http.Response, error) {
+				resBody := `{"completed":true,"task":{},"error":{"type":"index_not_found_exception"}}`
+				if req.URL.Path == "/_reindex" {
+					resBody = `{"task":"node-1:42"}`
+				}
+				return &http.Response{StatusCode: http.StatusOK, Body: ioutil.NopCloser(strings.NewReader(resBody))}, nil
+			},
+		}})
+
+		if _, err := ReindexAndWait(context.Background(), client, strings.NewReader(body), time.Millisecond, 0, false); err == nil {
+			t.Errorf("Expected error for failed task")
+		}
+	})
+
+	t.Run("Cancelled", func(t *testing.T) {
+		var cancelled bool
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		client, _ := opensearch.NewClient(opensearch.Config{Transport: &mockTransport{
+			RoundTripFunc: func(req *http.Request) (*http.Response, error) {
+				resBody := `{"completed":false,"task":{}}`
+				switch req.URL.Path {
+				case "/_reindex":
+					resBody = `{"task":"node-1:42"}`
+				case "/_tasks/node-1:42":
+					cancel()
+				case "/_tasks/node-1:42/_cancel":
+					cancelled = true
+					resBody = `{"nodes":{}}`
+				}
+				return &http.Response{StatusCode: http.StatusOK, Body: ioutil.NopCloser(strings.NewReader(resBody))}, nil
+			},
+		}})
+
+		_, err := ReindexAndWait(ctx, client, strings.NewReader(body), time.Hour, 0, false)
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("Unexpected error: %v", err)
+		}
+		if !cancelled {
+			t.Errorf("Expected the task to be cancelled")
+		}
+	})
+
+	t.Run("Invalid poll interval", func(t *testing.T) {
+		client, _ := opensearch.NewClient(opensearch.Config{Transport: &mockTransport{
+			RoundTripFunc: func(req *http.Request) (*http.Response, error) {
+				t.Errorf("Unexpected request: %s %s", req.Method, req.URL.Path)
+				return &http.Response{StatusCode: http.StatusOK, Body: ioutil.NopCloser(strings.NewReader(`{}`))}, nil
+			},
+		}})
+
+		if _, err := ReindexAndWait(context.Background(), client, strings.NewReader(body), 0, 0, false); err == nil {
+			t.Errorf("Expected error for a zero poll interval")
+		}
+	})
+}
+
+func TestReindexTaskPoller(t *testing.T) {
+	reindexBody := opensearchapi.ReindexBody{
+		Source: opensearchapi.ReindexSource{Index: []string{"src"}},
+		Dest:   opensearchapi.ReindexDest{Index: "dst", OpType: "create"},
+	}
+
+	t.Run("Progress", func(t *testing.T) {
+		var numPolls int
+
+		client, _ := opensearch.NewClient(opensearch.Config{Transport: &mockTransport{
+			RoundTripFunc: func(req *http.Request) (*http.Response, error) {
+				var resBody string
+				switch req.URL.Path {
+				case "/_reindex":
+					body, _ := ioutil.ReadAll(req.Body)
+					if want := `{"source":{"index":["src"]},"dest":{"index":"dst","op_type":"create"}}`; string(body) != want {
+						t.Errorf("Unexpected body: %s", body)
+					}
+					resBody = `{"task":"node-1:42"}`
+				case "/_tasks/node-1:42":
+					numPolls++
+					switch numPolls {
+					case 1:
+						resBody = `{"completed":false,"task":{"status":{"total":4,"created":1,"throttled_until_millis":5}}}`
+					case 2:
+						resBody = `{"completed":false,"task":{"status":{"total":4,"created":2,"updated":1}}}`
+					default:
+						resBody = `{"completed":true,"task":{"status":{"total":4,"created":3,"updated":1}},"response":{"total":4,"created":3,"updated":1}}`
+					}
+				default:
+					t.Errorf("Unexpected request: %s %s", req.Method, req.URL.Path)
+				}
+				return &http.Response{StatusCode: http.StatusOK, Body: ioutil.NopCloser(strings.NewReader(resBody))}, nil
+			},
+		}})
+
+		p, err := StartReindex(context.Background(), client, reindexBody, time.Millisecond)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if p.TaskID() != "node-1:42" {
+			t.Errorf("Unexpected task ID: %s", p.TaskID())
+		}
+
+		var created []int64
+		for p.Next(context.Background()) {
+			created = append(created, p.Status().Created)
+		}
+		if err := p.Err(); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if len(created) != 3 || created[0] != 1 || created[1] != 2 || created[2] != 3 {
+			t.Errorf("Unexpected progress: %v", created)
+		}
+		if rr := p.Response(); rr == nil || rr.Created != 3 || rr.Updated != 1 || rr.Total != 4 {
+			t.Errorf("Unexpected response: %+v", rr)
+		}
+	})
+
+	t.Run("Cancelled", func(t *testing.T) {
+		var cancelled bool
+
+		client, _ := opensearch.NewClient(opensearch.Config{Transport: &mockTransport{
+			RoundTripFunc: func(req *http.Request) (*http.Response, error) {
+				resBody := `{"completed":false,"task":{"status":{"total":4}}}`
+				if req.URL.Path == "/_tasks/node-1:42/_cancel" {
+					if req.Method != "POST" {
+						t.Errorf("Unexpected method: %s", req.Method)
+					}
+					cancelled = true
+					resBody = `{"nodes":{}}`
+				}
+				return &http.Response{StatusCode: http.StatusOK, Body: ioutil.NopCloser(strings.NewReader(resBody))}, nil
+			},
+		}})
+
+		ctx, cancel := context.WithCancel(context.Background())
+		p := NewReindexTaskPoller(client, "node-1:42", time.Hour)
+		if !p.Next(ctx) {
+			t.Fatalf("Unexpected error: %s", p.Err())
+		}
+		cancel()
+		if p.Next(ctx) {
+			t.Fatalf("Expected Next to return false")
+		}
+		if !errors.Is(p.Err(), context.Canceled) {
+			t.Errorf("Unexpected error: %v", p.Err())
+		}
+		if !cancelled {
+			t.Errorf("Expected the task to be cancelled")
+		}
+		if p.Response() != nil {
+			t.Errorf("Unexpected response: %+v", p.Response())
+		}
+	})
+
+	t.Run("Invalid body", func(t *testing.T) {
+		client, _ := opensearch.NewClient(opensearch.Config{Transport: &mockTransport{}})
+
+		if _, err := StartReindex(context.Background(), client, opensearchapi.ReindexBody{}, time.Millisecond); err == nil {
+			t.Errorf("Expected error for missing index")
+		}
+	})
+
+	t.Run("Invalid poll interval", func(t *testing.T) {
+		client, _ := opensearch.NewClient(opensearch.Config{Transport: &mockTransport{
+			RoundTripFunc: func(req *http.Request) (*http.Response, error) {
+				t.Errorf("Unexpected request: %s %s", req.Method, req.URL.Path)
+				return &http.Response{StatusCode: http.StatusOK, Body: ioutil.NopCloser(strings.NewReader(`{}`))}, nil
+			},
+		}})
+
+		if _, err := StartReindex(context.Background(), client, reindexBody, -time.Second); err == nil {
+			t.Errorf("Expected error for a negative poll interval")
+		}
+
+		p := NewReindexTaskPoller(client, "node-1:42", 0)
+		if p.Next(context.Background()) {
+			t.Errorf("Expected Next to return false")
+		}
+		if p.Err() == nil {
+			t.Errorf("Expected error for a zero poll interval")
+		}
+	})
+}
@@ -0,0 +1,72 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchutil
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/alphastrikelabs/opensearch-go/v2/opensearchapi"
+)
+
+// RemoteInfo returns the remote clusters configured for cross-cluster search, by alias.
+func RemoteInfo(ctx context.Context, client opensearchapi.Transport) (map[string]opensearchapi.RemoteClusterInfo, error) {
+	res, err := opensearchapi.ClusterRemoteInfoRequest{}.Do(ctx, client)
+	if err != nil {
+		if res != nil && res.Body != nil {
+			res.Body.Close()
+		}
+		return nil, fmt.Errorf("remote info: %w", err)
+	}
+
+	info, err := opensearchapi.ParseClusterRemoteInfoResponse(res)
+	if err != nil {
+		return nil, fmt.Errorf("remote info: %w", err)
+	}
+	return info, nil
+}
+
+// RemoteClusterSettings represents the connection settings of a remote cluster, see RemoteClusterUpdate.
+type RemoteClusterSettings struct {
+	Seeds           []string // The seed nodes, eg. "10.0.0.1:9300"; empty to remove the remote cluster
+	SkipUnavailable *bool    // Skip the remote cluster in the searches when it's unavailable (default: unchanged)
+}
+
+// RemoteClusterUpdate adds or updates the remote cluster with the alias, in the persistent cluster settings
+// "cluster.remote.<alias>.*". The remote cluster is removed when settings has no seeds.
+func RemoteClusterUpdate(ctx context.Context, client opensearchapi.Transport, alias string, settings RemoteClusterSettings) error {
+	if strings.TrimSpace(alias) == "" || strings.ContainsAny(alias, ".:") {
+		return fmt.Errorf("remote cluster update: invalid alias %q", alias)
+	}
+
+	var (
+		prefix     = "cluster.remote." + alias + "."
+		persistent = make(map[string]interface{})
+	)
+	if len(settings.Seeds) == 0 {
+		// The other settings must be removed with the seeds, as they're rejected without them
+		persistent[prefix+"seeds"] = nil
+		persistent[prefix+"skip_unavailable"] = nil
+	} else {
+		persistent[prefix+"seeds"] = settings.Seeds
+		if settings.SkipUnavailable != nil {
+			persistent[prefix+"skip_unavailable"] = *settings.SkipUnavailable
+		}
+	}
+
+	req := opensearchapi.ClusterPutSettingsRequest{
+		Body: opensearchapi.JSONBody(map[string]interface{}{"persistent": persistent}),
+	}
+	if err := doRequest(ctx, client, req); err != nil {
+		return fmt.Errorf("remote cluster update: %w", err)
+	}
+	return nil
+}
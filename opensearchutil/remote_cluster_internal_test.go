@@ -0,0 +1,110 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+//go:build !integration
+// +build !integration
+
+package opensearchutil
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/alphastrikelabs/opensearch-go/v2"
+	"github.com/alphastrikelabs/opensearch-go/v2/opensearchapi"
+)
+
+func TestRemoteInfo(t *testing.T) {
+	client, _ := opensearch.NewClient(opensearch.Config{Transport: &mockTransport{
+		RoundTripFunc: func(req *http.Request) (*http.Response, error) {
+			if req.Method != http.MethodGet || req.URL.Path != "/_remote/info" {
+				t.Errorf("Unexpected request: %s %s", req.Method, req.URL.Path)
+			}
+			body := `{"logs_eu":{"connected":true,"mode":"sniff","seeds":["10.0.0.1:9300"],"num_nodes_connected":3,
+				"max_connections_per_cluster":3,"initial_connect_timeout":"30s","skip_unavailable":true}}`
+			return &http.Response{StatusCode: http.StatusOK, Body: ioutil.NopCloser(strings.NewReader(body))}, nil
+		},
+	}})
+
+	info, err := RemoteInfo(context.Background(), client)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	want := map[string]opensearchapi.RemoteClusterInfo{
+		"logs_eu": {
+			Connected:                true,
+			Mode:                     "sniff",
+			Seeds:                    []string{"10.0.0.1:9300"},
+			NumNodesConnected:        3,
+			MaxConnectionsPerCluster: 3,
+			InitialConnectTimeout:    "30s",
+			SkipUnavailable:          true,
+		},
+	}
+	if !reflect.DeepEqual(info, want) {
+		t.Errorf("Unexpected remote info: %+v", info)
+	}
+}
+
+func TestRemoteClusterUpdate(t *testing.T) {
+	var body string
+	client, _ := opensearch.NewClient(opensearch.Config{Transport: &mockTransport{
+		RoundTripFunc: func(req *http.Request) (*http.Response, error) {
+			if req.Method != http.MethodPut || req.URL.Path != "/_cluster/settings" {
+				t.Errorf("Unexpected request: %s %s", req.Method, req.URL.Path)
+			}
+			b, _ := ioutil.ReadAll(req.Body)
+			body = string(b)
+			return &http.Response{StatusCode: http.StatusOK, Body: ioutil.NopCloser(strings.NewReader(`{"acknowledged":true}`))}, nil
+		},
+	}})
+
+	skip := true
+	for _, tt := range []struct {
+		name     string
+		settings RemoteClusterSettings
+		want     string
+	}{
+		{
+			"Add",
+			RemoteClusterSettings{Seeds: []string{"10.0.0.1:9300", "10.0.0.2:9300"}, SkipUnavailable: &skip},
+			`{"persistent":{"cluster.remote.logs_eu.seeds":["10.0.0.1:9300","10.0.0.2:9300"],"cluster.remote.logs_eu.skip_unavailable":true}}`,
+		},
+		{
+			"Seeds",
+			RemoteClusterSettings{Seeds: []string{"10.0.0.3:9300"}},
+			`{"persistent":{"cluster.remote.logs_eu.seeds":["10.0.0.3:9300"]}}`,
+		},
+		{
+			"Remove",
+			RemoteClusterSettings{},
+			`{"persistent":{"cluster.remote.logs_eu.seeds":null,"cluster.remote.logs_eu.skip_unavailable":null}}`,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := RemoteClusterUpdate(context.Background(), client, "logs_eu", tt.settings); err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if body != tt.want {
+				t.Errorf("Unexpected body:\ngot:  %s\nwant: %s", body, tt.want)
+			}
+		})
+	}
+
+	for _, alias := range []string{"", "logs.eu", "logs:eu"} {
+		if err := RemoteClusterUpdate(context.Background(), client, alias, RemoteClusterSettings{}); err == nil {
+			t.Errorf("Expected error for alias %q", alias)
+		}
+	}
+}
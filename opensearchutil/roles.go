@@ -0,0 +1,230 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchutil
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/alphastrikelabs/opensearch-go/v2/opensearchapi"
+)
+
+// RolesBulkUpsertError represents the failures of RolesBulkUpsert, by role name.
+type RolesBulkUpsertError struct {
+	Errors map[string]error
+}
+
+// Error returns the failures sorted by role name.
+func (e *RolesBulkUpsertError) Error() string {
+	names := make([]string, 0, len(e.Errors))
+	for name := range e.Errors {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "roles bulk upsert: %d role(s) failed", len(names))
+	for _, name := range names {
+		fmt.Fprintf(&b, "; %s: %s", name, e.Errors[name])
+	}
+	return b.String()
+}
+
+type roleInfo struct {
+	Reserved bool `json:"reserved"`
+	Hidden   bool `json:"hidden"`
+	Static   bool `json:"static"`
+}
+
+// builtin returns whether the resource is defined by the security plugin, and can't be changed through the API.
+func (i roleInfo) builtin() bool {
+	return i.Reserved || i.Hidden || i.Static
+}
+
+// RolesBulkUpsert creates or replaces the roles, in the order of their names.
+//
+// When prune is true, the existing roles which are not in roles are deleted,
+// except the reserved, hidden and static roles.
+//
+// The failures of single roles don't stop the operation; they're returned as a *RolesBulkUpsertError.
+// The operation stops when the context is cancelled, and the context error is returned.
+func RolesBulkUpsert(ctx context.Context, client opensearchapi.Transport, roles map[string]opensearchapi.RoleBody, prune bool) error {
+	var (
+		errs  = make(map[string]error)
+		names = make([]string, 0, len(roles))
+	)
+
+	for name := range roles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if err := ctx.Err(); err != nil {
+			return fmt.Errorf("roles bulk upsert: %w", err)
+		}
+
+		role := roles[name]
+		req := opensearchapi.RoleCreateRequest{Role: name, RoleBody: &role}
+		if err := doRequest(ctx, client, req); err != nil {
+			errs[name] = err
+		}
+	}
+
+	if prune {
+		existing, err := getRoles(ctx, client)
+		if err != nil {
+			return fmt.Errorf("roles bulk upsert: cannot list roles: %w", err)
+		}
+
+		var stale []string
+		for name, info := range existing {
+			if _, ok := roles[name]; !ok && !info.builtin() {
+				stale = append(stale, name)
+			}
+		}
+		sort.Strings(stale)
+
+		for _, name := range stale {
+			if err := ctx.Err(); err != nil {
+				return fmt.Errorf("roles bulk upsert: %w", err)
+			}
+
+			req := opensearchapi.RoleDeleteRequest{Role: name}
+			if err := doRequest(ctx, client, req); err != nil {
+				errs[name] = fmt.Errorf("cannot delete role: %w", err)
+			}
+		}
+	}
+
+	if len(errs) > 0 {
+		return &RolesBulkUpsertError{Errors: errs}
+	}
+	return nil
+}
+
+// RoleMappingsDeleteConfig represents the configuration of RoleMappingsDelete.
+type RoleMappingsDeleteConfig struct {
+	NumWorkers    int  // The number of concurrent requests (default: number of CPUs)
+	IgnoreMissing bool // Don't report the missing role mappings as failures
+}
+
+// RoleMappingsDelete deletes the role mappings of the roles, and returns the failures by role name.
+//
+// The failures of single role mappings don't stop the operation. When the context is cancelled,
+// the remaining role mappings are not deleted, and the context error is returned.
+func RoleMappingsDelete(ctx context.Context, client opensearchapi.Transport, roles []string, cfg RoleMappingsDeleteConfig) (map[string]error, error) {
+	if cfg.NumWorkers <= 0 {
+		cfg.NumWorkers = runtime.NumCPU()
+	}
+
+	var (
+		mu   sync.Mutex
+		wg   sync.WaitGroup
+		errs = make(map[string]error)
+		ch   = make(chan string)
+	)
+
+	for i := 0; i < cfg.NumWorkers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for role := range ch {
+				if err := deleteRoleMapping(ctx, client, role, cfg.IgnoreMissing); err != nil {
+					mu.Lock()
+					errs[role] = err
+					mu.Unlock()
+				}
+			}
+		}()
+	}
+
+	var ctxErr error
+	for _, role := range roles {
+		if ctx.Err() == nil {
+			select {
+			case ch <- role:
+				continue
+			case <-ctx.Done():
+			}
+		}
+		ctxErr = fmt.Errorf("role mappings delete: %w", ctx.Err())
+		break
+	}
+	close(ch)
+	wg.Wait()
+
+	return errs, ctxErr
+}
+
+// deleteRoleMapping deletes the role mapping, ignoring a missing one when ignoreMissing is true.
+func deleteRoleMapping(ctx context.Context, client opensearchapi.Transport, role string, ignoreMissing bool) error {
+	res, err := opensearchapi.RoleMappingDeleteRequest{Role: role}.Do(ctx, client)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if ignoreMissing && res.StatusCode == http.StatusNotFound {
+		return nil
+	}
+	return res.Err()
+}
+
+// RoleExists returns true when the role exists, and false when it doesn't.
+//
+// Responses other than 200 and 404 are returned as an error.
+// The existence is checked with a GET request, as the security plugin doesn't support HEAD requests.
+func RoleExists(ctx context.Context, client opensearchapi.Transport, role string) (bool, error) {
+	return exists(ctx, client, opensearchapi.RoleGetRequest{Role: role})
+}
+
+// RoleMappingExists returns true when the role mapping exists, and false when it doesn't.
+//
+// Responses other than 200 and 404 are returned as an error.
+// The existence is checked with a GET request, as the security plugin doesn't support HEAD requests.
+func RoleMappingExists(ctx context.Context, client opensearchapi.Transport, role string) (bool, error) {
+	return exists(ctx, client, opensearchapi.RoleMappingGetRequest{Role: role})
+}
+
+// doRequest performs the request and returns an error for error responses.
+func doRequest(ctx context.Context, client opensearchapi.Transport, req opensearchapi.Request) error {
+	res, err := req.Do(ctx, client)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	return res.Err()
+}
+
+// getRoles returns all the roles of the cluster.
+func getRoles(ctx context.Context, client opensearchapi.Transport) (map[string]roleInfo, error) {
+	res, err := opensearchapi.RoleGetRequest{}.Do(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if err := res.Err(); err != nil {
+		return nil, err
+	}
+
+	var roles map[string]roleInfo
+	if err := decodeBody(client, res.Body, &roles); err != nil {
+		return nil, fmt.Errorf("cannot decode response: %s", err)
+	}
+	return roles, nil
+}
@@ -0,0 +1,214 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+//go:build !integration
+// +build !integration
+
+package opensearchutil
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/alphastrikelabs/opensearch-go/v2"
+	"github.com/alphastrikelabs/opensearch-go/v2/opensearchapi"
+)
+
+func TestRolesBulkUpsert(t *testing.T) {
+	newClient := func(t *testing.T, calls *[]string) *opensearch.Client {
+		client, _ := opensearch.NewClient(opensearch.Config{Transport: &mockTransport{
+			RoundTripFunc: func(req *http.Request) (*http.Response, error) {
+				*calls = append(*calls, req.Method+" "+req.URL.Path)
+
+				switch {
+				case req.Method == "GET":
+					return &http.Response{StatusCode: http.StatusOK, Body: ioutil.NopCloser(strings.NewReader(
+						`{"readers":{},"writers":{},"stale":{},"all_access":{"reserved":true},"hidden_role":{"hidden":true}}`))}, nil
+				case strings.HasSuffix(req.URL.Path, "/writers"):
+					return &http.Response{StatusCode: http.StatusBadRequest, Body: ioutil.NopCloser(strings.NewReader(
+						`{"status":"BAD_REQUEST","message":"invalid permission"}`))}, nil
+				default:
+					return &http.Response{StatusCode: http.StatusOK, Body: ioutil.NopCloser(strings.NewReader(`{}`))}, nil
+				}
+			},
+		}})
+		return client
+	}
+
+	roles := map[string]opensearchapi.RoleBody{
+		"writers": {IndexPermissions: []opensearchapi.RoleIndexPermission{{IndexPatterns: []string{"logs-*"}, AllowedActions: []string{"write"}}}},
+		"readers": {IndexPermissions: []opensearchapi.RoleIndexPermission{{IndexPatterns: []string{"logs-*"}, AllowedActions: []string{"read"}}}},
+	}
+
+	t.Run("Upsert", func(t *testing.T) {
+		var calls []string
+		client := newClient(t, &calls)
+
+		err := RolesBulkUpsert(context.Background(), client, roles, false)
+
+		var upsertErr *RolesBulkUpsertError
+		if !errors.As(err, &upsertErr) {
+			t.Fatalf("Expected RolesBulkUpsertError, got: %v", err)
+		}
+		if len(upsertErr.Errors) != 1 || upsertErr.Errors["writers"] == nil {
+			t.Errorf("Unexpected errors: %v", upsertErr.Errors)
+		}
+
+		want := []string{"PUT /_plugins/_security/api/roles/readers", "PUT /_plugins/_security/api/roles/writers"}
+		if strings.Join(calls, ",") != strings.Join(want, ",") {
+			t.Errorf("Unexpected calls: %v", calls)
+		}
+	})
+
+	t.Run("Prune", func(t *testing.T) {
+		var calls []string
+		client := newClient(t, &calls)
+
+		RolesBulkUpsert(context.Background(), client, roles, true)
+
+		want := []string{
+			"PUT /_plugins/_security/api/roles/readers",
+			"PUT /_plugins/_security/api/roles/writers",
+			"GET /_plugins/_security/api/roles",
+			"DELETE /_plugins/_security/api/roles/stale",
+		}
+		if strings.Join(calls, ",") != strings.Join(want, ",") {
+			t.Errorf("Unexpected calls: %v", calls)
+		}
+	})
+
+	t.Run("Cancelled", func(t *testing.T) {
+		var calls []string
+		client := newClient(t, &calls)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		if err := RolesBulkUpsert(ctx, client, roles, true); !errors.Is(err, context.Canceled) {
+			t.Errorf("Expected context error, got: %v", err)
+		}
+		if len(calls) != 0 {
+			t.Errorf("Unexpected calls: %v", calls)
+		}
+	})
+}
+
+func TestRoleExists(t *testing.T) {
+	client, _ := opensearch.NewClient(opensearch.Config{Transport: &mockTransport{
+		RoundTripFunc: func(req *http.Request) (*http.Response, error) {
+			var status int
+			switch {
+			case strings.HasSuffix(req.URL.Path, "/found"):
+				status = http.StatusOK
+			case strings.HasSuffix(req.URL.Path, "/missing"):
+				status = http.StatusNotFound
+			default:
+				status = http.StatusForbidden
+			}
+			return &http.Response{StatusCode: status, Body: ioutil.NopCloser(strings.NewReader(`{}`))}, nil
+		},
+	}})
+
+	tests := []struct {
+		role    string
+		want    bool
+		wantErr bool
+	}{
+		{"found", true, false},
+		{"missing", false, false},
+		{"forbidden", false, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.role, func(t *testing.T) {
+			for name, fn := range map[string]func(context.Context, opensearchapi.Transport, string) (bool, error){
+				"RoleExists":        RoleExists,
+				"RoleMappingExists": RoleMappingExists,
+			} {
+				ok, err := fn(context.Background(), client, tt.role)
+				if (err != nil) != tt.wantErr {
+					t.Errorf("%s: unexpected error: %v", name, err)
+				}
+				if ok != tt.want {
+					t.Errorf("%s: want=%t, got=%t", name, tt.want, ok)
+				}
+			}
+		})
+	}
+}
+
+func TestRoleMappingsDelete(t *testing.T) {
+	var (
+		mu    sync.Mutex
+		calls []string
+	)
+	client, _ := opensearch.NewClient(opensearch.Config{Transport: &mockTransport{
+		RoundTripFunc: func(req *http.Request) (*http.Response, error) {
+			mu.Lock()
+			calls = append(calls, req.URL.Path)
+			mu.Unlock()
+
+			switch {
+			case strings.HasSuffix(req.URL.Path, "/missing"):
+				return &http.Response{StatusCode: http.StatusNotFound, Body: ioutil.NopCloser(strings.NewReader(
+					`{"status":"NOT_FOUND","message":"not found"}`))}, nil
+			case strings.HasSuffix(req.URL.Path, "/forbidden"):
+				return &http.Response{StatusCode: http.StatusForbidden, Body: ioutil.NopCloser(strings.NewReader(
+					`{"status":"FORBIDDEN","message":"reserved"}`))}, nil
+			default:
+				return &http.Response{StatusCode: http.StatusOK, Body: ioutil.NopCloser(strings.NewReader(`{}`))}, nil
+			}
+		},
+	}})
+
+	roles := []string{"a", "missing", "b", "forbidden", "c"}
+
+	t.Run("Partial failure", func(t *testing.T) {
+		calls = nil
+		errs, err := RoleMappingsDelete(context.Background(), client, roles, RoleMappingsDeleteConfig{NumWorkers: 2})
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if len(errs) != 2 || errs["missing"] == nil || errs["forbidden"] == nil {
+			t.Errorf("Unexpected errors: %v", errs)
+		}
+		if len(calls) != len(roles) {
+			t.Errorf("Expected %d requests, got: %v", len(roles), calls)
+		}
+	})
+
+	t.Run("Ignore missing", func(t *testing.T) {
+		errs, err := RoleMappingsDelete(context.Background(), client, roles, RoleMappingsDeleteConfig{IgnoreMissing: true})
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if len(errs) != 1 || errs["forbidden"] == nil {
+			t.Errorf("Unexpected errors: %v", errs)
+		}
+	})
+
+	t.Run("Cancelled", func(t *testing.T) {
+		calls = nil
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		_, err := RoleMappingsDelete(ctx, client, roles, RoleMappingsDeleteConfig{})
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("Expected context error, got: %v", err)
+		}
+		if len(calls) != 0 {
+			t.Errorf("Unexpected calls: %v", calls)
+		}
+	})
+}
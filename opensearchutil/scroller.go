@@ -0,0 +1,270 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchutil
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/alphastrikelabs/opensearch-go/v2/opensearchapi"
+)
+
+// Scroller iterates over the hits of a search request using the Scroll API.
+//
+// The scroll context is cleared automatically when the hits are exhausted, an error occurs,
+// or the context of the first call to Next is cancelled, eg. at the end of a request handler;
+// call Close when abandoning the iteration early otherwise.
+//
+//	s := opensearchutil.NewScroller(client, opensearchapi.SearchRequest{Index: []string{"test"}}, time.Minute)
+//	defer s.Close(ctx)
+//	for s.Next(ctx) {
+//		fmt.Println(string(s.Hit()))
+//	}
+//	if err := s.Err(); err != nil {
+//		log.Fatal(err)
+//	}
+type Scroller struct {
+	client    opensearchapi.Transport
+	req       opensearchapi.SearchRequest
+	keepAlive time.Duration
+
+	mu       sync.Mutex
+	watcher  *contextWatcher
+	scrollID string
+	hits     []json.RawMessage
+	hit      json.RawMessage
+	started  bool
+	done     bool
+	err      error
+}
+
+type scrollResponse struct {
+	ScrollID string `json:"_scroll_id"`
+	Hits     struct {
+		Hits []json.RawMessage `json:"hits"`
+	} `json:"hits"`
+}
+
+// NewScroller creates a new scroller for the search request,
+// keeping the scroll context alive for keepAlive between pages.
+func NewScroller(client opensearchapi.Transport, req opensearchapi.SearchRequest, keepAlive time.Duration) *Scroller {
+	return &Scroller{client: client, req: req, keepAlive: keepAlive}
+}
+
+// Next advances the scroller to the next hit, fetching the next page when needed.
+// It returns false when the hits are exhausted, the context is cancelled, or an error occurs.
+func (s *Scroller) Next(ctx context.Context) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.watcher == nil && !s.done {
+		s.watcher = watchContext(ctx, s.cancel)
+	}
+
+	if len(s.hits) == 0 && !s.done {
+		s.fetch(ctx)
+	}
+
+	if len(s.hits) == 0 {
+		s.hit = nil
+		return false
+	}
+
+	s.hit, s.hits = s.hits[0], s.hits[1:]
+	return true
+}
+
+// Hit returns the current hit as raw JSON.
+func (s *Scroller) Hit() json.RawMessage {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.hit
+}
+
+// ScrollID returns the current scroll ID.
+func (s *Scroller) ScrollID() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.scrollID
+}
+
+// Err returns the error which stopped the iteration, if any.
+func (s *Scroller) Err() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.err
+}
+
+// Close clears the scroll context, when still open. It is safe to call Close multiple times.
+func (s *Scroller) Close(ctx context.Context) error {
+	s.mu.Lock()
+	scrollID := s.detach()
+	s.mu.Unlock()
+
+	return clearScroll(ctx, s.client, scrollID)
+}
+
+// detach ends the iteration, stops watching the context,
+// and returns the ID of the scroll context to clear, if any.
+func (s *Scroller) detach() string {
+	s.done = true
+	s.hits = nil
+	if s.watcher != nil {
+		s.watcher.stop()
+	}
+
+	scrollID := s.scrollID
+	s.scrollID = ""
+	return scrollID
+}
+
+// clearScroll clears the scroll context with the ID, when not empty.
+func clearScroll(ctx context.Context, client opensearchapi.Transport, scrollID string) error {
+	if scrollID == "" {
+		return nil
+	}
+
+	res, err := opensearchapi.ClearScrollRequest{ScrollID: []string{scrollID}}.Do(ctx, client)
+	if err != nil {
+		return fmt.Errorf("clear scroll: %s", err)
+	}
+	defer res.Body.Close()
+
+	if res.IsError() && res.StatusCode != 404 {
+		return fmt.Errorf("clear scroll: %w", res.Err())
+	}
+
+	return nil
+}
+
+// fetch retrieves the next page of hits.
+func (s *Scroller) fetch(ctx context.Context) {
+	if err := ctx.Err(); err != nil {
+		s.stop(err)
+		return
+	}
+
+	var (
+		res *opensearchapi.Response
+		err error
+	)
+
+	if !s.started {
+		s.started = true
+		req := s.req
+		req.Scroll = s.keepAlive
+		res, err = req.Do(ctx, s.client)
+	} else {
+		req := opensearchapi.ScrollRequest{
+			Body:   NewJSONReader(map[string]string{"scroll_id": s.scrollID}),
+			Scroll: s.keepAlive,
+		}
+		res, err = req.Do(ctx, s.client)
+	}
+	if err != nil {
+		s.stop(fmt.Errorf("scroll: %s", err))
+		return
+	}
+	defer res.Body.Close()
+
+	if err := res.Err(); err != nil {
+		s.stop(fmt.Errorf("scroll: %w", err))
+		return
+	}
+
+	var page scrollResponse
+	if err := decodeBody(s.client, res.Body, &page); err != nil {
+		s.stop(fmt.Errorf("scroll: error parsing response body: %s", err))
+		return
+	}
+
+	if page.ScrollID != "" {
+		s.scrollID = page.ScrollID
+	}
+
+	if len(page.Hits.Hits) == 0 {
+		s.stop(nil)
+		return
+	}
+
+	s.hits = page.Hits.Hits
+}
+
+// stop ends the iteration with err and clears the scroll context.
+func (s *Scroller) stop(err error) {
+	s.err = err
+	// The caller's context may already be cancelled, use a fresh one for the cleanup.
+	ctx, cancel := context.WithTimeout(context.Background(), cleanupTimeout)
+	defer cancel()
+
+	if cerr := clearScroll(ctx, s.client, s.detach()); cerr != nil && s.err == nil {
+		s.err = cerr
+	}
+}
+
+// cancel ends the iteration when the watched context is cancelled.
+//
+// The scroll context is cleared without holding the lock, so that a slow node
+// doesn't block the other methods; the error of the cancellation is kept.
+func (s *Scroller) cancel(err error) {
+	s.mu.Lock()
+	if s.done {
+		s.mu.Unlock()
+		return
+	}
+	s.err = err
+	scrollID := s.detach()
+	s.mu.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), cleanupTimeout)
+	defer cancel()
+
+	_ = clearScroll(ctx, s.client, scrollID)
+}
+
+// cleanupTimeout limits the requests releasing the server-side resources of an iterator or a task,
+// eg. a scroll context or a reindex task, when the context of the caller is done.
+var cleanupTimeout = 30 * time.Second
+
+// contextWatcher calls a function when a context is cancelled, unless it was stopped before.
+//
+// It releases the server-side contexts of the iterators abandoned without being closed,
+// eg. when a request handler returns early.
+type contextWatcher struct {
+	stopc chan struct{}
+	once  sync.Once
+}
+
+// watchContext calls f with the error of ctx when ctx is cancelled, until the watcher is stopped.
+// f is called from another goroutine.
+func watchContext(ctx context.Context, f func(error)) *contextWatcher {
+	w := &contextWatcher{stopc: make(chan struct{})}
+	if ctx.Done() == nil {
+		// The context is never cancelled
+		return w
+	}
+
+	go func() {
+		select {
+		case <-ctx.Done():
+			f(ctx.Err())
+		case <-w.stopc:
+		}
+	}()
+	return w
+}
+
+// stop stops watching the context. It is safe to call stop multiple times.
+func (w *contextWatcher) stop() {
+	w.once.Do(func() { close(w.stopc) })
+}
@@ -0,0 +1,262 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+//go:build !integration
+// +build !integration
+
+package opensearchutil
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alphastrikelabs/opensearch-go/v2"
+	"github.com/alphastrikelabs/opensearch-go/v2/opensearchapi"
+)
+
+func newScrollTestClient(t *testing.T, pages []string, numClears *int) *opensearch.Client {
+	var page int
+
+	client, _ := opensearch.NewClient(opensearch.Config{Transport: &mockTransport{
+		RoundTripFunc: func(req *http.Request) (*http.Response, error) {
+			if req.Method == "DELETE" {
+				*numClears++
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Body:       ioutil.NopCloser(strings.NewReader(`{"succeeded":true}`)),
+				}, nil
+			}
+
+			body := `{"_scroll_id":"abc","hits":{"hits":[]}}`
+			if page < len(pages) {
+				body = fmt.Sprintf(`{"_scroll_id":"abc","hits":{"hits":[%s]}}`, pages[page])
+			}
+			page++
+			return &http.Response{StatusCode: http.StatusOK, Body: ioutil.NopCloser(strings.NewReader(body))}, nil
+		},
+	}})
+
+	return client
+}
+
+func TestScroller(t *testing.T) {
+	t.Run("Iterate", func(t *testing.T) {
+		var numClears int
+		client := newScrollTestClient(t, []string{`{"_id":"1"},{"_id":"2"}`, `{"_id":"3"}`}, &numClears)
+
+		s := NewScroller(client, opensearchapi.SearchRequest{Index: []string{"test"}}, time.Minute)
+
+		var ids []string
+		for s.Next(context.Background()) {
+			ids = append(ids, string(s.Hit()))
+		}
+		if err := s.Err(); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+
+		if len(ids) != 3 || ids[2] != `{"_id":"3"}` {
+			t.Errorf("Unexpected hits: %v", ids)
+		}
+		if numClears != 1 {
+			t.Errorf("Expected scroll to be cleared once, got: %d", numClears)
+		}
+
+		if err := s.Close(context.Background()); err != nil {
+			t.Errorf("Unexpected error: %s", err)
+		}
+		if numClears != 1 {
+			t.Errorf("Expected Close() after exhaustion to be a no-op, got: %d clears", numClears)
+		}
+	})
+
+	t.Run("Close early", func(t *testing.T) {
+		var numClears int
+		client := newScrollTestClient(t, []string{`{"_id":"1"},{"_id":"2"}`}, &numClears)
+
+		s := NewScroller(client, opensearchapi.SearchRequest{}, time.Minute)
+		if !s.Next(context.Background()) {
+			t.Fatalf("Expected a hit, err: %v", s.Err())
+		}
+		if err := s.Close(context.Background()); err != nil {
+			t.Errorf("Unexpected error: %s", err)
+		}
+		if numClears != 1 {
+			t.Errorf("Expected scroll to be cleared once, got: %d", numClears)
+		}
+		if s.Next(context.Background()) {
+			t.Errorf("Unexpected hit after Close()")
+		}
+	})
+
+	t.Run("Context cancelled", func(t *testing.T) {
+		var numClears int
+		client := newScrollTestClient(t, []string{`{"_id":"1"}`, `{"_id":"2"}`}, &numClears)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		s := NewScroller(client, opensearchapi.SearchRequest{}, time.Minute)
+		if !s.Next(ctx) {
+			t.Fatalf("Expected a hit, err: %v", s.Err())
+		}
+		cancel()
+
+		if s.Next(ctx) {
+			t.Errorf("Unexpected hit after cancellation")
+		}
+		if s.Err() != context.Canceled {
+			t.Errorf("Expected context.Canceled, got: %v", s.Err())
+		}
+		if numClears != 1 {
+			t.Errorf("Expected scroll to be cleared once, got: %d", numClears)
+		}
+	})
+
+	t.Run("Context cancelled mid-iteration", func(t *testing.T) {
+		clears := make(chan string, 2)
+		client, _ := opensearch.NewClient(opensearch.Config{Transport: &mockTransport{
+			RoundTripFunc: func(req *http.Request) (*http.Response, error) {
+				body := `{"_scroll_id":"abc","hits":{"hits":[{"_id":"1"},{"_id":"2"}]}}`
+				if req.Method == "DELETE" {
+					clears <- req.URL.Path
+					body = `{"succeeded":true}`
+				}
+				return &http.Response{StatusCode: http.StatusOK, Body: ioutil.NopCloser(strings.NewReader(body))}, nil
+			},
+		}})
+
+		ctx, cancel := context.WithCancel(context.Background())
+		s := NewScroller(client, opensearchapi.SearchRequest{}, time.Minute)
+		if !s.Next(ctx) {
+			t.Fatalf("Expected a hit, err: %v", s.Err())
+		}
+
+		// The iteration is abandoned without calling Next or Close again
+		cancel()
+
+		select {
+		case path := <-clears:
+			if path != "/_search/scroll/abc" {
+				t.Errorf("Unexpected clear scroll path: %s", path)
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("Expected the scroll to be cleared on cancellation")
+		}
+
+		if s.Err() != context.Canceled {
+			t.Errorf("Expected context.Canceled, got: %v", s.Err())
+		}
+		if s.Next(context.Background()) {
+			t.Errorf("Unexpected hit after cancellation")
+		}
+		if err := s.Close(context.Background()); err != nil {
+			t.Errorf("Unexpected error: %s", err)
+		}
+		if len(clears) != 0 {
+			t.Errorf("Expected the scroll to be cleared once")
+		}
+	})
+
+	t.Run("Context cancelled with a hanging node", func(t *testing.T) {
+		defer func(d time.Duration) { cleanupTimeout = d }(cleanupTimeout)
+		cleanupTimeout = 50 * time.Millisecond
+
+		clears := make(chan struct{})
+		cleared := make(chan error, 1)
+		client, _ := opensearch.NewClient(opensearch.Config{Transport: &mockTransport{
+			RoundTripFunc: func(req *http.Request) (*http.Response, error) {
+				if req.Method == "DELETE" {
+					close(clears)
+					<-req.Context().Done()
+					cleared <- req.Context().Err()
+					return nil, req.Context().Err()
+				}
+				body := `{"_scroll_id":"abc","hits":{"hits":[{"_id":"1"},{"_id":"2"}]}}`
+				return &http.Response{StatusCode: http.StatusOK, Body: ioutil.NopCloser(strings.NewReader(body))}, nil
+			},
+		}})
+
+		ctx, cancel := context.WithCancel(context.Background())
+		s := NewScroller(client, opensearchapi.SearchRequest{}, time.Minute)
+		if !s.Next(ctx) {
+			t.Fatalf("Expected a hit, err: %v", s.Err())
+		}
+		cancel()
+		<-clears
+
+		// The methods don't wait for the clear scroll request
+		if s.Err() != context.Canceled {
+			t.Errorf("Expected context.Canceled, got: %v", s.Err())
+		}
+		if err := s.Close(context.Background()); err != nil {
+			t.Errorf("Unexpected error: %s", err)
+		}
+
+		select {
+		case err := <-cleared:
+			if err != context.DeadlineExceeded {
+				t.Errorf("Expected context.DeadlineExceeded, got: %v", err)
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("Expected the clear scroll request to time out")
+		}
+	})
+
+	t.Run("Context cancelled after Close", func(t *testing.T) {
+		clears := make(chan struct{}, 2)
+		client, _ := opensearch.NewClient(opensearch.Config{Transport: &mockTransport{
+			RoundTripFunc: func(req *http.Request) (*http.Response, error) {
+				body := `{"_scroll_id":"abc","hits":{"hits":[{"_id":"1"}]}}`
+				if req.Method == "DELETE" {
+					clears <- struct{}{}
+					body = `{"succeeded":true}`
+				}
+				return &http.Response{StatusCode: http.StatusOK, Body: ioutil.NopCloser(strings.NewReader(body))}, nil
+			},
+		}})
+
+		ctx, cancel := context.WithCancel(context.Background())
+		s := NewScroller(client, opensearchapi.SearchRequest{}, time.Minute)
+		s.Next(ctx)
+		if err := s.Close(context.Background()); err != nil {
+			t.Errorf("Unexpected error: %s", err)
+		}
+		cancel()
+
+		time.Sleep(10 * time.Millisecond)
+		if len(clears) != 1 {
+			t.Errorf("Expected the scroll to be cleared once, got: %d", len(clears))
+		}
+		if s.Err() != nil {
+			t.Errorf("Unexpected error: %s", s.Err())
+		}
+	})
+
+	t.Run("Error response", func(t *testing.T) {
+		client, _ := opensearch.NewClient(opensearch.Config{Transport: &mockTransport{
+			RoundTripFunc: func(req *http.Request) (*http.Response, error) {
+				return &http.Response{
+					StatusCode: http.StatusBadRequest,
+					Body:       ioutil.NopCloser(strings.NewReader(`{"error":{"type":"parsing_exception","reason":"bad"},"status":400}`)),
+				}, nil
+			},
+		}})
+
+		s := NewScroller(client, opensearchapi.SearchRequest{}, time.Minute)
+		if s.Next(context.Background()) {
+			t.Errorf("Unexpected hit")
+		}
+		if s.Err() == nil {
+			t.Errorf("Expected error")
+		}
+	})
+}
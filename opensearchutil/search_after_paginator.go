@@ -0,0 +1,170 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchutil
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/alphastrikelabs/opensearch-go/v2/opensearchapi"
+)
+
+// SearchAfterPaginator pages through the results of a search using search_after, without a Point In Time.
+//
+// The body is the search request body; its "sort" clause must end with the tiebreaker field,
+// which has to contain a unique value per document, otherwise hits would be duplicated or skipped
+// across pages. The paginator adds the "search_after" clause.
+//
+// Without a Point In Time, the pages don't reflect a consistent view of the index;
+// this is suitable for append-only indices sorted by an increasing field.
+//
+// Use Cursor to persist the position, and SetCursor to resume the pagination later.
+type SearchAfterPaginator struct {
+	client     opensearchapi.Transport
+	index      []string
+	body       map[string]interface{}
+	tiebreaker string
+
+	cursor []json.RawMessage
+	hits   []json.RawMessage
+	done   bool
+	err    error
+}
+
+// NewSearchAfterPaginator creates a new paginator for the search body on index,
+// with tiebreaker being the unique field ending the sort clause.
+func NewSearchAfterPaginator(
+	client opensearchapi.Transport,
+	index []string,
+	body map[string]interface{},
+	tiebreaker string,
+) *SearchAfterPaginator {
+	return &SearchAfterPaginator{client: client, index: index, body: body, tiebreaker: tiebreaker}
+}
+
+// Next fetches the next page of hits.
+// It returns false when the hits are exhausted, the context is cancelled, or an error occurs.
+func (p *SearchAfterPaginator) Next(ctx context.Context) bool {
+	p.hits = nil
+
+	if p.done {
+		return false
+	}
+
+	if err := ctx.Err(); err != nil {
+		p.stop(err)
+		return false
+	}
+
+	if err := checkTiebreaker(p.body["sort"], p.tiebreaker); err != nil {
+		p.stop(fmt.Errorf("search after paginator: %s", err))
+		return false
+	}
+
+	body := make(map[string]interface{}, len(p.body)+1)
+	for k, v := range p.body {
+		body[k] = v
+	}
+	if len(p.cursor) > 0 {
+		body["search_after"] = p.cursor
+	}
+
+	page, err := searchAfter(ctx, p.client, opensearchapi.SearchRequest{Index: p.index, Body: NewJSONReader(body)})
+	if err != nil {
+		p.stop(fmt.Errorf("search after paginator: %w", err))
+		return false
+	}
+
+	if len(page.Hits.Hits) == 0 {
+		p.stop(nil)
+		return false
+	}
+
+	sort, err := lastSortValues(page.Hits.Hits)
+	if err != nil {
+		p.stop(fmt.Errorf("search after paginator: %s", err))
+		return false
+	}
+
+	p.hits = page.Hits.Hits
+	p.cursor = sort
+	return true
+}
+
+// Hits returns the hits of the current page as raw JSON.
+func (p *SearchAfterPaginator) Hits() []json.RawMessage {
+	return p.hits
+}
+
+// Cursor returns the sort values of the last hit of the current page.
+func (p *SearchAfterPaginator) Cursor() []json.RawMessage {
+	return p.cursor
+}
+
+// SetCursor sets the sort values to search after, eg. to resume a persisted pagination.
+func (p *SearchAfterPaginator) SetCursor(cursor []json.RawMessage) {
+	p.cursor = cursor
+}
+
+// Err returns the error which stopped the pagination, if any.
+func (p *SearchAfterPaginator) Err() error {
+	return p.err
+}
+
+func (p *SearchAfterPaginator) stop(err error) {
+	p.done = true
+	p.err = err
+}
+
+// checkTiebreaker returns an error when the sort clause doesn't end with the tiebreaker field.
+func checkTiebreaker(sort interface{}, tiebreaker string) error {
+	if tiebreaker == "" {
+		return errors.New("tiebreaker field is required")
+	}
+	if sort == nil {
+		return errors.New("search body must contain a sort clause")
+	}
+
+	// Normalize the sort clause to its JSON representation.
+	b, err := json.Marshal(sort)
+	if err != nil {
+		return fmt.Errorf("invalid sort clause: %s", err)
+	}
+	var clauses []interface{}
+	if err := json.Unmarshal(b, &clauses); err != nil {
+		var clause interface{}
+		if err := json.Unmarshal(b, &clause); err != nil {
+			return fmt.Errorf("invalid sort clause: %s", err)
+		}
+		clauses = []interface{}{clause}
+	}
+	if len(clauses) == 0 {
+		return errors.New("search body must contain a sort clause")
+	}
+
+	var last string
+	switch c := clauses[len(clauses)-1].(type) {
+	case string:
+		last = c
+	case map[string]interface{}:
+		if len(c) == 1 {
+			for k := range c {
+				last = k
+			}
+		}
+	}
+	if last != tiebreaker {
+		return fmt.Errorf("sort clause must end with the tiebreaker field %q", tiebreaker)
+	}
+
+	return nil
+}
@@ -0,0 +1,125 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+//go:build !integration
+// +build !integration
+
+package opensearchutil
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/alphastrikelabs/opensearch-go/v2"
+)
+
+func TestSearchAfterPaginator(t *testing.T) {
+	body := map[string]interface{}{
+		"size": 2,
+		"sort": []interface{}{map[string]string{"timestamp": "asc"}, map[string]string{"id": "asc"}},
+	}
+
+	t.Run("Paginate and resume", func(t *testing.T) {
+		var (
+			searchBodies []map[string]interface{}
+			pages        = []string{
+				`{"hits":{"hits":[{"_id":"1","sort":[10,"a"]},{"_id":"2","sort":[10,"b"]}]}}`,
+				`{"hits":{"hits":[]}}`,
+				`{"hits":{"hits":[]}}`,
+			}
+		)
+
+		client, _ := opensearch.NewClient(opensearch.Config{Transport: &mockTransport{
+			RoundTripFunc: func(req *http.Request) (*http.Response, error) {
+				if req.URL.Path != "/test/_search" {
+					t.Errorf("Unexpected request: %s %s", req.Method, req.URL.Path)
+				}
+				var b map[string]interface{}
+				json.NewDecoder(req.Body).Decode(&b)
+				searchBodies = append(searchBodies, b)
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Body:       ioutil.NopCloser(strings.NewReader(pages[len(searchBodies)-1])),
+				}, nil
+			},
+		}})
+
+		p := NewSearchAfterPaginator(client, []string{"test"}, body, "id")
+		var numHits int
+		for p.Next(context.Background()) {
+			numHits += len(p.Hits())
+		}
+		if err := p.Err(); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if numHits != 2 {
+			t.Errorf("Unexpected number of hits: %d", numHits)
+		}
+
+		cursor := p.Cursor()
+		if len(cursor) != 2 || string(cursor[1]) != `"b"` {
+			t.Fatalf("Unexpected cursor: %s", cursor)
+		}
+		if sa := searchBodies[1]["search_after"].([]interface{}); sa[0] != float64(10) || sa[1] != "b" {
+			t.Errorf("Unexpected search_after: %v", sa)
+		}
+
+		resumed := NewSearchAfterPaginator(client, []string{"test"}, body, "id")
+		resumed.SetCursor(cursor)
+		if resumed.Next(context.Background()) {
+			t.Errorf("Unexpected page")
+		}
+		if sa := searchBodies[2]["search_after"].([]interface{}); sa[1] != "b" {
+			t.Errorf("Unexpected search_after after resume: %v", sa)
+		}
+	})
+
+	t.Run("Missing tiebreaker", func(t *testing.T) {
+		client, _ := opensearch.NewClient(opensearch.Config{Transport: &mockTransport{
+			RoundTripFunc: func(req *http.Request) (*http.Response, error) {
+				t.Errorf("Unexpected request")
+				return nil, nil
+			},
+		}})
+
+		for _, tc := range []struct {
+			body       map[string]interface{}
+			tiebreaker string
+		}{
+			{body, ""},
+			{body, "timestamp"},
+			{map[string]interface{}{}, "id"},
+			{map[string]interface{}{"sort": []string{}}, "id"},
+		} {
+			p := NewSearchAfterPaginator(client, []string{"test"}, tc.body, tc.tiebreaker)
+			if p.Next(context.Background()) {
+				t.Errorf("Unexpected page")
+			}
+			if p.Err() == nil {
+				t.Errorf("Expected error for sort=%v, tiebreaker=%q", tc.body["sort"], tc.tiebreaker)
+			}
+		}
+	})
+
+	t.Run("Sort formats", func(t *testing.T) {
+		for _, sort := range []interface{}{
+			"id",
+			[]string{"timestamp", "id"},
+			map[string]interface{}{"id": map[string]string{"order": "desc"}},
+		} {
+			if err := checkTiebreaker(sort, "id"); err != nil {
+				t.Errorf("Unexpected error for sort=%v: %s", sort, err)
+			}
+		}
+	})
+}
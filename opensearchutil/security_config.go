@@ -0,0 +1,298 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchutil
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/alphastrikelabs/opensearch-go/v2/opensearchapi"
+)
+
+const securityAPIPath = "/_plugins/_security/api/"
+
+// SecurityConfigBundle represents the security configuration of a cluster, as read by SecurityConfigExport
+// and applied by SecurityConfigImport. It can be serialized to JSON, eg. to clone the configuration of a cluster.
+//
+// The reserved, hidden and static resources, defined by the security plugin itself, are not part of the bundle.
+// The password hashes of the internal users are not returned by the security plugin, so they're not exported.
+type SecurityConfigBundle struct {
+	ActionGroups  map[string]ActionGroup                    `json:"action_groups,omitempty"`
+	Tenants       map[string]Tenant                         `json:"tenants,omitempty"`
+	Roles         map[string]opensearchapi.RoleBody         `json:"roles,omitempty"`
+	RoleMappings  map[string]opensearchapi.RoleMappingBody  `json:"roles_mapping,omitempty"`
+	InternalUsers map[string]opensearchapi.InternalUserBody `json:"internal_users,omitempty"`
+
+	Config json.RawMessage `json:"config,omitempty"` // The dynamic configuration, eg. the authentication backends
+}
+
+// ActionGroup represents an action group of the security plugin.
+type ActionGroup struct {
+	AllowedActions []string `json:"allowed_actions"`
+	Type           string   `json:"type,omitempty"` // "cluster", "index" or "kibana"
+	Description    string   `json:"description,omitempty"`
+}
+
+// Tenant represents a tenant of the security plugin.
+type Tenant struct {
+	Description string `json:"description,omitempty"`
+}
+
+// SecurityConfigExport reads the action groups, tenants, roles, role mappings, internal users,
+// and the dynamic configuration of the cluster.
+func SecurityConfigExport(ctx context.Context, client opensearchapi.Transport) (*SecurityConfigBundle, error) {
+	var (
+		b            SecurityConfigBundle
+		actionGroups map[string]struct {
+			roleInfo
+			ActionGroup
+		}
+		tenants map[string]struct {
+			roleInfo
+			Tenant
+		}
+		roles map[string]struct {
+			roleInfo
+			opensearchapi.RoleBody
+		}
+		mappings map[string]struct {
+			roleInfo
+			opensearchapi.RoleMappingBody
+		}
+		users map[string]struct {
+			roleInfo
+			opensearchapi.InternalUserBody
+		}
+		config struct {
+			Config struct {
+				Dynamic json.RawMessage `json:"dynamic"`
+			} `json:"config"`
+		}
+	)
+
+	for kind, v := range map[string]interface{}{
+		"actiongroups":   &actionGroups,
+		"tenants":        &tenants,
+		"roles":          &roles,
+		"rolesmapping":   &mappings,
+		"internalusers":  &users,
+		"securityconfig": &config,
+	} {
+		req := opensearchapi.GenericRequest{Method: http.MethodGet, Path: securityAPIPath + kind}
+		if err := getSecurityResource(ctx, client, req, v); err != nil {
+			return nil, fmt.Errorf("security config export: get %s: %w", kind, err)
+		}
+	}
+
+	b.ActionGroups = make(map[string]ActionGroup)
+	for name, v := range actionGroups {
+		if !v.builtin() {
+			b.ActionGroups[name] = v.ActionGroup
+		}
+	}
+	b.Tenants = make(map[string]Tenant)
+	for name, v := range tenants {
+		if !v.builtin() {
+			b.Tenants[name] = v.Tenant
+		}
+	}
+	b.Roles = make(map[string]opensearchapi.RoleBody)
+	for name, v := range roles {
+		if !v.builtin() {
+			b.Roles[name] = v.RoleBody
+		}
+	}
+	b.RoleMappings = make(map[string]opensearchapi.RoleMappingBody)
+	for name, v := range mappings {
+		if !v.builtin() {
+			b.RoleMappings[name] = v.RoleMappingBody
+		}
+	}
+	b.InternalUsers = make(map[string]opensearchapi.InternalUserBody)
+	for name, v := range users {
+		if !v.builtin() {
+			v.Password, v.Hash = "", ""
+			b.InternalUsers[name] = v.InternalUserBody
+		}
+	}
+	b.Config = config.Config.Dynamic
+
+	return &b, nil
+}
+
+// SecurityConfigImportConfig represents the configuration of SecurityConfigImport.
+type SecurityConfigImportConfig struct {
+	Prune bool // Delete the resources which are not in the bundle, except the reserved, hidden and static ones
+}
+
+// SecurityConfigImportError represents the failures of SecurityConfigImport,
+// by resource type and name, eg. "roles/logs_reader", or "securityconfig" for the dynamic configuration.
+type SecurityConfigImportError struct {
+	Errors map[string]error
+}
+
+// Error returns the failures sorted by resource.
+func (e *SecurityConfigImportError) Error() string {
+	keys := make([]string, 0, len(e.Errors))
+	for k := range e.Errors {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "security config import: %d resource(s) failed", len(keys))
+	for _, k := range keys {
+		fmt.Fprintf(&b, "; %s: %s", k, e.Errors[k])
+	}
+	return b.String()
+}
+
+// SecurityConfigImport creates or replaces the resources of the bundle, in the order of their dependencies:
+// action groups, tenants, roles, role mappings and internal users, then the dynamic configuration when set.
+//
+// The internal users without password or hash keep the password they have; new users need one of them.
+// Replacing the dynamic configuration requires the plugins.security.unsupported.restapi.allow_securityconfig_modification
+// setting on the cluster.
+//
+// When cfg.Prune is true, the existing resources which are not in the bundle are deleted,
+// in the reverse order, except the reserved, hidden and static ones.
+//
+// The failures of single resources don't stop the operation; they're returned as a *SecurityConfigImportError.
+// The operation stops when the context is cancelled, and the context error is returned.
+func SecurityConfigImport(ctx context.Context, client opensearchapi.Transport, bundle *SecurityConfigBundle, cfg SecurityConfigImportConfig) error {
+	resources := []struct {
+		kind  string
+		names []string
+		put   func(name string) opensearchapi.Request
+	}{
+		{"actiongroups", mapNames(bundle.ActionGroups), func(name string) opensearchapi.Request {
+			return putSecurityResource("actiongroups", name, bundle.ActionGroups[name])
+		}},
+		{"tenants", mapNames(bundle.Tenants), func(name string) opensearchapi.Request {
+			return putSecurityResource("tenants", name, bundle.Tenants[name])
+		}},
+		{"roles", mapNames(bundle.Roles), func(name string) opensearchapi.Request {
+			role := bundle.Roles[name]
+			return opensearchapi.RoleCreateRequest{Role: name, RoleBody: &role}
+		}},
+		{"rolesmapping", mapNames(bundle.RoleMappings), func(name string) opensearchapi.Request {
+			mapping := bundle.RoleMappings[name]
+			return opensearchapi.RoleMappingCreateRequest{Role: name, MappingBody: &mapping}
+		}},
+		{"internalusers", mapNames(bundle.InternalUsers), func(name string) opensearchapi.Request {
+			user := bundle.InternalUsers[name]
+			return opensearchapi.InternalUserCreateRequest{Username: name, UserBody: &user}
+		}},
+	}
+
+	errs := make(map[string]error)
+
+	for _, r := range resources {
+		for _, name := range r.names {
+			if err := ctx.Err(); err != nil {
+				return fmt.Errorf("security config import: %w", err)
+			}
+			if err := doRequest(ctx, client, r.put(name)); err != nil {
+				errs[r.kind+"/"+name] = err
+			}
+		}
+	}
+
+	if len(bundle.Config) > 0 {
+		req := opensearchapi.GenericRequest{
+			Method: http.MethodPut,
+			Path:   securityAPIPath + "securityconfig/config",
+			Body:   opensearchapi.JSONBody(map[string]json.RawMessage{"dynamic": bundle.Config}),
+		}
+		if err := doRequest(ctx, client, req); err != nil {
+			errs["securityconfig"] = err
+		}
+	}
+
+	if cfg.Prune {
+		for i := len(resources) - 1; i >= 0; i-- {
+			r := resources[i]
+
+			var existing map[string]roleInfo
+			req := opensearchapi.GenericRequest{Method: http.MethodGet, Path: securityAPIPath + r.kind}
+			if err := getSecurityResource(ctx, client, req, &existing); err != nil {
+				return fmt.Errorf("security config import: cannot list %s: %w", r.kind, err)
+			}
+
+			keep := stringSet(r.names)
+			var stale []string
+			for name, info := range existing {
+				if !keep[name] && !info.builtin() {
+					stale = append(stale, name)
+				}
+			}
+			sort.Strings(stale)
+
+			for _, name := range stale {
+				if err := ctx.Err(); err != nil {
+					return fmt.Errorf("security config import: %w", err)
+				}
+
+				req := opensearchapi.GenericRequest{Method: http.MethodDelete, Path: securityAPIPath + r.kind + "/" + url.PathEscape(name)}
+				if err := doRequest(ctx, client, req); err != nil {
+					errs[r.kind+"/"+name] = fmt.Errorf("cannot delete: %w", err)
+				}
+			}
+		}
+	}
+
+	if len(errs) > 0 {
+		return &SecurityConfigImportError{Errors: errs}
+	}
+	return nil
+}
+
+// putSecurityResource returns the request creating or replacing the resource of a security API without typed request.
+func putSecurityResource(kind, name string, body interface{}) opensearchapi.Request {
+	return opensearchapi.GenericRequest{
+		Method: http.MethodPut,
+		Path:   securityAPIPath + kind + "/" + url.PathEscape(name),
+		Body:   opensearchapi.JSONBody(body),
+	}
+}
+
+// mapNames returns the sorted names of the resources of a map of the bundle.
+func mapNames(m interface{}) []string {
+	var names []string
+	switch m := m.(type) {
+	case map[string]ActionGroup:
+		for name := range m {
+			names = append(names, name)
+		}
+	case map[string]Tenant:
+		for name := range m {
+			names = append(names, name)
+		}
+	case map[string]opensearchapi.RoleBody:
+		for name := range m {
+			names = append(names, name)
+		}
+	case map[string]opensearchapi.RoleMappingBody:
+		for name := range m {
+			names = append(names, name)
+		}
+	case map[string]opensearchapi.InternalUserBody:
+		for name := range m {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
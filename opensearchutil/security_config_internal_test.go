@@ -0,0 +1,184 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+//go:build !integration
+// +build !integration
+
+package opensearchutil
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/alphastrikelabs/opensearch-go/v2"
+	"github.com/alphastrikelabs/opensearch-go/v2/opensearchapi"
+)
+
+var securityConfigBodies = map[string]string{
+	"/_plugins/_security/api/actiongroups": `{
+		"read":{"reserved":true,"allowed_actions":["indices:data/read/*"]},
+		"logs_write":{"allowed_actions":["indices:data/write/*"],"type":"index","description":"Write logs"}}`,
+	"/_plugins/_security/api/tenants": `{
+		"global_tenant":{"reserved":true,"description":"Global tenant"},
+		"ops":{"description":"Operations"}}`,
+	"/_plugins/_security/api/roles": `{
+		"all_access":{"reserved":true,"cluster_permissions":["*"]},
+		"logs_reader":{"index_permissions":[{"index_patterns":["logs-*"],"allowed_actions":["read"]}]}}`,
+	"/_plugins/_security/api/rolesmapping": `{
+		"all_access":{"reserved":true,"backend_roles":["admin"]},
+		"logs_reader":{"hidden":false,"users":["alice"]}}`,
+	"/_plugins/_security/api/internalusers": `{
+		"admin":{"static":true,"hash":"","backend_roles":["admin"]},
+		"alice":{"hash":"","backend_roles":["dev"],"attributes":{"team":"search"}}}`,
+	"/_plugins/_security/api/securityconfig": `{"config":{"dynamic":{"do_not_fail_on_forbidden":true}}}`,
+}
+
+func TestSecurityConfigExport(t *testing.T) {
+	client, _ := opensearch.NewClient(opensearch.Config{Transport: &mockTransport{
+		RoundTripFunc: func(req *http.Request) (*http.Response, error) {
+			body, ok := securityConfigBodies[req.URL.Path]
+			if req.Method != http.MethodGet || !ok {
+				t.Errorf("Unexpected request: %s %s", req.Method, req.URL.Path)
+				return &http.Response{StatusCode: http.StatusNotFound, Body: http.NoBody}, nil
+			}
+			return &http.Response{StatusCode: http.StatusOK, Body: ioutil.NopCloser(strings.NewReader(body))}, nil
+		},
+	}})
+
+	b, err := SecurityConfigExport(context.Background(), client)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	want := SecurityConfigBundle{
+		ActionGroups: map[string]ActionGroup{
+			"logs_write": {AllowedActions: []string{"indices:data/write/*"}, Type: "index", Description: "Write logs"},
+		},
+		Tenants: map[string]Tenant{"ops": {Description: "Operations"}},
+		Roles: map[string]opensearchapi.RoleBody{
+			"logs_reader": {IndexPermissions: []opensearchapi.RoleIndexPermission{
+				{IndexPatterns: []string{"logs-*"}, AllowedActions: []string{"read"}},
+			}},
+		},
+		RoleMappings: map[string]opensearchapi.RoleMappingBody{"logs_reader": {Users: []string{"alice"}}},
+		InternalUsers: map[string]opensearchapi.InternalUserBody{
+			"alice": {BackendRoles: []string{"dev"}, Attributes: map[string]string{"team": "search"}},
+		},
+		Config: json.RawMessage(`{"do_not_fail_on_forbidden":true}`),
+	}
+	if !reflect.DeepEqual(*b, want) {
+		t.Errorf("Unexpected bundle:\ngot:  %+v\nwant: %+v", *b, want)
+	}
+}
+
+func TestSecurityConfigImport(t *testing.T) {
+	var (
+		mu         sync.Mutex
+		requests   []string
+		configBody string
+	)
+	client, _ := opensearch.NewClient(opensearch.Config{DisableRetry: true, Transport: &mockTransport{
+		RoundTripFunc: func(req *http.Request) (*http.Response, error) {
+			mu.Lock()
+			defer mu.Unlock()
+
+			if req.Method == http.MethodGet {
+				body := securityConfigBodies[req.URL.Path]
+				return &http.Response{StatusCode: http.StatusOK, Body: ioutil.NopCloser(strings.NewReader(body))}, nil
+			}
+
+			requests = append(requests, req.Method+" "+req.URL.Path)
+			if strings.HasSuffix(req.URL.Path, "/securityconfig/config") {
+				b, _ := ioutil.ReadAll(req.Body)
+				configBody = string(b)
+				body := `{"status":"FORBIDDEN","message":"Access denied"}`
+				return &http.Response{StatusCode: http.StatusForbidden, Body: ioutil.NopCloser(strings.NewReader(body))}, nil
+			}
+			return &http.Response{StatusCode: http.StatusOK, Body: ioutil.NopCloser(strings.NewReader(`{"status":"OK"}`))}, nil
+		},
+	}})
+
+	bundle := SecurityConfigBundle{
+		ActionGroups: map[string]ActionGroup{"logs_write": {AllowedActions: []string{"indices:data/write/*"}}},
+		Roles: map[string]opensearchapi.RoleBody{
+			"metrics_reader": {IndexPermissions: []opensearchapi.RoleIndexPermission{
+				{IndexPatterns: []string{"metrics-*"}, AllowedActions: []string{"read"}},
+			}},
+			"logs_reader": {IndexPermissions: []opensearchapi.RoleIndexPermission{
+				{IndexPatterns: []string{"logs-*"}, AllowedActions: []string{"read"}},
+			}},
+		},
+		InternalUsers: map[string]opensearchapi.InternalUserBody{"bob": {Password: "secret", BackendRoles: []string{"dev"}}},
+		Config:        json.RawMessage(`{"do_not_fail_on_forbidden":false}`),
+	}
+
+	t.Run("Upsert", func(t *testing.T) {
+		requests = nil
+		err := SecurityConfigImport(context.Background(), client, &bundle, SecurityConfigImportConfig{})
+
+		var importErr *SecurityConfigImportError
+		if !errors.As(err, &importErr) {
+			t.Fatalf("Expected *SecurityConfigImportError, got: %v", err)
+		}
+		if len(importErr.Errors) != 1 || !errors.Is(importErr.Errors["securityconfig"], opensearchapi.ErrForbidden) {
+			t.Errorf("Unexpected errors: %v", importErr.Errors)
+		}
+
+		want := []string{
+			"PUT /_plugins/_security/api/actiongroups/logs_write",
+			"PUT /_plugins/_security/api/roles/logs_reader",
+			"PUT /_plugins/_security/api/roles/metrics_reader",
+			"PUT /_plugins/_security/api/internalusers/bob",
+			"PUT /_plugins/_security/api/securityconfig/config",
+		}
+		if !reflect.DeepEqual(requests, want) {
+			t.Errorf("Unexpected requests:\ngot:  %v\nwant: %v", requests, want)
+		}
+		if want := `{"dynamic":{"do_not_fail_on_forbidden":false}}`; configBody != want {
+			t.Errorf("Unexpected securityconfig body: %s, want: %s", configBody, want)
+		}
+	})
+
+	t.Run("Prune", func(t *testing.T) {
+		requests = nil
+		b := bundle
+		b.Config = nil
+		if err := SecurityConfigImport(context.Background(), client, &b, SecurityConfigImportConfig{Prune: true}); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+
+		want := []string{
+			"PUT /_plugins/_security/api/actiongroups/logs_write",
+			"PUT /_plugins/_security/api/roles/logs_reader",
+			"PUT /_plugins/_security/api/roles/metrics_reader",
+			"PUT /_plugins/_security/api/internalusers/bob",
+			"DELETE /_plugins/_security/api/internalusers/alice",
+			"DELETE /_plugins/_security/api/rolesmapping/logs_reader",
+			"DELETE /_plugins/_security/api/tenants/ops",
+		}
+		if !reflect.DeepEqual(requests, want) {
+			t.Errorf("Unexpected requests:\ngot:  %v\nwant: %v", requests, want)
+		}
+	})
+
+	t.Run("ContextCancelled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		if err := SecurityConfigImport(ctx, client, &bundle, SecurityConfigImportConfig{}); !errors.Is(err, context.Canceled) {
+			t.Errorf("Expected context error, got: %v", err)
+		}
+	})
+}
@@ -0,0 +1,367 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchutil
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/alphastrikelabs/opensearch-go/v2/opensearchapi"
+)
+
+// RoleDiff represents the changes from a current role to a desired one, see DiffRole.
+type RoleDiff struct {
+	DescriptionChanged bool
+	Description        [2]string // The current and the desired description, when changed
+
+	AddedClusterPermissions   []string
+	RemovedClusterPermissions []string
+
+	AddedIndexPermissions   []opensearchapi.RoleIndexPermission
+	RemovedIndexPermissions []opensearchapi.RoleIndexPermission
+
+	AddedTenantPermissions   []opensearchapi.RoleTenantPermission
+	RemovedTenantPermissions []opensearchapi.RoleTenantPermission
+}
+
+// DiffRole returns the changes from the current role to the desired one.
+//
+// The comparison is independent of the order of the permissions, and of the order of the patterns,
+// actions and fields within a permission; duplicates are ignored. An index or tenant permission
+// which differs in any way is reported as removed and added. The changes are sorted.
+func DiffRole(current, desired opensearchapi.RoleBody) RoleDiff {
+	var d RoleDiff
+
+	if current.Description != desired.Description {
+		d.DescriptionChanged = true
+		d.Description = [2]string{current.Description, desired.Description}
+	}
+
+	d.AddedClusterPermissions, d.RemovedClusterPermissions = diffStrings(current.ClusterPermissions, desired.ClusterPermissions)
+
+	curIndex, desIndex := indexPermissionKeys(current.IndexPermissions), indexPermissionKeys(desired.IndexPermissions)
+	added, removed := diffKeys(curIndex, desIndex)
+	for _, k := range added {
+		d.AddedIndexPermissions = append(d.AddedIndexPermissions, desIndex[k])
+	}
+	for _, k := range removed {
+		d.RemovedIndexPermissions = append(d.RemovedIndexPermissions, curIndex[k])
+	}
+
+	curTenant, desTenant := tenantPermissionKeys(current.TenantPermissions), tenantPermissionKeys(desired.TenantPermissions)
+	added, removed = diffKeys(curTenant, desTenant)
+	for _, k := range added {
+		d.AddedTenantPermissions = append(d.AddedTenantPermissions, desTenant[k])
+	}
+	for _, k := range removed {
+		d.RemovedTenantPermissions = append(d.RemovedTenantPermissions, curTenant[k])
+	}
+
+	return d
+}
+
+// IsEmpty returns true when the roles are equivalent.
+func (d RoleDiff) IsEmpty() bool {
+	return !d.DescriptionChanged &&
+		len(d.AddedClusterPermissions) == 0 && len(d.RemovedClusterPermissions) == 0 &&
+		len(d.AddedIndexPermissions) == 0 && len(d.RemovedIndexPermissions) == 0 &&
+		len(d.AddedTenantPermissions) == 0 && len(d.RemovedTenantPermissions) == 0
+}
+
+// String returns the changes, one per line, prefixed with "+" for the additions and "-" for the removals.
+func (d RoleDiff) String() string {
+	var b diffWriter
+	if d.DescriptionChanged {
+		b.change("description", d.Description)
+	}
+	b.list("cluster_permissions", d.AddedClusterPermissions, d.RemovedClusterPermissions)
+	for _, p := range d.RemovedIndexPermissions {
+		b.line("-", "index_permissions", p)
+	}
+	for _, p := range d.AddedIndexPermissions {
+		b.line("+", "index_permissions", p)
+	}
+	for _, p := range d.RemovedTenantPermissions {
+		b.line("-", "tenant_permissions", p)
+	}
+	for _, p := range d.AddedTenantPermissions {
+		b.line("+", "tenant_permissions", p)
+	}
+	return b.String()
+}
+
+// RoleMappingDiff represents the changes from a current role mapping to a desired one, see DiffRoleMapping.
+type RoleMappingDiff struct {
+	DescriptionChanged bool
+	Description        [2]string // The current and the desired description, when changed
+
+	AddedBackendRoles      []string
+	RemovedBackendRoles    []string
+	AddedAndBackendRoles   []string
+	RemovedAndBackendRoles []string
+	AddedUsers             []string
+	RemovedUsers           []string
+	AddedHosts             []string
+	RemovedHosts           []string
+}
+
+// DiffRoleMapping returns the changes from the current role mapping to the desired one,
+// independently of the order of the lists; the changes are sorted.
+func DiffRoleMapping(current, desired opensearchapi.RoleMappingBody) RoleMappingDiff {
+	var d RoleMappingDiff
+
+	if current.Description != desired.Description {
+		d.DescriptionChanged = true
+		d.Description = [2]string{current.Description, desired.Description}
+	}
+
+	d.AddedBackendRoles, d.RemovedBackendRoles = diffStrings(current.BackendRoles, desired.BackendRoles)
+	d.AddedAndBackendRoles, d.RemovedAndBackendRoles = diffStrings(current.AndBackendRoles, desired.AndBackendRoles)
+	d.AddedUsers, d.RemovedUsers = diffStrings(current.Users, desired.Users)
+	d.AddedHosts, d.RemovedHosts = diffStrings(current.Hosts, desired.Hosts)
+
+	return d
+}
+
+// IsEmpty returns true when the role mappings are equivalent.
+func (d RoleMappingDiff) IsEmpty() bool {
+	return !d.DescriptionChanged &&
+		len(d.AddedBackendRoles) == 0 && len(d.RemovedBackendRoles) == 0 &&
+		len(d.AddedAndBackendRoles) == 0 && len(d.RemovedAndBackendRoles) == 0 &&
+		len(d.AddedUsers) == 0 && len(d.RemovedUsers) == 0 &&
+		len(d.AddedHosts) == 0 && len(d.RemovedHosts) == 0
+}
+
+// String returns the changes, one per line, prefixed with "+" for the additions and "-" for the removals.
+func (d RoleMappingDiff) String() string {
+	var b diffWriter
+	if d.DescriptionChanged {
+		b.change("description", d.Description)
+	}
+	b.list("backend_roles", d.AddedBackendRoles, d.RemovedBackendRoles)
+	b.list("and_backend_roles", d.AddedAndBackendRoles, d.RemovedAndBackendRoles)
+	b.list("users", d.AddedUsers, d.RemovedUsers)
+	b.list("hosts", d.AddedHosts, d.RemovedHosts)
+	return b.String()
+}
+
+// InternalUserDiff represents the changes from a current internal user to a desired one, see DiffInternalUser.
+type InternalUserDiff struct {
+	// PasswordChanged is true when the desired user sets a password or a hash, which replaces
+	// the current password; the current password is not returned by the security plugin.
+	PasswordChanged bool
+
+	AddedBackendRoles   []string
+	RemovedBackendRoles []string
+
+	AddedAttributes   map[string]string
+	RemovedAttributes map[string]string
+	ChangedAttributes map[string][2]string // The current and the desired value
+}
+
+// DiffInternalUser returns the changes from the current internal user to the desired one,
+// independently of the order of the backend roles; the changes are sorted.
+func DiffInternalUser(current, desired opensearchapi.InternalUserBody) InternalUserDiff {
+	var d InternalUserDiff
+
+	d.PasswordChanged = desired.Password != "" || (desired.Hash != "" && desired.Hash != current.Hash)
+	d.AddedBackendRoles, d.RemovedBackendRoles = diffStrings(current.BackendRoles, desired.BackendRoles)
+
+	for k, v := range desired.Attributes {
+		cv, ok := current.Attributes[k]
+		switch {
+		case !ok:
+			if d.AddedAttributes == nil {
+				d.AddedAttributes = make(map[string]string)
+			}
+			d.AddedAttributes[k] = v
+		case cv != v:
+			if d.ChangedAttributes == nil {
+				d.ChangedAttributes = make(map[string][2]string)
+			}
+			d.ChangedAttributes[k] = [2]string{cv, v}
+		}
+	}
+	for k, v := range current.Attributes {
+		if _, ok := desired.Attributes[k]; !ok {
+			if d.RemovedAttributes == nil {
+				d.RemovedAttributes = make(map[string]string)
+			}
+			d.RemovedAttributes[k] = v
+		}
+	}
+
+	return d
+}
+
+// IsEmpty returns true when the internal users are equivalent.
+func (d InternalUserDiff) IsEmpty() bool {
+	return !d.PasswordChanged &&
+		len(d.AddedBackendRoles) == 0 && len(d.RemovedBackendRoles) == 0 &&
+		len(d.AddedAttributes) == 0 && len(d.RemovedAttributes) == 0 && len(d.ChangedAttributes) == 0
+}
+
+// String returns the changes, one per line, prefixed with "+" for the additions and "-" for the removals;
+// the passwords are not included.
+func (d InternalUserDiff) String() string {
+	var b diffWriter
+	if d.PasswordChanged {
+		b.WriteString("~ password\n")
+	}
+	b.list("backend_roles", d.AddedBackendRoles, d.RemovedBackendRoles)
+	for _, k := range sortedKeys(d.RemovedAttributes) {
+		b.line("-", "attributes", k+"="+d.RemovedAttributes[k])
+	}
+	for _, k := range sortedKeys(d.AddedAttributes) {
+		b.line("+", "attributes", k+"="+d.AddedAttributes[k])
+	}
+	changed, _ := diffKeys(nil, d.ChangedAttributes)
+	for _, k := range changed {
+		b.change("attributes."+k, d.ChangedAttributes[k])
+	}
+	return b.String()
+}
+
+// diffStrings returns the sorted values of desired missing from current, and of current missing from desired.
+func diffStrings(current, desired []string) (added, removed []string) {
+	return diffKeys(stringSet(current), stringSet(desired))
+}
+
+// diffKeys returns the sorted keys of desired missing from current, and of current missing from desired.
+func diffKeys(current, desired interface{}) (added, removed []string) {
+	cur, des := mapKeys(current), mapKeys(desired)
+	for k := range des {
+		if !cur[k] {
+			added = append(added, k)
+		}
+	}
+	for k := range cur {
+		if !des[k] {
+			removed = append(removed, k)
+		}
+	}
+	sort.Strings(added)
+	sort.Strings(removed)
+	return added, removed
+}
+
+// mapKeys returns the set of the keys of the maps used by the diffs.
+func mapKeys(m interface{}) map[string]bool {
+	keys := make(map[string]bool)
+	switch m := m.(type) {
+	case map[string]bool:
+		return m
+	case map[string]opensearchapi.RoleIndexPermission:
+		for k := range m {
+			keys[k] = true
+		}
+	case map[string]opensearchapi.RoleTenantPermission:
+		for k := range m {
+			keys[k] = true
+		}
+	case map[string]string:
+		for k := range m {
+			keys[k] = true
+		}
+	case map[string][2]string:
+		for k := range m {
+			keys[k] = true
+		}
+	}
+	return keys
+}
+
+// indexPermissionKeys returns the normalized index permissions, keyed by their JSON encoding.
+func indexPermissionKeys(perms []opensearchapi.RoleIndexPermission) map[string]opensearchapi.RoleIndexPermission {
+	m := make(map[string]opensearchapi.RoleIndexPermission, len(perms))
+	for _, p := range perms {
+		p.IndexPatterns = normalizeStrings(p.IndexPatterns)
+		p.FLS = normalizeStrings(p.FLS)
+		p.MaskedFields = normalizeStrings(p.MaskedFields)
+		p.AllowedActions = normalizeStrings(p.AllowedActions)
+		m[blockKey(p)] = p
+	}
+	return m
+}
+
+// tenantPermissionKeys returns the normalized tenant permissions, keyed by their JSON encoding.
+func tenantPermissionKeys(perms []opensearchapi.RoleTenantPermission) map[string]opensearchapi.RoleTenantPermission {
+	m := make(map[string]opensearchapi.RoleTenantPermission, len(perms))
+	for _, p := range perms {
+		p.TenantPatterns = normalizeStrings(p.TenantPatterns)
+		p.AllowedActions = normalizeStrings(p.AllowedActions)
+		m[blockKey(p)] = p
+	}
+	return m
+}
+
+func blockKey(v interface{}) string {
+	b, _ := json.Marshal(v)
+	return string(b)
+}
+
+// normalizeStrings returns the values sorted and deduplicated.
+func normalizeStrings(values []string) []string {
+	if len(values) == 0 {
+		return nil
+	}
+	set := stringSet(values)
+	out := make([]string, 0, len(set))
+	for v := range set {
+		out = append(out, v)
+	}
+	sort.Strings(out)
+	return out
+}
+
+func stringSet(values []string) map[string]bool {
+	set := make(map[string]bool, len(values))
+	for _, v := range values {
+		set[v] = true
+	}
+	return set
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// diffWriter writes the lines of a diff.
+type diffWriter struct {
+	strings.Builder
+}
+
+func (w *diffWriter) line(op, field string, v interface{}) {
+	if s, ok := v.(string); ok {
+		fmt.Fprintf(w, "%s %s: %s\n", op, field, s)
+		return
+	}
+	fmt.Fprintf(w, "%s %s: %s\n", op, field, blockKey(v))
+}
+
+func (w *diffWriter) list(field string, added, removed []string) {
+	for _, v := range removed {
+		w.line("-", field, v)
+	}
+	for _, v := range added {
+		w.line("+", field, v)
+	}
+}
+
+func (w *diffWriter) change(field string, v [2]string) {
+	fmt.Fprintf(w, "~ %s: %q -> %q\n", field, v[0], v[1])
+}
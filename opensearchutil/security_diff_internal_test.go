@@ -0,0 +1,110 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+//go:build !integration
+// +build !integration
+
+package opensearchutil
+
+import (
+	"testing"
+
+	"github.com/alphastrikelabs/opensearch-go/v2/opensearchapi"
+)
+
+func TestDiffRole(t *testing.T) {
+	current := opensearchapi.RoleBody{
+		ClusterPermissions: []string{"cluster_monitor", "cluster_composite_ops"},
+		IndexPermissions: []opensearchapi.RoleIndexPermission{
+			{IndexPatterns: []string{"logs-*", "metrics-*"}, AllowedActions: []string{"read", "search"}},
+			{IndexPatterns: []string{"audit-*"}, AllowedActions: []string{"read"}},
+		},
+		TenantPermissions: []opensearchapi.RoleTenantPermission{{TenantPatterns: []string{"global"}, AllowedActions: []string{"kibana_all_read"}}},
+	}
+
+	t.Run("Equivalent", func(t *testing.T) {
+		desired := opensearchapi.RoleBody{
+			ClusterPermissions: []string{"cluster_composite_ops", "cluster_monitor", "cluster_monitor"},
+			IndexPermissions: []opensearchapi.RoleIndexPermission{
+				{IndexPatterns: []string{"audit-*"}, AllowedActions: []string{"read"}},
+				{IndexPatterns: []string{"metrics-*", "logs-*"}, AllowedActions: []string{"search", "read"}},
+			},
+			TenantPermissions: current.TenantPermissions,
+		}
+
+		if d := DiffRole(current, desired); !d.IsEmpty() {
+			t.Errorf("Expected no changes, got:\n%s", d)
+		}
+	})
+
+	t.Run("Changes", func(t *testing.T) {
+		desired := opensearchapi.RoleBody{
+			Description:        "Log readers",
+			ClusterPermissions: []string{"cluster_monitor", "indices_monitor"},
+			IndexPermissions: []opensearchapi.RoleIndexPermission{
+				{IndexPatterns: []string{"logs-*", "metrics-*"}, AllowedActions: []string{"read", "search"}},
+				{IndexPatterns: []string{"audit-*"}, AllowedActions: []string{"read"}, MaskedFields: []string{"user.ip"}},
+			},
+		}
+
+		d := DiffRole(current, desired)
+		if d.IsEmpty() {
+			t.Fatalf("Expected changes")
+		}
+
+		want := `~ description: "" -> "Log readers"
+- cluster_permissions: cluster_composite_ops
++ cluster_permissions: indices_monitor
+- index_permissions: {"index_patterns":["audit-*"],"allowed_actions":["read"]}
++ index_permissions: {"index_patterns":["audit-*"],"masked_fields":["user.ip"],"allowed_actions":["read"]}
+- tenant_permissions: {"tenant_patterns":["global"],"allowed_actions":["kibana_all_read"]}
+`
+		if d.String() != want {
+			t.Errorf("Unexpected diff:\n%s", d)
+		}
+	})
+}
+
+func TestDiffRoleMapping(t *testing.T) {
+	current := opensearchapi.RoleMappingBody{BackendRoles: []string{"ops", "dev"}, Users: []string{"alice"}}
+	desired := opensearchapi.RoleMappingBody{BackendRoles: []string{"dev", "sre"}, Users: []string{"alice"}, Hosts: []string{"*.example.com"}}
+
+	d := DiffRoleMapping(current, desired)
+
+	want := `- backend_roles: ops
++ backend_roles: sre
++ hosts: *.example.com
+`
+	if d.String() != want {
+		t.Errorf("Unexpected diff:\n%s", d)
+	}
+	if !DiffRoleMapping(current, opensearchapi.RoleMappingBody{BackendRoles: []string{"dev", "ops"}, Users: []string{"alice"}}).IsEmpty() {
+		t.Errorf("Expected no changes")
+	}
+}
+
+func TestDiffInternalUser(t *testing.T) {
+	current := opensearchapi.InternalUserBody{BackendRoles: []string{"ingest"}, Attributes: map[string]string{"team": "logs", "env": "prod"}}
+	desired := opensearchapi.InternalUserBody{Password: "s3cr3t!", BackendRoles: []string{"ingest", "search"}, Attributes: map[string]string{"team": "metrics", "owner": "sre"}}
+
+	d := DiffInternalUser(current, desired)
+
+	want := `~ password
++ backend_roles: search
+- attributes: env=prod
++ attributes: owner=sre
+~ attributes.team: "logs" -> "metrics"
+`
+	if d.String() != want {
+		t.Errorf("Unexpected diff:\n%s", d)
+	}
+	if !DiffInternalUser(current, opensearchapi.InternalUserBody{BackendRoles: []string{"ingest"}, Attributes: map[string]string{"env": "prod", "team": "logs"}}).IsEmpty() {
+		t.Errorf("Expected no changes")
+	}
+}
@@ -0,0 +1,92 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchutil
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/alphastrikelabs/opensearch-go/v2/opensearchapi"
+)
+
+// setFieldScript assigns params.value to the field named by params.field.
+// The field name and value are never interpolated into the script source.
+const setFieldScript = "ctx._source[params.field] = params.value"
+
+type setFieldByQueryBody struct {
+	Query  interface{}    `json:"query,omitempty"`
+	Script painlessScript `json:"script"`
+}
+
+type painlessScript struct {
+	Source string                 `json:"source"`
+	Lang   string                 `json:"lang"`
+	Params map[string]interface{} `json:"params"`
+}
+
+// SetFieldByQuery sets field to value on all documents in index matching query,
+// using the Update By Query API with a painless script.
+//
+// The query is the value of the "query" clause, eg. map[string]interface{}{"match_all": struct{}{}};
+// when nil, all documents are updated. The field name and value are passed to the script
+// as parameters, not concatenated into its source.
+//
+// Version conflicts don't abort the operation (conflicts=proceed): the documents changed
+// while the operation runs are skipped, and counted in the version_conflicts of the task result.
+//
+// The operation runs asynchronously and the task ID is returned; use the Tasks API to track it.
+func SetFieldByQuery(
+	ctx context.Context,
+	client opensearchapi.Transport,
+	index []string,
+	query interface{},
+	field string,
+	value interface{},
+) (string, error) {
+	if field == "" {
+		return "", errors.New("set field by query: field is required")
+	}
+
+	body := setFieldByQueryBody{
+		Query: query,
+		Script: painlessScript{
+			Source: setFieldScript,
+			Lang:   "painless",
+			Params: map[string]interface{}{"field": field, "value": value},
+		},
+	}
+
+	req := opensearchapi.UpdateByQueryRequest{
+		Index:             index,
+		Body:              NewJSONReader(body),
+		Conflicts:         "proceed",
+		WaitForCompletion: opensearchapi.BoolPtr(false),
+	}
+
+	res, err := req.Do(ctx, client)
+	if err != nil {
+		return "", fmt.Errorf("set field by query: %s", err)
+	}
+	defer res.Body.Close()
+
+	if err := res.Err(); err != nil {
+		return "", fmt.Errorf("set field by query: %w", err)
+	}
+
+	var task struct {
+		Task string `json:"task"`
+	}
+	if err := decodeBody(client, res.Body, &task); err != nil {
+		return "", fmt.Errorf("set field by query: error parsing response body: %s", err)
+	}
+
+	return task.Task, nil
+}
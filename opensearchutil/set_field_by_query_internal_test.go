@@ -0,0 +1,106 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+//go:build !integration
+// +build !integration
+
+package opensearchutil
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/alphastrikelabs/opensearch-go/v2"
+)
+
+func TestSetFieldByQuery(t *testing.T) {
+	t.Run("Params", func(t *testing.T) {
+		var (
+			body   map[string]interface{}
+			method string
+			path   string
+			query  string
+		)
+
+		client, _ := opensearch.NewClient(opensearch.Config{Transport: &mockTransport{
+			RoundTripFunc: func(req *http.Request) (*http.Response, error) {
+				method, path, query = req.Method, req.URL.Path, req.URL.RawQuery
+				if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+					t.Fatalf("Unexpected error: %s", err)
+				}
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Body:       ioutil.NopCloser(strings.NewReader(`{"task":"node-1:42"}`)),
+				}, nil
+			},
+		}})
+
+		field := `tag"]; ctx.op = "delete"; //`
+		task, err := SetFieldByQuery(context.Background(), client, []string{"test"},
+			map[string]interface{}{"term": map[string]string{"user": "foo"}}, field, "bar")
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+
+		if task != "node-1:42" {
+			t.Errorf("Unexpected task: %s", task)
+		}
+		if method != "POST" || path != "/test/_update_by_query" {
+			t.Errorf("Unexpected request: %s %s", method, path)
+		}
+		if !strings.Contains(query, "wait_for_completion=false") {
+			t.Errorf("Expected wait_for_completion=false in query: %s", query)
+		}
+		if !strings.Contains(query, "conflicts=proceed") {
+			t.Errorf("Expected conflicts=proceed in query: %s", query)
+		}
+
+		script := body["script"].(map[string]interface{})
+		if script["source"] != setFieldScript {
+			t.Errorf("Unexpected script source: %s", script["source"])
+		}
+		if strings.Contains(script["source"].(string), field) {
+			t.Errorf("Field name must not be interpolated into the script source")
+		}
+
+		params := script["params"].(map[string]interface{})
+		if params["field"] != field || params["value"] != "bar" {
+			t.Errorf("Unexpected script params: %v", params)
+		}
+
+		if _, ok := body["query"].(map[string]interface{})["term"]; !ok {
+			t.Errorf("Unexpected query: %v", body["query"])
+		}
+	})
+
+	t.Run("Missing field", func(t *testing.T) {
+		client, _ := opensearch.NewClient(opensearch.Config{Transport: &mockTransport{}})
+		if _, err := SetFieldByQuery(context.Background(), client, []string{"test"}, nil, "", "bar"); err == nil {
+			t.Errorf("Expected error for empty field")
+		}
+	})
+
+	t.Run("Error response", func(t *testing.T) {
+		client, _ := opensearch.NewClient(opensearch.Config{Transport: &mockTransport{
+			RoundTripFunc: func(req *http.Request) (*http.Response, error) {
+				return &http.Response{
+					StatusCode: http.StatusNotFound,
+					Body:       ioutil.NopCloser(strings.NewReader(`{"error":{"type":"index_not_found_exception","reason":"no such index [test]"},"status":404}`)),
+				}, nil
+			},
+		}})
+		if _, err := SetFieldByQuery(context.Background(), client, []string{"test"}, nil, "tag", "bar"); err == nil {
+			t.Errorf("Expected error for 404 response")
+		}
+	})
+}
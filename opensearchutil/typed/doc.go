@@ -0,0 +1,35 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+/*
+Package typed provides generic helpers to decode the API responses into typed structs.
+
+The package requires Go 1.21 or later: it uses type parameters, which the go directive of the module
+(go 1.15) doesn't allow, and Go 1.21 is the first version to enable them per file with the go1.21
+build constraint. It is kept apart from the opensearchutil package, so the client can still be built
+with the earlier versions.
+
+	type Movie struct {
+		Title string `json:"title"`
+		Year  int    `json:"year"`
+	}
+
+	res, err := client.Search(client.Search.WithIndex("movies"))
+	if err != nil {
+		log.Fatal(err)
+	}
+	sr, err := typed.DecodeSearchResponse[Movie](res)
+	if err != nil {
+		log.Fatal(err)
+	}
+	for _, hit := range sr.Hits.Hits {
+		fmt.Println(hit.ID, hit.Source.Title)
+	}
+*/
+package typed
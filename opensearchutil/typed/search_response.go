@@ -0,0 +1,79 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+//go:build go1.21
+// +build go1.21
+
+package typed
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/alphastrikelabs/opensearch-go/v2/opensearchapi"
+)
+
+// SearchResponse represents the response of the Search API, with the document sources decoded into T.
+type SearchResponse[T any] struct {
+	Took         int64                      `json:"took"`
+	TimedOut     bool                       `json:"timed_out"`
+	Shards       SearchShards               `json:"_shards"`
+	Hits         SearchHits[T]              `json:"hits"`
+	Aggregations map[string]json.RawMessage `json:"aggregations,omitempty"`
+	ScrollID     string                     `json:"_scroll_id,omitempty"`
+	PitID        string                     `json:"pit_id,omitempty"`
+}
+
+// SearchShards represents the shard summary of a search response, see opensearchapi.SearchResponseShards.
+type SearchShards = opensearchapi.SearchResponseShards
+
+// SearchHits represents the hits of a search response.
+type SearchHits[T any] struct {
+	Total    SearchTotal `json:"total"`
+	MaxScore *float64    `json:"max_score"`
+	Hits     []Hit[T]    `json:"hits"`
+}
+
+// Hit represents a search hit, with the document source decoded into T.
+type Hit[T any] struct {
+	Index     string                     `json:"_index"`
+	ID        string                     `json:"_id"`
+	Score     *float64                   `json:"_score"`
+	Routing   string                     `json:"_routing,omitempty"`
+	Source    T                          `json:"_source"`
+	Fields    map[string]json.RawMessage `json:"fields,omitempty"`
+	Highlight map[string][]string        `json:"highlight,omitempty"`
+	Sort      []json.RawMessage          `json:"sort,omitempty"`
+}
+
+// SearchTotal represents the total number of hits matching the query, see opensearchapi.SearchResponseTotal.
+//
+// It is decoded from both the object format and the number format returned with rest_total_hits_as_int.
+type SearchTotal = opensearchapi.SearchResponseTotal
+
+// DecodeSearchResponse decodes the body of the search response, and closes it.
+//
+// The error of the response is returned when its status indicates a failure.
+func DecodeSearchResponse[T any](res *opensearchapi.Response) (*SearchResponse[T], error) {
+	if res == nil || res.Body == nil {
+		return nil, fmt.Errorf("decode search response: empty response")
+	}
+	defer res.Close()
+
+	if err := res.Err(); err != nil {
+		return nil, err
+	}
+
+	var sr SearchResponse[T]
+	if err := json.NewDecoder(res.Body).Decode(&sr); err != nil {
+		return nil, fmt.Errorf("decode search response: %s", err)
+	}
+
+	return &sr, nil
+}
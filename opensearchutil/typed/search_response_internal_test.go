@@ -0,0 +1,95 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+//go:build !integration && go1.21
+// +build !integration,go1.21
+
+package typed
+
+import (
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/alphastrikelabs/opensearch-go/v2/opensearchapi"
+)
+
+type movie struct {
+	Title string `json:"title"`
+	Year  int    `json:"year"`
+}
+
+func TestDecodeSearchResponse(t *testing.T) {
+	newResponse := func(status int, body string) *opensearchapi.Response {
+		return &opensearchapi.Response{StatusCode: status, Body: ioutil.NopCloser(strings.NewReader(body))}
+	}
+
+	t.Run("Total object", func(t *testing.T) {
+		res := newResponse(http.StatusOK, `{"took":3,"timed_out":false,"_shards":{"total":1,"successful":1,"skipped":0,"failed":0},
+			"hits":{"total":{"value":12,"relation":"gte"},"max_score":1.5,
+			"hits":[{"_index":"movies","_id":"1","_score":1.5,"_source":{"title":"Tenet","year":2020}}]}}`)
+
+		sr, err := DecodeSearchResponse[movie](res)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if sr.Took != 3 || sr.TimedOut || sr.Shards.Successful != 1 {
+			t.Errorf("Unexpected response: %+v", sr)
+		}
+		if sr.Hits.Total.Value != 12 || sr.Hits.Total.Relation != "gte" {
+			t.Errorf("Unexpected total: %+v", sr.Hits.Total)
+		}
+		if len(sr.Hits.Hits) != 1 {
+			t.Fatalf("Unexpected hits: %+v", sr.Hits.Hits)
+		}
+		hit := sr.Hits.Hits[0]
+		if hit.Index != "movies" || hit.ID != "1" || hit.Source.Title != "Tenet" || hit.Source.Year != 2020 {
+			t.Errorf("Unexpected hit: %+v", hit)
+		}
+	})
+
+	t.Run("Total number", func(t *testing.T) {
+		res := newResponse(http.StatusOK, `{"took":1,"hits":{"total":7,"hits":[]}}`)
+
+		sr, err := DecodeSearchResponse[movie](res)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if sr.Hits.Total.Value != 7 || sr.Hits.Total.Relation != "eq" {
+			t.Errorf("Unexpected total: %+v", sr.Hits.Total)
+		}
+	})
+
+	t.Run("Total missing", func(t *testing.T) {
+		res := newResponse(http.StatusOK, `{"took":1,"hits":{"max_score":null,"hits":[{"_id":"1","_score":null,"_source":{"title":"Dune"}}]}}`)
+
+		sr, err := DecodeSearchResponse[movie](res)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if sr.Hits.Total.Value != 0 || sr.Hits.MaxScore != nil || sr.Hits.Hits[0].Score != nil {
+			t.Errorf("Unexpected hits: %+v", sr.Hits)
+		}
+	})
+
+	t.Run("Error response", func(t *testing.T) {
+		res := newResponse(http.StatusNotFound, `{"error":{"type":"index_not_found_exception","reason":"no such index [movies]"},"status":404}`)
+
+		if _, err := DecodeSearchResponse[movie](res); err == nil {
+			t.Errorf("Expected error for the error response")
+		}
+	})
+
+	t.Run("Invalid body", func(t *testing.T) {
+		if _, err := DecodeSearchResponse[movie](newResponse(http.StatusOK, `{"hits":{"total":"many"}}`)); err == nil {
+			t.Errorf("Expected error for the invalid total")
+		}
+	})
+}
@@ -0,0 +1,128 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+// Package yamlutil decodes the YAML responses of the APIs, requested with the WithAccept option:
+//
+//	res, err := client.Role.GetRole(client.Role.GetRole.WithAccept(yamlutil.ContentType))
+//	// ...
+//	var roles map[string]opensearchapi.RoleBody
+//	err = yamlutil.DecodeResponse(res, &roles)
+//
+// It's a separate package to keep the YAML dependency out of the client.
+package yamlutil
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"mime"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/alphastrikelabs/opensearch-go/v2/opensearchapi"
+)
+
+// ContentType is the media type of the YAML responses, to set with the WithAccept option.
+const ContentType = "application/yaml"
+
+// IsYAML returns whether the content type of the response is YAML.
+func IsYAML(res *opensearchapi.Response) bool {
+	if res == nil {
+		return false
+	}
+	t, _, _ := mime.ParseMediaType(res.Header.Get("Content-Type"))
+	return t == ContentType || t == "application/x-yaml" || t == "text/yaml"
+}
+
+// DecodeResponse decodes the body of the response into v and closes it, as YAML or JSON
+// according to its content type. The YAML documents are decoded with the JSON field names,
+// so v can be one of the types of the opensearchapi package.
+func DecodeResponse(res *opensearchapi.Response, v interface{}) error {
+	if res == nil || res.Body == nil {
+		return errors.New("cannot decode response: empty response")
+	}
+	defer res.Close()
+
+	if err := res.Err(); err != nil {
+		return err
+	}
+
+	b, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return fmt.Errorf("cannot decode response: %s", err)
+	}
+
+	if IsYAML(res) {
+		if b, err = ToJSON(b); err != nil {
+			return fmt.Errorf("cannot decode response: %s", err)
+		}
+	}
+	if err := json.Unmarshal(b, v); err != nil {
+		return fmt.Errorf("cannot decode response: %s", err)
+	}
+	return nil
+}
+
+// ToJSON converts the YAML document to JSON.
+func ToJSON(b []byte) ([]byte, error) {
+	var v interface{}
+	if err := yaml.Unmarshal(b, &v); err != nil {
+		return nil, err
+	}
+
+	v, err := jsonValue(v)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(v)
+}
+
+// jsonValue converts the maps decoded from YAML, which keys can be of any type, to maps with string keys.
+func jsonValue(v interface{}) (interface{}, error) {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, e := range v {
+			e, err := jsonValue(e)
+			if err != nil {
+				return nil, err
+			}
+			v[k] = e
+		}
+		return v, nil
+	case map[interface{}]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for k, e := range v {
+			e, err := jsonValue(e)
+			if err != nil {
+				return nil, err
+			}
+			switch k := k.(type) {
+			case string:
+				m[k] = e
+			case int, bool, float64:
+				m[fmt.Sprint(k)] = e
+			default:
+				return nil, fmt.Errorf("unsupported key of type %T", k)
+			}
+		}
+		return m, nil
+	case []interface{}:
+		for i, e := range v {
+			e, err := jsonValue(e)
+			if err != nil {
+				return nil, err
+			}
+			v[i] = e
+		}
+		return v, nil
+	default:
+		return v, nil
+	}
+}
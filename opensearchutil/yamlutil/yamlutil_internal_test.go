@@ -0,0 +1,101 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+//go:build !integration
+// +build !integration
+
+package yamlutil
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/alphastrikelabs/opensearch-go/v2/opensearchapi"
+)
+
+func TestDecodeResponse(t *testing.T) {
+	newResponse := func(status int, contentType, body string) *opensearchapi.Response {
+		return &opensearchapi.Response{
+			StatusCode: status,
+			Header:     http.Header{"Content-Type": []string{contentType}},
+			Body:       ioutil.NopCloser(strings.NewReader(body)),
+		}
+	}
+
+	want := map[string]opensearchapi.RoleBody{
+		"logs_reader": {
+			ClusterPermissions: []string{"cluster_monitor"},
+			IndexPermissions: []opensearchapi.RoleIndexPermission{
+				{IndexPatterns: []string{"logs-*"}, AllowedActions: []string{"read"}},
+			},
+		},
+	}
+
+	t.Run("YAML", func(t *testing.T) {
+		res := newResponse(200, "application/yaml; charset=UTF-8", `---
+logs_reader:
+  cluster_permissions:
+  - "cluster_monitor"
+  index_permissions:
+  - index_patterns:
+    - "logs-*"
+    allowed_actions:
+    - "read"
+`)
+		if !IsYAML(res) {
+			t.Errorf("Expected the response to be YAML")
+		}
+
+		var roles map[string]opensearchapi.RoleBody
+		if err := DecodeResponse(res, &roles); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if !reflect.DeepEqual(roles, want) {
+			t.Errorf("Unexpected roles: %+v", roles)
+		}
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		res := newResponse(200, "application/json; charset=UTF-8",
+			`{"logs_reader":{"cluster_permissions":["cluster_monitor"],"index_permissions":[{"index_patterns":["logs-*"],"allowed_actions":["read"]}]}}`)
+		if IsYAML(res) {
+			t.Errorf("Unexpected YAML response")
+		}
+
+		var roles map[string]opensearchapi.RoleBody
+		if err := DecodeResponse(res, &roles); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if !reflect.DeepEqual(roles, want) {
+			t.Errorf("Unexpected roles: %+v", roles)
+		}
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		var v interface{}
+		err := DecodeResponse(newResponse(404, "application/yaml", "status: \"NOT_FOUND\"\n"), &v)
+		if !errors.Is(err, opensearchapi.ErrNotFound) {
+			t.Errorf("Expected ErrNotFound, got: %v", err)
+		}
+	})
+
+	t.Run("Non-string keys", func(t *testing.T) {
+		b, err := ToJSON([]byte("1: one\ntrue: yes\n"))
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if string(b) != `{"1":"one","true":"yes"}` {
+			t.Errorf("Unexpected JSON: %s", b)
+		}
+	})
+}